@@ -2,7 +2,9 @@ package analysis
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -13,10 +15,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/metricproviders"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
@@ -67,6 +73,12 @@ func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alph
 	}
 
 	err = analysisutil.ValidateMetrics(resolvedMetrics)
+	if err == nil {
+		err = analysisutil.ValidateCompositeConditions(run.Spec.CompositeConditions, resolvedMetrics)
+	}
+	if err == nil {
+		err = analysisutil.ValidateSuccessWeightThreshold(run.Spec.SuccessWeightThreshold, run.Spec.CompositeConditions)
+	}
 	if err != nil {
 		message := fmt.Sprintf("Analysis spec invalid: %v", err)
 		logger.Warn(message)
@@ -308,6 +320,25 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 	return tasks, secrets, nil
 }
 
+// runMeasurement takes a new measurement of the metric, which is shared with the other AnalysisRuns when the
+// measurement cache is enabled, and is rescheduled when the metric provider is throttled
+func (c *Controller) runMeasurement(run *v1alpha1.AnalysisRun, provider metric.Provider, metric v1alpha1.Metric) v1alpha1.Measurement {
+	measure := func() v1alpha1.Measurement {
+		return provider.Run(run, metric)
+	}
+	if c.providerThrottle != nil {
+		measure = func() v1alpha1.Measurement {
+			return c.providerThrottle.Measure(metricproviders.Type(metric), metric, func() v1alpha1.Measurement {
+				return provider.Run(run, metric)
+			})
+		}
+	}
+	if c.measurementCache == nil {
+		return measure()
+	}
+	return c.measurementCache.Measure(run, provider.Type(), metric, measure)
+}
+
 // runMeasurements iterates a list of metric tasks, and runs, resumes, or terminates measurements
 func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTask, dryRunMetricsMap map[string]bool) error {
 	var wg sync.WaitGroup
@@ -332,6 +363,10 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 			logger := logutil.WithRedactor(*logutil.WithAnalysisRun(run).WithField("metric", t.metric.Name), secrets)
 
 			var newMeasurement v1alpha1.Measurement
+			// a throttled measurement was never taken, so it is taken again rather than resumed, and is discarded
+			// when the run terminates
+			throttled := t.incompleteMeasurement != nil && metricutil.IsMeasurementThrottled(*t.incompleteMeasurement)
+			discarded := false
 			provider, providerErr := c.newProvider(*logger, run.Namespace, t.metric)
 			if providerErr != nil {
 				log.Errorf("Error in getting metric provider :%v", providerErr)
@@ -344,8 +379,11 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				newMeasurement.Phase = v1alpha1.AnalysisPhaseError
 				newMeasurement.Message = providerErr.Error()
 			} else {
-				if t.incompleteMeasurement == nil {
-					newMeasurement = provider.Run(run, t.metric)
+				if t.incompleteMeasurement == nil || (throttled && !terminating) {
+					newMeasurement = c.runMeasurement(run, provider, t.metric)
+				} else if throttled {
+					logger.Infof("Discarding throttled measurement: run is terminating")
+					discarded = true
 				} else {
 					// metric is incomplete. either terminate or resume it
 					if terminating {
@@ -365,9 +403,10 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 			resultsLock.Unlock()
 			if metricResult == nil {
 				metricResult = &v1alpha1.MetricResult{
-					Name:   t.metric.Name,
-					Phase:  v1alpha1.AnalysisPhaseRunning,
-					DryRun: dryRunMetricsMap[t.metric.Name],
+					Name:     t.metric.Name,
+					Phase:    v1alpha1.AnalysisPhaseRunning,
+					DryRun:   dryRunMetricsMap[t.metric.Name],
+					Advisory: t.metric.Advisory,
 				}
 
 				if provider != nil && providerErr == nil {
@@ -415,6 +454,8 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 
 			if t.incompleteMeasurement == nil {
 				metricResult.Measurements = append(metricResult.Measurements, newMeasurement)
+			} else if discarded {
+				metricResult.Measurements = metricResult.Measurements[:len(metricResult.Measurements)-1]
 			} else {
 				metricResult.Measurements[len(metricResult.Measurements)-1] = newMeasurement
 			}
@@ -462,14 +503,20 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 		Inconclusive: 0,
 		Error:        0,
 	}
+	advisorySummary := v1alpha1.RunSummary{}
 
 	// Iterate all metrics and update `MetricResult.Phase` fields based on latest measurement(s)
 	for _, metric := range metrics {
+		// Advisory metrics are assessed like dry-run metrics, but have their own summary
+		advisory := metric.Advisory && !dryRunMetricsMap[metric.Name]
 		if dryRunMetricsMap[metric.Name] {
 			log.Infof("Metric '%s' is running in the Dry-Run mode.", metric.Name)
 			dryRunSummary.Count++
+		} else if advisory {
+			advisorySummary.Count++
 		} else {
 			runSummary.Count++
+			runSummary.Weight += metric.EffectiveWeight()
 		}
 		if result := analysisutil.GetResult(run, metric.Name); result != nil {
 			logger := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
@@ -482,7 +529,11 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 					case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
 						eventType = corev1.EventTypeWarning
 					}
-					c.recorder.Eventf(run, record.EventOptions{EventType: eventType, EventReason: "Metric" + string(metricStatus)}, "Metric '%s' Completed. Result: %s", metric.Name, metricStatus)
+					if advisory {
+						c.recorder.Eventf(run, record.EventOptions{EventType: eventType, EventReason: "Metric" + string(metricStatus)}, "Advisory metric '%s' Completed. Result: %s", metric.Name, metricStatus)
+					} else {
+						c.recorder.Eventf(run, record.EventOptions{EventType: eventType, EventReason: "Metric" + string(metricStatus)}, "Metric '%s' Completed. Result: %s", metric.Name, metricStatus)
+					}
 				}
 				if lastMeasurement := analysisutil.LastMeasurement(run, metric.Name); lastMeasurement != nil {
 					result.Message = lastMeasurement.Message
@@ -495,9 +546,9 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 				everythingCompleted = false
 			} else {
 				phase, message := assessMetricFailureInconclusiveOrError(metric, *result)
-				// NOTE: We don't care about the status if the metric is marked as a Dry-Run
+				// NOTE: We don't care about the status if the metric is marked as a Dry-Run or is advisory
 				// otherwise, remember the worst status of all completed metric results
-				if !dryRunMetricsMap[metric.Name] {
+				if !dryRunMetricsMap[metric.Name] && !advisory {
 					if worstStatus == "" || analysisutil.IsWorse(worstStatus, metricStatus) {
 						worstStatus = metricStatus
 						if message != "" {
@@ -517,13 +568,15 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 						runSummary.Inconclusive++
 					case v1alpha1.AnalysisPhaseSuccessful:
 						runSummary.Successful++
+						runSummary.SuccessfulWeight += metric.EffectiveWeight()
 					default:
 						// We'll mark the status as success by default if it doesn't match anything.
 						runSummary.Successful++
+						runSummary.SuccessfulWeight += metric.EffectiveWeight()
 					}
 				} else {
-					// We don't really care about the failures from dry-runs and hence, if there is no current status
-					// found then we just set it to `AnalysisPhaseSuccessful`
+					// We don't really care about the failures from dry-runs and advisory metrics and hence, if there
+					// is no current status found then we just set it to `AnalysisPhaseSuccessful`
 					if worstStatus == "" {
 						worstStatus = v1alpha1.AnalysisPhaseSuccessful
 					}
@@ -532,19 +585,23 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 						result.Message = fmt.Sprintf("Metric assessed %s due to %s", metricStatus, message)
 						analysisutil.SetResult(run, *result)
 					}
-					// Update DryRun Summary
+					// Update DryRun or Advisory Summary
+					summary := &dryRunSummary
+					if advisory {
+						summary = &advisorySummary
+					}
 					switch phase {
 					case v1alpha1.AnalysisPhaseError:
-						dryRunSummary.Error++
+						summary.Error++
 					case v1alpha1.AnalysisPhaseFailed:
-						dryRunSummary.Failed++
+						summary.Failed++
 					case v1alpha1.AnalysisPhaseInconclusive:
-						dryRunSummary.Inconclusive++
+						summary.Inconclusive++
 					case v1alpha1.AnalysisPhaseSuccessful:
-						dryRunSummary.Successful++
+						summary.Successful++
 					default:
 						// We'll mark the status as success by default if it doesn't match anything.
-						dryRunSummary.Successful++
+						summary.Successful++
 					}
 				}
 			}
@@ -557,6 +614,27 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 	worstMessage = strings.TrimSpace(worstMessage)
 	run.Status.RunSummary = runSummary
 	run.Status.DryRunSummary = &dryRunSummary
+	if advisorySummary.Count > 0 {
+		run.Status.AdvisorySummary = &advisorySummary
+	} else {
+		run.Status.AdvisorySummary = nil
+	}
+	if run.Spec.CompositeConditions != nil || run.Spec.SuccessWeightThreshold != nil {
+		if run.Spec.Terminate && worstStatus == "" {
+			// we have yet to take a single measurement, but have already been instructed to stop
+			log.Infof(SuccessfulAssessmentRunTerminatedResult)
+			return v1alpha1.AnalysisPhaseSuccessful, worstMessage
+		}
+		if !everythingCompleted && !run.Spec.Terminate {
+			return v1alpha1.AnalysisPhaseRunning, ""
+		}
+		switch {
+		case run.Spec.CompositeConditions != nil:
+			return assessCompositeConditions(run, metrics)
+		default:
+			return assessSuccessWeightThreshold(run, worstStatus, worstMessage)
+		}
+	}
 	if terminating {
 		if worstStatus == "" {
 			// we have yet to take a single measurement, but have already been instructed to stop
@@ -572,6 +650,107 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 	return worstStatus, worstMessage
 }
 
+// assessCompositeConditions assesses the run from the composite conditions over the results of all its metrics
+func assessCompositeConditions(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric) (v1alpha1.AnalysisPhase, string) {
+	phase, err := evaluate.EvaluateCompositeResult(compositeResults(run, metrics), *run.Spec.CompositeConditions)
+	if err != nil {
+		log.Infof("Composite Conditions Assessment Result - %s: %v", v1alpha1.AnalysisPhaseError, err)
+		return v1alpha1.AnalysisPhaseError, err.Error()
+	}
+	log.Infof("Composite Conditions Assessment Result - %s", phase)
+	if phase == v1alpha1.AnalysisPhaseSuccessful {
+		if run.Spec.Terminate {
+			return phase, "Run Terminated"
+		}
+		return phase, ""
+	}
+	return phase, fmt.Sprintf("Composite conditions assessed %s", phase)
+}
+
+// assessSuccessWeightThreshold assesses the run from the weighted share of its successful required metrics. The run
+// is successful when the share reaches the success weight threshold, and otherwise takes the worst status of its
+// metrics.
+func assessSuccessWeightThreshold(run *v1alpha1.AnalysisRun, worstStatus v1alpha1.AnalysisPhase, worstMessage string) (v1alpha1.AnalysisPhase, string) {
+	threshold := *run.Spec.SuccessWeightThreshold
+	share := int32(100)
+	if summary := run.Status.RunSummary; summary.Weight > 0 {
+		share = summary.SuccessfulWeight * 100 / summary.Weight
+	}
+	log.Infof("Success Weight Threshold Assessment Result - %d%% of %d%%", share, threshold)
+	if share >= threshold {
+		if worstStatus == "" || worstStatus == v1alpha1.AnalysisPhaseSuccessful {
+			return v1alpha1.AnalysisPhaseSuccessful, worstMessage
+		}
+		return v1alpha1.AnalysisPhaseSuccessful, fmt.Sprintf("Weighted share of successful metrics (%d%%) reached successWeightThreshold (%d%%)", share, threshold)
+	}
+	if worstStatus == v1alpha1.AnalysisPhaseSuccessful {
+		// the run was terminated before all the metrics completed
+		return worstStatus, worstMessage
+	}
+	message := fmt.Sprintf("Weighted share of successful metrics (%d%%) below successWeightThreshold (%d%%)", share, threshold)
+	if worstMessage != "" {
+		message += ": " + worstMessage
+	}
+	return worstStatus, message
+}
+
+// compositeResults returns the results of the metrics, keyed by metric name, which the composite conditions are
+// evaluated over
+func compositeResults(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric) map[string]any {
+	results := make(map[string]any, len(metrics))
+	for _, metric := range metrics {
+		result := map[string]any{
+			"phase":              string(v1alpha1.AnalysisPhasePending),
+			"value":              nil,
+			"count":              0,
+			"successful":         0,
+			"failed":             0,
+			"inconclusive":       0,
+			"error":              0,
+			"consecutiveError":   0,
+			"consecutiveSuccess": 0,
+		}
+		if metricResult := analysisutil.GetResult(run, metric.Name); metricResult != nil {
+			result["phase"] = string(metricResult.Phase)
+			result["count"] = int(metricResult.Count)
+			result["successful"] = int(metricResult.Successful)
+			result["failed"] = int(metricResult.Failed)
+			result["inconclusive"] = int(metricResult.Inconclusive)
+			result["error"] = int(metricResult.Error)
+			result["consecutiveError"] = int(metricResult.ConsecutiveError)
+			result["consecutiveSuccess"] = int(metricResult.ConsecutiveSuccess)
+			for i := len(metricResult.Measurements) - 1; i >= 0; i-- {
+				measurement := metricResult.Measurements[i]
+				if measurement.Phase.Completed() && measurement.Phase != v1alpha1.AnalysisPhaseError {
+					result["value"] = parseMeasurementValue(measurement.Value)
+					break
+				}
+			}
+		}
+		results[metric.Name] = result
+	}
+	return results
+}
+
+// parseMeasurementValue parses the value of a measurement as a number, or as JSON. A single element array, such as
+// a Prometheus vector with a single sample, is parsed as its element.
+func parseMeasurementValue(value string) any {
+	if value == "" {
+		return nil
+	}
+	if number, err := strconv.ParseFloat(value, 64); err == nil {
+		return number
+	}
+	var parsed any
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return value
+	}
+	if array, ok := parsed.([]any); ok && len(array) == 1 {
+		return array[0]
+	}
+	return parsed
+}
+
 // assessMetricStatus assesses the status of a single metric based on:
 // * current or latest measurement status
 // * parameters given by the metric (failureLimit, count, etc...)
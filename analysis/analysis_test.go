@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -22,11 +23,15 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	k8stesting "k8s.io/client-go/testing"
+	k8srecord "k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	"github.com/argoproj/argo-rollouts/utils/record"
 )
 
 func timePtr(t metav1.Time) *metav1.Time {
@@ -480,6 +485,214 @@ func TestAssessRunStatus(t *testing.T) {
 	}
 }
 
+func newCompositeRun(latency string, successRatePhase v1alpha1.AnalysisPhase) *v1alpha1.AnalysisRun {
+	latencyMeasurement := newMeasurement(v1alpha1.AnalysisPhaseSuccessful)
+	latencyMeasurement.Value = latency
+	failed := int32(0)
+	if successRatePhase == v1alpha1.AnalysisPhaseFailed {
+		failed = 1
+	}
+	return &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{Name: "latency"}, {Name: "success-rate"}},
+			CompositeConditions: &v1alpha1.CompositeConditions{
+				SuccessCondition: `metrics["success-rate"].phase == Successful || latency.value < 300`,
+			},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{
+				{
+					Name:         "latency",
+					Phase:        v1alpha1.AnalysisPhaseSuccessful,
+					Count:        1,
+					Successful:   1,
+					Measurements: []v1alpha1.Measurement{latencyMeasurement},
+				},
+				{
+					Name:         "success-rate",
+					Phase:        successRatePhase,
+					Count:        failed,
+					Failed:       failed,
+					Measurements: []v1alpha1.Measurement{newMeasurement(successRatePhase)},
+				},
+			},
+		},
+	}
+}
+
+// TestAssessRunStatusCompositeConditions ensures the composite conditions assess the run instead of the worst of
+// the completed metrics
+func TestAssessRunStatusCompositeConditions(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := newCompositeRun("[250]", v1alpha1.AnalysisPhaseFailed)
+	assert.False(t, analysisutil.IsTerminating(run))
+	status, message := c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+	assert.Equal(t, "", message)
+	assert.Equal(t, int32(1), run.Status.RunSummary.Failed)
+
+	run = newCompositeRun("[400]", v1alpha1.AnalysisPhaseFailed)
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+	assert.Equal(t, "Composite conditions assessed Failed", message)
+
+	run = newCompositeRun("[400]", v1alpha1.AnalysisPhaseSuccessful)
+	status, _ = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+
+	// the run is assessed once all its metrics completed
+	run = newCompositeRun("[400]", v1alpha1.AnalysisPhaseRunning)
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, status)
+	assert.Equal(t, "", message)
+
+	// or when it is terminated
+	run.Spec.Terminate = true
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+	assert.Equal(t, "Composite conditions assessed Failed", message)
+
+	run = newCompositeRun(`{"p99": 400}`, v1alpha1.AnalysisPhaseFailed)
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, status)
+	assert.Contains(t, message, "could not evaluate compositeConditions.successCondition")
+}
+
+func newWeightedRun(phases ...v1alpha1.AnalysisPhase) *v1alpha1.AnalysisRun {
+	run := &v1alpha1.AnalysisRun{
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+		},
+	}
+	for i, phase := range phases {
+		name := fmt.Sprintf("metric-%d", i)
+		result := v1alpha1.MetricResult{
+			Name:         name,
+			Phase:        phase,
+			Measurements: []v1alpha1.Measurement{newMeasurement(phase)},
+		}
+		if phase == v1alpha1.AnalysisPhaseFailed {
+			result.Count = 1
+			result.Failed = 1
+		} else if phase == v1alpha1.AnalysisPhaseSuccessful {
+			result.Count = 1
+			result.Successful = 1
+		}
+		run.Spec.Metrics = append(run.Spec.Metrics, v1alpha1.Metric{Name: name})
+		run.Status.MetricResults = append(run.Status.MetricResults, result)
+	}
+	return run
+}
+
+// TestAssessRunStatusAdvisoryMetric ensures the failure of an advisory metric does not fail the run, and is
+// summarized separately from the required metrics
+func TestAssessRunStatusAdvisoryMetric(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := newWeightedRun(v1alpha1.AnalysisPhaseSuccessful, v1alpha1.AnalysisPhaseFailed)
+	run.Spec.Metrics[1].Advisory = true
+	run.Status.MetricResults[1].Advisory = true
+	assert.False(t, analysisutil.IsTerminating(run))
+	status, message := c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+	assert.Equal(t, "", message)
+	assert.Equal(t, v1alpha1.RunSummary{Count: 1, Successful: 1, Weight: 1, SuccessfulWeight: 1}, run.Status.RunSummary)
+	assert.Equal(t, &v1alpha1.RunSummary{Count: 1, Failed: 1}, run.Status.AdvisorySummary)
+
+	// a run without advisory metrics has no advisory summary
+	run = newWeightedRun(v1alpha1.AnalysisPhaseSuccessful)
+	c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Nil(t, run.Status.AdvisorySummary)
+}
+
+// TestAssessRunStatusAdvisoryMetricEvent ensures the completion of an advisory metric is recorded as such
+func TestAssessRunStatusAdvisoryMetricEvent(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := newWeightedRun(v1alpha1.AnalysisPhaseRunning)
+	run.Spec.Metrics[0].Advisory = true
+	run.Spec.Metrics[0].FailureLimit = &intstr.IntOrString{Type: intstr.Int, IntVal: 0}
+	run.Status.MetricResults[0].Advisory = true
+	run.Status.MetricResults[0].Count = 1
+	run.Status.MetricResults[0].Failed = 1
+	run.Status.MetricResults[0].Measurements = []v1alpha1.Measurement{newMeasurement(v1alpha1.AnalysisPhaseFailed)}
+	status, _ := c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, run.Status.MetricResults[0].Phase)
+	recorder := c.recorder.(*record.FakeEventRecorder)
+	assert.Equal(t, []string{"MetricFailed"}, recorder.Events())
+	assert.Equal(t, "Warning MetricFailed Advisory metric 'metric-0' Completed. Result: Failed", <-recorder.K8sRecorder().(*k8srecord.FakeRecorder).Events)
+}
+
+// TestAssessRunStatusSuccessWeightThreshold ensures the run succeeds when the weighted share of its successful
+// metrics reaches the success weight threshold
+func TestAssessRunStatusSuccessWeightThreshold(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	newRun := func(phases ...v1alpha1.AnalysisPhase) *v1alpha1.AnalysisRun {
+		run := newWeightedRun(phases...)
+		run.Spec.SuccessWeightThreshold = ptr.To[int32](75)
+		run.Spec.Metrics[0].Weight = ptr.To[int32](3)
+		return run
+	}
+
+	run := newRun(v1alpha1.AnalysisPhaseSuccessful, v1alpha1.AnalysisPhaseFailed)
+	assert.False(t, analysisutil.IsTerminating(run))
+	status, message := c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+	assert.Equal(t, "Weighted share of successful metrics (75%) reached successWeightThreshold (75%)", message)
+	assert.Equal(t, v1alpha1.RunSummary{Count: 2, Successful: 1, Failed: 1, Weight: 4, SuccessfulWeight: 3}, run.Status.RunSummary)
+
+	run = newRun(v1alpha1.AnalysisPhaseSuccessful, v1alpha1.AnalysisPhaseSuccessful)
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+	assert.Equal(t, "", message)
+
+	run = newRun(v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseSuccessful)
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+	assert.True(t, strings.HasPrefix(message, "Weighted share of successful metrics (25%) below successWeightThreshold (75%)"), message)
+
+	// the run is assessed once all its metrics completed
+	run = newRun(v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseRunning)
+	status, message = c.assessRunStatus(run, run.Spec.Metrics, map[string]bool{})
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, status)
+	assert.Equal(t, "", message)
+}
+
+// TestGenerateMetricTasksCompositeConditions ensures a failed metric does not stop the other metrics of a run
+// assessed by composite conditions
+func TestGenerateMetricTasksCompositeConditions(t *testing.T) {
+	run := newCompositeRun("[400]", v1alpha1.AnalysisPhaseFailed)
+	run.Status.MetricResults = run.Status.MetricResults[1:]
+	tasks := generateMetricTasks(run, run.Spec.Metrics)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, "latency", tasks[0].metric.Name)
+
+	run.Spec.CompositeConditions = nil
+	assert.Empty(t, generateMetricTasks(run, run.Spec.Metrics))
+}
+
+func TestParseMeasurementValue(t *testing.T) {
+	assert.Nil(t, parseMeasurementValue(""))
+	assert.Equal(t, 0.5, parseMeasurementValue("0.5"))
+	assert.Equal(t, 250.0, parseMeasurementValue("[250]"))
+	assert.Equal(t, []any{1.0, 2.0}, parseMeasurementValue("[1,2]"))
+	assert.Equal(t, map[string]any{"p99": 400.0}, parseMeasurementValue(`{"p99": 400}`))
+	assert.True(t, math.IsNaN(parseMeasurementValue("NaN").(float64)))
+	assert.Equal(t, "not json", parseMeasurementValue("not json"))
+}
+
 // TestAssessRunStatusUpdateResult ensures we update the metricresult status properly
 // based on latest measurements
 func TestAssessRunStatusUpdateResult(t *testing.T) {
@@ -1256,6 +1469,89 @@ func TestRunMeasurementsResetConsecutiveErrorCounter(t *testing.T) {
 	}
 }
 
+func newThrottledRun(measurements ...v1alpha1.Measurement) *v1alpha1.AnalysisRun {
+	return &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:             "test",
+				SuccessCondition: "result == 100",
+				Provider: v1alpha1.MetricProvider{
+					Web: &v1alpha1.WebMetric{URL: "https://example.com/metrics"},
+				},
+			}},
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:             "test",
+				Phase:            v1alpha1.AnalysisPhaseRunning,
+				ConsecutiveError: 4,
+				Error:            4,
+				Measurements:     measurements,
+			}},
+		},
+	}
+}
+
+// TestRunMeasurementsThrottled verifies a measurement of a throttled metric provider is rescheduled, and is not
+// counted as an error
+func TestRunMeasurementsThrottled(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	throttledErr := &metricutil.ThrottledError{RetryAfter: 30 * time.Second, Err: errors.New("received rate limited response code: 429")}
+	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(metricutil.MarkMeasurementError(v1alpha1.Measurement{StartedAt: timePtr(metav1.NewTime(f.now))}, throttledErr))
+
+	newRun := c.reconcileAnalysisRun(newThrottledRun())
+	result := newRun.Status.MetricResults[0]
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, newRun.Status.Phase)
+	assert.Equal(t, int32(4), result.ConsecutiveError)
+	assert.Equal(t, int32(4), result.Error)
+	assert.Len(t, result.Measurements, 1)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, result.Measurements[0].Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(result.Measurements[0]))
+	assert.Nil(t, result.Measurements[0].FinishedAt)
+	assert.Equal(t, f.now.Add(30*time.Second), result.Measurements[0].ResumeAt.Time)
+}
+
+// TestRunMeasurementsRetryThrottled verifies a throttled measurement is taken again once its metric provider is
+// available
+func TestRunMeasurementsRetryThrottled(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	throttled := metricutil.MarkMeasurementThrottled(v1alpha1.Measurement{StartedAt: timePtr(metav1.NewTime(f.now.Add(-time.Minute)))}, time.Second, "rate limited")
+	throttled.ResumeAt = timePtr(metav1.NewTime(f.now.Add(-time.Second)))
+	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful))
+
+	newRun := c.reconcileAnalysisRun(newThrottledRun(throttled))
+	result := newRun.Status.MetricResults[0]
+	f.provider.AssertNotCalled(t, "Resume", mock.Anything, mock.Anything, mock.Anything)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
+	assert.Len(t, result.Measurements, 1)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, result.Measurements[0].Phase)
+	assert.Equal(t, int32(0), result.ConsecutiveError)
+}
+
+// TestRunMeasurementsTerminateThrottled verifies a throttled measurement is discarded when the run terminates
+func TestRunMeasurementsTerminateThrottled(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	throttled := metricutil.MarkMeasurementThrottled(v1alpha1.Measurement{StartedAt: timePtr(metav1.NewTime(f.now.Add(-time.Minute)))}, time.Minute, "rate limited")
+	run := newThrottledRun(throttled)
+	run.Spec.Terminate = true
+
+	newRun := c.reconcileAnalysisRun(run)
+	f.provider.AssertNotCalled(t, "Run", mock.Anything, mock.Anything, mock.Anything)
+	f.provider.AssertNotCalled(t, "Terminate", mock.Anything, mock.Anything, mock.Anything)
+	assert.Empty(t, newRun.Status.MetricResults[0].Measurements)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
+}
+
 // TestTrimMeasurementHistory verifies we trim the measurement list appropriately to the correct length
 // and retain the newest measurements
 func TestTrimMeasurementHistory(t *testing.T) {
@@ -0,0 +1,182 @@
+package analysis
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/cloudwatch"
+	"github.com/argoproj/argo-rollouts/metricproviders/datadog"
+	"github.com/argoproj/argo-rollouts/metricproviders/elasticsearch"
+	"github.com/argoproj/argo-rollouts/metricproviders/graphite"
+	"github.com/argoproj/argo-rollouts/metricproviders/influxdb"
+	"github.com/argoproj/argo-rollouts/metricproviders/loki"
+	"github.com/argoproj/argo-rollouts/metricproviders/newrelic"
+	prometheusProvider "github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/metricproviders/skywalking"
+	"github.com/argoproj/argo-rollouts/metricproviders/wavefront"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	cacheResultHit       = "hit"
+	cacheResultCoalesced = "coalesced"
+	cacheResultMiss      = "miss"
+
+	// SharedFromMetadataKey is the metadata key of a shared measurement, whose value is the AnalysisRun which took it
+	SharedFromMetadataKey = "sharedFrom"
+)
+
+// cacheableProviders are the providers whose measurements only depend on the resolved metric, and which complete
+// their measurements in their Run method
+var cacheableProviders = map[string]bool{
+	prometheusProvider.ProviderType: true,
+	datadog.ProviderType:            true,
+	newrelic.ProviderType:           true,
+	wavefront.ProviderType:          true,
+	cloudwatch.ProviderType:         true,
+	graphite.ProviderType:           true,
+	influxdb.ProviderType:           true,
+	skywalking.ProviderType:         true,
+	loki.ProviderType:               true,
+	elasticsearch.ProviderType:      true,
+}
+
+// MeasurementCache shares the measurements of identical metrics between the AnalysisRuns, e.g. the AnalysisRuns of
+// many Rollouts using the same ClusterAnalysisTemplate. A measurement is shared within a time bucket of the TTL, and
+// the identical measurements which are in flight are coalesced into a single query.
+type MeasurementCache struct {
+	ttl     time.Duration
+	counter *prometheus.CounterVec
+	now     func() time.Time
+
+	lock    sync.Mutex
+	entries map[string]*measurementCacheEntry
+}
+
+type measurementCacheEntry struct {
+	// done is closed once the measurement is complete
+	done        chan struct{}
+	measurement v1alpha1.Measurement
+	expiresAt   time.Time
+	// source is the AnalysisRun which took the measurement
+	source string
+}
+
+// NewMeasurementCache returns a measurement cache whose time buckets last the TTL
+func NewMeasurementCache(ttl time.Duration, counter *prometheus.CounterVec) *MeasurementCache {
+	return &MeasurementCache{
+		ttl:     ttl,
+		counter: counter,
+		now:     time.Now,
+		entries: map[string]*measurementCacheEntry{},
+	}
+}
+
+// Measure returns the measurement of the metric of the run, which is either the measurement of an identical metric
+// of the current time bucket, or the one returned by measure. The measurements which error are not cached.
+func (c *MeasurementCache) Measure(run *v1alpha1.AnalysisRun, providerType string, metric v1alpha1.Metric, measure func() v1alpha1.Measurement) v1alpha1.Measurement {
+	if !cacheableProviders[providerType] {
+		return measure()
+	}
+	now := c.now()
+	bucket := now.Truncate(c.ttl)
+	key, err := measurementCacheKey(run, providerType, metric, bucket)
+	if err != nil {
+		return measure()
+	}
+
+	c.lock.Lock()
+	c.prune(now)
+	if entry, ok := c.entries[key]; ok {
+		c.lock.Unlock()
+		select {
+		case <-entry.done:
+			c.counter.WithLabelValues(providerType, cacheResultHit).Inc()
+		default:
+			c.counter.WithLabelValues(providerType, cacheResultCoalesced).Inc()
+			<-entry.done
+		}
+		return sharedMeasurement(entry.measurement, entry.source)
+	}
+	entry := &measurementCacheEntry{
+		done:      make(chan struct{}),
+		expiresAt: bucket.Add(c.ttl),
+		source:    run.Namespace + "/" + run.Name,
+	}
+	c.entries[key] = entry
+	c.lock.Unlock()
+	c.counter.WithLabelValues(providerType, cacheResultMiss).Inc()
+
+	defer func() {
+		if entry.measurement.Phase == v1alpha1.AnalysisPhaseError || !entry.measurement.Phase.Completed() {
+			c.lock.Lock()
+			delete(c.entries, key)
+			c.lock.Unlock()
+		}
+		close(entry.done)
+	}()
+	entry.measurement = measure()
+	return entry.measurement
+}
+
+// prune deletes the completed entries of the past time buckets. The lock must be held.
+func (c *MeasurementCache) prune(now time.Time) {
+	for key, entry := range c.entries {
+		if now.Before(entry.expiresAt) {
+			continue
+		}
+		select {
+		case <-entry.done:
+			delete(c.entries, key)
+		default:
+		}
+	}
+}
+
+// sharedMeasurement returns a copy of a cached measurement for another AnalysisRun. It keeps the times of the
+// measurement, which is annotated with the AnalysisRun which took it. A coalesced measurement which is still in flight
+// (e.g. because its metric provider was throttled) stays in flight.
+func sharedMeasurement(measurement v1alpha1.Measurement, source string) v1alpha1.Measurement {
+	shared := measurement.DeepCopy()
+	if shared.Metadata == nil {
+		shared.Metadata = map[string]string{}
+	}
+	shared.Metadata[SharedFromMetadataKey] = source
+	return *shared
+}
+
+// measurementCacheKey returns the key of the measurements of the metric in a time bucket. The key includes the
+// conditions and the interval of the metric, which the providers use to evaluate the results and to derive their time
+// windows, and the namespace of the run when the credentials of the metric are namespaced.
+func measurementCacheKey(run *v1alpha1.AnalysisRun, providerType string, metric v1alpha1.Metric, bucket time.Time) (string, error) {
+	key := struct {
+		Provider         string                  `json:"provider"`
+		Namespace        string                  `json:"namespace,omitempty"`
+		Spec             v1alpha1.MetricProvider `json:"spec"`
+		SuccessCondition string                  `json:"successCondition,omitempty"`
+		FailureCondition string                  `json:"failureCondition,omitempty"`
+		Interval         v1alpha1.DurationString `json:"interval,omitempty"`
+		Bucket           int64                   `json:"bucket"`
+	}{
+		Provider:         providerType,
+		Spec:             metric.Provider,
+		SuccessCondition: metric.SuccessCondition,
+		FailureCondition: metric.FailureCondition,
+		Interval:         metric.Interval,
+		Bucket:           bucket.UnixNano(),
+	}
+	if metric.Provider.Datadog != nil && metric.Provider.Datadog.SecretRef.Namespaced {
+		key.Namespace = run.Namespace
+	}
+	b, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,177 @@
+package analysis
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/datadog"
+	"github.com/argoproj/argo-rollouts/metricproviders/job"
+	prometheusProvider "github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newTestMeasurementCache(now *time.Time) (*MeasurementCache, *prometheus.CounterVec) {
+	counter := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_cache_total"}, []string{"provider", "result"})
+	c := NewMeasurementCache(time.Minute, counter)
+	c.now = func() time.Time {
+		return *now
+	}
+	return c, counter
+}
+
+func newCacheTestRun(namespace string) *v1alpha1.AnalysisRun {
+	run := &v1alpha1.AnalysisRun{}
+	run.Namespace = namespace
+	run.Name = "run"
+	return run
+}
+
+func newCacheTestMetric(query string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "success-rate",
+		SuccessCondition: "result[0] > 0.95",
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{Query: query},
+		},
+	}
+}
+
+func mustCacheKey(t *testing.T, run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, now time.Time) string {
+	t.Helper()
+	key, err := measurementCacheKey(run, prometheusProvider.ProviderType, metric, now.Truncate(time.Minute))
+	assert.NoError(t, err)
+	return key
+}
+
+// countingMeasure returns a measure function which counts its calls
+func countingMeasure(calls *int32, phase v1alpha1.AnalysisPhase) func() v1alpha1.Measurement {
+	return func() v1alpha1.Measurement {
+		atomic.AddInt32(calls, 1)
+		startedAt := timeutil.MetaNow()
+		return v1alpha1.Measurement{Phase: phase, Value: "[0.99]", StartedAt: &startedAt, FinishedAt: &startedAt}
+	}
+}
+
+func TestMeasurementCacheHit(t *testing.T) {
+	now := time.Unix(1700000010, 0)
+	c, counter := newTestMeasurementCache(&now)
+	var calls int32
+	metric := newCacheTestMetric("up")
+
+	measured := c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measured.Phase)
+	assert.NotContains(t, measured.Metadata, SharedFromMetadataKey)
+	m := c.Measure(newCacheTestRun("b"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, m.Phase)
+	assert.Equal(t, "[0.99]", m.Value)
+	assert.Equal(t, int32(1), calls)
+	// The shared measurement keeps the times of the measurement, and records the run which took it
+	assert.Equal(t, measured.StartedAt, m.StartedAt)
+	assert.Equal(t, measured.FinishedAt, m.FinishedAt)
+	assert.Equal(t, map[string]string{SharedFromMetadataKey: "a/run"}, m.Metadata)
+	assert.Nil(t, c.entries[mustCacheKey(t, newCacheTestRun("a"), metric, now)].measurement.Metadata)
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter.WithLabelValues(prometheusProvider.ProviderType, cacheResultHit)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(counter.WithLabelValues(prometheusProvider.ProviderType, cacheResultMiss)))
+
+	// Another query, or other conditions, are not shared
+	c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, newCacheTestMetric("down"), countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	metric.SuccessCondition = "result[0] > 0.99"
+	c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	assert.Equal(t, int32(3), calls)
+
+	// The next time bucket measures again, and prunes the entries of the previous one
+	now = now.Add(time.Minute)
+	c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	assert.Equal(t, int32(4), calls)
+	assert.Len(t, c.entries, 1)
+}
+
+func TestMeasurementCacheCoalesce(t *testing.T) {
+	now := time.Unix(1700000010, 0)
+	c, counter := newTestMeasurementCache(&now)
+	metric := newCacheTestMetric("up")
+
+	var calls int32
+	release := make(chan struct{})
+	started := make(chan struct{})
+	go c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, metric, func() v1alpha1.Measurement {
+		close(started)
+		<-release
+		return countingMeasure(&calls, v1alpha1.AnalysisPhaseFailed)()
+	})
+	<-started
+
+	var wg sync.WaitGroup
+	results := make([]v1alpha1.Measurement, 3)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = c.Measure(newCacheTestRun("b"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+		}(i)
+	}
+	assert.Eventually(t, func() bool {
+		return testutil.ToFloat64(counter.WithLabelValues(prometheusProvider.ProviderType, cacheResultCoalesced)) == 3
+	}, 5*time.Second, time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), calls)
+	for _, result := range results {
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, result.Phase)
+		assert.Equal(t, "a/run", result.Metadata[SharedFromMetadataKey])
+	}
+}
+
+func TestMeasurementCacheErrorsNotCached(t *testing.T) {
+	now := time.Unix(1700000010, 0)
+	c, _ := newTestMeasurementCache(&now)
+	metric := newCacheTestMetric("up")
+	var calls int32
+
+	c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseError))
+	m := c.Measure(newCacheTestRun("a"), prometheusProvider.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, m.Phase)
+	assert.Equal(t, int32(2), calls)
+	assert.Len(t, c.entries, 1)
+}
+
+func TestMeasurementCacheNotCacheable(t *testing.T) {
+	now := time.Unix(1700000010, 0)
+	c, _ := newTestMeasurementCache(&now)
+	metric := v1alpha1.Metric{Name: "job", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}}
+	var calls int32
+
+	c.Measure(newCacheTestRun("a"), job.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	c.Measure(newCacheTestRun("a"), job.ProviderType, metric, countingMeasure(&calls, v1alpha1.AnalysisPhaseSuccessful))
+	assert.Equal(t, int32(2), calls)
+	assert.Empty(t, c.entries)
+}
+
+func TestMeasurementCacheKeyNamespacedCredentials(t *testing.T) {
+	bucket := time.Unix(1700000000, 0)
+	metric := v1alpha1.Metric{
+		Name:     "errors",
+		Provider: v1alpha1.MetricProvider{Datadog: &v1alpha1.DatadogMetric{Query: "sum:errors{*}"}},
+	}
+	keyA, err := measurementCacheKey(newCacheTestRun("a"), datadog.ProviderType, metric, bucket)
+	assert.NoError(t, err)
+	keyB, _ := measurementCacheKey(newCacheTestRun("b"), datadog.ProviderType, metric, bucket)
+	assert.Equal(t, keyA, keyB)
+
+	metric.Provider.Datadog.SecretRef.Namespaced = true
+	metric.Provider.Datadog.SecretRef.Name = "datadog"
+	keyA, _ = measurementCacheKey(newCacheTestRun("a"), datadog.ProviderType, metric, bucket)
+	keyB, _ = measurementCacheKey(newCacheTestRun("b"), datadog.ProviderType, metric, bucket)
+	assert.NotEqual(t, keyA, keyB)
+
+	keyNext, _ := measurementCacheKey(newCacheTestRun("a"), datadog.ProviderType, metric, bucket.Add(time.Minute))
+	assert.NotEqual(t, keyA, keyNext)
+}
@@ -26,12 +26,15 @@ import (
 
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/metricproviders"
+	"github.com/argoproj/argo-rollouts/metricproviders/otlp"
+	"github.com/argoproj/argo-rollouts/metricproviders/podhealth"
 	register "github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
 	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -60,6 +63,15 @@ type Controller struct {
 
 	newProvider func(logCtx log.Entry, namespace string, metric v1alpha1.Metric) (metric.Provider, error)
 
+	// measurementCache shares the measurements of identical metrics between the AnalysisRuns, and is nil when disabled
+	measurementCache *MeasurementCache
+
+	// podHealthCache caches the pods and Warning events measured by the Kubernetes metric, and is started on first use
+	podHealthCache *podhealth.Cache
+
+	// providerThrottle rate limits the queries to the metric providers, and backs off from the throttled ones
+	providerThrottle *ProviderThrottle
+
 	// used for unit testing
 	enqueueAnalysis      func(obj any)
 	enqueueAnalysisAfter func(obj any, duration time.Duration)
@@ -87,6 +99,8 @@ type ControllerConfig struct {
 	AnalysisRunWorkQueue workqueue.RateLimitingInterface
 	MetricsServer        *metrics.MetricsServer
 	Recorder             record.EventRecorder
+	OTLPBuffer           *otlp.Buffer
+	PodHealthCache       *podhealth.Cache
 }
 
 // NewController returns a new analysis controller
@@ -103,6 +117,8 @@ func NewController(cfg ControllerConfig) *Controller {
 		analysisRunSynced:    cfg.AnalysisRunInformer.Informer().HasSynced,
 		recorder:             cfg.Recorder,
 		resyncPeriod:         cfg.ResyncPeriod,
+		providerThrottle:     NewProviderThrottle(),
+		podHealthCache:       cfg.PodHealthCache,
 	}
 
 	controller.enqueueAnalysis = func(obj any) {
@@ -113,11 +129,16 @@ func NewController(cfg ControllerConfig) *Controller {
 	}
 
 	providerFactory := metricproviders.ProviderFactory{
-		KubeClient:    controller.kubeclientset,
-		JobLister:     cfg.JobInformer.Lister(),
-		JobPodsLister: cfg.JobPodsInformer.Lister(),
+		KubeClient:     controller.kubeclientset,
+		JobLister:      cfg.JobInformer.Lister(),
+		JobPodsLister:  cfg.JobPodsInformer.Lister(),
+		OTLPBuffer:     cfg.OTLPBuffer,
+		PodHealthCache: cfg.PodHealthCache,
 	}
 	controller.newProvider = providerFactory.NewProvider
+	if ttl := defaults.GetAnalysisMeasurementCacheTTL(); ttl > 0 {
+		controller.measurementCache = NewMeasurementCache(ttl, metrics.MetricAnalysisMeasurementCacheTotal)
+	}
 
 	cfg.JobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
@@ -152,6 +173,9 @@ func NewController(cfg ControllerConfig) *Controller {
 
 func (c *Controller) Run(ctx context.Context, threadiness int) error {
 	log.Info("Starting analysis workers")
+	if c.podHealthCache != nil {
+		c.podHealthCache.Run(ctx.Done())
+	}
 	wg := sync.WaitGroup{}
 	for i := 0; i < threadiness; i++ {
 		wg.Add(1)
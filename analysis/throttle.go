@@ -0,0 +1,203 @@
+package analysis
+
+import (
+	"net/url"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// DefaultMaxThrottleBackoff caps the backoff of a throttled metric provider without a configured maxBackoff
+	DefaultMaxThrottleBackoff = 5 * time.Minute
+)
+
+// ProviderThrottle applies the rate limits of the metric providers configured in the argo-rollouts-config ConfigMap,
+// and backs off from the metric providers which throttled a query. The limits and backoffs are shared by all the
+// AnalysisRuns of the controller.
+type ProviderThrottle struct {
+	now        func() time.Time
+	rateLimits func() []config.MetricProviderRateLimit
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	backoffs map[string]*providerBackoff
+}
+
+type providerBackoff struct {
+	failures int
+	until    time.Time
+}
+
+// NewProviderThrottle returns a throttle using the rate limits of the argo-rollouts-config ConfigMap
+func NewProviderThrottle() *ProviderThrottle {
+	return &ProviderThrottle{
+		now: timeutil.Now,
+		rateLimits: func() []config.MetricProviderRateLimit {
+			cfg, err := config.GetConfig()
+			if err != nil {
+				return nil
+			}
+			return cfg.GetMetricProviderRateLimits()
+		},
+		limiters: map[string]*rate.Limiter{},
+		backoffs: map[string]*providerBackoff{},
+	}
+}
+
+// Measure returns the measurement returned by measure, unless the metric provider is backing off or over its rate
+// limit, in which case the measurement is rescheduled for when the metric provider is available again. A throttled
+// measurement returned by measure backs off the metric provider.
+func (t *ProviderThrottle) Measure(providerType string, metric v1alpha1.Metric, measure func() v1alpha1.Measurement) v1alpha1.Measurement {
+	address := metricProviderAddress(metric)
+	if delay := t.delay(providerType, address); delay > 0 {
+		startedAt := metav1.NewTime(t.now())
+		measurement := v1alpha1.Measurement{StartedAt: &startedAt}
+		return metricutil.MarkMeasurementThrottled(measurement, delay, "waiting for the rate limit of the "+providerType+" provider")
+	}
+	return t.observe(providerType, address, measure())
+}
+
+// delay returns how long to wait before querying the metric provider, and takes a token of its rate limit when the
+// metric provider can be queried now
+func (t *ProviderThrottle) delay(providerType, address string) time.Duration {
+	now := t.now()
+	limit := findRateLimit(t.rateLimits(), providerType, address)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if backoff, ok := t.backoffs[backoffKey(providerType, address)]; ok && backoff.until.After(now) {
+		return backoff.until.Sub(now)
+	}
+	if limit == nil || limit.QPS <= 0 {
+		return 0
+	}
+	key := backoffKey(limit.Provider, limit.Address)
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = 1
+	}
+	limiter, ok := t.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit.QPS), burst)
+		t.limiters[key] = limiter
+	} else if limiter.Limit() != rate.Limit(limit.QPS) || limiter.Burst() != burst {
+		limiter.SetLimitAt(now, rate.Limit(limit.QPS))
+		limiter.SetBurstAt(now, burst)
+	}
+	reservation := limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay
+	}
+	return 0
+}
+
+// observe backs off the metric provider when the measurement was throttled, with an exponential backoff starting
+// from the error retry interval, or the delay requested by the metric provider when longer. A completed measurement
+// resets the backoff.
+func (t *ProviderThrottle) observe(providerType, address string, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	key := backoffKey(providerType, address)
+	if !metricutil.IsMeasurementThrottled(measurement) {
+		if measurement.Phase.Completed() {
+			t.lock.Lock()
+			delete(t.backoffs, key)
+			t.lock.Unlock()
+		}
+		return measurement
+	}
+	now := t.now()
+	maxBackoff := DefaultMaxThrottleBackoff
+	if limit := findRateLimit(t.rateLimits(), providerType, address); limit != nil && limit.MaxBackoff != "" {
+		if parsed, err := time.ParseDuration(limit.MaxBackoff); err == nil {
+			maxBackoff = parsed
+		}
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	backoff, ok := t.backoffs[key]
+	if !ok {
+		backoff = &providerBackoff{}
+		t.backoffs[key] = backoff
+	}
+	backoff.failures++
+	delay := DefaultErrorRetryInterval
+	for i := 1; i < backoff.failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if measurement.ResumeAt != nil && measurement.ResumeAt.Time.Sub(now) > delay {
+		delay = measurement.ResumeAt.Time.Sub(now)
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	backoff.until = now.Add(delay)
+	log.Warnf("%s provider at '%s' throttled, backing off for %s", providerType, address, delay)
+
+	resumeAt := metav1.NewTime(backoff.until)
+	measurement.ResumeAt = &resumeAt
+	return measurement
+}
+
+// findRateLimit returns the rate limit of the metric provider at the address. The limits of the address take
+// precedence over the limits of all the addresses of the metric provider.
+func findRateLimit(limits []config.MetricProviderRateLimit, providerType, address string) *config.MetricProviderRateLimit {
+	var providerLimit *config.MetricProviderRateLimit
+	for i := range limits {
+		if limits[i].Provider != providerType {
+			continue
+		}
+		if limits[i].Address == "" {
+			if providerLimit == nil {
+				providerLimit = &limits[i]
+			}
+		} else if address != "" && limits[i].Address == address {
+			return &limits[i]
+		}
+	}
+	return providerLimit
+}
+
+func backoffKey(providerType, address string) string {
+	return providerType + "|" + address
+}
+
+// metricProviderAddress returns the address queried by the metric, or an empty string when the address is not part
+// of the spec of the metric (e.g. when it comes from a secret)
+func metricProviderAddress(metric v1alpha1.Metric) string {
+	provider := metric.Provider
+	switch {
+	case provider.Prometheus != nil:
+		return provider.Prometheus.Address
+	case provider.Wavefront != nil:
+		return provider.Wavefront.Address
+	case provider.Graphite != nil:
+		return provider.Graphite.Address
+	case provider.Loki != nil:
+		return provider.Loki.Address
+	case provider.Elasticsearch != nil:
+		return provider.Elasticsearch.Address
+	case provider.SkyWalking != nil:
+		return provider.SkyWalking.Address
+	case provider.OTLP != nil:
+		return provider.OTLP.Address
+	case provider.Kayenta != nil:
+		return provider.Kayenta.Address
+	case provider.Web != nil:
+		u, err := url.Parse(provider.Web.URL)
+		if err != nil {
+			return ""
+		}
+		return u.Scheme + "://" + u.Host
+	}
+	return ""
+}
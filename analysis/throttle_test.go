@@ -0,0 +1,139 @@
+package analysis
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/datadog"
+	"github.com/argoproj/argo-rollouts/metricproviders/webmetric"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/config"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newTestProviderThrottle(now *time.Time, limits ...config.MetricProviderRateLimit) *ProviderThrottle {
+	t := NewProviderThrottle()
+	t.now = func() time.Time {
+		return *now
+	}
+	t.rateLimits = func() []config.MetricProviderRateLimit {
+		return limits
+	}
+	return t
+}
+
+func newDatadogMetric() v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:     "errors",
+		Provider: v1alpha1.MetricProvider{Datadog: &v1alpha1.DatadogMetric{Query: "sum:errors{*}"}},
+	}
+}
+
+func successfulMeasure(calls *int) func() v1alpha1.Measurement {
+	return func() v1alpha1.Measurement {
+		*calls++
+		return newMeasurement(v1alpha1.AnalysisPhaseSuccessful)
+	}
+}
+
+func throttledMeasure(calls *int, retryAfter time.Duration) func() v1alpha1.Measurement {
+	return func() v1alpha1.Measurement {
+		*calls++
+		err := &metricutil.ThrottledError{RetryAfter: retryAfter, Err: errors.New("received rate limited response code: 429")}
+		return metricutil.MarkMeasurementError(v1alpha1.Measurement{}, err)
+	}
+}
+
+func TestProviderThrottleRateLimit(t *testing.T) {
+	now := time.Now()
+	throttle := newTestProviderThrottle(&now, config.MetricProviderRateLimit{Provider: datadog.ProviderType, QPS: 1, Burst: 2})
+	calls := 0
+
+	for i := 0; i < 2; i++ {
+		m := throttle.Measure(datadog.ProviderType, newDatadogMetric(), successfulMeasure(&calls))
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, m.Phase)
+	}
+	m := throttle.Measure(datadog.ProviderType, newDatadogMetric(), successfulMeasure(&calls))
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, m.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(m))
+	assert.Nil(t, m.FinishedAt)
+	assert.NotNil(t, m.ResumeAt)
+
+	now = now.Add(time.Second)
+	m = throttle.Measure(datadog.ProviderType, newDatadogMetric(), successfulMeasure(&calls))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, m.Phase)
+	assert.Equal(t, 3, calls)
+}
+
+func TestProviderThrottleBackoff(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	throttle := newTestProviderThrottle(&f.now, config.MetricProviderRateLimit{Provider: datadog.ProviderType, MaxBackoff: "30s"})
+	calls := 0
+
+	// the first throttled query backs off for the error retry interval
+	m := throttle.Measure(datadog.ProviderType, newDatadogMetric(), throttledMeasure(&calls, 0))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, m.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(m))
+	assert.Equal(t, f.now.Add(DefaultErrorRetryInterval), m.ResumeAt.Time)
+
+	// queries are not sent while backing off
+	f.now = f.now.Add(5 * time.Second)
+	m = throttle.Measure(datadog.ProviderType, newDatadogMetric(), successfulMeasure(&calls))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, f.now.Add(5*time.Second), m.ResumeAt.Time)
+
+	// the backoff doubles, and the Retry-After of the provider is honored up to the max backoff
+	f.now = f.now.Add(5 * time.Second)
+	m = throttle.Measure(datadog.ProviderType, newDatadogMetric(), throttledMeasure(&calls, 0))
+	assert.Equal(t, f.now.Add(2*DefaultErrorRetryInterval), m.ResumeAt.Time)
+	f.now = f.now.Add(2 * DefaultErrorRetryInterval)
+	m = throttle.Measure(datadog.ProviderType, newDatadogMetric(), throttledMeasure(&calls, time.Hour))
+	assert.Equal(t, f.now.Add(30*time.Second), m.ResumeAt.Time)
+
+	// a successful query resets the backoff
+	f.now = f.now.Add(30 * time.Second)
+	m = throttle.Measure(datadog.ProviderType, newDatadogMetric(), successfulMeasure(&calls))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, m.Phase)
+	m = throttle.Measure(datadog.ProviderType, newDatadogMetric(), throttledMeasure(&calls, 0))
+	assert.Equal(t, f.now.Add(DefaultErrorRetryInterval), m.ResumeAt.Time)
+	assert.Equal(t, 5, calls)
+}
+
+func TestProviderThrottleBackoffPerAddress(t *testing.T) {
+	now := timeutil.Now()
+	throttle := newTestProviderThrottle(&now)
+	calls := 0
+	metricA := v1alpha1.Metric{Provider: v1alpha1.MetricProvider{Web: &v1alpha1.WebMetric{URL: "https://a.example.com/api?q=1"}}}
+	metricB := v1alpha1.Metric{Provider: v1alpha1.MetricProvider{Web: &v1alpha1.WebMetric{URL: "https://b.example.com/api"}}}
+
+	throttle.Measure(webmetric.ProviderType, metricA, throttledMeasure(&calls, 0))
+	m := throttle.Measure(webmetric.ProviderType, metricB, successfulMeasure(&calls))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, m.Phase)
+	m = throttle.Measure(webmetric.ProviderType, metricA, successfulMeasure(&calls))
+	assert.True(t, metricutil.IsMeasurementThrottled(m))
+	assert.Equal(t, 2, calls)
+}
+
+func TestFindRateLimit(t *testing.T) {
+	limits := []config.MetricProviderRateLimit{
+		{Provider: "Prometheus", QPS: 1},
+		{Provider: "Prometheus", Address: "http://prometheus-b", QPS: 2},
+		{Provider: "Datadog", Address: "http://datadog", QPS: 3},
+	}
+	assert.Equal(t, float64(1), findRateLimit(limits, "Prometheus", "http://prometheus-a").QPS)
+	assert.Equal(t, float64(2), findRateLimit(limits, "Prometheus", "http://prometheus-b").QPS)
+	assert.Nil(t, findRateLimit(limits, "Datadog", ""))
+	assert.Nil(t, findRateLimit(limits, "Web", ""))
+}
+
+func TestMetricProviderAddress(t *testing.T) {
+	assert.Equal(t, "http://prometheus:9090", metricProviderAddress(v1alpha1.Metric{Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{Address: "http://prometheus:9090"}}}))
+	assert.Equal(t, "https://example.com", metricProviderAddress(v1alpha1.Metric{Provider: v1alpha1.MetricProvider{Web: &v1alpha1.WebMetric{URL: "https://example.com/api/v1?q=x"}}}))
+	assert.Equal(t, "", metricProviderAddress(newDatadogMetric()))
+}
@@ -11,6 +11,7 @@ import (
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
@@ -30,6 +31,7 @@ import (
 	"github.com/argoproj/argo-rollouts/controller"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	jobprovider "github.com/argoproj/argo-rollouts/metricproviders/job"
+	"github.com/argoproj/argo-rollouts/metricproviders/otlp"
 	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-rollouts/pkg/signals"
@@ -62,11 +64,14 @@ func newCommand() *cobra.Command {
 		klogLevel                      int
 		metricsPort                    int
 		healthzPort                    int
+		otlpReceiverConfig             otlp.ReceiverConfig
+		analysisMeasurementCacheTTL    time.Duration
 		instanceID                     string
 		qps                            float32
 		burst                          int
 		rolloutThreads                 int
 		experimentThreads              int
+		releaseTrainThreads            int
 		analysisThreads                int
 		serviceThreads                 int
 		ingressThreads                 int
@@ -120,6 +125,7 @@ func newCommand() *cobra.Command {
 			defaults.SetAppMeshCRDVersion(appmeshCRDVersion)
 			defaults.SetTraefikAPIGroup(traefikAPIGroup)
 			defaults.SetTraefikVersion(traefikVersion)
+			defaults.SetAnalysisMeasurementCacheTTL(analysisMeasurementCacheTTL)
 
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
@@ -132,11 +138,12 @@ func newCommand() *cobra.Command {
 				namespace = configNS
 			}
 			log.WithFields(log.Fields{
-				"version":     version.GetVersion(),
-				"namespace":   namespace,
-				"instanceID":  instanceID,
-				"metricsPort": metricsPort,
-				"healthzPort": healthzPort,
+				"version":          version.GetVersion(),
+				"namespace":        namespace,
+				"instanceID":       instanceID,
+				"metricsPort":      metricsPort,
+				"healthzPort":      healthzPort,
+				"otlpReceiverPort": otlpReceiverConfig.Port,
 			}).Info("Argo Rollouts controller starting")
 
 			k8sRequestProvider := &metrics.K8sRequestsCountProvider{}
@@ -167,6 +174,21 @@ func newCommand() *cobra.Command {
 				kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
 					options.LabelSelector = v1alpha1.DefaultRolloutUniqueLabelKey
 				}))
+			// workloadInformerFactory and workloadPodInformerFactory cache the StatefulSets, DaemonSets,
+			// ControllerRevisions and pods which the rollouts of StatefulSet workloads and of the DaemonSet
+			// strategy count by revision. They are started by the rollout controller once such a rollout is
+			// reconciled, and the pods cached are limited to those of StatefulSets and DaemonSets.
+			workloadInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
+				kubeClient,
+				resyncDuration,
+				kubeinformers.WithNamespace(namespace))
+			workloadPodInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
+				kubeClient,
+				resyncDuration,
+				kubeinformers.WithNamespace(namespace),
+				kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+					options.LabelSelector = appsv1.ControllerRevisionHashLabelKey
+				}))
 			instanceIDSelector := controllerutil.InstanceIDRequirement(instanceID)
 			instanceIDTweakListFunc := func(options *metav1.ListOptions) {
 				options.LabelSelector = instanceIDSelector.String()
@@ -256,6 +278,7 @@ func newCommand() *cobra.Command {
 					resyncDuration,
 					metricsPort,
 					healthzPort,
+					otlpReceiverConfig,
 					k8sRequestProvider,
 					dynamicInformerFactory,
 					clusterDynamicInformerFactory,
@@ -280,6 +303,8 @@ func newCommand() *cobra.Command {
 					tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantAnalysisTemplateInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantClusterAnalysisTemplateInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantRolloutScheduleInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantReleaseTrainInformer(dynamicInformerFactory),
 					istioPrimaryDynamicClient,
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioVirtualServiceGVR()).Informer(),
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
@@ -289,6 +314,7 @@ func newCommand() *cobra.Command {
 					instanceID,
 					metricsPort,
 					healthzPort,
+					otlpReceiverConfig,
 					k8sRequestProvider,
 					nginxIngressClasses,
 					albIngressClasses,
@@ -298,12 +324,14 @@ func newCommand() *cobra.Command {
 					namespaced,
 					kubeInformerFactory,
 					replicaSetInformerFactory,
+					workloadInformerFactory,
+					workloadPodInformerFactory,
 					jobInformerFactory,
 					ephemeralMetadataThreads,
 					ephemeralMetadataPodRetries,
 					selfServiceNotificationEnabled)
 			}
-			if err = cm.Run(ctx, rolloutThreads, serviceThreads, ingressThreads, experimentThreads, analysisThreads, electOpts); err != nil {
+			if err = cm.Run(ctx, rolloutThreads, serviceThreads, ingressThreads, experimentThreads, releaseTrainThreads, analysisThreads, electOpts); err != nil {
 				log.Fatalf("Error running controller: %s", err.Error())
 			}
 			return nil
@@ -323,11 +351,18 @@ func newCommand() *cobra.Command {
 	command.Flags().IntVar(&metricsPort, "metricsport", controller.DefaultMetricsPort, "Set the port the metrics endpoint should be exposed over (deprecated, use --metricsPort)")
 	command.Flags().MarkDeprecated("metricsport", "use --metricsPort instead")
 	command.Flags().IntVar(&healthzPort, "healthzPort", controller.DefaultHealthzPort, "Set the port the healthz endpoint should be exposed over")
+	command.Flags().DurationVar(&analysisMeasurementCacheTTL, "analysis-measurement-cache-ttl", 0, "Share the measurements of identical metrics between AnalysisRuns within time buckets of this duration, e.g. 30s (disabled when 0)")
+	command.Flags().IntVar(&otlpReceiverConfig.Port, "otlp-receiver-port", 0, "Set the port of the OTLP gRPC receiver of the data points queried by OTLP metrics (disabled when 0)")
+	command.Flags().StringVar(&otlpReceiverConfig.TLSCertFile, "otlp-receiver-tls-cert-file", "", "Serve the OTLP receiver over TLS with the certificate of this file")
+	command.Flags().StringVar(&otlpReceiverConfig.TLSKeyFile, "otlp-receiver-tls-key-file", "", "Serve the OTLP receiver over TLS with the key of this file")
+	command.Flags().StringVar(&otlpReceiverConfig.ClientCAFile, "otlp-receiver-client-ca-file", "", "Require OTLP clients to present a certificate verified by the CAs of this file (requires TLS)")
+	command.Flags().StringVar(&otlpReceiverConfig.BearerTokenFile, "otlp-receiver-bearer-token-file", "", "Require OTLP clients to send the bearer token of this file")
 	command.Flags().StringVar(&instanceID, "instance-id", "", "Indicates which argo rollout objects the controller should operate on")
 	command.Flags().Float32Var(&qps, "qps", defaults.DefaultQPS, "Maximum QPS (queries per second) to the K8s API server")
 	command.Flags().IntVar(&burst, "burst", defaults.DefaultBurst, "Maximum burst for throttle.")
 	command.Flags().IntVar(&rolloutThreads, "rollout-threads", controller.DefaultRolloutThreads, "Set the number of worker threads for the Rollout controller")
 	command.Flags().IntVar(&experimentThreads, "experiment-threads", controller.DefaultExperimentThreads, "Set the number of worker threads for the Experiment controller")
+	command.Flags().IntVar(&releaseTrainThreads, "release-train-threads", controller.DefaultReleaseTrainThreads, "Set the number of worker threads for the ReleaseTrain controller")
 	command.Flags().IntVar(&analysisThreads, "analysis-threads", controller.DefaultAnalysisThreads, "Set the number of worker threads for the Experiment controller")
 	command.Flags().IntVar(&serviceThreads, "service-threads", controller.DefaultServiceThreads, "Set the number of worker threads for the Service controller")
 	command.Flags().IntVar(&ingressThreads, "ingress-threads", controller.DefaultIngressThreads, "Set the number of worker threads for the Ingress controller")
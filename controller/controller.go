@@ -47,14 +47,18 @@ import (
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
+	"github.com/argoproj/argo-rollouts/metricproviders/otlp"
+	"github.com/argoproj/argo-rollouts/metricproviders/podhealth"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	rolloutscheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/releasetrain"
 	"github.com/argoproj/argo-rollouts/rollout"
 	"github.com/argoproj/argo-rollouts/service"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	"github.com/argoproj/argo-rollouts/utils/multicluster"
 	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
@@ -75,6 +79,9 @@ const (
 	// DefaultExperimentThreads is the default number of experiment worker threads to start with the controller
 	DefaultExperimentThreads = 10
 
+	// DefaultReleaseTrainThreads is the default number of release train worker threads to start with the controller
+	DefaultReleaseTrainThreads = 10
+
 	// DefaultAnalysisThreads is the default number of analysis worker threads to start with the controller
 	DefaultAnalysisThreads = 30
 
@@ -136,8 +143,10 @@ type Manager struct {
 	wg                      *sync.WaitGroup
 	metricsServer           *metrics.MetricsServer
 	healthzServer           *http.Server
+	otlpReceiver            *otlp.Receiver
 	rolloutController       *rollout.Controller
 	experimentController    *experiments.Controller
+	releaseTrainController  *releasetrain.Controller
 	analysisController      *analysis.Controller
 	serviceController       *service.Controller
 	ingressController       *ingress.Controller
@@ -148,6 +157,8 @@ type Manager struct {
 	analysisRunSynced             cache.InformerSynced
 	analysisTemplateSynced        cache.InformerSynced
 	clusterAnalysisTemplateSynced cache.InformerSynced
+	rolloutScheduleSynced         cache.InformerSynced
+	releaseTrainSynced            cache.InformerSynced
 	serviceSynced                 cache.InformerSynced
 	ingressSynced                 cache.InformerSynced
 	jobSynced                     cache.InformerSynced
@@ -156,14 +167,17 @@ type Manager struct {
 	configMapSynced               cache.InformerSynced
 	secretSynced                  cache.InformerSynced
 
-	rolloutWorkqueue     workqueue.RateLimitingInterface
-	serviceWorkqueue     workqueue.RateLimitingInterface
-	ingressWorkqueue     workqueue.RateLimitingInterface
-	experimentWorkqueue  workqueue.RateLimitingInterface
-	analysisRunWorkqueue workqueue.RateLimitingInterface
+	rolloutWorkqueue      workqueue.RateLimitingInterface
+	serviceWorkqueue      workqueue.RateLimitingInterface
+	ingressWorkqueue      workqueue.RateLimitingInterface
+	experimentWorkqueue   workqueue.RateLimitingInterface
+	releaseTrainWorkqueue workqueue.RateLimitingInterface
+	analysisRunWorkqueue  workqueue.RateLimitingInterface
 
 	refResolver rollout.TemplateRefResolver
 
+	clusterProvider *multicluster.SecretProvider
+
 	kubeClientSet kubernetes.Interface
 
 	namespace string
@@ -199,6 +213,7 @@ func NewAnalysisManager(
 	resyncPeriod time.Duration,
 	metricsPort int,
 	healthzPort int,
+	otlpReceiverConfig otlp.ReceiverConfig,
 	k8sRequestProvider *metrics.K8sRequestsCountProvider,
 	dynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory,
 	clusterDynamicInformerFactory dynamicinformer.DynamicSharedInformerFactory,
@@ -221,6 +236,7 @@ func NewAnalysisManager(
 	})
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
+	otlpBuffer, otlpReceiver := newOTLPReceiver(otlpReceiverConfig)
 	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
 	recorder := record.NewEventRecorder(kubeclientset, metrics.MetricRolloutEventsTotal, metrics.MetricNotificationFailedTotal, metrics.MetricNotificationSuccessTotal, metrics.MetricNotificationSend, nil)
 	analysisController := analysis.NewController(analysis.ControllerConfig{
@@ -233,12 +249,15 @@ func NewAnalysisManager(
 		AnalysisRunWorkQueue: analysisRunWorkqueue,
 		MetricsServer:        metricsServer,
 		Recorder:             recorder,
+		OTLPBuffer:           otlpBuffer,
+		PodHealthCache:       podhealth.NewCache(kubeclientset, namespace, resyncPeriod),
 	})
 
 	cm := &Manager{
 		wg:                            &sync.WaitGroup{},
 		metricsServer:                 metricsServer,
 		healthzServer:                 healthzServer,
+		otlpReceiver:                  otlpReceiver,
 		jobSynced:                     jobInformer.Informer().HasSynced,
 		jobPodsSynced:                 jobPodsInformer.Informer().HasSynced,
 		analysisRunSynced:             analysisRunInformer.Informer().HasSynced,
@@ -287,6 +306,8 @@ func NewManager(
 	analysisRunInformer informers.AnalysisRunInformer,
 	analysisTemplateInformer informers.AnalysisTemplateInformer,
 	clusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer,
+	rolloutScheduleInformer informers.RolloutScheduleInformer,
+	releaseTrainInformer informers.ReleaseTrainInformer,
 	istioPrimaryDynamicClient dynamic.Interface,
 	istioVirtualServiceInformer cache.SharedIndexInformer,
 	istioDestinationRuleInformer cache.SharedIndexInformer,
@@ -296,6 +317,7 @@ func NewManager(
 	instanceID string,
 	metricsPort int,
 	healthzPort int,
+	otlpReceiverConfig otlp.ReceiverConfig,
 	k8sRequestProvider *metrics.K8sRequestsCountProvider,
 	nginxIngressClasses []string,
 	albIngressClasses []string,
@@ -305,6 +327,8 @@ func NewManager(
 	namespaced bool,
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
 	replicaSetInformerFactory kubeinformers.SharedInformerFactory,
+	workloadInformerFactory kubeinformers.SharedInformerFactory,
+	workloadPodInformerFactory kubeinformers.SharedInformerFactory,
 	jobInformerFactory kubeinformers.SharedInformerFactory,
 	ephemeralMetadataThreads int,
 	ephemeralMetadataPodRetries int,
@@ -325,8 +349,10 @@ func NewManager(
 	})
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
+	otlpBuffer, otlpReceiver := newOTLPReceiver(otlpReceiverConfig)
 	rolloutWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts")
 	experimentWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Experiments")
+	releaseTrainWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "ReleaseTrains")
 	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
 	serviceWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services")
 	ingressWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses")
@@ -344,6 +370,8 @@ func NewManager(
 	}
 	notificationsController := newNotificationController(dynamicclientset.Resource(v1alpha1.RolloutGVR), rolloutsInformer.Informer(), apiFactory, toUnstructured)
 
+	clusterProvider := multicluster.NewSecretProvider(kubeclientset, defaults.Namespace(), resyncPeriod)
+
 	rolloutController := rollout.NewController(rollout.ControllerConfig{
 		Namespace:                       namespace,
 		KubeClientSet:                   kubeclientset,
@@ -355,10 +383,14 @@ func NewManager(
 		AnalysisRunInformer:             analysisRunInformer,
 		AnalysisTemplateInformer:        analysisTemplateInformer,
 		ClusterAnalysisTemplateInformer: clusterAnalysisTemplateInformer,
+		RolloutScheduleInformer:         rolloutScheduleInformer,
 		IstioPrimaryDynamicClient:       istioPrimaryDynamicClient,
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
 		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
 		ReplicaSetInformer:              replicaSetInformer,
+		WorkloadInformerFactory:         workloadInformerFactory,
+		WorkloadPodInformerFactory:      workloadPodInformerFactory,
+		NodeInformerFactory:             kubeinformers.NewSharedInformerFactory(kubeclientset, resyncPeriod),
 		ServicesInformer:                servicesInformer,
 		IngressWrapper:                  ingressWrap,
 		RolloutsInformer:                rolloutsInformer,
@@ -370,6 +402,7 @@ func NewManager(
 		Recorder:                        recorder,
 		EphemeralMetadataThreads:        ephemeralMetadataThreads,
 		EphemeralMetadataPodRetries:     ephemeralMetadataPodRetries,
+		ClusterProvider:                 clusterProvider,
 	})
 
 	experimentController := experiments.NewController(experiments.ControllerConfig{
@@ -388,6 +421,19 @@ func NewManager(
 		Recorder:                        recorder,
 	})
 
+	releaseTrainController := releasetrain.NewController(releasetrain.ControllerConfig{
+		ArgoProjClientset:               argoprojclientset,
+		ReleaseTrainInformer:            releaseTrainInformer,
+		RolloutsInformer:                rolloutsInformer,
+		ReplicaSetInformer:              replicaSetInformer,
+		AnalysisRunInformer:             analysisRunInformer,
+		AnalysisTemplateInformer:        analysisTemplateInformer,
+		ClusterAnalysisTemplateInformer: clusterAnalysisTemplateInformer,
+		ReleaseTrainWorkQueue:           releaseTrainWorkqueue,
+		MetricsServer:                   metricsServer,
+		Recorder:                        recorder,
+	})
+
 	analysisController := analysis.NewController(analysis.ControllerConfig{
 		KubeClientSet:        kubeclientset,
 		ArgoProjClientset:    argoprojclientset,
@@ -398,6 +444,8 @@ func NewManager(
 		AnalysisRunWorkQueue: analysisRunWorkqueue,
 		MetricsServer:        metricsServer,
 		Recorder:             recorder,
+		OTLPBuffer:           otlpBuffer,
+		PodHealthCache:       podhealth.NewCache(kubeclientset, namespace, resyncPeriod),
 	})
 
 	serviceController := service.NewController(service.ControllerConfig{
@@ -429,6 +477,7 @@ func NewManager(
 		wg:                                   &sync.WaitGroup{},
 		metricsServer:                        metricsServer,
 		healthzServer:                        healthzServer,
+		otlpReceiver:                         otlpReceiver,
 		rolloutSynced:                        rolloutsInformer.Informer().HasSynced,
 		serviceSynced:                        servicesInformer.Informer().HasSynced,
 		ingressSynced:                        ingressWrap.HasSynced,
@@ -438,11 +487,14 @@ func NewManager(
 		analysisRunSynced:                    analysisRunInformer.Informer().HasSynced,
 		analysisTemplateSynced:               analysisTemplateInformer.Informer().HasSynced,
 		clusterAnalysisTemplateSynced:        clusterAnalysisTemplateInformer.Informer().HasSynced,
+		rolloutScheduleSynced:                rolloutScheduleInformer.Informer().HasSynced,
+		releaseTrainSynced:                   releaseTrainInformer.Informer().HasSynced,
 		replicasSetSynced:                    replicaSetInformer.Informer().HasSynced,
 		configMapSynced:                      notificationConfigMapInformerFactory.Core().V1().ConfigMaps().Informer().HasSynced,
 		secretSynced:                         notificationSecretInformerFactory.Core().V1().Secrets().Informer().HasSynced,
 		rolloutWorkqueue:                     rolloutWorkqueue,
 		experimentWorkqueue:                  experimentWorkqueue,
+		releaseTrainWorkqueue:                releaseTrainWorkqueue,
 		analysisRunWorkqueue:                 analysisRunWorkqueue,
 		serviceWorkqueue:                     serviceWorkqueue,
 		ingressWorkqueue:                     ingressWorkqueue,
@@ -450,9 +502,11 @@ func NewManager(
 		serviceController:                    serviceController,
 		ingressController:                    ingressController,
 		experimentController:                 experimentController,
+		releaseTrainController:               releaseTrainController,
 		analysisController:                   analysisController,
 		notificationsController:              notificationsController,
 		refResolver:                          refResolver,
+		clusterProvider:                      clusterProvider,
 		namespace:                            namespace,
 		instanceID:                           instanceID,
 		kubeClientSet:                        kubeclientset,
@@ -495,10 +549,24 @@ func objectToUnstructured(obj metav1.Object) (*unstructured.Unstructured, error)
 	return res, nil
 }
 
+// newOTLPReceiver returns the buffer and the receiver of the data points pushed over OTLP, or nil if the receiver is
+// disabled
+func newOTLPReceiver(cfg otlp.ReceiverConfig) (*otlp.Buffer, *otlp.Receiver) {
+	if cfg.Port <= 0 {
+		return nil, nil
+	}
+	buffer := otlp.NewBuffer(otlp.DefaultRetention, otlp.DefaultMaxPoints)
+	receiver, err := otlp.NewReceiver(fmt.Sprintf(listenAddr, cfg.Port), buffer, cfg)
+	if err != nil {
+		log.Fatalf("Failed to create the OTLP receiver: %v", err)
+	}
+	return buffer, receiver
+}
+
 // Run will sync informer caches and start controllers. It will block until stopCh
 // is closed, at which point it will shutdown the workqueue and wait for
 // controllers to finish processing their current work items.
-func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int, electOpts *LeaderElectionOptions) error {
+func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, releaseTrainThreadiness, analysisThreadiness int, electOpts *LeaderElectionOptions) error {
 	defer runtime.HandleCrash()
 	defer func() {
 		log.Infof("Exiting Main Run function")
@@ -519,9 +587,18 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 		}
 	}()
 
+	if c.otlpReceiver != nil {
+		go func() {
+			log.Infof("Starting OTLP Receiver at %s", c.otlpReceiver.Addr)
+			if err := c.otlpReceiver.ListenAndServe(); err != nil {
+				log.Error(fmt.Errorf("OTLP Receiver Error: %w", err))
+			}
+		}()
+	}
+
 	if !electOpts.LeaderElect {
 		log.Info("Leader election is turned off. Running in single-instance mode")
-		go c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness)
+		go c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, releaseTrainThreadiness, analysisThreadiness)
 		<-ctx.Done()
 	} else {
 		// id used to distinguish between multiple controller manager instances
@@ -553,7 +630,7 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 			Callbacks: leaderelection.LeaderCallbacks{
 				OnStartedLeading: func(ctx context.Context) {
 					log.Infof("I am the new leader: %s", id)
-					c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness)
+					c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, releaseTrainThreadiness, analysisThreadiness)
 				},
 				OnStoppedLeading: func() {
 					log.Infof("OnStoppedLeading called, shutting down: %s, context err: %s", id, ctx.Err())
@@ -572,6 +649,7 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 		c.ingressWorkqueue.ShutDownWithDrain()
 		c.rolloutWorkqueue.ShutDownWithDrain()
 		c.experimentWorkqueue.ShutDownWithDrain()
+		c.releaseTrainWorkqueue.ShutDownWithDrain()
 	}
 
 	c.analysisRunWorkqueue.ShutDownWithDrain()
@@ -580,13 +658,16 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 	defer cancel()
 	c.healthzServer.Shutdown(ctxWithTimeout)
 	c.metricsServer.Shutdown(ctxWithTimeout)
+	if c.otlpReceiver != nil {
+		c.otlpReceiver.Shutdown()
+	}
 
 	c.wg.Wait()
 
 	return nil
 }
 
-func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int) {
+func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, releaseTrainThreadiness, analysisThreadiness int) {
 	defer runtime.HandleCrash()
 	// Start the informer factories to begin populating the informer caches
 	log.Info("Starting Controllers")
@@ -635,15 +716,18 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 
 		// Wait for the caches to be synced before starting workers
 		log.Info("Waiting for controller's informer caches to sync")
-		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.jobPodsSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
+		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.jobPodsSynced, c.rolloutSynced, c.experimentSynced, c.releaseTrainSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
 			log.Fatalf("failed to wait for caches to sync, exiting")
 		}
 		// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
 		if c.namespace == metav1.NamespaceAll {
-			if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterAnalysisTemplateSynced); !ok {
+			if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterAnalysisTemplateSynced, c.rolloutScheduleSynced); !ok {
 				log.Fatalf("failed to wait for cluster-scoped caches to sync, exiting")
 			}
 		}
+		if c.clusterProvider != nil {
+			c.clusterProvider.Start(ctx)
+		}
 
 		c.wg.Add(1)
 		go func() {
@@ -666,6 +750,11 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 			c.wg.Done()
 		}()
 		c.wg.Add(1)
+		go func() {
+			wait.Until(func() { c.releaseTrainController.Run(ctx, releaseTrainThreadiness) }, time.Second, ctx.Done())
+			c.wg.Done()
+		}()
+		c.wg.Add(1)
 		go func() {
 			wait.Until(func() { c.analysisController.Run(ctx, analysisThreadiness) }, time.Second, ctx.Done())
 			c.wg.Done()
@@ -28,9 +28,11 @@ import (
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	experimentsController "github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
+	"github.com/argoproj/argo-rollouts/metricproviders/otlp"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/releasetrain"
 	rolloutController "github.com/argoproj/argo-rollouts/rollout"
 	"github.com/argoproj/argo-rollouts/service"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
@@ -65,6 +67,7 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 	serviceWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services")
 	ingressWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses")
 	experimentWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Experiments")
+	releaseTrainWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "ReleaseTrains")
 	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
 
 	cm := &Manager{
@@ -75,6 +78,8 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		analysisRunSynced:                    alwaysReady,
 		analysisTemplateSynced:               alwaysReady,
 		clusterAnalysisTemplateSynced:        alwaysReady,
+		rolloutScheduleSynced:                alwaysReady,
+		releaseTrainSynced:                   alwaysReady,
 		serviceSynced:                        alwaysReady,
 		ingressSynced:                        alwaysReady,
 		jobSynced:                            alwaysReady,
@@ -86,6 +91,7 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		serviceWorkqueue:                     serviceWorkqueue,
 		ingressWorkqueue:                     ingressWorkqueue,
 		experimentWorkqueue:                  experimentWorkqueue,
+		releaseTrainWorkqueue:                releaseTrainWorkqueue,
 		analysisRunWorkqueue:                 analysisRunWorkqueue,
 		kubeClientSet:                        f.kubeclient,
 		namespace:                            "",
@@ -142,7 +148,11 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
 		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
 		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutScheduleInformer:         i.Argoproj().V1alpha1().RolloutSchedules(),
 		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		WorkloadInformerFactory:         k8sI,
+		WorkloadPodInformerFactory:      k8sI,
+		NodeInformerFactory:             k8sI,
 		ServicesInformer:                k8sI.Core().V1().Services(),
 		IngressWrapper:                  ingressWrapper,
 		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
@@ -208,6 +218,19 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		Recorder:                        record.NewFakeEventRecorder(),
 	})
 
+	cm.releaseTrainController = releasetrain.NewController(releasetrain.ControllerConfig{
+		ArgoProjClientset:               f.client,
+		ReleaseTrainInformer:            i.Argoproj().V1alpha1().ReleaseTrains(),
+		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
+		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		ReleaseTrainWorkQueue:           releaseTrainWorkqueue,
+		MetricsServer:                   cm.metricsServer,
+		Recorder:                        record.NewFakeEventRecorder(),
+	})
+
 	apiFactory := notificationapi.NewFactory(record.NewAPIFactorySettings(i.Argoproj().V1alpha1().AnalysisRuns()), "default", k8sI.Core().V1().Secrets().Informer(), k8sI.Core().V1().ConfigMaps().Informer())
 	// rolloutsInformer := rolloutinformers.NewRolloutInformer(f.client, "", time.Minute, cache.Indexers{})
 	cm.notificationsController = notificationcontroller.NewController(dynamicClient.Resource(v1alpha1.RolloutGVR), i.Argoproj().V1alpha1().Rollouts().Informer(), apiFactory,
@@ -260,6 +283,8 @@ func TestNewManager(t *testing.T) {
 				i.Argoproj().V1alpha1().AnalysisRuns(),
 				i.Argoproj().V1alpha1().AnalysisTemplates(),
 				i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+				i.Argoproj().V1alpha1().RolloutSchedules(),
+				i.Argoproj().V1alpha1().ReleaseTrains(),
 				dynamicClient,
 				istioVirtualServiceInformer,
 				istioDestinationRuleInformer,
@@ -269,6 +294,7 @@ func TestNewManager(t *testing.T) {
 				"test",
 				8090,
 				8080,
+				otlp.ReceiverConfig{},
 				k8sRequestProvider,
 				nil,
 				nil,
@@ -279,6 +305,8 @@ func TestNewManager(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				rolloutController.DefaultEphemeralMetadataThreads,
 				rolloutController.DefaultEphemeralMetadataPodRetries,
 				selfService,
@@ -328,6 +356,7 @@ func TestNewAnalysisManager(t *testing.T) {
 		noResyncPeriodFunc(),
 		8090,
 		8080,
+		otlp.ReceiverConfig{},
 		k8sRequestProvider,
 		nil,
 		dynamicInformerFactory,
@@ -349,7 +378,7 @@ func TestPrimaryController(t *testing.T) {
 		time.Sleep(5 * time.Second)
 		cancel()
 	}()
-	cm.Run(ctx, 1, 1, 1, 1, 1, electOpts)
+	cm.Run(ctx, 1, 1, 1, 1, 1, 1, electOpts)
 }
 
 func TestPrimaryControllerSingleInstanceWithShutdown(t *testing.T) {
@@ -363,7 +392,7 @@ func TestPrimaryControllerSingleInstanceWithShutdown(t *testing.T) {
 		time.Sleep(5 * time.Second)
 		cancel()
 	}()
-	cm.Run(ctx, 1, 1, 1, 1, 1, electOpts)
+	cm.Run(ctx, 1, 1, 1, 1, 1, 1, electOpts)
 }
 
 func TestLeaseLockName(t *testing.T) {
@@ -32,6 +32,9 @@ type MetricsServer struct {
 	reconcileExperimentHistogram *prometheus.HistogramVec
 	errorExperimentCounter       *prometheus.CounterVec
 
+	reconcileReleaseTrainHistogram *prometheus.HistogramVec
+	errorReleaseTrainCounter       *prometheus.CounterVec
+
 	reconcileAnalysisRunHistogram *prometheus.HistogramVec
 	errorAnalysisRunCounter       *prometheus.CounterVec
 	successNotificationCounter    *prometheus.CounterVec
@@ -116,8 +119,11 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 	reg.MustRegister(MetricRolloutEventsTotal)
 	reg.MustRegister(MetricExperimentReconcile)
 	reg.MustRegister(MetricExperimentReconcileError)
+	reg.MustRegister(MetricReleaseTrainReconcile)
+	reg.MustRegister(MetricReleaseTrainReconcileError)
 	reg.MustRegister(MetricAnalysisRunReconcile)
 	reg.MustRegister(MetricAnalysisRunReconcileError)
+	reg.MustRegister(MetricAnalysisMeasurementCacheTotal)
 	reg.MustRegister(MetricNotificationSuccessTotal)
 	reg.MustRegister(MetricNotificationFailedTotal)
 	reg.MustRegister(MetricNotificationSend)
@@ -147,6 +153,9 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 		reconcileExperimentHistogram: MetricExperimentReconcile,
 		errorExperimentCounter:       MetricExperimentReconcileError,
 
+		reconcileReleaseTrainHistogram: MetricReleaseTrainReconcile,
+		errorReleaseTrainCounter:       MetricReleaseTrainReconcileError,
+
 		reconcileAnalysisRunHistogram: MetricAnalysisRunReconcile,
 		errorAnalysisRunCounter:       MetricAnalysisRunReconcileError,
 		successNotificationCounter:    MetricNotificationSuccessTotal,
@@ -171,6 +180,11 @@ func (m *MetricsServer) IncExperimentReconcile(ex *v1alpha1.Experiment, duration
 	m.reconcileExperimentHistogram.WithLabelValues(ex.Namespace, ex.Name).Observe(duration.Seconds())
 }
 
+// IncReleaseTrainReconcile increments the reconcile counter for a ReleaseTrain
+func (m *MetricsServer) IncReleaseTrainReconcile(rt *v1alpha1.ReleaseTrain, duration time.Duration) {
+	m.reconcileReleaseTrainHistogram.WithLabelValues(rt.Namespace, rt.Name).Observe(duration.Seconds())
+}
+
 // IncAnalysisRunReconcile increments the reconcile counter for an AnalysisRun
 func (m *MetricsServer) IncAnalysisRunReconcile(ar *v1alpha1.AnalysisRun, duration time.Duration) {
 	m.reconcileAnalysisRunHistogram.WithLabelValues(ar.Namespace, ar.Name).Observe(duration.Seconds())
@@ -185,6 +199,8 @@ func (m *MetricsServer) IncError(namespace, name string, kind string) {
 		m.errorAnalysisRunCounter.WithLabelValues(namespace, name).Inc()
 	case log.ExperimentKey:
 		m.errorExperimentCounter.WithLabelValues(namespace, name).Inc()
+	case log.ReleaseTrainKey:
+		m.errorReleaseTrainCounter.WithLabelValues(namespace, name).Inc()
 	}
 }
 
@@ -262,6 +278,10 @@ func (m *MetricsServer) Remove(namespace string, name string, kind string) {
 
 			MetricExperimentReconcile.Delete(map[string]string{"namespace": namespace, "name": name})
 			MetricExperimentReconcileError.Delete(map[string]string{"namespace": namespace, "name": name})
+
+		case log.ReleaseTrainKey:
+			m.reconcileReleaseTrainHistogram.Delete(map[string]string{"namespace": namespace, "name": name})
+			m.errorReleaseTrainCounter.Delete(map[string]string{"namespace": namespace, "name": name})
 		}
 	}(namespace, name, kind)
 
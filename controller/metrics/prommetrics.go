@@ -101,6 +101,15 @@ var (
 		},
 		namespaceNameLabels,
 	)
+
+	MetricAnalysisMeasurementCacheTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "analysis_measurement_cache_total",
+			Help: "Count of the lookups of the analysis measurement cache, by provider and result (hit, coalesced or miss)",
+		},
+		[]string{"provider", "result"},
+	)
+
 	MetricAnalysisRunInfo = prometheus.NewDesc(
 		"analysis_run_info",
 		"Information about analysis run.",
@@ -183,6 +192,26 @@ var (
 	)
 )
 
+// ReleaseTrain metrics
+var (
+	MetricReleaseTrainReconcile = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "release_train_reconcile",
+			Help:    "ReleaseTrains reconciliation performance.",
+			Buckets: []float64{0.01, 0.15, .25, .5, 1},
+		},
+		namespaceNameLabels,
+	)
+
+	MetricReleaseTrainReconcileError = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "release_train_reconcile_error",
+			Help: "Error occurring during the release train",
+		},
+		namespaceNameLabels,
+	)
+)
+
 // Notification metrics
 var (
 	MetricNotificationSuccessTotal = prometheus.NewCounterVec(
@@ -41,6 +41,8 @@ var crdPaths = map[string]string{
 	"AnalysisTemplate":        "manifests/crds/analysis-template-crd.yaml",
 	"ClusterAnalysisTemplate": "manifests/crds/cluster-analysis-template-crd.yaml",
 	"AnalysisRun":             "manifests/crds/analysis-run-crd.yaml",
+	"RolloutSchedule":         "manifests/crds/rollout-schedule-crd.yaml",
+	"ReleaseTrain":            "manifests/crds/release-train-crd.yaml",
 }
 
 func setValidationOverride(un *unstructured.Unstructured, fieldOverride map[string]any, path string) {
@@ -104,6 +106,8 @@ func NewCustomResourceDefinition() []*extensionsobj.CustomResourceDefinition {
 	deleteFile("config/crd/argoproj.io_clusteranalysistemplates.yaml")
 	deleteFile("config/crd/argoproj.io_experiments.yaml")
 	deleteFile("config/crd/argoproj.io_rollouts.yaml")
+	deleteFile("config/crd/argoproj.io_rolloutschedules.yaml")
+	deleteFile("config/crd/argoproj.io_releasetrains.yaml")
 	deleteFile("config/crd")
 	deleteFile("config")
 
@@ -219,6 +223,8 @@ func createMetadataValidation(un *unstructured.Unstructured) {
 			analysisJobValidated = append(analysisJobValidated, v)
 		}
 		unstructured.SetNestedSlice(un.Object, analysisJobValidated, prePath...)
+	case "RolloutSchedule", "ReleaseTrain":
+		// RolloutSchedules and ReleaseTrains do not embed any object metadata
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
@@ -257,6 +263,7 @@ func removeK8S118Fields(un *unstructured.Unstructured) {
 		// Replace this with "spec.metrics[].provider.job.spec.template.spec.volumes[].ephemeral.volumeClaimTemplate.spec.resources.{limits/requests}"
 		// when it's ok to only support k8s 1.17+
 		setValidationOverride(un, preserveUnknownFields, "spec.metrics[].provider.job.spec.template.spec.volumes")
+	case "RolloutSchedule", "ReleaseTrain":
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
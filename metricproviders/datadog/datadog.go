@@ -424,7 +424,9 @@ func (p *Provider) parseResponseV1(metric v1alpha1.Metric, response *http.Respon
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Received no bytes in response: %v", err)
 	}
 
-	if response.StatusCode == http.StatusForbidden || response.StatusCode == http.StatusUnauthorized {
+	if metricutil.IsThrottledResponse(response) {
+		return "", v1alpha1.AnalysisPhaseError, metricutil.NewThrottledError(response, fmt.Errorf("received rate limited response code: %v %s", response.StatusCode, string(bodyBytes)))
+	} else if response.StatusCode == http.StatusForbidden || response.StatusCode == http.StatusUnauthorized {
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("received authentication error response code: %v %s", response.StatusCode, string(bodyBytes))
 	} else if response.StatusCode != http.StatusOK {
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("received non 2xx response code: %v %s", response.StatusCode, string(bodyBytes))
@@ -466,7 +468,9 @@ func (p *Provider) parseResponseV2(metric v1alpha1.Metric, response *http.Respon
 		return "", v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("Received no bytes in response: %v", err)
 	}
 
-	if response.StatusCode == http.StatusForbidden || response.StatusCode == http.StatusUnauthorized {
+	if metricutil.IsThrottledResponse(response) {
+		return "", v1alpha1.AnalysisPhaseError, nil, metricutil.NewThrottledError(response, fmt.Errorf("received rate limited response code: %v %s", response.StatusCode, string(bodyBytes)))
+	} else if response.StatusCode == http.StatusForbidden || response.StatusCode == http.StatusUnauthorized {
 		return "", v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("received authentication error response code: %v %s", response.StatusCode, string(bodyBytes))
 	} else if response.StatusCode != http.StatusOK {
 		return "", v1alpha1.AnalysisPhaseError, nil, fmt.Errorf("received non 2xx response code: %v %s", response.StatusCode, string(bodyBytes))
@@ -0,0 +1,103 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+)
+
+// API represents an Elasticsearch API client
+type API interface {
+	Search(index string, body []byte) (*searchResponse, error)
+}
+
+// APIClient is an Elasticsearch API client
+type APIClient struct {
+	url     url.URL
+	client  *http.Client
+	headers []v1alpha1.WebMetricHeader
+	logCTX  log.Entry
+}
+
+type searchResponse struct {
+	Hits struct {
+		// Total is a number up to Elasticsearch 6, and an object since Elasticsearch 7 and in OpenSearch
+		Total json.RawMessage `json:"total"`
+	} `json:"hits"`
+	Aggregations map[string]any `json:"aggregations"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Type   string `json:"type"`
+		Reason string `json:"reason"`
+	} `json:"error"`
+}
+
+// totalHits returns the number of documents matching the query of the search
+func (r *searchResponse) totalHits() (int64, error) {
+	var total struct {
+		Value int64 `json:"value"`
+	}
+	if err := json.Unmarshal(r.Hits.Total, &total); err == nil {
+		return total.Value, nil
+	}
+	var value int64
+	if err := json.Unmarshal(r.Hits.Total, &value); err != nil {
+		return 0, fmt.Errorf("unexpected total hits %s", string(r.Hits.Total))
+	}
+	return value, nil
+}
+
+// Search performs a search of the index with the request body it's passed
+func (api APIClient) Search(index string, body []byte) (*searchResponse, error) {
+	u := api.url
+	u.Path = path.Join(u.Path, index, "_search")
+
+	req, err := http.NewRequest("POST", u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for _, header := range api.headers {
+		req.Header.Set(header.Key, header.Value)
+	}
+
+	r, err := api.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Body.Close()
+
+	b, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if 400 <= r.StatusCode {
+		var errResp errorResponse
+		err := fmt.Errorf("error response: %s", string(b))
+		if jsonErr := json.Unmarshal(b, &errResp); jsonErr == nil && errResp.Error.Reason != "" {
+			err = fmt.Errorf("error response: %s: %s", errResp.Error.Type, errResp.Error.Reason)
+		}
+		if metricutil.IsThrottledResponse(r) {
+			err = metricutil.NewThrottledError(r, err)
+		}
+		return nil, err
+	}
+
+	var result searchResponse
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
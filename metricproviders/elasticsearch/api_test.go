@@ -0,0 +1,117 @@
+package elasticsearch
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func testElasticsearchMetric(addr string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Provider: v1alpha1.MetricProvider{
+			Elasticsearch: &v1alpha1.ElasticsearchMetric{
+				Address: addr,
+				Index:   "logs-*",
+				Headers: []v1alpha1.WebMetricHeader{{Key: "Authorization", Value: "ApiKey secret"}},
+			},
+		},
+	}
+}
+
+func TestNewAPIClientWithValidURL(t *testing.T) {
+	c, err := NewAPIClient(testElasticsearchMetric("https://some-elasticsearch.foo:9200"), log.Entry{})
+	assert.NoError(t, err)
+	assert.Nil(t, c.client.Transport)
+}
+
+func TestNewAPIClientWithInvalidURL(t *testing.T) {
+	addr := ":::"
+	c, err := NewAPIClient(testElasticsearchMetric(addr), log.Entry{})
+	assert.EqualError(t, err, fmt.Sprintf("Elasticsearch address %s is not a valid URL", addr))
+	assert.Nil(t, c)
+
+	_, err = NewAPIClient(testElasticsearchMetric(""), log.Entry{})
+	assert.Error(t, err)
+}
+
+func TestNewAPIClientOptions(t *testing.T) {
+	metric := testElasticsearchMetric("https://some-elasticsearch.foo:9200")
+	metric.Provider.Elasticsearch.TimeoutSeconds = 3
+	metric.Provider.Elasticsearch.Insecure = true
+	c, err := NewAPIClient(metric, log.Entry{})
+	require.NoError(t, err)
+	assert.Equal(t, "3s", c.client.Timeout.String())
+	assert.Equal(t, insecureTransport, c.client.Transport)
+}
+
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		response      string
+		expectedTotal int64
+		expectedErr   string
+	}{{
+		name:          "total as object",
+		status:        http.StatusOK,
+		response:      `{"hits": {"total": {"value": 42, "relation": "eq"}, "hits": []}}`,
+		expectedTotal: 42,
+	}, {
+		name:          "total as number",
+		status:        http.StatusOK,
+		response:      `{"hits": {"total": 7, "hits": []}}`,
+		expectedTotal: 7,
+	}, {
+		name:        "error response",
+		status:      http.StatusBadRequest,
+		response:    `{"error": {"type": "parsing_exception", "reason": "unknown query [foo]"}, "status": 400}`,
+		expectedErr: "error response: parsing_exception: unknown query [foo]",
+	}, {
+		name:        "error response without reason",
+		status:      http.StatusUnauthorized,
+		response:    `Unauthorized`,
+		expectedErr: "error response: Unauthorized",
+	}, {
+		name:        "invalid response",
+		status:      http.StatusOK,
+		response:    `not json`,
+		expectedErr: "invalid character 'o' in literal null (expecting 'u')",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "POST", r.Method)
+				assert.Equal(t, "/es/logs-*/_search", r.URL.Path)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, "ApiKey secret", r.Header.Get("Authorization"))
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.Equal(t, `{"size":0}`, string(body))
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			c, err := NewAPIClient(testElasticsearchMetric(server.URL+"/es"), log.Entry{})
+			require.NoError(t, err)
+			response, err := c.Search("logs-*", []byte(`{"size":0}`))
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			total, err := response.totalHits()
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedTotal, total)
+		})
+	}
+}
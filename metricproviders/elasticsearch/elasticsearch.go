@@ -0,0 +1,222 @@
+package elasticsearch
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is Elasticsearch.
+	ProviderType = "Elasticsearch"
+	// DefaultTimestampField is the field holding the time of the documents when none is set
+	DefaultTimestampField = "@timestamp"
+	// DefaultWindow is the time window of the search when the metric has no interval
+	DefaultWindow = 5 * time.Minute
+
+	// aggregationName is the name of the aggregation in the search request and response
+	aggregationName = "result"
+)
+
+var insecureTransport *http.Transport = &http.Transport{
+	TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+}
+
+// NewAPIClient generates a APIClient from the metric configuration
+func NewAPIClient(metric v1alpha1.Metric, logCTX log.Entry) (*APIClient, error) {
+	addr := metric.Provider.Elasticsearch.Address
+	esURL, err := url.Parse(addr)
+	if addr == "" || err != nil {
+		return nil, fmt.Errorf("%s address %s is not a valid URL", ProviderType, addr)
+	}
+
+	timeout := time.Duration(10) * time.Second
+	if metric.Provider.Elasticsearch.TimeoutSeconds > 0 {
+		timeout = time.Duration(metric.Provider.Elasticsearch.TimeoutSeconds) * time.Second
+	}
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	if metric.Provider.Elasticsearch.Insecure {
+		client.Transport = insecureTransport
+	}
+
+	return &APIClient{
+		logCTX:  logCTX,
+		client:  client,
+		headers: metric.Provider.Elasticsearch.Headers,
+		url:     *esURL,
+	}, nil
+}
+
+// Provider contains the required components to run an Elasticsearch search.
+type Provider struct {
+	api    API
+	logCtx log.Entry
+}
+
+// Type indicates provider is an Elasticsearch provider.
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run searches the documents of the interval of the metric before the measurement.
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	body, err := buildSearchRequest(metric, startTime.Time)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+
+	response, err := p.api.Search(metric.Provider.Elasticsearch.Index, body)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+
+	result, err := processResponse(metric.Provider.Elasticsearch, response)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Value = string(value)
+
+	newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+
+	newMeasurement.Phase = newStatus
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+
+	return newMeasurement
+}
+
+// buildSearchRequest returns the body of the search, which filters the documents matching the query by the time
+// window ending at now
+func buildSearchRequest(metric v1alpha1.Metric, now time.Time) ([]byte, error) {
+	es := metric.Provider.Elasticsearch
+	window := DefaultWindow
+	if metric.Interval != "" {
+		interval, err := metric.Interval.Duration()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse interval as duration: %w", err)
+		}
+		window = interval
+	}
+	timestampField := es.TimestampField
+	if timestampField == "" {
+		timestampField = DefaultTimestampField
+	}
+
+	filters := []any{
+		map[string]any{
+			"range": map[string]any{
+				timestampField: map[string]any{
+					"gte":    now.Add(-window).UTC().Format(time.RFC3339Nano),
+					"lte":    now.UTC().Format(time.RFC3339Nano),
+					"format": "strict_date_optional_time",
+				},
+			},
+		},
+	}
+	if es.Query != "" {
+		var query map[string]any
+		if err := json.Unmarshal([]byte(es.Query), &query); err != nil {
+			return nil, fmt.Errorf("failed to parse query: %w", err)
+		}
+		filters = append(filters, query)
+	}
+
+	request := map[string]any{
+		"size":             0,
+		"track_total_hits": true,
+		"query": map[string]any{
+			"bool": map[string]any{
+				"filter": filters,
+			},
+		},
+	}
+	if es.Aggregation != "" {
+		var aggregation map[string]any
+		if err := json.Unmarshal([]byte(es.Aggregation), &aggregation); err != nil {
+			return nil, fmt.Errorf("failed to parse aggregation: %w", err)
+		}
+		request["aggs"] = map[string]any{
+			aggregationName: aggregation,
+		}
+	}
+	return json.Marshal(request)
+}
+
+// processResponse returns the number of matching documents, or the value of the aggregation. The result of an
+// aggregation without a single value, such as a filter or a terms aggregation, is the whole aggregation.
+func processResponse(es *v1alpha1.ElasticsearchMetric, response *searchResponse) (any, error) {
+	if es.Aggregation == "" {
+		total, err := response.totalHits()
+		if err != nil {
+			return nil, err
+		}
+		return float64(total), nil
+	}
+
+	aggregation, ok := response.Aggregations[aggregationName]
+	if !ok {
+		return nil, fmt.Errorf("aggregation '%s' not found in the response", aggregationName)
+	}
+	result, ok := aggregation.(map[string]any)
+	if !ok {
+		return aggregation, nil
+	}
+	if value, ok := result["value"]; ok {
+		return value, nil
+	}
+	return result, nil
+}
+
+// Resume should not be used with the Elasticsearch provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Elasticsearch provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used with the Elasticsearch provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Elasticsearch provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the Elasticsearch provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+// NewElasticsearchProvider returns a new Elasticsearch provider
+func NewElasticsearchProvider(api API, logCtx log.Entry) *Provider {
+	return &Provider{
+		logCtx: logCtx,
+		api:    api,
+	}
+}
@@ -0,0 +1,145 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newMetric(query, aggregation, successCondition string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "errors",
+		Interval:         "2m",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			Elasticsearch: &v1alpha1.ElasticsearchMetric{
+				Address:     "http://elasticsearch:9200",
+				Index:       "logs-*",
+				Query:       query,
+				Aggregation: aggregation,
+			},
+		},
+	}
+}
+
+func newResponse(t *testing.T, response string) *searchResponse {
+	var r searchResponse
+	require.NoError(t, json.Unmarshal([]byte(response), &r))
+	return &r
+}
+
+func TestType(t *testing.T) {
+	p := NewElasticsearchProvider(&mockAPI{}, log.Entry{})
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(newMetric("", "", "")))
+	assert.NoError(t, p.GarbageCollect(nil, newMetric("", "", ""), 0))
+}
+
+func TestRunCount(t *testing.T) {
+	api := &mockAPI{response: newResponse(t, `{"hits": {"total": {"value": 3}}}`)}
+	p := NewElasticsearchProvider(api, *log.NewEntry(log.New()))
+	metric := newMetric(`{"term": {"log.level": "error"}}`, "", "result < 5")
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "3", measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+	assert.Equal(t, "logs-*", api.index)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(api.body, &body))
+	assert.Equal(t, float64(0), body["size"])
+	assert.NotContains(t, body, "aggs")
+	filters := body["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	require.Len(t, filters, 2)
+	assert.Equal(t, map[string]any{"term": map[string]any{"log.level": "error"}}, filters[1])
+	timeRange := filters[0].(map[string]any)["range"].(map[string]any)[DefaultTimestampField].(map[string]any)
+	gte, err := time.Parse(time.RFC3339Nano, timeRange["gte"].(string))
+	require.NoError(t, err)
+	lte, err := time.Parse(time.RFC3339Nano, timeRange["lte"].(string))
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, lte.Sub(gte))
+}
+
+func TestRunAggregation(t *testing.T) {
+	api := &mockAPI{response: newResponse(t, `{"hits": {"total": {"value": 200}}, "aggregations": {"result": {"value": 0.02}}}`)}
+	p := NewElasticsearchProvider(api, *log.NewEntry(log.New()))
+	metric := newMetric("", `{"avg": {"field": "error"}}`, "result < 0.01")
+	metric.Interval = ""
+	metric.Provider.Elasticsearch.TimestampField = "time"
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "0.02", measurement.Value)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(api.body, &body))
+	assert.Equal(t, map[string]any{"result": map[string]any{"avg": map[string]any{"field": "error"}}}, body["aggs"])
+	filters := body["query"].(map[string]any)["bool"].(map[string]any)["filter"].([]any)
+	require.Len(t, filters, 1)
+	timeRange := filters[0].(map[string]any)["range"].(map[string]any)["time"].(map[string]any)
+	gte, _ := time.Parse(time.RFC3339Nano, timeRange["gte"].(string))
+	lte, _ := time.Parse(time.RFC3339Nano, timeRange["lte"].(string))
+	assert.Equal(t, DefaultWindow, lte.Sub(gte))
+}
+
+func TestRunAggregationWithoutValue(t *testing.T) {
+	api := &mockAPI{response: newResponse(t, `{"hits": {"total": {"value": 0}}, "aggregations": {"result": {"value": null}}}`)}
+	p := NewElasticsearchProvider(api, *log.NewEntry(log.New()))
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric("", `{"avg": {"field": "error"}}`, "result < 0.01"))
+
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "null", measurement.Value)
+}
+
+func TestRunMultiValueAggregation(t *testing.T) {
+	api := &mockAPI{response: newResponse(t, `{"hits": {"total": {"value": 200}}, "aggregations": {"result": {"doc_count": 4}}}`)}
+	p := NewElasticsearchProvider(api, *log.NewEntry(log.New()))
+	metric := newMetric("", `{"filter": {"term": {"log.level": "error"}}}`, "result.doc_count < 5")
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, `{"doc_count":4}`, measurement.Value)
+}
+
+func TestRunErrors(t *testing.T) {
+	p := NewElasticsearchProvider(&mockAPI{err: errors.New("connection refused")}, *log.NewEntry(log.New()))
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newMetric("", "", "result < 5"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "connection refused", measurement.Message)
+
+	p = NewElasticsearchProvider(&mockAPI{response: newResponse(t, `{"hits": {"total": {"value": 3}}}`)}, *log.NewEntry(log.New()))
+	tests := []struct {
+		metric  v1alpha1.Metric
+		message string
+	}{
+		{newMetric("{", "", "result < 5"), "failed to parse query"},
+		{newMetric("", "[", "result < 5"), "failed to parse aggregation"},
+		{newMetric("", `{"avg": {"field": "error"}}`, "result < 5"), "aggregation 'result' not found in the response"},
+	}
+	for _, test := range tests {
+		measurement := p.Run(&v1alpha1.AnalysisRun{}, test.metric)
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+		assert.Contains(t, measurement.Message, test.message)
+	}
+
+	metric := newMetric("", "", "result < 5")
+	metric.Interval = "invalid"
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Contains(t, measurement.Message, "failed to parse interval as duration")
+}
+
+func TestResumeAndTerminate(t *testing.T) {
+	p := NewElasticsearchProvider(&mockAPI{}, *log.NewEntry(log.New()))
+	measurement := v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseRunning}
+	assert.Equal(t, measurement, p.Resume(&v1alpha1.AnalysisRun{}, newMetric("", "", ""), measurement))
+	assert.Equal(t, measurement, p.Terminate(&v1alpha1.AnalysisRun{}, newMetric("", "", ""), measurement))
+}
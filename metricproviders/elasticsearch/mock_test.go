@@ -0,0 +1,17 @@
+package elasticsearch
+
+type mockAPI struct {
+	response *searchResponse
+	err      error
+	index    string
+	body     []byte
+}
+
+func (m *mockAPI) Search(index string, body []byte) (*searchResponse, error) {
+	m.index = index
+	m.body = body
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.response, nil
+}
@@ -13,6 +13,8 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 )
 
 // API represents a Graphite API client
@@ -61,7 +63,11 @@ func (api APIClient) Query(quer string) ([]dataPoint, error) {
 	}
 
 	if 400 <= r.StatusCode {
-		return []dataPoint{}, fmt.Errorf("error response: %s", string(b))
+		err = fmt.Errorf("error response: %s", string(b))
+		if metricutil.IsThrottledResponse(r) {
+			err = metricutil.NewThrottledError(r, err)
+		}
+		return []dataPoint{}, err
 	}
 
 	var result graphiteResponse
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 )
 
 func testGraphiteMetric(addr string) v1alpha1.Metric {
@@ -322,3 +323,21 @@ func TestQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryThrottled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("too many requests"))
+	}))
+	defer ts.Close()
+
+	g, err := NewAPIClient(testGraphiteMetric(ts.URL), log.Entry{})
+	assert.Nil(t, err)
+
+	_, err = g.Query("target=sumSeries(app.http.*.*.count)")
+	var throttledErr *metricutil.ThrottledError
+	assert.True(t, errors.As(err, &throttledErr))
+	assert.Equal(t, 120*time.Second, throttledErr.RetryAfter)
+	assert.EqualError(t, err, "error response: too many requests")
+}
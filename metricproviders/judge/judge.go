@@ -0,0 +1,169 @@
+package judge
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is the judge
+	ProviderType = "Judge"
+	// PValueMetadataSuffix is the suffix of the metadata keys holding the p-values of the comparisons
+	PValueMetadataSuffix = ".pValue"
+
+	defaultConfidenceLevel = 95
+)
+
+// NewProviderFunc creates the provider of a query
+type NewProviderFunc func(logCtx log.Entry, namespace string, metric v1alpha1.Metric) (metric.Provider, error)
+
+type Provider struct {
+	logCtx      log.Entry
+	namespace   string
+	newProvider NewProviderFunc
+}
+
+// NewJudgeProvider creates a judge provider, which runs its queries with the providers created by newProvider
+func NewJudgeProvider(logCtx log.Entry, namespace string, newProvider NewProviderFunc) *Provider {
+	return &Provider{
+		logCtx:      logCtx,
+		namespace:   namespace,
+		newProvider: newProvider,
+	}
+}
+
+// Type indicates provider is a judge provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run queries the samples of the canary and of the baseline for every comparison, and scores the canary with the
+// percentage of comparisons which did not find a significant difference
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	judge := metric.Provider.Judge
+	if len(judge.Comparisons) == 0 {
+		return metricutil.MarkMeasurementError(newMeasurement, errors.New("judge has no comparisons"))
+	}
+	confidenceLevel := int32(defaultConfidenceLevel)
+	if judge.ConfidenceLevel != nil {
+		confidenceLevel = *judge.ConfidenceLevel
+	}
+	alpha := 1 - float64(confidenceLevel)/100
+
+	newMeasurement.Metadata = map[string]string{}
+	passed := 0
+	for _, comparison := range judge.Comparisons {
+		canary, err := p.query(run, metric, comparison.Canary)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, fmt.Errorf("comparison '%s' canary: %w", comparison.Name, err))
+		}
+		baseline, err := p.query(run, metric, comparison.Baseline)
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, fmt.Errorf("comparison '%s' baseline: %w", comparison.Name, err))
+		}
+		pValue := mannWhitneyU(canary, baseline, comparison.Direction)
+		newMeasurement.Metadata[comparison.Name+PValueMetadataSuffix] = strconv.FormatFloat(pValue, 'f', 4, 64)
+		if pValue >= alpha {
+			passed++
+		}
+	}
+
+	score := 100 * passed / len(judge.Comparisons)
+	newMeasurement.Value = strconv.Itoa(score)
+	newMeasurement.Phase = evaluateResult(score, int(judge.Threshold.Pass), int(judge.Threshold.Marginal))
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+// query runs a query with its provider and returns the samples of its value
+func (p *Provider) query(run *v1alpha1.AnalysisRun, judgeMetric v1alpha1.Metric, query v1alpha1.JudgeQuery) ([]float64, error) {
+	queryMetric := v1alpha1.Metric{
+		Name:     judgeMetric.Name,
+		Provider: query.MetricProvider(),
+	}
+	provider, err := p.newProvider(p.logCtx, p.namespace, queryMetric)
+	if err != nil {
+		return nil, err
+	}
+	measurement := provider.Run(run, queryMetric)
+	if measurement.Phase == v1alpha1.AnalysisPhaseError {
+		return nil, errors.New(measurement.Message)
+	}
+	if !measurement.Phase.Completed() {
+		return nil, fmt.Errorf("%s provider did not complete the query", provider.Type())
+	}
+	samples, err := parseSamples(measurement.Value)
+	if err != nil {
+		return nil, err
+	}
+	if len(samples) == 0 {
+		return nil, errors.New("query returned no samples")
+	}
+	return samples, nil
+}
+
+// parseSamples parses the value of a measurement, which is either a number or an array of numbers. NaN samples are
+// dropped.
+func parseSamples(value string) ([]float64, error) {
+	fields := strings.FieldsFunc(strings.Trim(value, "[] "), func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	samples := make([]float64, 0, len(fields))
+	for _, field := range fields {
+		sample, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse '%s' as a number or an array of numbers", value)
+		}
+		if !math.IsNaN(sample) {
+			samples = append(samples, sample)
+		}
+	}
+	return samples, nil
+}
+
+func evaluateResult(score int, pass int, marginal int) v1alpha1.AnalysisPhase {
+	if score >= pass {
+		return v1alpha1.AnalysisPhaseSuccessful
+	} else if score >= marginal {
+		return v1alpha1.AnalysisPhaseInconclusive
+	}
+	return v1alpha1.AnalysisPhaseFailed
+}
+
+// Resume should not be used the judge provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Judge provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the judge provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Judge provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the judge provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
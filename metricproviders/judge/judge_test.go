@@ -0,0 +1,179 @@
+package judge
+
+import (
+	"errors"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/metric"
+	"github.com/argoproj/argo-rollouts/metricproviders/mocks"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// fakeProvider returns the measurements of prometheus queries
+type fakeProvider struct {
+	mocks.Provider
+	measurements map[string]v1alpha1.Measurement
+}
+
+func (f *fakeProvider) Type() string {
+	return "Prometheus"
+}
+
+func (f *fakeProvider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	return f.measurements[metric.Provider.Prometheus.Query]
+}
+
+func newFakeProvider(values map[string]string) NewProviderFunc {
+	measurements := map[string]v1alpha1.Measurement{}
+	for query, value := range values {
+		measurements[query] = v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: value}
+	}
+	return func(logCtx log.Entry, namespace string, metric v1alpha1.Metric) (metric.Provider, error) {
+		if metric.Provider.Prometheus == nil {
+			return nil, errors.New("no provider")
+		}
+		return &fakeProvider{measurements: measurements}, nil
+	}
+}
+
+func newComparison(name string, direction v1alpha1.JudgeDirection) v1alpha1.JudgeComparison {
+	return v1alpha1.JudgeComparison{
+		Name:      name,
+		Canary:    v1alpha1.JudgeQuery{Prometheus: &v1alpha1.PrometheusMetric{Query: name + "-canary"}},
+		Baseline:  v1alpha1.JudgeQuery{Prometheus: &v1alpha1.PrometheusMetric{Query: name + "-baseline"}},
+		Direction: direction,
+	}
+}
+
+func newJudgeMetric(comparisons ...v1alpha1.JudgeComparison) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name: "judge",
+		Provider: v1alpha1.MetricProvider{
+			Judge: &v1alpha1.JudgeMetric{
+				Comparisons: comparisons,
+				Threshold:   v1alpha1.KayentaThreshold{Pass: 100, Marginal: 50},
+			},
+		},
+	}
+}
+
+func TestType(t *testing.T) {
+	p := NewJudgeProvider(*log.NewEntry(log.New()), "default", newFakeProvider(nil))
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(newJudgeMetric()))
+	assert.NoError(t, p.GarbageCollect(nil, newJudgeMetric(), 0))
+}
+
+func TestRun(t *testing.T) {
+	p := NewJudgeProvider(*log.NewEntry(log.New()), "default", newFakeProvider(map[string]string{
+		"latency-canary":   "[10,11,12,13,14,15,16,17]",
+		"latency-baseline": "[10.5,11.5,12.5,13.5,14.5,15.5,16.5]",
+		"errors-canary":    "[5,6,7,8,9,10,11,12]",
+		"errors-baseline":  "[0,0.1,0.2,0.3,0.4,0.5,0.6,NaN]",
+	}))
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("latency", ""), newComparison("errors", v1alpha1.JudgeDirectionIncrease)))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, measurement.Phase)
+	assert.Equal(t, "50", measurement.Value)
+	assert.Equal(t, "1.0000", measurement.Metadata["latency.pValue"])
+	assert.Equal(t, "0.0007", measurement.Metadata["errors.pValue"])
+	assert.NotNil(t, measurement.StartedAt)
+	assert.NotNil(t, measurement.FinishedAt)
+
+	// A decrease of errors does not fail the comparison
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("latency", ""), newComparison("errors", v1alpha1.JudgeDirectionDecrease)))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "100", measurement.Value)
+
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("errors", v1alpha1.JudgeDirectionEither)))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "0", measurement.Value)
+}
+
+func TestRunConfidenceLevel(t *testing.T) {
+	p := NewJudgeProvider(*log.NewEntry(log.New()), "default", newFakeProvider(map[string]string{
+		"latency-canary":   "[3,4,5,6,7]",
+		"latency-baseline": "[1,2,3,4,5]",
+	}))
+	metric := newJudgeMetric(newComparison("latency", v1alpha1.JudgeDirectionIncrease))
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, "0.0569", measurement.Metadata["latency.pValue"])
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+
+	metric.Provider.Judge.ConfidenceLevel = ptr.To[int32](90)
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+}
+
+func TestRunErrors(t *testing.T) {
+	p := NewJudgeProvider(*log.NewEntry(log.New()), "default", newFakeProvider(map[string]string{
+		"empty-canary":     "[]",
+		"empty-baseline":   "[1]",
+		"invalid-canary":   "[1]",
+		"invalid-baseline": "{}",
+	}))
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric())
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "judge has no comparisons", measurement.Message)
+
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("empty", "")))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "comparison 'empty' canary: query returned no samples", measurement.Message)
+
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("invalid", "")))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "comparison 'invalid' baseline: could not parse '{}' as a number or an array of numbers", measurement.Message)
+
+	comparison := newComparison("web", "")
+	comparison.Canary = v1alpha1.JudgeQuery{Web: &v1alpha1.WebMetric{}}
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(comparison))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "comparison 'web' canary: no provider", measurement.Message)
+}
+
+func TestRunQueryNotCompleted(t *testing.T) {
+	newProvider := func(logCtx log.Entry, namespace string, metric v1alpha1.Metric) (metric.Provider, error) {
+		return &fakeProvider{measurements: map[string]v1alpha1.Measurement{
+			"running-canary": {Phase: v1alpha1.AnalysisPhaseRunning},
+			"error-canary":   {Phase: v1alpha1.AnalysisPhaseError, Message: "query failed"},
+		}}, nil
+	}
+	p := NewJudgeProvider(*log.NewEntry(log.New()), "default", newProvider)
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("running", "")))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "comparison 'running' canary: Prometheus provider did not complete the query", measurement.Message)
+
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newJudgeMetric(newComparison("error", "")))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "comparison 'error' canary: query failed", measurement.Message)
+}
+
+func TestParseSamples(t *testing.T) {
+	samples, err := parseSamples("1.5")
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5}, samples)
+
+	samples, err = parseSamples("[1, 2,3]")
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1, 2, 3}, samples)
+
+	samples, err = parseSamples("[NaN]")
+	assert.NoError(t, err)
+	assert.Empty(t, samples)
+
+	_, err = parseSamples(`["a"]`)
+	assert.Error(t, err)
+}
+
+func TestEvaluateResult(t *testing.T) {
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, evaluateResult(90, 90, 75))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, evaluateResult(75, 90, 75))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, evaluateResult(74, 90, 75))
+}
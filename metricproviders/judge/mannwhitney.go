@@ -0,0 +1,78 @@
+package judge
+
+import (
+	"math"
+	"sort"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// mannWhitneyU returns the p-value of a Mann-Whitney U test of the null hypothesis that the canary samples and the
+// baseline samples come from the same distribution. The direction selects the alternative hypothesis: the canary is
+// higher than the baseline (Increase), lower (Decrease), or either (Either). The p-value is computed with the normal
+// approximation of U, corrected for ties and continuity.
+func mannWhitneyU(canary, baseline []float64, direction v1alpha1.JudgeDirection) float64 {
+	n1 := float64(len(canary))
+	n2 := float64(len(baseline))
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	type sample struct {
+		value  float64
+		canary bool
+	}
+	samples := make([]sample, 0, len(canary)+len(baseline))
+	for _, v := range canary {
+		samples = append(samples, sample{value: v, canary: true})
+	}
+	for _, v := range baseline {
+		samples = append(samples, sample{value: v})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].value < samples[j].value
+	})
+
+	// Tied samples get the average of the ranks they span
+	canaryRanks := 0.0
+	ties := 0.0
+	for i := 0; i < len(samples); {
+		j := i + 1
+		for j < len(samples) && samples[j].value == samples[i].value {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if samples[k].canary {
+				canaryRanks += rank
+			}
+		}
+		t := float64(j - i)
+		ties += t*t*t - t
+		i = j
+	}
+
+	n := n1 + n2
+	u := canaryRanks - n1*(n1+1)/2
+	mean := n1 * n2 / 2
+	sigma := math.Sqrt(n1 * n2 / 12 * ((n + 1) - ties/(n*(n-1))))
+	if sigma == 0 {
+		// All samples are equal
+		return 1
+	}
+
+	switch direction {
+	case v1alpha1.JudgeDirectionIncrease:
+		return upperTail((u - mean - 0.5) / sigma)
+	case v1alpha1.JudgeDirectionDecrease:
+		return upperTail((mean - u - 0.5) / sigma)
+	default:
+		z := (math.Abs(u-mean) - 0.5) / sigma
+		return math.Min(1, 2*upperTail(z))
+	}
+}
+
+// upperTail returns the probability that a standard normal variable is greater than z
+func upperTail(z float64) float64 {
+	return math.Erfc(z/math.Sqrt2) / 2
+}
@@ -0,0 +1,29 @@
+package judge
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestMannWhitneyU(t *testing.T) {
+	canary := []float64{7, 8, 9, 10, 11}
+	baseline := []float64{1, 2, 3, 4, 5}
+
+	assert.InDelta(t, 0.0122, mannWhitneyU(canary, baseline, v1alpha1.JudgeDirectionEither), 0.0001)
+	assert.InDelta(t, 0.0061, mannWhitneyU(canary, baseline, v1alpha1.JudgeDirectionIncrease), 0.0001)
+	assert.InDelta(t, 0.9967, mannWhitneyU(canary, baseline, v1alpha1.JudgeDirectionDecrease), 0.0001)
+	assert.InDelta(t, 0.0061, mannWhitneyU(baseline, canary, v1alpha1.JudgeDirectionDecrease), 0.0001)
+}
+
+func TestMannWhitneyUTies(t *testing.T) {
+	assert.Equal(t, float64(1), mannWhitneyU([]float64{1, 1}, []float64{1, 1, 1}, v1alpha1.JudgeDirectionEither))
+	assert.Equal(t, float64(1), mannWhitneyU([]float64{1, 2, 3}, []float64{1, 2, 3}, v1alpha1.JudgeDirectionEither))
+	assert.InDelta(t, 0.0131, mannWhitneyU([]float64{2, 2, 2, 2}, []float64{1, 1, 1, 1}, v1alpha1.JudgeDirectionEither), 0.0001)
+}
+
+func TestMannWhitneyUNoSamples(t *testing.T) {
+	assert.Equal(t, float64(1), mannWhitneyU(nil, []float64{1}, v1alpha1.JudgeDirectionEither))
+}
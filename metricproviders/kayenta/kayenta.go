@@ -87,7 +87,11 @@ func getCanaryConfigId(metric v1alpha1.Metric, p *Provider) (string, error) {
 	defer response.Body.Close()
 
 	if response.StatusCode != 200 {
-		return "", fmt.Errorf("Invalid Response: HTTP %d", response.StatusCode)
+		err := fmt.Errorf("Invalid Response: HTTP %d", response.StatusCode)
+		if metricutil.IsThrottledResponse(response) {
+			err = metricutil.NewThrottledError(response, err)
+		}
+		return "", err
 	}
 
 	var cc []canaryConfig
@@ -144,6 +148,9 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	if err != nil || response.Body == nil || response.StatusCode != 200 {
 		if err == nil {
 			err = errors.New("Invalid Response")
+			if metricutil.IsThrottledResponse(response) {
+				err = metricutil.NewThrottledError(response, err)
+			}
 		}
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
@@ -182,6 +189,9 @@ func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, mea
 	if err != nil || response.Body == nil || response.StatusCode != 200 {
 		if err == nil {
 			err = errors.New("Invalid Response")
+			if metricutil.IsThrottledResponse(response) {
+				err = metricutil.NewThrottledError(response, err)
+			}
 		}
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 )
 
 func newAnalysisRun() *v1alpha1.AnalysisRun {
@@ -535,6 +536,52 @@ func TestResumeBadResponse(t *testing.T) {
 
 }
 
+func TestRunThrottledLookup(t *testing.T) {
+	e := log.Entry{}
+	c := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       io.NopCloser(bytes.NewBufferString("")),
+			Header:     http.Header{"Retry-After": []string{"120"}},
+		}
+	})
+
+	p := NewKayentaProvider(e, c)
+	metric := buildMetric()
+
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, measurement.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(measurement))
+	assert.Equal(t, "Metric provider throttled: Invalid Response: HTTP 429", measurement.Message)
+	assert.NotNil(t, measurement.ResumeAt)
+}
+
+func TestResumeThrottled(t *testing.T) {
+	e := log.Entry{}
+	c := NewTestClient(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Body:       io.NopCloser(bytes.NewBufferString("")),
+			Header:     http.Header{"Retry-After": []string{"120"}},
+		}
+	})
+
+	p := NewKayentaProvider(e, c)
+
+	metric := buildMetric()
+	m := make(map[string]string)
+	m["canaryExecutionId"] = "01DS50WVHAWSTAQACJKB1VKDQB"
+	measurement := v1alpha1.Measurement{
+		Metadata: m,
+	}
+
+	measurement = p.Resume(newAnalysisRun(), metric, measurement)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, measurement.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(measurement))
+	assert.Equal(t, "01DS50WVHAWSTAQACJKB1VKDQB", measurement.Metadata["canaryExecutionId"])
+	assert.NotNil(t, measurement.ResumeAt)
+}
+
 func TestResumeMissingScore(t *testing.T) {
 	e := log.Entry{}
 	c := NewTestClient(func(req *http.Request) *http.Response {
@@ -0,0 +1,149 @@
+package loki
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is Loki
+	ProviderType = "Loki"
+	// ResolvedLokiQuery is used as the key for storing the resolved LogQL query in the metrics result metadata object.
+	ResolvedLokiQuery = "ResolvedLokiQuery"
+	// TenantHeader is the header holding the tenant of the requests to a multi-tenant Loki
+	TenantHeader = "X-Scope-OrgID"
+
+	// apiPrefix is the path prefix of the Loki HTTP API, under which it serves the same query API as Prometheus
+	apiPrefix = "/loki"
+)
+
+// Provider contains all the required components to run a LogQL query
+type Provider struct {
+	api     v1.API
+	logCtx  log.Entry
+	timeout time.Duration
+}
+
+// Type indicates provider is a Loki provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	metricsMetadata := make(map[string]string)
+	if metric.Provider.Loki.Query != "" {
+		metricsMetadata[ResolvedLokiQuery] = metric.Provider.Loki.Query
+	}
+	return metricsMetadata
+}
+
+// Run queries Loki for the metric
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	response, warnings, err := prometheus.ExecuteQuery(ctx, p.api, metric.Provider.Loki.Query, metric.Provider.Loki.RangeQuery)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+
+	newValue, newStatus, err := prometheus.ProcessResponse(metric, response, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Value = newValue
+	if len(warnings) > 0 {
+		warningMetadata := fmt.Sprintf(`"%s"`, strings.Join(warnings, `", "`))
+		newMeasurement.Metadata = map[string]string{"warnings": warningMetadata}
+		p.logCtx.Warnf("Loki returned the following warnings: %s", warningMetadata)
+	}
+
+	newMeasurement.Phase = newStatus
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+// Resume should not be used the Loki provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Loki provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the Loki provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Loki provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the Loki provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
+
+// NewLokiProvider creates a new Loki provider
+func NewLokiProvider(api v1.API, logCtx log.Entry, metric v1alpha1.Metric) (*Provider, error) {
+	provider := &Provider{
+		logCtx:  logCtx,
+		api:     api,
+		timeout: 30 * time.Second,
+	}
+	if metric.Provider.Loki.Timeout != nil {
+		if *metric.Provider.Loki.Timeout < 0 {
+			return nil, errors.New("loki timeout should not be negative")
+		}
+		provider.timeout = time.Duration(*metric.Provider.Loki.Timeout) * time.Second
+	}
+	return provider, nil
+}
+
+// NewLokiAPI generates an API to the query endpoints of Loki from the metric configuration
+func NewLokiAPI(metric v1alpha1.Metric) (v1.API, error) {
+	loki := metric.Provider.Loki
+	if !prometheus.IsUrl(loki.Address) {
+		return nil, errors.New("loki address is not in url format")
+	}
+	address, err := url.Parse(loki.Address)
+	if err != nil {
+		return nil, err
+	}
+	address.Path = path.Join(address.Path, apiPrefix)
+
+	headers := loki.Headers
+	if loki.TenantID != "" {
+		headers = append([]v1alpha1.WebMetricHeader{{Key: TenantHeader, Value: loki.TenantID}}, headers...)
+	}
+	httpClient, err := prometheus.NewHTTPClient(loki.Address, loki.Insecure, headers, loki.Authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := api.NewClient(api.Config{
+		Address: address.String(),
+		Client:  httpClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v1.NewAPI(client), nil
+}
@@ -0,0 +1,178 @@
+package loki
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+)
+
+const (
+	vectorResponse = `{"status": "success", "data": {"resultType": "vector", "result": [
+		{"metric": {"pod": "a"}, "value": [1700000000, "0.5"]},
+		{"metric": {"pod": "b"}, "value": [1700000000, "2"]}
+	]}, "warnings": ["query is slow"]}`
+	matrixResponse = `{"status": "success", "data": {"resultType": "matrix", "result": [
+		{"metric": {"pod": "a"}, "values": [[1700000000, "0.5"], [1700000060, "1"]]}
+	]}}`
+	streamsResponse = `{"status": "success", "data": {"resultType": "streams", "result": [
+		{"stream": {"pod": "a"}, "values": [["1700000000000000000", "error"]]}
+	]}}`
+)
+
+func newLokiMetric(address string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "errors",
+		SuccessCondition: "all(result, {# < 5})",
+		Provider: v1alpha1.MetricProvider{
+			Loki: &v1alpha1.LokiMetric{
+				Address:  address,
+				Query:    `sum by (pod) (rate({app="guestbook"} |= "error" [1m]))`,
+				TenantID: "team-a",
+				Headers:  []v1alpha1.WebMetricHeader{{Key: "X-Custom", Value: "value"}},
+			},
+		},
+	}
+}
+
+func newServer(t *testing.T, expectedPath, response string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, expectedPath, r.URL.Path)
+		assert.Equal(t, "team-a", r.Header.Get(TenantHeader))
+		assert.Equal(t, "value", r.Header.Get("X-Custom"))
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, `sum by (pod) (rate({app="guestbook"} |= "error" [1m]))`, r.Form.Get("query"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+}
+
+func newProvider(t *testing.T, metric v1alpha1.Metric) *Provider {
+	api, err := NewLokiAPI(metric)
+	require.NoError(t, err)
+	p, err := NewLokiProvider(api, *log.NewEntry(log.New()), metric)
+	require.NoError(t, err)
+	return p
+}
+
+func TestType(t *testing.T) {
+	metric := newLokiMetric("http://loki:3100")
+	p := newProvider(t, metric)
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Equal(t, map[string]string{ResolvedLokiQuery: metric.Provider.Loki.Query}, p.GetMetadata(metric))
+	assert.NoError(t, p.GarbageCollect(nil, metric, 0))
+}
+
+func TestRunInstantQuery(t *testing.T) {
+	server := newServer(t, "/loki/api/v1/query", vectorResponse)
+	defer server.Close()
+
+	metric := newLokiMetric(server.URL)
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "[0.5,2]", measurement.Value)
+	assert.Equal(t, `"query is slow"`, measurement.Metadata["warnings"])
+	assert.NotNil(t, measurement.FinishedAt)
+}
+
+func TestRunRangeQuery(t *testing.T) {
+	server := newServer(t, "/logs/loki/api/v1/query_range", matrixResponse)
+	defer server.Close()
+
+	metric := newLokiMetric(server.URL + "/logs")
+	metric.SuccessCondition = "all(result, {# < 1})"
+	metric.Provider.Loki.RangeQuery = &v1alpha1.PrometheusRangeQueryArgs{
+		Start: `now() - duration("5m")`,
+		End:   "now()",
+		Step:  "1m",
+	}
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "[0.5,1]", measurement.Value)
+
+	metric.Provider.Loki.RangeQuery.Step = "invalid"
+	measurement = newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Contains(t, measurement.Message, "failed to parse rangeQuery.step as duration")
+}
+
+func TestRunLogQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(streamsResponse))
+	}))
+	defer server.Close()
+
+	metric := newLokiMetric(server.URL)
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Contains(t, measurement.Message, "streams")
+}
+
+func TestRunThrottled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	metric := newLokiMetric(server.URL)
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, measurement.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(measurement))
+	assert.Contains(t, measurement.Message, "received rate limited response code: 429")
+	assert.Nil(t, measurement.FinishedAt)
+	assert.NotNil(t, measurement.ResumeAt)
+}
+
+func TestRunBasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(vectorResponse))
+	}))
+	defer server.Close()
+
+	metric := newLokiMetric(server.URL)
+	metric.Provider.Loki.Authentication.BasicAuth = v1alpha1.BasicAuthConfig{Username: "user", Password: "pass"}
+	measurement := newProvider(t, metric).Run(&v1alpha1.AnalysisRun{}, metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+
+	metric.Provider.Loki.Authentication.BasicAuth.Password = ""
+	_, err := NewLokiAPI(metric)
+	assert.EqualError(t, err, "missing mandatory parameter in metric for basic auth setup: password")
+}
+
+func TestNewLokiAPI(t *testing.T) {
+	_, err := NewLokiAPI(newLokiMetric("loki:3100"))
+	assert.EqualError(t, err, "loki address is not in url format")
+}
+
+func TestNewLokiProviderTimeout(t *testing.T) {
+	metric := newLokiMetric("http://loki:3100")
+	timeout := int64(5)
+	metric.Provider.Loki.Timeout = &timeout
+	p := newProvider(t, metric)
+	assert.Equal(t, "5s", p.timeout.String())
+
+	timeout = -1
+	_, err := NewLokiProvider(nil, log.Entry{}, metric)
+	assert.EqualError(t, err, "loki timeout should not be negative")
+}
+
+func TestResumeAndTerminate(t *testing.T) {
+	metric := newLokiMetric("http://loki:3100")
+	p := newProvider(t, metric)
+	measurement := v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseRunning}
+	assert.Equal(t, measurement, p.Resume(&v1alpha1.AnalysisRun{}, metric, measurement))
+	assert.Equal(t, measurement, p.Terminate(&v1alpha1.AnalysisRun{}, metric, measurement))
+}
@@ -11,13 +11,19 @@ import (
 	"github.com/argoproj/argo-rollouts/metric"
 	"github.com/argoproj/argo-rollouts/metricproviders/influxdb"
 	"github.com/argoproj/argo-rollouts/metricproviders/skywalking"
+	"github.com/argoproj/argo-rollouts/metricproviders/sqlmetric"
 
 	"github.com/argoproj/argo-rollouts/metricproviders/cloudwatch"
 	"github.com/argoproj/argo-rollouts/metricproviders/datadog"
+	"github.com/argoproj/argo-rollouts/metricproviders/elasticsearch"
 	"github.com/argoproj/argo-rollouts/metricproviders/graphite"
+	"github.com/argoproj/argo-rollouts/metricproviders/judge"
 	"github.com/argoproj/argo-rollouts/metricproviders/kayenta"
+	"github.com/argoproj/argo-rollouts/metricproviders/loki"
 	"github.com/argoproj/argo-rollouts/metricproviders/newrelic"
+	"github.com/argoproj/argo-rollouts/metricproviders/otlp"
 	"github.com/argoproj/argo-rollouts/metricproviders/plugin"
+	"github.com/argoproj/argo-rollouts/metricproviders/podhealth"
 	"github.com/argoproj/argo-rollouts/metricproviders/wavefront"
 	"github.com/argoproj/argo-rollouts/metricproviders/webmetric"
 
@@ -41,6 +47,10 @@ type ProviderFactory struct {
 	KubeClient    kubernetes.Interface
 	JobLister     batchlisters.JobLister
 	JobPodsLister coreListers.PodLister
+	// OTLPBuffer holds the data points pushed to the OTLP receiver, and is nil when the receiver is not enabled
+	OTLPBuffer *otlp.Buffer
+	// PodHealthCache caches the pods and Warning events measured by the Kubernetes metric
+	PodHealthCache *podhealth.Cache
 }
 
 type ProviderFactoryFunc func(logCtx log.Entry, metric v1alpha1.Metric) (metric.Provider, error)
@@ -94,6 +104,22 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric
 			return nil, err
 		}
 		return graphite.NewGraphiteProvider(client, logCtx), nil
+	case loki.ProviderType:
+		api, err := loki.NewLokiAPI(metric)
+		if err != nil {
+			return nil, err
+		}
+		return loki.NewLokiProvider(api, logCtx, metric)
+	case podhealth.ProviderType:
+		return podhealth.NewPodHealthProvider(logCtx, f.PodHealthCache), nil
+	case sqlmetric.ProviderType:
+		return sqlmetric.NewSQLProvider(logCtx), nil
+	case elasticsearch.ProviderType:
+		client, err := elasticsearch.NewAPIClient(metric, logCtx)
+		if err != nil {
+			return nil, err
+		}
+		return elasticsearch.NewElasticsearchProvider(client, logCtx), nil
 	case cloudwatch.ProviderType:
 		client, err := cloudwatch.NewCloudWatchAPIClient(metric)
 		if err != nil {
@@ -112,6 +138,10 @@ func (f *ProviderFactory) NewProvider(logCtx log.Entry, namespace string, metric
 			return nil, err
 		}
 		return skywalking.NewSkyWalkingProvider(client, logCtx), nil
+	case otlp.ProviderType:
+		return otlp.NewOTLPProvider(logCtx, f.OTLPBuffer, metric), nil
+	case judge.ProviderType:
+		return judge.NewJudgeProvider(logCtx, namespace, f.NewProvider), nil
 	case plugin.ProviderType:
 		plugin, err := plugin.NewRpcPlugin(metric)
 		if err != nil {
@@ -142,10 +172,22 @@ func Type(metric v1alpha1.Metric) string {
 		return cloudwatch.ProviderType
 	} else if metric.Provider.Graphite != nil {
 		return graphite.ProviderType
+	} else if metric.Provider.Loki != nil {
+		return loki.ProviderType
+	} else if metric.Provider.Kubernetes != nil {
+		return podhealth.ProviderType
+	} else if metric.Provider.SQL != nil {
+		return sqlmetric.ProviderType
+	} else if metric.Provider.Elasticsearch != nil {
+		return elasticsearch.ProviderType
 	} else if metric.Provider.Influxdb != nil {
 		return influxdb.ProviderType
 	} else if metric.Provider.SkyWalking != nil {
 		return skywalking.ProviderType
+	} else if metric.Provider.OTLP != nil {
+		return otlp.ProviderType
+	} else if metric.Provider.Judge != nil {
+		return judge.ProviderType
 	} else if metric.Provider.Plugin != nil {
 		return plugin.ProviderType
 	}
@@ -0,0 +1,179 @@
+package otlp
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// NamespaceAttribute is the resource attribute holding the namespace of the AnalysisRuns which may read a data point
+	NamespaceAttribute = "k8s.namespace.name"
+	// AnalysisRunAttribute is the resource attribute restricting a data point to the AnalysisRun of the given name
+	AnalysisRunAttribute = "argo_rollouts.analysis_run"
+
+	// DefaultRetention is the duration for which the buffer keeps data points
+	DefaultRetention = 15 * time.Minute
+	// DefaultMaxPoints is the maximum number of data points the buffer keeps per AnalysisRun or namespace
+	DefaultMaxPoints = 10000
+)
+
+// DataPoint is a data point of a metric pushed over OTLP
+type DataPoint struct {
+	MetricName string
+	// Attributes are the attributes of the resource, scope and data point, in increasing order of precedence
+	Attributes map[string]string
+	Timestamp  time.Time
+	Value      float64
+}
+
+// Buffer keeps the data points pushed over OTLP for a short time, keyed by the AnalysisRun which may read them. Data
+// points without an AnalysisRun attribute may be read by every AnalysisRun of their namespace.
+type Buffer struct {
+	retention time.Duration
+	maxPoints int
+	now       func() time.Time
+
+	mutex  sync.Mutex
+	points map[string][]DataPoint
+}
+
+// NewBuffer returns a buffer keeping data points for the retention duration, and at most maxPoints data points per
+// AnalysisRun or namespace
+func NewBuffer(retention time.Duration, maxPoints int) *Buffer {
+	return &Buffer{
+		retention: retention,
+		maxPoints: maxPoints,
+		now:       time.Now,
+		points:    map[string][]DataPoint{},
+	}
+}
+
+func bufferKey(namespace, analysisRun string) string {
+	return namespace + "/" + analysisRun
+}
+
+// Add adds data points to the buffer. Data points without a namespace attribute are dropped, and the number of added
+// data points is returned.
+func (b *Buffer) Add(points []DataPoint) int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := b.now()
+	added := 0
+	for _, point := range points {
+		namespace := point.Attributes[NamespaceAttribute]
+		if namespace == "" {
+			continue
+		}
+		if point.Timestamp.IsZero() {
+			point.Timestamp = now
+		}
+		key := bufferKey(namespace, point.Attributes[AnalysisRunAttribute])
+		b.points[key] = append(b.points[key], point)
+		added++
+	}
+	b.prune(now)
+	return added
+}
+
+// prune drops the data points older than the retention duration, and the oldest data points over the maximum
+func (b *Buffer) prune(now time.Time) {
+	for key, points := range b.points {
+		kept := points[:0]
+		for _, point := range points {
+			if now.Sub(point.Timestamp) <= b.retention {
+				kept = append(kept, point)
+			}
+		}
+		if len(kept) > b.maxPoints {
+			kept = kept[len(kept)-b.maxPoints:]
+		}
+		if len(kept) == 0 {
+			delete(b.points, key)
+		} else {
+			b.points[key] = kept
+		}
+	}
+}
+
+// Series is a series of a metric, identified by its attributes
+type Series struct {
+	Labels  map[string]string
+	Samples []Sample
+}
+
+// Sample is a value of a series at a point in time
+type Sample struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// Query returns the series of a metric with the given labels which the AnalysisRun may read, with their samples
+// since the given time, in increasing order of time. The series are sorted by their labels.
+func (b *Buffer) Query(namespace, analysisRun, metricName string, labels map[string]string, since time.Time) []Series {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	keys := []string{bufferKey(namespace, "")}
+	if analysisRun != "" {
+		keys = append(keys, bufferKey(namespace, analysisRun))
+	}
+	seriesByID := map[string]*Series{}
+	for _, key := range keys {
+		for _, point := range b.points[key] {
+			if point.MetricName != metricName || point.Timestamp.Before(since) || !matchLabels(point.Attributes, labels) {
+				continue
+			}
+			id := seriesID(point.Attributes)
+			series, ok := seriesByID[id]
+			if !ok {
+				series = &Series{Labels: point.Attributes}
+				seriesByID[id] = series
+			}
+			series.Samples = append(series.Samples, Sample{Timestamp: point.Timestamp, Value: point.Value})
+		}
+	}
+
+	ids := make([]string, 0, len(seriesByID))
+	for id := range seriesByID {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	result := make([]Series, 0, len(ids))
+	for _, id := range ids {
+		series := seriesByID[id]
+		sort.SliceStable(series.Samples, func(i, j int) bool {
+			return series.Samples[i].Timestamp.Before(series.Samples[j].Timestamp)
+		})
+		result = append(result, *series)
+	}
+	return result
+}
+
+func matchLabels(attributes, labels map[string]string) bool {
+	for name, value := range labels {
+		if attributes[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// seriesID returns a string identifying the series with the given labels
+func seriesID(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var id strings.Builder
+	for _, name := range names {
+		id.WriteString(name)
+		id.WriteByte(0xff)
+		id.WriteString(labels[name])
+		id.WriteByte(0xff)
+	}
+	return id.String()
+}
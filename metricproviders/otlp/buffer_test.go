@@ -0,0 +1,63 @@
+package otlp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestBuffer(now time.Time, maxPoints int) *Buffer {
+	b := NewBuffer(10*time.Minute, maxPoints)
+	b.now = func() time.Time {
+		return now
+	}
+	return b
+}
+
+func TestBufferQuery(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	b := newTestBuffer(now, 100)
+	added := b.Add([]DataPoint{
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "pod": "a"}, Timestamp: now.Add(-time.Minute), Value: 1},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "pod": "a"}, Timestamp: now.Add(-2 * time.Minute), Value: 2},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "pod": "b", AnalysisRunAttribute: "run"}, Value: 3},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "pod": "c", AnalysisRunAttribute: "other-run"}, Value: 4},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "other", "pod": "d"}, Value: 5},
+		{MetricName: "latency", Attributes: map[string]string{NamespaceAttribute: "default", "pod": "a"}, Value: 6},
+		{MetricName: "errors", Attributes: map[string]string{"pod": "e"}, Value: 7},
+	})
+	assert.Equal(t, 6, added)
+
+	series := b.Query("default", "run", "errors", nil, now.Add(-5*time.Minute))
+	assert.Len(t, series, 2)
+	// The series of b sorts first by its analysis run attribute
+	assert.Equal(t, "b", series[0].Labels["pod"])
+	assert.Equal(t, []Sample{{Timestamp: now, Value: 3}}, series[0].Samples)
+	assert.Equal(t, "a", series[1].Labels["pod"])
+	assert.Equal(t, []Sample{{Timestamp: now.Add(-2 * time.Minute), Value: 2}, {Timestamp: now.Add(-time.Minute), Value: 1}}, series[1].Samples)
+
+	series = b.Query("default", "run", "errors", map[string]string{"pod": "b"}, now.Add(-5*time.Minute))
+	assert.Len(t, series, 1)
+
+	series = b.Query("default", "run", "errors", nil, now.Add(-90*time.Second))
+	assert.Len(t, series[1].Samples, 1)
+
+	assert.Empty(t, b.Query("default", "run", "requests", nil, now.Add(-5*time.Minute)))
+}
+
+func TestBufferPrune(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	b := newTestBuffer(now, 2)
+	b.Add([]DataPoint{
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default"}, Timestamp: now.Add(-11 * time.Minute), Value: 1},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default"}, Timestamp: now.Add(-3 * time.Minute), Value: 2},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default"}, Timestamp: now.Add(-2 * time.Minute), Value: 3},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default"}, Timestamp: now.Add(-time.Minute), Value: 4},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "other"}, Timestamp: now.Add(-20 * time.Minute), Value: 5},
+	})
+
+	series := b.Query("default", "", "errors", nil, time.Time{})
+	assert.Equal(t, []Sample{{Timestamp: now.Add(-2 * time.Minute), Value: 3}, {Timestamp: now.Add(-time.Minute), Value: 4}}, series[0].Samples)
+	assert.NotContains(t, b.points, bufferKey("other", ""))
+}
@@ -0,0 +1,129 @@
+package otlp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is OTLP
+	ProviderType = "OTLP"
+
+	defaultLookback = 5 * time.Minute
+	defaultTimeout  = 10 * time.Second
+)
+
+// Provider queries the latest sample of the series of a metric, either from a Prometheus remote-read endpoint or from
+// the buffer of the data points pushed to the OTLP receiver
+type Provider struct {
+	logCtx log.Entry
+	client *http.Client
+	buffer *Buffer
+}
+
+// NewOTLPProvider creates an OTLP provider. The buffer is nil when the OTLP receiver is not enabled.
+func NewOTLPProvider(logCtx log.Entry, buffer *Buffer, metric v1alpha1.Metric) *Provider {
+	timeout := defaultTimeout
+	if metric.Provider.OTLP.TimeoutSeconds > 0 {
+		timeout = time.Duration(metric.Provider.OTLP.TimeoutSeconds) * time.Second
+	}
+	return &Provider{
+		logCtx: logCtx,
+		client: &http.Client{Timeout: timeout},
+		buffer: buffer,
+	}
+}
+
+// Type indicates provider is an OTLP provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run queries the series of the metric and evaluates the latest sample of each series
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	series, err := p.query(run, metric.Provider.OTLP, startTime.Time)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	results := make([]float64, 0, len(series))
+	values := make([]string, 0, len(series))
+	for _, s := range series {
+		if len(s.Samples) == 0 {
+			continue
+		}
+		value := s.Samples[len(s.Samples)-1].Value
+		results = append(results, value)
+		values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	newStatus, err := evaluate.EvaluateResult(results, metric, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Value = fmt.Sprintf("[%s]", strings.Join(values, ","))
+	newMeasurement.Phase = newStatus
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+func (p *Provider) query(run *v1alpha1.AnalysisRun, metric *v1alpha1.OTLPMetric, now time.Time) ([]Series, error) {
+	lookback := defaultLookback
+	if metric.Lookback != "" {
+		var err error
+		lookback, err = metric.Lookback.Duration()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse lookback as duration: %w", err)
+		}
+	}
+	start := now.Add(-lookback)
+
+	if metric.Address != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), p.client.Timeout)
+		defer cancel()
+		return remoteRead(ctx, p.client, metric, start, now)
+	}
+	if p.buffer == nil {
+		return nil, errors.New("the OTLP receiver of the controller is not enabled and no remote read address is set")
+	}
+	return p.buffer.Query(run.Namespace, run.Name, metric.MetricName, metric.Labels, start), nil
+}
+
+// Resume should not be used the OTLP provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("OTLP provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the OTLP provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("OTLP provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the OTLP provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
@@ -0,0 +1,193 @@
+package otlp
+
+import (
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+)
+
+func newOTLPMetric(address string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "errors",
+		SuccessCondition: "all(result, {# < 2})",
+		Provider: v1alpha1.MetricProvider{
+			OTLP: &v1alpha1.OTLPMetric{
+				Address:    address,
+				MetricName: "errors",
+				Labels:     map[string]string{"app": "guestbook"},
+				Headers:    []v1alpha1.WebMetricHeader{{Key: "Authorization", Value: "Bearer token"}},
+			},
+		},
+	}
+}
+
+func newAnalysisRun() *v1alpha1.AnalysisRun {
+	run := &v1alpha1.AnalysisRun{}
+	run.Namespace = "default"
+	run.Name = "run"
+	return run
+}
+
+// decodeMatchers returns the matchers of the query of a ReadRequest
+func decodeMatchers(t *testing.T, b []byte) map[string]string {
+	matchers := map[string]string{}
+	err := parseFields(b, func(f field) error {
+		if f.number != 1 {
+			return nil
+		}
+		return parseFields(f.bytes, func(f field) error {
+			if f.number != 3 {
+				return nil
+			}
+			var name, value string
+			err := parseFields(f.bytes, func(f field) error {
+				switch f.number {
+				case 1:
+					assert.Equal(t, uint64(0), f.value)
+				case 2:
+					name = string(f.bytes)
+				case 3:
+					value = string(f.bytes)
+				}
+				return nil
+			})
+			matchers[name] = value
+			return err
+		})
+	})
+	require.NoError(t, err)
+	return matchers
+}
+
+func appendMessage(b []byte, number protowire.Number, message []byte) []byte {
+	b = protowire.AppendTag(b, number, protowire.BytesType)
+	return protowire.AppendBytes(b, message)
+}
+
+func encodeTimeSeries(pod string, values ...float64) []byte {
+	var label []byte
+	label = protowire.AppendTag(label, 1, protowire.BytesType)
+	label = protowire.AppendString(label, "pod")
+	label = protowire.AppendTag(label, 2, protowire.BytesType)
+	label = protowire.AppendString(label, pod)
+	series := appendMessage(nil, 1, label)
+	for i, value := range values {
+		var sample []byte
+		sample = protowire.AppendTag(sample, 1, protowire.Fixed64Type)
+		sample = protowire.AppendFixed64(sample, math.Float64bits(value))
+		sample = protowire.AppendTag(sample, 2, protowire.VarintType)
+		sample = protowire.AppendVarint(sample, uint64(1700000000000+i))
+		series = appendMessage(series, 2, sample)
+	}
+	return series
+}
+
+func TestType(t *testing.T) {
+	p := NewOTLPProvider(*log.NewEntry(log.New()), nil, newOTLPMetric(""))
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(newOTLPMetric("")))
+	assert.NoError(t, p.GarbageCollect(nil, newOTLPMetric(""), 0))
+}
+
+func TestRunRemoteRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "snappy", r.Header.Get("Content-Encoding"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		request, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"__name__": "errors", "app": "guestbook"}, decodeMatchers(t, request))
+
+		var result []byte
+		result = appendMessage(result, 1, encodeTimeSeries("a", 5, 1))
+		result = appendMessage(result, 1, encodeTimeSeries("b", 1.5))
+		w.Write(snappy.Encode(nil, appendMessage(nil, 1, result)))
+	}))
+	defer server.Close()
+
+	metric := newOTLPMetric(server.URL)
+	p := NewOTLPProvider(*log.NewEntry(log.New()), nil, metric)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "[1,1.5]", measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+}
+
+func TestRunRemoteReadError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("invalid query"))
+	}))
+	defer server.Close()
+
+	metric := newOTLPMetric(server.URL)
+	p := NewOTLPProvider(*log.NewEntry(log.New()), nil, metric)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "remote read returned status 400: invalid query", measurement.Message)
+}
+
+func TestRunRemoteReadThrottled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte("too many queries"))
+	}))
+	defer server.Close()
+
+	metric := newOTLPMetric(server.URL)
+	p := NewOTLPProvider(*log.NewEntry(log.New()), nil, metric)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, measurement.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(measurement))
+	assert.Equal(t, "Metric provider throttled: remote read returned status 429: too many queries", measurement.Message)
+	assert.NotNil(t, measurement.ResumeAt)
+}
+
+func TestRunBuffer(t *testing.T) {
+	buffer := NewBuffer(DefaultRetention, DefaultMaxPoints)
+	buffer.Add([]DataPoint{
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "app": "guestbook", "pod": "a"}, Value: 1},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "app": "guestbook", "pod": "b", AnalysisRunAttribute: "run"}, Value: 3},
+		{MetricName: "errors", Attributes: map[string]string{NamespaceAttribute: "default", "app": "other"}, Value: 10},
+	})
+
+	metric := newOTLPMetric("")
+	p := NewOTLPProvider(*log.NewEntry(log.New()), buffer, metric)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, "[3,1]", measurement.Value)
+
+	metric.Provider.OTLP.Lookback = "invalid"
+	measurement = p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Contains(t, measurement.Message, "failed to parse lookback as duration")
+}
+
+func TestRunBufferDisabled(t *testing.T) {
+	metric := newOTLPMetric("")
+	p := NewOTLPProvider(*log.NewEntry(log.New()), nil, metric)
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "the OTLP receiver of the controller is not enabled and no remote read address is set", measurement.Message)
+}
+
+func TestNewOTLPProviderTimeout(t *testing.T) {
+	metric := newOTLPMetric("")
+	assert.Equal(t, defaultTimeout, NewOTLPProvider(*log.NewEntry(log.New()), nil, metric).client.Timeout)
+	metric.Provider.OTLP.TimeoutSeconds = 3
+	assert.Equal(t, 3*time.Second, NewOTLPProvider(*log.NewEntry(log.New()), nil, metric).client.Timeout)
+}
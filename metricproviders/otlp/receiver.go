@@ -0,0 +1,232 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	_ "google.golang.org/grpc/encoding/gzip" // OTLP exporters may compress their requests with gzip
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ReceiverConfig configures the OTLP receiver of the controller
+type ReceiverConfig struct {
+	// Port is the port the receiver listens on. The receiver is disabled when it is 0
+	Port int
+	// TLSCertFile and TLSKeyFile are the certificate and key served by the receiver, which serves plaintext without
+	// them. The files are read again on every handshake so that a rotated certificate is served without a restart
+	TLSCertFile string
+	TLSKeyFile  string
+	// ClientCAFile is the bundle of the CAs which verify the certificates clients must present (mutual TLS)
+	ClientCAFile string
+	// BearerTokenFile is the file holding the token which clients must send as a bearer token in the authorization
+	// metadata of their requests
+	BearerTokenFile string
+}
+
+// Receiver is an OTLP gRPC server which adds the gauge and sum data points pushed to it to a buffer
+type Receiver struct {
+	collectormetricspb.UnimplementedMetricsServiceServer
+	Addr   string
+	buffer *Buffer
+	server *grpc.Server
+	// bearerToken is the token clients must send, if any
+	bearerToken []byte
+}
+
+// NewReceiver returns an OTLP receiver listening on the given address, secured as configured
+func NewReceiver(addr string, buffer *Buffer, cfg ReceiverConfig) (*Receiver, error) {
+	r := &Receiver{
+		Addr:   addr,
+		buffer: buffer,
+	}
+	var opts []grpc.ServerOption
+	tlsConfig, err := newReceiverTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	if cfg.BearerTokenFile != "" {
+		token, err := os.ReadFile(cfg.BearerTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the OTLP receiver bearer token: %w", err)
+		}
+		r.bearerToken = bytes.TrimSpace(token)
+		if len(r.bearerToken) == 0 {
+			return nil, fmt.Errorf("OTLP receiver bearer token file %s is empty", cfg.BearerTokenFile)
+		}
+		if tlsConfig == nil {
+			log.Warn("The OTLP receiver requires a bearer token without TLS: the token is sent in plaintext")
+		}
+		opts = append(opts, grpc.UnaryInterceptor(r.authenticate))
+	}
+	r.server = grpc.NewServer(opts...)
+	collectormetricspb.RegisterMetricsServiceServer(r.server, r)
+	return r, nil
+}
+
+// newReceiverTLSConfig returns the TLS configuration of the receiver, or nil if it serves plaintext
+func newReceiverTLSConfig(cfg ReceiverConfig) (*tls.Config, error) {
+	if cfg.TLSCertFile == "" && cfg.TLSKeyFile == "" {
+		if cfg.ClientCAFile != "" {
+			return nil, fmt.Errorf("the OTLP receiver requires a TLS certificate and key to verify client certificates")
+		}
+		return nil, nil
+	}
+	if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+		return nil, fmt.Errorf("the OTLP receiver requires both a TLS certificate and key")
+	}
+	// fail on startup rather than on the first handshake
+	if _, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile); err != nil {
+		return nil, fmt.Errorf("failed to load the OTLP receiver TLS certificate: %w", err)
+	}
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(cfg.TLSCertFile, cfg.TLSKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			return &cert, nil
+		},
+	}
+	if cfg.ClientCAFile != "" {
+		caPEM, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the OTLP receiver client CA: %w", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificate found in the OTLP receiver client CA file %s", cfg.ClientCAFile)
+		}
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// authenticate rejects the requests which do not send the bearer token of the receiver
+func (r *Receiver) authenticate(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, authorization := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(authorization, "Bearer ")
+		if ok && subtle.ConstantTimeCompare([]byte(token), r.bearerToken) == 1 {
+			return handler(ctx, req)
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "missing or invalid bearer token")
+}
+
+// ListenAndServe listens on the address of the receiver and serves OTLP requests
+func (r *Receiver) ListenAndServe() error {
+	listener, err := net.Listen("tcp", r.Addr)
+	if err != nil {
+		return err
+	}
+	return r.Serve(listener)
+}
+
+// Serve serves OTLP requests on the listener
+func (r *Receiver) Serve(listener net.Listener) error {
+	return r.server.Serve(listener)
+}
+
+// Shutdown stops the receiver once the pending requests are served
+func (r *Receiver) Shutdown() {
+	r.server.GracefulStop()
+}
+
+// Export adds the gauge and sum data points of the request to the buffer
+func (r *Receiver) Export(_ context.Context, request *collectormetricspb.ExportMetricsServiceRequest) (*collectormetricspb.ExportMetricsServiceResponse, error) {
+	points := exportedDataPoints(request)
+	added := r.buffer.Add(points)
+	rejected := len(points) - added
+	response := &collectormetricspb.ExportMetricsServiceResponse{}
+	if rejected > 0 {
+		log.Warnf("Rejected %d OTLP data points without the %s resource attribute", rejected, NamespaceAttribute)
+		response.PartialSuccess = &collectormetricspb.ExportMetricsPartialSuccess{
+			RejectedDataPoints: int64(rejected),
+			ErrorMessage:       fmt.Sprintf("data points require the %s resource attribute", NamespaceAttribute),
+		}
+	}
+	return response, nil
+}
+
+// exportedDataPoints returns the gauge and sum data points of an ExportMetricsServiceRequest, with the attributes of
+// their resource and scope
+func exportedDataPoints(request *collectormetricspb.ExportMetricsServiceRequest) []DataPoint {
+	var points []DataPoint
+	for _, resourceMetrics := range request.GetResourceMetrics() {
+		resourceAttributes := map[string]string{}
+		addAttributes(resourceAttributes, resourceMetrics.GetResource().GetAttributes())
+		for _, scopeMetrics := range resourceMetrics.GetScopeMetrics() {
+			scopeAttributes := maps.Clone(resourceAttributes)
+			addAttributes(scopeAttributes, scopeMetrics.GetScope().GetAttributes())
+			for _, metric := range scopeMetrics.GetMetrics() {
+				points = append(points, metricDataPoints(metric, scopeAttributes)...)
+			}
+		}
+	}
+	return points
+}
+
+func metricDataPoints(metric *metricspb.Metric, scopeAttributes map[string]string) []DataPoint {
+	var dataPoints []*metricspb.NumberDataPoint
+	switch data := metric.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		dataPoints = data.Gauge.GetDataPoints()
+	case *metricspb.Metric_Sum:
+		dataPoints = data.Sum.GetDataPoints()
+	}
+	points := make([]DataPoint, 0, len(dataPoints))
+	for _, dataPoint := range dataPoints {
+		point := DataPoint{MetricName: metric.GetName(), Attributes: maps.Clone(scopeAttributes)}
+		if dataPoint.GetTimeUnixNano() != 0 {
+			point.Timestamp = time.Unix(0, int64(dataPoint.GetTimeUnixNano()))
+		}
+		switch value := dataPoint.GetValue().(type) {
+		case *metricspb.NumberDataPoint_AsDouble:
+			point.Value = value.AsDouble
+		case *metricspb.NumberDataPoint_AsInt:
+			point.Value = float64(value.AsInt)
+		}
+		addAttributes(point.Attributes, dataPoint.GetAttributes())
+		points = append(points, point)
+	}
+	return points
+}
+
+// addAttributes adds the attributes with a scalar value to the map
+func addAttributes(attributes map[string]string, keyValues []*commonpb.KeyValue) {
+	for _, keyValue := range keyValues {
+		switch value := keyValue.GetValue().GetValue().(type) {
+		case *commonpb.AnyValue_StringValue:
+			attributes[keyValue.GetKey()] = value.StringValue
+		case *commonpb.AnyValue_BoolValue:
+			attributes[keyValue.GetKey()] = strconv.FormatBool(value.BoolValue)
+		case *commonpb.AnyValue_IntValue:
+			attributes[keyValue.GetKey()] = strconv.FormatInt(value.IntValue, 10)
+		case *commonpb.AnyValue_DoubleValue:
+			attributes[keyValue.GetKey()] = strconv.FormatFloat(value.DoubleValue, 'f', -1, 64)
+		}
+	}
+}
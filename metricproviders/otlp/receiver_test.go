@@ -0,0 +1,229 @@
+package otlp
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func stringKeyValue(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+// newExportRequest returns an ExportMetricsServiceRequest with a gauge of double data points and a sum of int data
+// points
+func newExportRequest(resourceAttributes map[string]string, timestamp time.Time) *collectormetricspb.ExportMetricsServiceRequest {
+	var attributes []*commonpb.KeyValue
+	for key, value := range resourceAttributes {
+		attributes = append(attributes, stringKeyValue(key, value))
+	}
+	return &collectormetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{{
+			Resource: &resourcepb.Resource{Attributes: attributes},
+			ScopeMetrics: []*metricspb.ScopeMetrics{{
+				Scope: &commonpb.InstrumentationScope{Attributes: []*commonpb.KeyValue{stringKeyValue("library", "test")}},
+				Metrics: []*metricspb.Metric{{
+					Name: "latency",
+					Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: []*metricspb.NumberDataPoint{{
+						TimeUnixNano: uint64(timestamp.UnixNano()),
+						Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: 0.25},
+						Attributes:   []*commonpb.KeyValue{stringKeyValue("route", "/api")},
+					}}}},
+				}, {
+					Name: "errors",
+					Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{DataPoints: []*metricspb.NumberDataPoint{{
+						Value: &metricspb.NumberDataPoint_AsInt{AsInt: -3},
+						Attributes: []*commonpb.KeyValue{{
+							Key:   "code",
+							Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: 500}},
+						}},
+					}}}},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestExportedDataPoints(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	points := exportedDataPoints(newExportRequest(map[string]string{NamespaceAttribute: "default"}, timestamp))
+	assert.Equal(t, []DataPoint{{
+		MetricName: "latency",
+		Attributes: map[string]string{NamespaceAttribute: "default", "library": "test", "route": "/api"},
+		Timestamp:  timestamp,
+		Value:      0.25,
+	}, {
+		MetricName: "errors",
+		Attributes: map[string]string{NamespaceAttribute: "default", "library": "test", "code": "500"},
+		Value:      -3,
+	}}, points)
+}
+
+func TestReceiver(t *testing.T) {
+	receiver := serveReceiver(t, ReceiverConfig{})
+	conn, err := grpc.NewClient(receiver.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := collectormetricspb.NewMetricsServiceClient(conn)
+
+	now := time.Now()
+	response, err := client.Export(t.Context(), newExportRequest(map[string]string{NamespaceAttribute: "default", AnalysisRunAttribute: "run"}, now))
+	require.NoError(t, err)
+	assert.Nil(t, response.GetPartialSuccess())
+	series := receiver.buffer.Query("default", "run", "latency", map[string]string{"route": "/api"}, now.Add(-time.Minute))
+	require.Len(t, series, 1)
+	assert.Equal(t, 0.25, series[0].Samples[0].Value)
+
+	// Data points without a namespace are rejected
+	response, err = client.Export(t.Context(), newExportRequest(nil, now))
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), response.GetPartialSuccess().GetRejectedDataPoints())
+}
+
+func TestReceiverGzip(t *testing.T) {
+	receiver := serveReceiver(t, ReceiverConfig{})
+	conn, err := grpc.NewClient(receiver.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	now := time.Now()
+	request := newExportRequest(map[string]string{NamespaceAttribute: "default", AnalysisRunAttribute: "run"}, now)
+	_, err = collectormetricspb.NewMetricsServiceClient(conn).Export(t.Context(), request, grpc.UseCompressor(gzip.Name))
+	require.NoError(t, err)
+	series := receiver.buffer.Query("default", "run", "errors", map[string]string{"code": "500"}, now.Add(-time.Minute))
+	require.Len(t, series, 1)
+	assert.Equal(t, float64(-3), series[0].Samples[0].Value)
+}
+
+// serveReceiver serves a receiver with the given configuration on a local port
+func serveReceiver(t *testing.T, cfg ReceiverConfig) *Receiver {
+	t.Helper()
+	receiver, err := NewReceiver("127.0.0.1:0", NewBuffer(DefaultRetention, DefaultMaxPoints), cfg)
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", receiver.Addr)
+	require.NoError(t, err)
+	receiver.Addr = listener.Addr().String()
+	go receiver.Serve(listener)
+	t.Cleanup(receiver.Shutdown)
+	return receiver
+}
+
+// export sends an empty ExportMetricsServiceRequest to the receiver
+func export(t *testing.T, receiver *Receiver, opts ...grpc.DialOption) error {
+	t.Helper()
+	conn, err := grpc.NewClient(receiver.Addr, opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = collectormetricspb.NewMetricsServiceClient(conn).Export(t.Context(), &collectormetricspb.ExportMetricsServiceRequest{})
+	return err
+}
+
+// writeFile writes the content to a file of the temporary directory of the test and returns its path
+func writeFile(t *testing.T, name string, content []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	return path
+}
+
+type bearerToken string
+
+func (b bearerToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(b)}, nil
+}
+
+func (b bearerToken) RequireTransportSecurity() bool {
+	return false
+}
+
+func TestReceiverBearerToken(t *testing.T) {
+	receiver := serveReceiver(t, ReceiverConfig{BearerTokenFile: writeFile(t, "token", []byte("secret\n"))})
+	insecureCreds := grpc.WithTransportCredentials(insecure.NewCredentials())
+
+	err := export(t, receiver, insecureCreds)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	err = export(t, receiver, insecureCreds, grpc.WithPerRPCCredentials(bearerToken("wrong")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.NoError(t, export(t, receiver, insecureCreds, grpc.WithPerRPCCredentials(bearerToken("secret"))))
+}
+
+// newClientCertKey returns a self-signed certificate for client authentication and its key, in PEM
+func newClientCertKey(t *testing.T) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestReceiverMutualTLS(t *testing.T) {
+	serverCert, serverKey, err := certutil.GenerateSelfSignedCertKey("127.0.0.1", []net.IP{net.ParseIP("127.0.0.1")}, nil)
+	require.NoError(t, err)
+	clientCert, clientKey := newClientCertKey(t)
+	receiver := serveReceiver(t, ReceiverConfig{
+		TLSCertFile:  writeFile(t, "tls.crt", serverCert),
+		TLSKeyFile:   writeFile(t, "tls.key", serverKey),
+		ClientCAFile: writeFile(t, "ca.crt", clientCert),
+	})
+	serverCAs := x509.NewCertPool()
+	require.True(t, serverCAs.AppendCertsFromPEM(serverCert))
+
+	// plaintext is refused
+	assert.Error(t, export(t, receiver, grpc.WithTransportCredentials(insecure.NewCredentials())))
+	// a client without a certificate is refused
+	err = export(t, receiver, grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: serverCAs})))
+	assert.Error(t, err)
+
+	cert, err := tls.X509KeyPair(clientCert, clientKey)
+	require.NoError(t, err)
+	creds := credentials.NewTLS(&tls.Config{RootCAs: serverCAs, Certificates: []tls.Certificate{cert}})
+	assert.NoError(t, export(t, receiver, grpc.WithTransportCredentials(creds)))
+}
+
+func TestNewReceiverInvalidConfig(t *testing.T) {
+	buffer := NewBuffer(DefaultRetention, DefaultMaxPoints)
+	_, err := NewReceiver("127.0.0.1:0", buffer, ReceiverConfig{TLSCertFile: "tls.crt"})
+	assert.EqualError(t, err, "the OTLP receiver requires both a TLS certificate and key")
+	_, err = NewReceiver("127.0.0.1:0", buffer, ReceiverConfig{ClientCAFile: "ca.crt"})
+	assert.EqualError(t, err, "the OTLP receiver requires a TLS certificate and key to verify client certificates")
+	_, err = NewReceiver("127.0.0.1:0", buffer, ReceiverConfig{BearerTokenFile: writeFile(t, "token", nil)})
+	assert.ErrorContains(t, err, "is empty")
+}
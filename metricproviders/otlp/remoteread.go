@@ -0,0 +1,157 @@
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+)
+
+const (
+	remoteReadVersion = "0.1.0"
+	metricNameLabel   = "__name__"
+	// maxErrorBodySize is the maximum size of the body of an error response included in the error
+	maxErrorBodySize = 512
+)
+
+// remoteRead queries the series of a metric from a Prometheus remote-read endpoint
+func remoteRead(ctx context.Context, client *http.Client, metric *v1alpha1.OTLPMetric, start, end time.Time) ([]Series, error) {
+	body := snappy.Encode(nil, encodeReadRequest(metric, start, end))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, metric.Address, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for _, header := range metric.Headers {
+		request.Header.Set(header.Key, header.Value)
+	}
+	request.Header.Set("Content-Encoding", "snappy")
+	request.Header.Set("Content-Type", "application/x-protobuf")
+	request.Header.Set("X-Prometheus-Remote-Read-Version", remoteReadVersion)
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		if len(data) > maxErrorBodySize {
+			data = data[:maxErrorBodySize]
+		}
+		err = fmt.Errorf("remote read returned status %d: %s", response.StatusCode, string(data))
+		if metricutil.IsThrottledResponse(response) {
+			err = metricutil.NewThrottledError(response, err)
+		}
+		return nil, err
+	}
+	data, err = snappy.Decode(nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress remote read response: %w", err)
+	}
+	return decodeReadResponse(data)
+}
+
+// encodeReadRequest returns a ReadRequest with a single query of the series of the metric with its labels
+func encodeReadRequest(metric *v1alpha1.OTLPMetric, start, end time.Time) []byte {
+	names := make([]string, 0, len(metric.Labels))
+	for name := range metric.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var query []byte
+	query = protowire.AppendTag(query, 1, protowire.VarintType) // start_timestamp_ms
+	query = protowire.AppendVarint(query, uint64(start.UnixMilli()))
+	query = protowire.AppendTag(query, 2, protowire.VarintType) // end_timestamp_ms
+	query = protowire.AppendVarint(query, uint64(end.UnixMilli()))
+	query = appendEqualMatcher(query, metricNameLabel, metric.MetricName)
+	for _, name := range names {
+		query = appendEqualMatcher(query, name, metric.Labels[name])
+	}
+
+	var request []byte
+	request = protowire.AppendTag(request, 1, protowire.BytesType) // queries
+	request = protowire.AppendBytes(request, query)
+	request = protowire.AppendTag(request, 2, protowire.VarintType) // accepted_response_types
+	return protowire.AppendVarint(request, 0)                       // SAMPLES
+}
+
+func appendEqualMatcher(query []byte, name, value string) []byte {
+	var matcher []byte
+	matcher = protowire.AppendTag(matcher, 1, protowire.VarintType) // type
+	matcher = protowire.AppendVarint(matcher, 0)                    // EQ
+	matcher = protowire.AppendTag(matcher, 2, protowire.BytesType)  // name
+	matcher = protowire.AppendString(matcher, name)
+	matcher = protowire.AppendTag(matcher, 3, protowire.BytesType) // value
+	matcher = protowire.AppendString(matcher, value)
+
+	query = protowire.AppendTag(query, 3, protowire.BytesType) // matchers
+	return protowire.AppendBytes(query, matcher)
+}
+
+// decodeReadResponse returns the series of the results of a ReadResponse
+func decodeReadResponse(b []byte) ([]Series, error) {
+	var result []Series
+	err := parseFields(b, func(f field) error {
+		if f.number != 1 { // results
+			return nil
+		}
+		return parseFields(f.bytes, func(f field) error {
+			if f.number != 1 { // timeseries
+				return nil
+			}
+			series, err := decodeTimeSeries(f.bytes)
+			result = append(result, series)
+			return err
+		})
+	})
+	return result, err
+}
+
+func decodeTimeSeries(b []byte) (Series, error) {
+	series := Series{Labels: map[string]string{}}
+	err := parseFields(b, func(f field) error {
+		switch f.number {
+		case 1: // labels
+			var name, value string
+			err := parseFields(f.bytes, func(f field) error {
+				switch f.number {
+				case 1:
+					name = string(f.bytes)
+				case 2:
+					value = string(f.bytes)
+				}
+				return nil
+			})
+			series.Labels[name] = value
+			return err
+		case 2: // samples
+			var sample Sample
+			err := parseFields(f.bytes, func(f field) error {
+				switch f.number {
+				case 1: // value
+					sample.Value = f.double()
+				case 2: // timestamp
+					sample.Timestamp = time.UnixMilli(int64(f.value))
+				}
+				return nil
+			})
+			series.Samples = append(series.Samples, sample)
+			return err
+		}
+		return nil
+	})
+	return series, err
+}
@@ -0,0 +1,54 @@
+package otlp
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// field is a field of a protobuf message. Scalar values are held in value, and the bytes of length-delimited values
+// in bytes.
+type field struct {
+	number protowire.Number
+	value  uint64
+	bytes  []byte
+}
+
+func (f field) double() float64 {
+	return math.Float64frombits(f.value)
+}
+
+// parseFields calls fn with every field of a protobuf message. It decodes the messages of the Prometheus remote-read
+// protocol, whose generated types are not a dependency of the controller.
+func parseFields(b []byte, fn func(f field) error) error {
+	for len(b) > 0 {
+		number, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		f := field{number: number}
+		switch typ {
+		case protowire.VarintType:
+			f.value, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed64Type:
+			f.value, n = protowire.ConsumeFixed64(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.value = uint64(v)
+		case protowire.BytesType:
+			f.bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(number, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package podhealth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// eventsByInvolvedObjectIndex indexes the cached events by the UID of the pod they involve
+	eventsByInvolvedObjectIndex = "involvedObject"
+	// cacheSyncTimeout is how long a measurement waits for the informers to sync
+	cacheSyncTimeout = 30 * time.Second
+)
+
+// Cache caches the pods managed by rollouts and the Warning events of pods for the Kubernetes metric. Its informers
+// are only started once a Kubernetes metric is measured, so that the controller neither caches these resources nor
+// watches them otherwise.
+type Cache struct {
+	podFactory   kubeinformers.SharedInformerFactory
+	eventFactory kubeinformers.SharedInformerFactory
+	once         sync.Once
+	stopCh       <-chan struct{}
+
+	podLister    v1.PodLister
+	eventIndexer cache.Indexer
+	synced       []cache.InformerSynced
+	err          error
+}
+
+// NewCache creates a cache of the pods and Warning events of the namespace, or of all namespaces if it is empty
+func NewCache(kubeclientset kubernetes.Interface, namespace string, resync time.Duration) *Cache {
+	return &Cache{
+		podFactory: kubeinformers.NewSharedInformerFactoryWithOptions(
+			kubeclientset,
+			resync,
+			kubeinformers.WithNamespace(namespace),
+			kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.LabelSelector = v1alpha1.DefaultRolloutUniqueLabelKey
+			})),
+		eventFactory: kubeinformers.NewSharedInformerFactoryWithOptions(
+			kubeclientset,
+			resync,
+			kubeinformers.WithNamespace(namespace),
+			kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+				options.FieldSelector = fields.Set{"involvedObject.kind": "Pod", "type": corev1.EventTypeWarning}.AsSelector().String()
+			})),
+	}
+}
+
+// Run sets the channel which stops the informers once they are started
+func (c *Cache) Run(stopCh <-chan struct{}) {
+	c.stopCh = stopCh
+}
+
+// start starts the informers on first use and waits for them to sync. The informers are only requested from the
+// factories here, since a factory starts every informer requested from it. An error is returned if the informers have
+// not synced within the sync timeout, so that the metric is not measured against a partial cache.
+func (c *Cache) start() error {
+	c.once.Do(func() {
+		pods := c.podFactory.Core().V1().Pods()
+		events := c.eventFactory.Core().V1().Events().Informer()
+		err := events.AddIndexers(cache.Indexers{
+			eventsByInvolvedObjectIndex: func(obj any) ([]string, error) {
+				event, ok := obj.(*corev1.Event)
+				if !ok {
+					return nil, nil
+				}
+				return []string{string(event.InvolvedObject.UID)}, nil
+			},
+		})
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.podLister = pods.Lister()
+		c.eventIndexer = events.GetIndexer()
+		c.synced = []cache.InformerSynced{pods.Informer().HasSynced, events.HasSynced}
+		c.podFactory.Start(c.stopCh)
+		c.eventFactory.Start(c.stopCh)
+	})
+	if c.err != nil {
+		return c.err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), cacheSyncTimeout)
+	defer cancel()
+	if !cache.WaitForCacheSync(ctx.Done(), c.synced...) {
+		return errors.New("timed out waiting for the pod health cache to sync")
+	}
+	return nil
+}
+
+// warningEvents returns the cached Warning events of the pod
+func (c *Cache) warningEvents(pod *corev1.Pod) ([]*corev1.Event, error) {
+	objs, err := c.eventIndexer.ByIndex(eventsByInvolvedObjectIndex, string(pod.UID))
+	if err != nil {
+		return nil, err
+	}
+	events := make([]*corev1.Event, 0, len(objs))
+	for _, obj := range objs {
+		if event, ok := obj.(*corev1.Event); ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
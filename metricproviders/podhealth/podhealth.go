@@ -0,0 +1,198 @@
+package podhealth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is Kubernetes
+	ProviderType = "Kubernetes"
+	// DefaultWindow is the window of the readiness flaps and Warning events when the metric has no interval
+	DefaultWindow = 5 * time.Minute
+
+	reasonOOMKilled        = "OOMKilled"
+	reasonCrashLoopBackOff = "CrashLoopBackOff"
+)
+
+// Health holds the health signals of the pods of a ReplicaSet, which is the result of the evaluation of the metric
+type Health struct {
+	// Pods is the number of pods
+	Pods int `json:"pods"`
+	// ReadyPods is the number of ready pods
+	ReadyPods int `json:"readyPods"`
+	// Restarts is the number of restarts of the containers of the pods
+	Restarts int `json:"restarts"`
+	// OOMKills is the number of containers whose current or last termination is an OOM kill
+	OOMKills int `json:"oomKills"`
+	// CrashLoopBackOffs is the number of containers waiting in CrashLoopBackOff
+	CrashLoopBackOffs int `json:"crashLoopBackOffs"`
+	// ReadinessFlaps is the number of pods started before the window whose readiness changed within the window
+	ReadinessFlaps int `json:"readinessFlaps"`
+	// WarningEvents is the number of Warning events of the pods last seen within the window
+	WarningEvents int `json:"warningEvents"`
+}
+
+// Provider measures the health signals of the pods of a ReplicaSet from the cached pods and Warning events
+type Provider struct {
+	cache  *Cache
+	logCtx log.Entry
+}
+
+// NewPodHealthProvider creates a Kubernetes provider
+func NewPodHealthProvider(logCtx log.Entry, cache *Cache) *Provider {
+	return &Provider{
+		cache:  cache,
+		logCtx: logCtx,
+	}
+}
+
+// Type indicates provider is a Kubernetes provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run measures the health signals of the pods of the pod-template-hash of the metric
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	window := DefaultWindow
+	if metric.Interval != "" {
+		interval, err := metric.Interval.Duration()
+		if err != nil {
+			return metricutil.MarkMeasurementError(newMeasurement, fmt.Errorf("failed to parse interval as duration: %w", err))
+		}
+		window = interval
+	}
+
+	health, err := p.measure(run.Namespace, metric.Provider.Kubernetes.PodTemplateHash, startTime.Add(-window))
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	value, err := json.Marshal(health)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Value = string(value)
+
+	// The conditions reference the fields of the result by their JSON names
+	var result map[string]any
+	if err := json.Unmarshal(value, &result); err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Phase = newStatus
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+func (p *Provider) measure(namespace, podTemplateHash string, since time.Time) (*Health, error) {
+	if p.cache == nil {
+		return nil, errors.New("the pod health cache is not configured")
+	}
+	if err := p.cache.start(); err != nil {
+		return nil, err
+	}
+	selector := labels.Set{v1alpha1.DefaultRolloutUniqueLabelKey: podTemplateHash}.AsSelector()
+	pods, err := p.cache.podLister.Pods(namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &Health{}
+	for _, pod := range pods {
+		measurePod(health, pod, since)
+		events, err := p.cache.warningEvents(pod)
+		if err != nil {
+			return nil, err
+		}
+		for _, event := range events {
+			if event.Type == corev1.EventTypeWarning && !lastSeen(event).Before(since) {
+				health.WarningEvents++
+			}
+		}
+	}
+	return health, nil
+}
+
+func measurePod(health *Health, pod *corev1.Pod, since time.Time) {
+	health.Pods++
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != corev1.PodReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			health.ReadyPods++
+		}
+		startedBeforeWindow := pod.Status.StartTime != nil && pod.Status.StartTime.Time.Before(since)
+		if startedBeforeWindow && !condition.LastTransitionTime.Time.Before(since) {
+			health.ReadinessFlaps++
+		}
+	}
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		health.Restarts += int(status.RestartCount)
+		if (status.State.Terminated != nil && status.State.Terminated.Reason == reasonOOMKilled) ||
+			(status.LastTerminationState.Terminated != nil && status.LastTerminationState.Terminated.Reason == reasonOOMKilled) {
+			health.OOMKills++
+		}
+		if status.State.Waiting != nil && status.State.Waiting.Reason == reasonCrashLoopBackOff {
+			health.CrashLoopBackOffs++
+		}
+	}
+}
+
+// lastSeen returns the last time an event occurred, which depends on the version of the events API of its reporter
+func lastSeen(event *corev1.Event) time.Time {
+	switch {
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// Resume should not be used the Kubernetes provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Kubernetes provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the Kubernetes provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("Kubernetes provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the Kubernetes provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
@@ -0,0 +1,178 @@
+package podhealth
+
+import (
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newMetric(successCondition string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "health",
+		Interval:         "5m",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			Kubernetes: &v1alpha1.KubernetesMetric{
+				PodTemplateHash: "abc123",
+			},
+		},
+	}
+}
+
+func newRun() *v1alpha1.AnalysisRun {
+	run := &v1alpha1.AnalysisRun{}
+	run.Namespace = "default"
+	run.Name = "run"
+	return run
+}
+
+func newPod(name, hash string, startedAgo, readyAgo time.Duration, ready bool, statuses ...corev1.ContainerStatus) *corev1.Pod {
+	now := time.Now()
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			UID:       types.UID(name),
+			Labels:    map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: hash},
+		},
+		Status: corev1.PodStatus{
+			StartTime: &metav1.Time{Time: now.Add(-startedAgo)},
+			Conditions: []corev1.PodCondition{{
+				Type:               corev1.PodReady,
+				Status:             readyStatus,
+				LastTransitionTime: metav1.Time{Time: now.Add(-readyAgo)},
+			}},
+			ContainerStatuses: statuses,
+		},
+	}
+}
+
+func newEvent(name, podName, eventType string, lastSeenAgo time.Duration) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: podName, UID: types.UID(podName)},
+		Type:           eventType,
+		LastTimestamp:  metav1.Time{Time: time.Now().Add(-lastSeenAgo)},
+	}
+}
+
+func newProvider(t *testing.T, client *k8sfake.Clientset) *Provider {
+	t.Helper()
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	cache := NewCache(client, "", 0)
+	cache.Run(stopCh)
+	return NewPodHealthProvider(*log.NewEntry(log.New()), cache)
+}
+
+func TestType(t *testing.T) {
+	p := newProvider(t, k8sfake.NewSimpleClientset())
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(newMetric("")))
+	assert.NoError(t, p.GarbageCollect(nil, newMetric(""), 0))
+}
+
+func TestRun(t *testing.T) {
+	objects := []runtime.Object{
+		// Healthy pod, ready long ago
+		newPod("healthy", "abc123", time.Hour, time.Hour, true),
+		// Pod restarted after an OOM kill, ready again within the window
+		newPod("oom", "abc123", time.Hour, time.Minute, true, corev1.ContainerStatus{
+			RestartCount:         2,
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+		}),
+		// Pod crash looping
+		newPod("crashing", "abc123", time.Hour, 2*time.Minute, false, corev1.ContainerStatus{
+			RestartCount: 5,
+			State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		}),
+		// Pod started within the window, whose readiness is not a flap
+		newPod("new", "abc123", time.Minute, 30*time.Second, true),
+		// Pod of another ReplicaSet
+		newPod("stable", "def456", time.Hour, time.Minute, false, corev1.ContainerStatus{RestartCount: 10}),
+		newEvent("crashing.1", "crashing", corev1.EventTypeWarning, time.Minute),
+		newEvent("crashing.2", "crashing", corev1.EventTypeNormal, time.Minute),
+		newEvent("healthy.1", "healthy", corev1.EventTypeWarning, time.Hour),
+		newEvent("stable.1", "stable", corev1.EventTypeWarning, time.Minute),
+	}
+	p := newProvider(t, k8sfake.NewSimpleClientset(objects...))
+
+	measurement := p.Run(newRun(), newMetric("result.restarts == 0 && result.oomKills == 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, `{"pods":4,"readyPods":3,"restarts":7,"oomKills":1,"crashLoopBackOffs":1,"readinessFlaps":2,"warningEvents":1}`, measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+
+	measurement = p.Run(newRun(), newMetric("result.pods > 0 && result.crashLoopBackOffs <= 1 && result.warningEvents < 5"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+}
+
+func TestRunNoPods(t *testing.T) {
+	p := newProvider(t, k8sfake.NewSimpleClientset())
+	measurement := p.Run(newRun(), newMetric("result.pods > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+	assert.Equal(t, `{"pods":0,"readyPods":0,"restarts":0,"oomKills":0,"crashLoopBackOffs":0,"readinessFlaps":0,"warningEvents":0}`, measurement.Value)
+}
+
+func TestCacheStartsOnFirstUse(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(newPod("healthy", "abc123", time.Hour, time.Hour, true))
+	p := newProvider(t, client)
+	assert.Empty(t, client.Actions())
+
+	measurement := p.Run(newRun(), newMetric("result.pods > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	var resources []string
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "list" {
+			resources = append(resources, action.GetResource().Resource)
+		}
+	}
+	assert.ElementsMatch(t, []string{"pods", "events"}, resources)
+}
+
+func TestRunErrors(t *testing.T) {
+	p := NewPodHealthProvider(*log.NewEntry(log.New()), nil)
+	measurement := p.Run(newRun(), newMetric("result.pods > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "the pod health cache is not configured", measurement.Message)
+
+	p = newProvider(t, k8sfake.NewSimpleClientset())
+	metric := newMetric("result.pods > 0")
+	metric.Interval = "invalid"
+	measurement = p.Run(newRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Contains(t, measurement.Message, "failed to parse interval as duration")
+}
+
+func TestLastSeen(t *testing.T) {
+	now := time.Now()
+	event := &corev1.Event{}
+	event.CreationTimestamp = metav1.Time{Time: now.Add(-time.Hour)}
+	assert.Equal(t, now.Add(-time.Hour), lastSeen(event))
+	event.EventTime = metav1.MicroTime{Time: now.Add(-time.Minute)}
+	assert.Equal(t, now.Add(-time.Minute), lastSeen(event))
+	event.Series = &corev1.EventSeries{LastObservedTime: metav1.MicroTime{Time: now}}
+	assert.Equal(t, now, lastSeen(event))
+}
+
+func TestResumeAndTerminate(t *testing.T) {
+	p := newProvider(t, k8sfake.NewSimpleClientset())
+	measurement := v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseRunning}
+	assert.Equal(t, measurement, p.Resume(newRun(), newMetric(""), measurement))
+	assert.Equal(t, measurement, p.Terminate(newRun(), newMetric(""), measurement))
+}
@@ -58,26 +58,31 @@ func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
 }
 
 func (p *Provider) executeQuery(ctx context.Context, metric v1alpha1.Metric) (model.Value, v1.Warnings, error) {
-	if metric.Provider.Prometheus.RangeQuery != nil {
-		start, err := evaluate.EvalTime(metric.Provider.Prometheus.RangeQuery.Start)
+	return ExecuteQuery(ctx, p.api, metric.Provider.Prometheus.Query, metric.Provider.Prometheus.RangeQuery)
+}
+
+// ExecuteQuery performs an instant query, or a range query when the range query arguments are set
+func ExecuteQuery(ctx context.Context, api v1.API, query string, rangeQuery *v1alpha1.PrometheusRangeQueryArgs) (model.Value, v1.Warnings, error) {
+	if rangeQuery != nil {
+		start, err := evaluate.EvalTime(rangeQuery.Start)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to parse rangeQuery.start as time: %w", err)
 		}
-		end, err := evaluate.EvalTime(metric.Provider.Prometheus.RangeQuery.End)
+		end, err := evaluate.EvalTime(rangeQuery.End)
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to parse rangeQuery.end as time: %w", err)
 		}
-		stepDuration, err := metric.Provider.Prometheus.RangeQuery.Step.Duration()
+		stepDuration, err := rangeQuery.Step.Duration()
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to parse rangeQuery.step as duration: %w", err)
 		}
-		return p.api.QueryRange(ctx, metric.Provider.Prometheus.Query, v1.Range{
+		return api.QueryRange(ctx, query, v1.Range{
 			Start: start,
 			End:   end,
 			Step:  stepDuration,
 		})
 	} else {
-		return p.api.Query(ctx, metric.Provider.Prometheus.Query, time.Now())
+		return api.Query(ctx, query, time.Now())
 	}
 }
 
@@ -154,11 +159,16 @@ func sampleValuesToResultStr(sampleValues []model.SampleValue) string {
 }
 
 func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value) (string, v1alpha1.AnalysisPhase, error) {
+	return ProcessResponse(metric, response, p.logCtx)
+}
+
+// ProcessResponse evaluates the scalar, vector or matrix result of a query
+func ProcessResponse(metric v1alpha1.Metric, response model.Value, logCtx log.Entry) (string, v1alpha1.AnalysisPhase, error) {
 	switch value := response.(type) {
 	case *model.Scalar:
 		valueStr := value.Value.String()
 		result := float64(value.Value)
-		newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(result, metric, logCtx)
 		return valueStr, newStatus, err
 	case model.Matrix:
 		sampleValues := []model.SampleValue{}
@@ -170,7 +180,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 			}
 		}
 		floatResults := sampleValuesToFloatSlice(sampleValues)
-		newStatus, err := evaluate.EvaluateResult(floatResults, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(floatResults, metric, logCtx)
 		return sampleValuesToResultStr(sampleValues), newStatus, err
 	case model.Vector:
 		sampleValues := []model.SampleValue{}
@@ -180,7 +190,7 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 			}
 		}
 		floatResults := sampleValuesToFloatSlice(sampleValues)
-		newStatus, err := evaluate.EvaluateResult(floatResults, metric, p.logCtx)
+		newStatus, err := evaluate.EvaluateResult(floatResults, metric, logCtx)
 		return sampleValuesToResultStr(sampleValues), newStatus, err
 	//TODO(dthomson) add other response types
 	default:
@@ -247,24 +257,45 @@ func NewPrometheusAPI(metric v1alpha1.Metric) (v1.API, error) {
 		return nil, errors.New("prometheus address is not configured")
 	}
 
+	httpClient, err := NewHTTPClient(metric.Provider.Prometheus.Address, metric.Provider.Prometheus.Insecure, metric.Provider.Prometheus.Headers, metric.Provider.Prometheus.Authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	prometheusApiConfig := api.Config{
+		Address: metric.Provider.Prometheus.Address,
+		Client:  httpClient,
+	}
+
+	client, err := api.NewClient(prometheusApiConfig)
+	if err != nil {
+		log.Errorf("Error in getting prometheus client: %v", err)
+		return nil, err
+	}
+
+	return v1.NewAPI(client), nil
+}
+
+// NewHTTPClient returns the HTTP client of a Prometheus API, which adds the headers, authenticates the requests and
+// fails the rate limited queries with a throttled error
+func NewHTTPClient(address string, insecure bool, headers []v1alpha1.WebMetricHeader, auth v1alpha1.Authentication) (*http.Client, error) {
 	var roundTripper http.RoundTripper
-	if metric.Provider.Prometheus.Insecure {
+	if insecure {
 		roundTripper = insecureTransport
 	} else {
 		roundTripper = secureTransport
 	}
 
 	// attach custom headers to api requests, if specified
-	customHeaders := metric.Provider.Prometheus.Headers
-	if len(customHeaders) > 0 {
+	if len(headers) > 0 {
 		roundTripper = httpHeadersRoundTripper{
-			headers:      customHeaders,
+			headers:      headers,
 			roundTripper: roundTripper,
 		}
 	}
 
 	// Check if using basic auth to connect a prometheus instance (example: grafana cloud prometheus instance)
-	basicAuth := metric.Provider.Prometheus.Authentication.BasicAuth
+	basicAuth := auth.BasicAuth
 	if basicAuth.Username != "" || basicAuth.Password != "" {
 		if basicAuth.Username == "" {
 			return nil, errors.New("missing mandatory parameter in metric for basic auth setup: username")
@@ -279,11 +310,11 @@ func NewPrometheusAPI(metric v1alpha1.Metric) (v1.API, error) {
 	}
 
 	//Check if using Amazon Managed Prometheus if true build sigv4 client
-	if strings.Contains(metric.Provider.Prometheus.Address, "aps-workspaces") && (v1alpha1.Sigv4Config{}) != metric.Provider.Prometheus.Authentication.Sigv4 {
+	if strings.Contains(address, "aps-workspaces") && (v1alpha1.Sigv4Config{}) != auth.Sigv4 {
 		cfg := sigv4.SigV4Config{
-			Region:  metric.Provider.Prometheus.Authentication.Sigv4.Region,
-			Profile: metric.Provider.Prometheus.Authentication.Sigv4.Profile,
-			RoleARN: metric.Provider.Prometheus.Authentication.Sigv4.RoleARN,
+			Region:  auth.Sigv4.Region,
+			Profile: auth.Sigv4.Profile,
+			RoleARN: auth.Sigv4.RoleARN,
 		}
 		sigv4RoundTripper, err := sigv4.NewSigV4RoundTripper(&cfg, roundTripper)
 		if err != nil {
@@ -297,32 +328,23 @@ func NewPrometheusAPI(metric v1alpha1.Metric) (v1.API, error) {
 		Transport: roundTripper,
 	}
 
-	if metric.Provider.Prometheus.Authentication.OAuth2.TokenURL != "" {
-		if metric.Provider.Prometheus.Authentication.OAuth2.ClientID == "" || metric.Provider.Prometheus.Authentication.OAuth2.ClientSecret == "" {
+	if auth.OAuth2.TokenURL != "" {
+		if auth.OAuth2.ClientID == "" || auth.OAuth2.ClientSecret == "" {
 			return nil, errors.New("missing mandatory parameter in metric for OAuth2 setup")
 		}
 		oauthCfg := &clientcredentials.Config{
-			ClientID:     metric.Provider.Prometheus.Authentication.OAuth2.ClientID,
-			ClientSecret: metric.Provider.Prometheus.Authentication.OAuth2.ClientSecret,
-			TokenURL:     metric.Provider.Prometheus.Authentication.OAuth2.TokenURL,
-			Scopes:       metric.Provider.Prometheus.Authentication.OAuth2.Scopes,
+			ClientID:     auth.OAuth2.ClientID,
+			ClientSecret: auth.OAuth2.ClientSecret,
+			TokenURL:     auth.OAuth2.TokenURL,
+			Scopes:       auth.OAuth2.Scopes,
 		}
 		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
 		httpClient = oauthCfg.Client(ctx)
 	}
+	// the API client does not return the responses of the failed queries, so the rate limited ones are detected here
+	httpClient.Transport = metricutil.NewThrottledRoundTripper(httpClient.Transport)
 
-	prometheusApiConfig := api.Config{
-		Address: metric.Provider.Prometheus.Address,
-		Client:  httpClient,
-	}
-
-	client, err := api.NewClient(prometheusApiConfig)
-	if err != nil {
-		log.Errorf("Error in getting prometheus client: %v", err)
-		return nil, err
-	}
-
-	return v1.NewAPI(client), nil
+	return httpClient, nil
 }
 
 func IsUrl(str string) bool {
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 )
 
 const (
@@ -881,6 +882,37 @@ func TestRunErrorOAuthFailure(t *testing.T) {
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
 }
 
+func TestRunThrottled(t *testing.T) {
+	e := log.Entry{}
+	promServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+	}))
+	defer promServer.Close()
+
+	metric := v1alpha1.Metric{
+		Name:             "foo",
+		SuccessCondition: "result[0] == 10",
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{
+				Address: promServer.URL,
+				Query:   "test",
+			},
+		},
+	}
+	api, err := NewPrometheusAPI(metric)
+	assert.NoError(t, err)
+	p, err := NewPrometheusProvider(api, e, metric)
+	assert.NoError(t, err)
+
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, measurement.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(measurement))
+	assert.Contains(t, measurement.Message, "received rate limited response code: 429")
+	assert.Nil(t, measurement.FinishedAt)
+	assert.NotNil(t, measurement.ResumeAt)
+}
+
 func TestRunSuccessfulWithBasicAuth(t *testing.T) {
 	e := log.Entry{}
 	promServer := mockPromServer("")
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/machinebox/graphql"
@@ -138,7 +139,8 @@ func NewSkyWalkingProvider(client SkyWalkingClientAPI, logCtx log.Entry) *Provid
 
 // NewSkyWalkingClient creates a new GraphQL API client from metric configuration
 func NewSkyWalkingClient(metric v1alpha1.Metric, kubeclientset kubernetes.Interface) (*SkyWalkingClient, error) {
-	c := graphql.NewClient(metric.Provider.SkyWalking.Address + "/graphql")
+	httpClient := &http.Client{Transport: metricutil.NewThrottledRoundTripper(nil)}
+	c := graphql.NewClient(metric.Provider.SkyWalking.Address+"/graphql", graphql.WithHTTPClient(httpClient))
 	d, err := metric.Provider.SkyWalking.Interval.Duration()
 	if err != nil {
 		return nil, err
@@ -2,6 +2,8 @@ package skywalking
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -9,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 )
 
 func newAnalysisRun() *v1alpha1.AnalysisRun {
@@ -102,6 +105,37 @@ func TestRunWithQueryError(t *testing.T) {
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
 }
 
+func TestRunThrottled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	e := log.NewEntry(log.New())
+	metric := v1alpha1.Metric{
+		Name:             "foo",
+		SuccessCondition: "result == 10",
+		Provider: v1alpha1.MetricProvider{
+			SkyWalking: &v1alpha1.SkyWalkingMetric{
+				Address:  server.URL,
+				Query:    "test",
+				Interval: "5m",
+			},
+		},
+	}
+	client, err := NewSkyWalkingClient(metric, nil)
+	assert.NoError(t, err)
+	p := NewSkyWalkingProvider(client, *e)
+
+	measurement := p.Run(newAnalysisRun(), metric)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, measurement.Phase)
+	assert.True(t, metricutil.IsMeasurementThrottled(measurement))
+	assert.Contains(t, measurement.Message, "received rate limited response code: 429")
+	assert.Nil(t, measurement.FinishedAt)
+	assert.NotNil(t, measurement.ResumeAt)
+}
+
 func TestRunWithResolveArgsError(t *testing.T) {
 	e := log.NewEntry(log.New())
 	expectedErr := fmt.Errorf("failed to resolve {{args.var}}")
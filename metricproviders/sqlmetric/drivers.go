@@ -0,0 +1,7 @@
+package sqlmetric
+
+import (
+	// drivers of the databases supported by the controller, which register as mysql and postgres
+	_ "github.com/go-sql-driver/mysql"
+	_ "github.com/lib/pq"
+)
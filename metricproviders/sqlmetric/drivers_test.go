@@ -0,0 +1,12 @@
+package sqlmetric
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriversRegistered(t *testing.T) {
+	assert.Subset(t, sql.Drivers(), []string{"mysql", "postgres"})
+}
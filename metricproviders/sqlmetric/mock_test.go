@@ -0,0 +1,114 @@
+package sqlmetric
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+)
+
+const mockDriverName = "mocksql"
+
+// mockResult is the result of the queries of a DSN of the mock driver
+type mockResult struct {
+	columns []string
+	rows    [][]driver.Value
+	err     error
+	// query and args are those of the last query
+	query string
+	args  []driver.Value
+}
+
+var (
+	mockResultsLock sync.Mutex
+	mockResults     = map[string]*mockResult{}
+)
+
+func init() {
+	sql.Register(mockDriverName, mockDriver{})
+}
+
+func setMockResult(dsn string, result *mockResult) {
+	mockResultsLock.Lock()
+	defer mockResultsLock.Unlock()
+	mockResults[dsn] = result
+}
+
+type mockDriver struct{}
+
+func (mockDriver) Open(dsn string) (driver.Conn, error) {
+	mockResultsLock.Lock()
+	defer mockResultsLock.Unlock()
+	result, ok := mockResults[dsn]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+	return &mockConn{result: result}, nil
+}
+
+type mockConn struct {
+	result *mockResult
+}
+
+func (c *mockConn) Prepare(query string) (driver.Stmt, error) {
+	return &mockStmt{conn: c, query: query}, nil
+}
+
+func (c *mockConn) Close() error {
+	return nil
+}
+
+func (c *mockConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type mockStmt struct {
+	conn  *mockConn
+	query string
+}
+
+func (s *mockStmt) Close() error {
+	return nil
+}
+
+func (s *mockStmt) NumInput() int {
+	return -1
+}
+
+func (s *mockStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec is not supported")
+}
+
+func (s *mockStmt) Query(args []driver.Value) (driver.Rows, error) {
+	mockResultsLock.Lock()
+	defer mockResultsLock.Unlock()
+	s.conn.result.query = s.query
+	s.conn.result.args = args
+	if s.conn.result.err != nil {
+		return nil, s.conn.result.err
+	}
+	return &mockRows{columns: s.conn.result.columns, rows: s.conn.result.rows}, nil
+}
+
+type mockRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *mockRows) Columns() []string {
+	return r.columns
+}
+
+func (r *mockRows) Close() error {
+	return nil
+}
+
+func (r *mockRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,88 @@
+//go:build cgo
+
+package sqlmetric
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// newSQLiteDatabase returns the DSN of a SQLite database with the checkout funnel of two versions
+func newSQLiteDatabase(t *testing.T) string {
+	t.Helper()
+	dsn := filepath.Join(t.TempDir(), "analytics.db")
+	db, err := sql.Open("sqlite3", dsn)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.Exec(`CREATE TABLE checkout (version TEXT, visits INTEGER, purchases INTEGER, rate REAL, note TEXT, created TIMESTAMP)`)
+	require.NoError(t, err)
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, row := range [][]any{
+		{"v1", 1000, 10, 0.01, "stable", created},
+		{"v2", 1000, 30, 0.03, nil, created},
+		{"v2", 500, 20, 0.04, nil, created},
+	} {
+		_, err = db.Exec(`INSERT INTO checkout VALUES (?, ?, ?, ?, ?, ?)`, row...)
+		require.NoError(t, err)
+	}
+	return dsn
+}
+
+func runSQLite(t *testing.T, dsn, query, successCondition string, parameters ...string) v1alpha1.Measurement {
+	t.Helper()
+	metric := v1alpha1.Metric{
+		Name:             "conversion",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			SQL: &v1alpha1.SQLMetric{
+				Driver:     "sqlite3",
+				DSN:        dsn,
+				Query:      query,
+				Parameters: parameters,
+			},
+		},
+	}
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+	return p.Run(&v1alpha1.AnalysisRun{}, metric)
+}
+
+func TestRunSQLiteParameters(t *testing.T) {
+	dsn := newSQLiteDatabase(t)
+	query := `SELECT CAST(sum(purchases) AS REAL) / sum(visits) AS conversion_rate, sum(purchases) AS orders
+FROM checkout WHERE version = ?`
+
+	measurement := runSQLite(t, dsn, query, "result.conversion_rate > 0.02 && result.orders >= 50", "v2")
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase, measurement.Message)
+	assert.Equal(t, `{"conversion_rate":0.03333333333333333,"orders":50}`, measurement.Value)
+
+	// the parameter is bound as a value rather than interpolated into the query
+	measurement = runSQLite(t, dsn, `SELECT count(*) FROM checkout WHERE version = ?`, "result[0] == 0", "v1' OR '1'='1")
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase, measurement.Message)
+	assert.Equal(t, `[0]`, measurement.Value)
+}
+
+func TestRunSQLiteTypes(t *testing.T) {
+	dsn := newSQLiteDatabase(t)
+
+	measurement := runSQLite(t, dsn, `SELECT version, visits, rate, note, created FROM checkout WHERE version = ?`,
+		`result.version == "v1" && result.visits == 1000 && result.rate == 0.01 && result.note == "stable"`, "v1")
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase, measurement.Message)
+	assert.Equal(t, `{"created":"2024-01-02T03:04:05Z","note":"stable","rate":0.01,"version":"v1","visits":1000}`, measurement.Value)
+
+	measurement = runSQLite(t, dsn, `SELECT note FROM checkout WHERE version = ? ORDER BY visits`, "all(result, {# == nil})", "v2")
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase, measurement.Message)
+	assert.Equal(t, `[null,null]`, measurement.Value)
+
+	measurement = runSQLite(t, dsn, `SELECT purchases FROM checkout WHERE version = ? ORDER BY visits`, "all(result, {# >= 20})", "v2")
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase, measurement.Message)
+	assert.Equal(t, `[20,30]`, measurement.Value)
+}
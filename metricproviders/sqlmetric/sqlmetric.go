@@ -0,0 +1,220 @@
+package sqlmetric
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ProviderType indicates the provider is SQL
+	ProviderType = "SQL"
+
+	defaultTimeout = 30 * time.Second
+	// maxRows is the maximum number of rows read from the result of a query with a single column
+	maxRows = 1000
+)
+
+// driverAliases maps the schemes of DSNs to the names of the drivers
+var driverAliases = map[string]string{
+	"postgresql": "postgres",
+}
+
+// Provider runs SQL queries through the database/sql drivers registered in the controller
+type Provider struct {
+	logCtx log.Entry
+}
+
+// NewSQLProvider creates a SQL provider
+func NewSQLProvider(logCtx log.Entry) *Provider {
+	return &Provider{
+		logCtx: logCtx,
+	}
+}
+
+// Type indicates provider is a SQL provider
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+// GetMetadata returns any additional metadata which needs to be stored & displayed as part of the metrics result.
+func (p *Provider) GetMetadata(metric v1alpha1.Metric) map[string]string {
+	return nil
+}
+
+// Run performs the query of the metric and evaluates its first column or its first row
+func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alpha1.Measurement {
+	startTime := timeutil.MetaNow()
+	newMeasurement := v1alpha1.Measurement{
+		StartedAt: &startTime,
+	}
+
+	result, err := p.query(metric.Provider.SQL)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	value, err := json.Marshal(result)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Value = string(value)
+
+	newStatus, err := evaluate.EvaluateResult(result, metric, p.logCtx)
+	if err != nil {
+		return metricutil.MarkMeasurementError(newMeasurement, err)
+	}
+	newMeasurement.Phase = newStatus
+	finishedTime := timeutil.MetaNow()
+	newMeasurement.FinishedAt = &finishedTime
+	return newMeasurement
+}
+
+func (p *Provider) query(metric *v1alpha1.SQLMetric) (any, error) {
+	driver, err := DriverName(metric)
+	if err != nil {
+		return nil, err
+	}
+	db, err := sql.Open(driver, metric.DSN)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	timeout := defaultTimeout
+	if metric.TimeoutSeconds > 0 {
+		timeout = time.Duration(metric.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	parameters := make([]any, 0, len(metric.Parameters))
+	for _, parameter := range metric.Parameters {
+		parameters = append(parameters, parameter)
+	}
+	rows, err := db.QueryContext(ctx, metric.Query, parameters...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return readRows(rows)
+}
+
+// readRows returns the values of the single column of the rows, or the map of the columns of the first row
+func readRows(rows *sql.Rows) (any, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errors.New("query returned no columns")
+	}
+
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	if len(columns) == 1 {
+		results := []any{}
+		for rows.Next() {
+			if len(results) == maxRows {
+				return nil, fmt.Errorf("query returned more than %d rows", maxRows)
+			}
+			if err := rows.Scan(pointers...); err != nil {
+				return nil, err
+			}
+			results = append(results, convertValue(values[0]))
+		}
+		return results, rows.Err()
+	}
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("query returned no rows")
+	}
+	if err := rows.Scan(pointers...); err != nil {
+		return nil, err
+	}
+	result := make(map[string]any, len(columns))
+	for i, column := range columns {
+		result[column] = convertValue(values[i])
+	}
+	return result, nil
+}
+
+// convertValue converts a value of a column to a type of the evaluation of the conditions. Numbers are converted to
+// float64, including the numeric types which drivers return as text.
+func convertValue(value any) any {
+	switch v := value.(type) {
+	case int64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case []byte:
+		return convertValue(string(v))
+	case string:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+// DriverName returns the name of the driver of the metric, which defaults to the scheme of the DSN
+func DriverName(metric *v1alpha1.SQLMetric) (string, error) {
+	driver := metric.Driver
+	if driver == "" {
+		if u, err := url.Parse(metric.DSN); err == nil {
+			driver = strings.ToLower(u.Scheme)
+		}
+		if alias, ok := driverAliases[driver]; ok {
+			driver = alias
+		}
+	}
+	if driver == "" {
+		return "", errors.New("sql driver is not set and the dsn has no scheme")
+	}
+	if !slices.Contains(sql.Drivers(), driver) {
+		return "", fmt.Errorf("sql driver '%s' is not available, available drivers: %s", driver, strings.Join(sql.Drivers(), ", "))
+	}
+	return driver, nil
+}
+
+// Resume should not be used the SQL provider since all the work should occur in the Run method
+func (p *Provider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("SQL provider should not execute the Resume method")
+	return measurement
+}
+
+// Terminate should not be used the SQL provider since all the work should occur in the Run method
+func (p *Provider) Terminate(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, measurement v1alpha1.Measurement) v1alpha1.Measurement {
+	p.logCtx.Warn("SQL provider should not execute the Terminate method")
+	return measurement
+}
+
+// GarbageCollect is a no-op for the SQL provider
+func (p *Provider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric, limit int) error {
+	return nil
+}
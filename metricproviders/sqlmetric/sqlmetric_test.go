@@ -0,0 +1,136 @@
+package sqlmetric
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newSQLMetric(dsn, successCondition string) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "conversion",
+		SuccessCondition: successCondition,
+		Provider: v1alpha1.MetricProvider{
+			SQL: &v1alpha1.SQLMetric{
+				Driver:     mockDriverName,
+				DSN:        dsn,
+				Query:      "SELECT conversion_rate, orders FROM checkout WHERE version = $1",
+				Parameters: []string{"v2"},
+			},
+		},
+	}
+}
+
+func TestType(t *testing.T) {
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+	assert.Equal(t, ProviderType, p.Type())
+	assert.Nil(t, p.GetMetadata(newSQLMetric("", "")))
+	assert.NoError(t, p.GarbageCollect(nil, newSQLMetric("", ""), 0))
+}
+
+func TestRunFirstRow(t *testing.T) {
+	result := &mockResult{
+		columns: []string{"conversion_rate", "orders", "version", "updated_at"},
+		rows: [][]driver.Value{
+			{[]byte("0.031"), int64(120), "v2", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)},
+			{[]byte("0.001"), int64(1), "v1", nil},
+		},
+	}
+	setMockResult(t.Name(), result)
+
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric(t.Name(), "result.conversion_rate > 0.02 && result.orders >= 100"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, `{"conversion_rate":0.031,"orders":120,"updated_at":"2026-01-02T03:04:05Z","version":"v2"}`, measurement.Value)
+	assert.NotNil(t, measurement.FinishedAt)
+	assert.Equal(t, "SELECT conversion_rate, orders FROM checkout WHERE version = $1", result.query)
+	assert.Equal(t, []driver.Value{"v2"}, result.args)
+}
+
+func TestRunColumn(t *testing.T) {
+	setMockResult(t.Name(), &mockResult{
+		columns: []string{"conversion_rate"},
+		rows:    [][]driver.Value{{float64(0.03)}, {float64(0.01)}, {nil}},
+	})
+
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric(t.Name(), "result[0] > 0.02"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "[0.03,0.01,null]", measurement.Value)
+
+	setMockResult(t.Name(), &mockResult{columns: []string{"conversion_rate"}})
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric(t.Name(), "len(result) == 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	assert.Equal(t, "[]", measurement.Value)
+}
+
+func TestRunTooManyRows(t *testing.T) {
+	rows := make([][]driver.Value, maxRows+1)
+	for i := range rows {
+		rows[i] = []driver.Value{int64(i)}
+	}
+	setMockResult(t.Name(), &mockResult{columns: []string{"id"}, rows: rows})
+
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric(t.Name(), "len(result) > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "query returned more than 1000 rows", measurement.Message)
+}
+
+func TestRunErrors(t *testing.T) {
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+
+	measurement := p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric("unknown", "result.orders > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "connection refused", measurement.Message)
+
+	setMockResult(t.Name(), &mockResult{err: errors.New("relation \"checkout\" does not exist")})
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric(t.Name(), "result.orders > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "relation \"checkout\" does not exist", measurement.Message)
+
+	setMockResult(t.Name(), &mockResult{columns: []string{"conversion_rate", "orders"}})
+	measurement = p.Run(&v1alpha1.AnalysisRun{}, newSQLMetric(t.Name(), "result.orders > 0"))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+	assert.Equal(t, "query returned no rows", measurement.Message)
+}
+
+func TestDriverName(t *testing.T) {
+	tests := []struct {
+		driver string
+		dsn    string
+		name   string
+		err    string
+	}{
+		{driver: mockDriverName, dsn: "host=db user=rollouts", name: mockDriverName},
+		{dsn: mockDriverName + "://db:5432/analytics", name: mockDriverName},
+		{dsn: "postgresql://db:5432/analytics", name: "postgres"},
+		{driver: "mysql", dsn: "user:password@tcp(db:3306)/analytics", name: "mysql"},
+		{dsn: "sqlserver://db:1433", err: "sql driver 'sqlserver' is not available, available drivers: " + strings.Join(sql.Drivers(), ", ")},
+		{dsn: "host=db user=rollouts", err: "sql driver is not set and the dsn has no scheme"},
+	}
+	for _, test := range tests {
+		name, err := DriverName(&v1alpha1.SQLMetric{Driver: test.driver, DSN: test.dsn})
+		if test.err != "" {
+			assert.EqualError(t, err, test.err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.name, name)
+	}
+}
+
+func TestResumeAndTerminate(t *testing.T) {
+	p := NewSQLProvider(*log.NewEntry(log.New()))
+	measurement := v1alpha1.Measurement{Phase: v1alpha1.AnalysisPhaseRunning}
+	assert.Equal(t, measurement, p.Resume(&v1alpha1.AnalysisRun{}, newSQLMetric("", ""), measurement))
+	assert.Equal(t, measurement, p.Terminate(&v1alpha1.AnalysisRun{}, newSQLMetric("", ""), measurement))
+}
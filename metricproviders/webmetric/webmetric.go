@@ -108,7 +108,11 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 	defer response.Body.Close()
 
 	if response.StatusCode < 200 || response.StatusCode >= 300 {
-		return metricutil.MarkMeasurementError(measurement, fmt.Errorf("received non 2xx response code: %v", response.StatusCode))
+		err = fmt.Errorf("received non 2xx response code: %v", response.StatusCode)
+		if metricutil.IsThrottledResponse(response) {
+			err = metricutil.NewThrottledError(response, err)
+		}
+		return metricutil.MarkMeasurementError(measurement, err)
 	}
 
 	value, status, err := p.parseResponse(metric, response)
@@ -43,4 +43,14 @@ const (
 	AnalysisRunSingular string = "analysisrun"
 	AnalysisRunPlural   string = "analysisruns"
 	AnalysisRunFullName string = AnalysisRunPlural + "." + Group
+
+	RolloutScheduleKind     string = "RolloutSchedule"
+	RolloutScheduleSingular string = "rolloutschedule"
+	RolloutSchedulePlural   string = "rolloutschedules"
+	RolloutScheduleFullName string = RolloutSchedulePlural + "." + Group
+
+	ReleaseTrainKind     string = "ReleaseTrain"
+	ReleaseTrainSingular string = "releasetrain"
+	ReleaseTrainPlural   string = "releasetrains"
+	ReleaseTrainFullName string = ReleaseTrainPlural + "." + Group
 )
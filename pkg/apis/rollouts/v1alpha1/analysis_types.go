@@ -76,6 +76,26 @@ type AnalysisTemplateSpec struct {
 	// +patchMergeKey=templateName
 	// +patchStrategy=merge
 	Templates []AnalysisTemplateRef `json:"templates,omitempty" patchStrategy:"merge" patchMergeKey:"templateName" protobuf:"bytes,5,rep,name=templates"`
+	// CompositeConditions assess the analysis run from the results of all its metrics, instead of failing the run
+	// when any of its metrics fails
+	// +optional
+	CompositeConditions *CompositeConditions `json:"compositeConditions,omitempty" protobuf:"bytes,6,opt,name=compositeConditions"`
+	// SuccessWeightThreshold is the percentage of the weight of the metrics which must be successful for the
+	// analysis run to be successful, instead of failing the run when any of its metrics fails
+	// +optional
+	SuccessWeightThreshold *int32 `json:"successWeightThreshold,omitempty" protobuf:"varint,7,opt,name=successWeightThreshold"`
+}
+
+// CompositeConditions are expressions over the results of the metrics of an analysis run which assess the run once
+// all its metrics completed. The results of the metrics are available by metric name (e.g. errorRate.phase,
+// latency.value), or through the metrics map (e.g. metrics["error-rate"].phase).
+type CompositeConditions struct {
+	// SuccessCondition is an expression which, when true, assesses the run Successful
+	// +optional
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,1,opt,name=successCondition"`
+	// FailureCondition is an expression which, when true, assesses the run Failed
+	// +optional
+	FailureCondition string `json:"failureCondition,omitempty" protobuf:"bytes,2,opt,name=failureCondition"`
 }
 
 // DurationString is a string representing a duration (e.g. 30s, 5m, 1h)
@@ -125,6 +145,14 @@ type Metric struct {
 	// ConsecutiveSuccessLimit is the number of consecutive times the measurement must succeed for the
 	// entire metric to be considered Successful (default: 0, which means it's disabled)
 	ConsecutiveSuccessLimit *intstrutil.IntOrString `json:"consecutiveSuccessLimit,omitempty" protobuf:"bytes,11,opt,name=consecutiveSuccessLimit"`
+	// Advisory marks a metric which is measured and alerted on, but which does not affect the phase of the
+	// analysis run
+	// +optional
+	Advisory bool `json:"advisory,omitempty" protobuf:"varint,12,opt,name=advisory"`
+	// Weight is the weight of the metric in the weighted share of successful metrics of the analysis run
+	// (default: 1)
+	// +optional
+	Weight *int32 `json:"weight,omitempty" protobuf:"varint,13,opt,name=weight"`
 }
 
 // DryRun defines the settings for running the analysis in Dry-Run mode.
@@ -168,6 +196,14 @@ func (m *Metric) EffectiveCount() *intstrutil.IntOrString {
 	return m.Count
 }
 
+// EffectiveWeight is the weight of the metric in the weighted share of successful metrics of the analysis run
+func (m *Metric) EffectiveWeight() int32 {
+	if m.Weight == nil {
+		return 1
+	}
+	return *m.Weight
+}
+
 // MetricProvider which external system to use to verify the analysis
 // Only one of the fields in this struct should be non-nil
 type MetricProvider struct {
@@ -193,6 +229,18 @@ type MetricProvider struct {
 	Influxdb *InfluxdbMetric `json:"influxdb,omitempty" protobuf:"bytes,10,opt,name=influxdb"`
 	// SkyWalking specifies the skywalking metric to query
 	SkyWalking *SkyWalkingMetric `json:"skywalking,omitempty" protobuf:"bytes,11,opt,name=skywalking"`
+	// Judge specifies a statistical comparison of the canary against the baseline
+	Judge *JudgeMetric `json:"judge,omitempty" protobuf:"bytes,13,opt,name=judge"`
+	// OTLP specifies a metric read from a Prometheus remote-read endpoint or pushed to the controller over OTLP
+	OTLP *OTLPMetric `json:"otlp,omitempty" protobuf:"bytes,14,opt,name=otlp"`
+	// Elasticsearch specifies the Elasticsearch or OpenSearch search to perform
+	Elasticsearch *ElasticsearchMetric `json:"elasticsearch,omitempty" protobuf:"bytes,15,opt,name=elasticsearch"`
+	// Loki specifies the Loki LogQL metric query to perform
+	Loki *LokiMetric `json:"loki,omitempty" protobuf:"bytes,16,opt,name=loki"`
+	// SQL specifies the SQL query to perform
+	SQL *SQLMetric `json:"sql,omitempty" protobuf:"bytes,17,opt,name=sql"`
+	// Kubernetes specifies the health signals of the pods of a ReplicaSet to measure
+	Kubernetes *KubernetesMetric `json:"kubernetes,omitempty" protobuf:"bytes,18,opt,name=kubernetes"`
 	// +kubebuilder:validation:Schemaless
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Type=object
@@ -330,6 +378,85 @@ type GraphiteMetric struct {
 	Query string `json:"query,omitempty" protobuf:"bytes,2,opt,name=query"`
 }
 
+// KubernetesMetric defines the pods whose health signals to measure, without an external monitoring system. The
+// result is a map of the restarts, OOM kills, CrashLoopBackOffs, readiness flaps and Warning events of the pods.
+type KubernetesMetric struct {
+	// PodTemplateHash is the pod-template-hash of the ReplicaSet of the pods, e.g. an argument whose value is the
+	// podTemplateHashValue of the Rollout
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,1,opt,name=podTemplateHash"`
+}
+
+// SQLMetric defines the SQL query to perform canary analysis. With a single column, the result is the array of the
+// values of the column. With several columns, the result is the map of the columns of the first row.
+type SQLMetric struct {
+	// Driver is the name of the database/sql driver, e.g. postgres or mysql. It defaults to the
+	// scheme of the DSN.
+	// +optional
+	Driver string `json:"driver,omitempty" protobuf:"bytes,1,opt,name=driver"`
+	// DSN is the connection string of the database, usually an argument whose value is taken from a Secret
+	DSN string `json:"dsn" protobuf:"bytes,2,opt,name=dsn"`
+	// Query is the SQL query to perform, with placeholders for the parameters in the syntax of the driver
+	Query string `json:"query" protobuf:"bytes,3,opt,name=query"`
+	// Parameters are the values of the placeholders of the query
+	// +optional
+	Parameters []string `json:"parameters,omitempty" protobuf:"bytes,4,rep,name=parameters"`
+	// TimeoutSeconds is the timeout of the query in seconds (default: 30)
+	// +optional
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,5,opt,name=timeoutSeconds"`
+}
+
+// LokiMetric defines the Loki LogQL metric query to perform canary analysis
+type LokiMetric struct {
+	// Address is the HTTP address and port of the Loki server
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Query is a LogQL metric query to perform, e.g. sum(rate({app="guestbook"} |= "error" [5m]))
+	Query string `json:"query" protobuf:"bytes,2,opt,name=query"`
+	// TenantID is the tenant of the query, sent as the X-Scope-OrgID header to a multi-tenant Loki
+	// +optional
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,3,opt,name=tenantID"`
+	// Authentication details
+	// +optional
+	Authentication Authentication `json:"authentication,omitempty" protobuf:"bytes,4,opt,name=authentication"`
+	// Timeout represents the duration within which a Loki query should complete. It is expressed in seconds.
+	// +optional
+	Timeout *int64 `json:"timeout,omitempty" protobuf:"bytes,5,opt,name=timeout"`
+	// Insecure skips host TLS verification
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,6,opt,name=insecure"`
+	// Headers are optional HTTP headers to use in the request
+	// +optional
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,7,rep,name=headers"`
+	// RangeQuery are the arguments of a range query. The query is an instant query when it is not set.
+	// +optional
+	RangeQuery *PrometheusRangeQueryArgs `json:"rangeQuery,omitempty" protobuf:"bytes,8,opt,name=rangeQuery"`
+}
+
+// ElasticsearchMetric defines the Elasticsearch or OpenSearch search to perform canary analysis. The search covers the
+// documents of the interval of the metric before the measurement.
+type ElasticsearchMetric struct {
+	// Address is the HTTP address and port of the Elasticsearch or OpenSearch server
+	Address string `json:"address" protobuf:"bytes,1,opt,name=address"`
+	// Index is the index, index pattern or alias to search
+	Index string `json:"index" protobuf:"bytes,2,opt,name=index"`
+	// Query is the query DSL, as JSON, which the documents must match
+	Query string `json:"query,omitempty" protobuf:"bytes,3,opt,name=query"`
+	// Aggregation is the aggregation DSL, as JSON, whose value is the result. When empty, the result is the number of
+	// matching documents.
+	Aggregation string `json:"aggregation,omitempty" protobuf:"bytes,4,opt,name=aggregation"`
+	// TimestampField is the field holding the time of the documents (default: @timestamp)
+	TimestampField string `json:"timestampField,omitempty" protobuf:"bytes,5,opt,name=timestampField"`
+	// Headers are optional HTTP headers to use in the request, e.g. for authentication
+	// +optional
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,6,rep,name=headers"`
+	// TimeoutSeconds is the timeout for the request in seconds (default: 10)
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,7,opt,name=timeoutSeconds"`
+	// Insecure skips host TLS verification
+	Insecure bool `json:"insecure,omitempty" protobuf:"varint,8,opt,name=insecure"`
+}
+
 // InfluxdbMetric defines the InfluxDB Flux query to perform canary analysis
 type InfluxdbMetric struct {
 	// Profile is the name of the secret holding InfluxDB account configuration
@@ -399,6 +526,27 @@ type SkyWalkingMetric struct {
 	Interval DurationString `json:"interval,omitempty" protobuf:"bytes,3,opt,name=interval,casttype=DurationString"`
 }
 
+// OTLPMetric defines the series of a metric to query, either from a Prometheus remote-read endpoint or from the OTLP
+// data points pushed to the controller
+type OTLPMetric struct {
+	// Address is the URL of the Prometheus remote-read endpoint. When empty, the data points pushed to the OTLP receiver
+	// of the controller are queried.
+	Address string `json:"address,omitempty" protobuf:"bytes,1,opt,name=address"`
+	// MetricName is the name of the metric
+	MetricName string `json:"metricName" protobuf:"bytes,2,opt,name=metricName"`
+	// Labels are the labels, or attributes, the series of the metric must have
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+	// Lookback is the duration before the measurement in which samples are read (default: 5m)
+	Lookback DurationString `json:"lookback,omitempty" protobuf:"bytes,4,opt,name=lookback,casttype=DurationString"`
+	// TimeoutSeconds is the timeout of the remote-read request in seconds (default: 10)
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,5,opt,name=timeoutSeconds"`
+	// Headers are optional HTTP headers to use in the remote-read request
+	// +optional
+	// +patchMergeKey=key
+	// +patchStrategy=merge
+	Headers []WebMetricHeader `json:"headers,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,6,rep,name=headers"`
+}
+
 // AnalysisRunSpec is the spec for a AnalysisRun resource
 type AnalysisRunSpec struct {
 	// Metrics contains the list of metrics to query as part of an analysis run
@@ -425,6 +573,14 @@ type AnalysisRunSpec struct {
 	// TTLStrategy object contains the strategy for the time to live depending on if the analysis succeeded or failed
 	// +optional
 	TTLStrategy *TTLStrategy `json:"ttlStrategy,omitempty" protobuf:"bytes,6,opt,name=ttlStrategy"`
+	// CompositeConditions assess the run from the results of all its metrics, instead of failing the run when any of
+	// its metrics fails
+	// +optional
+	CompositeConditions *CompositeConditions `json:"compositeConditions,omitempty" protobuf:"bytes,7,opt,name=compositeConditions"`
+	// SuccessWeightThreshold is the percentage of the weight of the metrics which must be successful for the run to
+	// be successful, instead of failing the run when any of its metrics fails
+	// +optional
+	SuccessWeightThreshold *int32 `json:"successWeightThreshold,omitempty" protobuf:"varint,8,opt,name=successWeightThreshold"`
 }
 
 // Argument is an argument to an AnalysisRun
@@ -472,6 +628,8 @@ type AnalysisRunStatus struct {
 	DryRunSummary *RunSummary `json:"dryRunSummary,omitempty" protobuf:"bytes,6,opt,name=dryRunSummary"`
 	// CompletedAt indicates when the analysisRun completed
 	CompletedAt *metav1.Time `json:"completedAt,omitempty" protobuf:"bytes,7,opt,name=completedAt"`
+	// AdvisorySummary contains the final results from the executions of the advisory metrics
+	AdvisorySummary *RunSummary `json:"advisorySummary,omitempty" protobuf:"bytes,8,opt,name=advisorySummary"`
 }
 
 // RunSummary contains the final results from the metric executions
@@ -486,6 +644,10 @@ type RunSummary struct {
 	Inconclusive int32 `json:"inconclusive,omitempty" protobuf:"varint,4,opt,name=inconclusive"`
 	// Error is the number of times an error was encountered during measurement
 	Error int32 `json:"error,omitempty" protobuf:"varint,5,opt,name=error"`
+	// Weight is the total weight of the metrics
+	Weight int32 `json:"weight,omitempty" protobuf:"varint,6,opt,name=weight"`
+	// SuccessfulWeight is the weight of the metrics which were assessed Successful
+	SuccessfulWeight int32 `json:"successfulWeight,omitempty" protobuf:"varint,7,opt,name=successfulWeight"`
 }
 
 // MetricResult contain a list of the most recent measurements for a single metric along with
@@ -522,6 +684,8 @@ type MetricResult struct {
 	// ConsecutiveSuccess is the number of times a measurement was successful in succession
 	// Resets to zero when failures, inconclusive measurements, or errors are encountered
 	ConsecutiveSuccess int32 `json:"consecutiveSuccess,omitempty" protobuf:"varint,13,opt,name=consecutiveSuccess"`
+	// Advisory indicates whether this metric is advisory, and does not affect the phase of the analysis run
+	Advisory bool `json:"advisory,omitempty" protobuf:"varint,14,opt,name=advisory"`
 }
 
 // Measurement is a point in time result value of a single metric, and the time it was measured
@@ -566,6 +730,79 @@ type KayentaThreshold struct {
 	Marginal int64 `json:"marginal" protobuf:"varint,2,opt,name=marginal"`
 }
 
+// JudgeMetric compares samples of the canary against samples of the baseline with a Mann-Whitney U test, and scores
+// the canary with the percentage of comparisons which did not find a significant difference
+type JudgeMetric struct {
+	// Comparisons are the metrics compared between the canary and the baseline
+	Comparisons []JudgeComparison `json:"comparisons" protobuf:"bytes,1,rep,name=comparisons"`
+	// Threshold is the score needed for the measurement to pass, or to be marginal
+	Threshold KayentaThreshold `json:"threshold" protobuf:"bytes,2,opt,name=threshold"`
+	// ConfidenceLevel is the confidence level, in percent, of the tests (default: 95)
+	ConfidenceLevel *int32 `json:"confidenceLevel,omitempty" protobuf:"varint,3,opt,name=confidenceLevel"`
+}
+
+// JudgeComparison is a metric compared between the canary and the baseline
+type JudgeComparison struct {
+	// Name is the name of the comparison, which prefixes its p-value in the metadata of the measurement
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Canary is the query returning the samples of the canary
+	Canary JudgeQuery `json:"canary" protobuf:"bytes,2,opt,name=canary"`
+	// Baseline is the query returning the samples of the baseline
+	Baseline JudgeQuery `json:"baseline" protobuf:"bytes,3,opt,name=baseline"`
+	// Direction is the direction of the difference which fails the comparison (default: Either)
+	Direction JudgeDirection `json:"direction,omitempty" protobuf:"bytes,4,opt,name=direction,casttype=JudgeDirection"`
+}
+
+// JudgeDirection is the direction of a difference between the canary and the baseline
+type JudgeDirection string
+
+const (
+	// JudgeDirectionIncrease fails a comparison when the canary is higher than the baseline
+	JudgeDirectionIncrease JudgeDirection = "Increase"
+	// JudgeDirectionDecrease fails a comparison when the canary is lower than the baseline
+	JudgeDirectionDecrease JudgeDirection = "Decrease"
+	// JudgeDirectionEither fails a comparison when the canary differs from the baseline
+	JudgeDirectionEither JudgeDirection = "Either"
+)
+
+// JudgeQuery is a query of one of the providers returning the samples of a metric. The provider must return a
+// number or an array of numbers.
+type JudgeQuery struct {
+	// Prometheus specifies the prometheus metric to query
+	Prometheus *PrometheusMetric `json:"prometheus,omitempty" protobuf:"bytes,1,opt,name=prometheus"`
+	// Web specifies a generic HTTP web metric
+	Web *WebMetric `json:"web,omitempty" protobuf:"bytes,2,opt,name=web"`
+	// Datadog specifies a datadog metric to query
+	Datadog *DatadogMetric `json:"datadog,omitempty" protobuf:"bytes,3,opt,name=datadog"`
+	// Wavefront specifies the wavefront metric to query
+	Wavefront *WavefrontMetric `json:"wavefront,omitempty" protobuf:"bytes,4,opt,name=wavefront"`
+	// NewRelic specifies the newrelic metric to query
+	NewRelic *NewRelicMetric `json:"newRelic,omitempty" protobuf:"bytes,5,opt,name=newRelic"`
+	// CloudWatch specifies the cloudWatch metric to query
+	CloudWatch *CloudWatchMetric `json:"cloudWatch,omitempty" protobuf:"bytes,6,opt,name=cloudWatch"`
+	// Graphite specifies the Graphite metric to query
+	Graphite *GraphiteMetric `json:"graphite,omitempty" protobuf:"bytes,7,opt,name=graphite"`
+	// Influxdb specifies the influxdb metric to query
+	Influxdb *InfluxdbMetric `json:"influxdb,omitempty" protobuf:"bytes,8,opt,name=influxdb"`
+	// SkyWalking specifies the skywalking metric to query
+	SkyWalking *SkyWalkingMetric `json:"skywalking,omitempty" protobuf:"bytes,9,opt,name=skywalking"`
+}
+
+// MetricProvider returns the provider of the query
+func (q JudgeQuery) MetricProvider() MetricProvider {
+	return MetricProvider{
+		Prometheus: q.Prometheus,
+		Web:        q.Web,
+		Datadog:    q.Datadog,
+		Wavefront:  q.Wavefront,
+		NewRelic:   q.NewRelic,
+		CloudWatch: q.CloudWatch,
+		Graphite:   q.Graphite,
+		Influxdb:   q.Influxdb,
+		SkyWalking: q.SkyWalking,
+	}
+}
+
 type KayentaScope struct {
 	Name            string      `json:"name" protobuf:"bytes,1,opt,name=name"`
 	ControlScope    ScopeDetail `json:"controlScope" protobuf:"bytes,2,opt,name=controlScope"`
@@ -1111,10 +1111,150 @@ func (m *ClusterAnalysisTemplateList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterAnalysisTemplateList proto.InternalMessageInfo
 
+func (m *CompositeConditions) Reset()      { *m = CompositeConditions{} }
+func (*CompositeConditions) ProtoMessage() {}
+func (*CompositeConditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{38}
+}
+func (m *CompositeConditions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CompositeConditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CompositeConditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompositeConditions.Merge(m, src)
+}
+func (m *CompositeConditions) XXX_Size() int {
+	return m.Size()
+}
+func (m *CompositeConditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompositeConditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompositeConditions proto.InternalMessageInfo
+
+func (m *DaemonSetCohort) Reset()      { *m = DaemonSetCohort{} }
+func (*DaemonSetCohort) ProtoMessage() {}
+func (*DaemonSetCohort) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{39}
+}
+func (m *DaemonSetCohort) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonSetCohort) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DaemonSetCohort) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonSetCohort.Merge(m, src)
+}
+func (m *DaemonSetCohort) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonSetCohort) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonSetCohort.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonSetCohort proto.InternalMessageInfo
+
+func (m *DaemonSetStatus) Reset()      { *m = DaemonSetStatus{} }
+func (*DaemonSetStatus) ProtoMessage() {}
+func (*DaemonSetStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{40}
+}
+func (m *DaemonSetStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonSetStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DaemonSetStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonSetStatus.Merge(m, src)
+}
+func (m *DaemonSetStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonSetStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonSetStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonSetStatus proto.InternalMessageInfo
+
+func (m *DaemonSetStep) Reset()      { *m = DaemonSetStep{} }
+func (*DaemonSetStep) ProtoMessage() {}
+func (*DaemonSetStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{41}
+}
+func (m *DaemonSetStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonSetStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DaemonSetStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonSetStep.Merge(m, src)
+}
+func (m *DaemonSetStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonSetStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonSetStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonSetStep proto.InternalMessageInfo
+
+func (m *DaemonSetStrategy) Reset()      { *m = DaemonSetStrategy{} }
+func (*DaemonSetStrategy) ProtoMessage() {}
+func (*DaemonSetStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{42}
+}
+func (m *DaemonSetStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonSetStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DaemonSetStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonSetStrategy.Merge(m, src)
+}
+func (m *DaemonSetStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonSetStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonSetStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonSetStrategy proto.InternalMessageInfo
+
 func (m *DatadogMetric) Reset()      { *m = DatadogMetric{} }
 func (*DatadogMetric) ProtoMessage() {}
 func (*DatadogMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{38}
+	return fileDescriptor_e0e705f843545fab, []int{43}
 }
 func (m *DatadogMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1139,10 +1279,66 @@ func (m *DatadogMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DatadogMetric proto.InternalMessageInfo
 
+func (m *DeploymentWindow) Reset()      { *m = DeploymentWindow{} }
+func (*DeploymentWindow) ProtoMessage() {}
+func (*DeploymentWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{44}
+}
+func (m *DeploymentWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeploymentWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentWindow.Merge(m, src)
+}
+func (m *DeploymentWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentWindow proto.InternalMessageInfo
+
+func (m *DeploymentWindows) Reset()      { *m = DeploymentWindows{} }
+func (*DeploymentWindows) ProtoMessage() {}
+func (*DeploymentWindows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{45}
+}
+func (m *DeploymentWindows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentWindows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DeploymentWindows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentWindows.Merge(m, src)
+}
+func (m *DeploymentWindows) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentWindows) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentWindows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentWindows proto.InternalMessageInfo
+
 func (m *DryRun) Reset()      { *m = DryRun{} }
 func (*DryRun) ProtoMessage() {}
 func (*DryRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{39}
+	return fileDescriptor_e0e705f843545fab, []int{46}
 }
 func (m *DryRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,10 +1363,38 @@ func (m *DryRun) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DryRun proto.InternalMessageInfo
 
+func (m *ElasticsearchMetric) Reset()      { *m = ElasticsearchMetric{} }
+func (*ElasticsearchMetric) ProtoMessage() {}
+func (*ElasticsearchMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{47}
+}
+func (m *ElasticsearchMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ElasticsearchMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ElasticsearchMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElasticsearchMetric.Merge(m, src)
+}
+func (m *ElasticsearchMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *ElasticsearchMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElasticsearchMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElasticsearchMetric proto.InternalMessageInfo
+
 func (m *Experiment) Reset()      { *m = Experiment{} }
 func (*Experiment) ProtoMessage() {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{40}
+	return fileDescriptor_e0e705f843545fab, []int{48}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1422,7 @@ var xxx_messageInfo_Experiment proto.InternalMessageInfo
 func (m *ExperimentAnalysisRunStatus) Reset()      { *m = ExperimentAnalysisRunStatus{} }
 func (*ExperimentAnalysisRunStatus) ProtoMessage() {}
 func (*ExperimentAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{41}
+	return fileDescriptor_e0e705f843545fab, []int{49}
 }
 func (m *ExperimentAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1450,7 @@ var xxx_messageInfo_ExperimentAnalysisRunStatus proto.InternalMessageInfo
 func (m *ExperimentAnalysisTemplateRef) Reset()      { *m = ExperimentAnalysisTemplateRef{} }
 func (*ExperimentAnalysisTemplateRef) ProtoMessage() {}
 func (*ExperimentAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{42}
+	return fileDescriptor_e0e705f843545fab, []int{50}
 }
 func (m *ExperimentAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1478,7 @@ var xxx_messageInfo_ExperimentAnalysisTemplateRef proto.InternalMessageInfo
 func (m *ExperimentCondition) Reset()      { *m = ExperimentCondition{} }
 func (*ExperimentCondition) ProtoMessage() {}
 func (*ExperimentCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{43}
+	return fileDescriptor_e0e705f843545fab, []int{51}
 }
 func (m *ExperimentCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1506,7 @@ var xxx_messageInfo_ExperimentCondition proto.InternalMessageInfo
 func (m *ExperimentList) Reset()      { *m = ExperimentList{} }
 func (*ExperimentList) ProtoMessage() {}
 func (*ExperimentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{44}
+	return fileDescriptor_e0e705f843545fab, []int{52}
 }
 func (m *ExperimentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1534,7 @@ var xxx_messageInfo_ExperimentList proto.InternalMessageInfo
 func (m *ExperimentSpec) Reset()      { *m = ExperimentSpec{} }
 func (*ExperimentSpec) ProtoMessage() {}
 func (*ExperimentSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{45}
+	return fileDescriptor_e0e705f843545fab, []int{53}
 }
 func (m *ExperimentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1562,7 @@ var xxx_messageInfo_ExperimentSpec proto.InternalMessageInfo
 func (m *ExperimentStatus) Reset()      { *m = ExperimentStatus{} }
 func (*ExperimentStatus) ProtoMessage() {}
 func (*ExperimentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{46}
+	return fileDescriptor_e0e705f843545fab, []int{54}
 }
 func (m *ExperimentStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1590,7 @@ var xxx_messageInfo_ExperimentStatus proto.InternalMessageInfo
 func (m *FieldRef) Reset()      { *m = FieldRef{} }
 func (*FieldRef) ProtoMessage() {}
 func (*FieldRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{47}
+	return fileDescriptor_e0e705f843545fab, []int{55}
 }
 func (m *FieldRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,10 +1615,66 @@ func (m *FieldRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FieldRef proto.InternalMessageInfo
 
+func (m *GatewayAPIRoute) Reset()      { *m = GatewayAPIRoute{} }
+func (*GatewayAPIRoute) ProtoMessage() {}
+func (*GatewayAPIRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{56}
+}
+func (m *GatewayAPIRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAPIRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GatewayAPIRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAPIRoute.Merge(m, src)
+}
+func (m *GatewayAPIRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAPIRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAPIRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayAPIRoute proto.InternalMessageInfo
+
+func (m *GatewayAPITrafficRouting) Reset()      { *m = GatewayAPITrafficRouting{} }
+func (*GatewayAPITrafficRouting) ProtoMessage() {}
+func (*GatewayAPITrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{57}
+}
+func (m *GatewayAPITrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GatewayAPITrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GatewayAPITrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayAPITrafficRouting.Merge(m, src)
+}
+func (m *GatewayAPITrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *GatewayAPITrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayAPITrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayAPITrafficRouting proto.InternalMessageInfo
+
 func (m *GraphiteMetric) Reset()      { *m = GraphiteMetric{} }
 func (*GraphiteMetric) ProtoMessage() {}
 func (*GraphiteMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{48}
+	return fileDescriptor_e0e705f843545fab, []int{58}
 }
 func (m *GraphiteMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,7 +1702,7 @@ var xxx_messageInfo_GraphiteMetric proto.InternalMessageInfo
 func (m *HeaderRoutingMatch) Reset()      { *m = HeaderRoutingMatch{} }
 func (*HeaderRoutingMatch) ProtoMessage() {}
 func (*HeaderRoutingMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{49}
+	return fileDescriptor_e0e705f843545fab, []int{59}
 }
 func (m *HeaderRoutingMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1730,7 @@ var xxx_messageInfo_HeaderRoutingMatch proto.InternalMessageInfo
 func (m *InfluxdbMetric) Reset()      { *m = InfluxdbMetric{} }
 func (*InfluxdbMetric) ProtoMessage() {}
 func (*InfluxdbMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{50}
+	return fileDescriptor_e0e705f843545fab, []int{60}
 }
 func (m *InfluxdbMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1478,7 +1758,7 @@ var xxx_messageInfo_InfluxdbMetric proto.InternalMessageInfo
 func (m *IstioDestinationRule) Reset()      { *m = IstioDestinationRule{} }
 func (*IstioDestinationRule) ProtoMessage() {}
 func (*IstioDestinationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{51}
+	return fileDescriptor_e0e705f843545fab, []int{61}
 }
 func (m *IstioDestinationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1786,7 @@ var xxx_messageInfo_IstioDestinationRule proto.InternalMessageInfo
 func (m *IstioTrafficRouting) Reset()      { *m = IstioTrafficRouting{} }
 func (*IstioTrafficRouting) ProtoMessage() {}
 func (*IstioTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{52}
+	return fileDescriptor_e0e705f843545fab, []int{62}
 }
 func (m *IstioTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1814,7 @@ var xxx_messageInfo_IstioTrafficRouting proto.InternalMessageInfo
 func (m *IstioVirtualService) Reset()      { *m = IstioVirtualService{} }
 func (*IstioVirtualService) ProtoMessage() {}
 func (*IstioVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{53}
+	return fileDescriptor_e0e705f843545fab, []int{63}
 }
 func (m *IstioVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1842,7 @@ var xxx_messageInfo_IstioVirtualService proto.InternalMessageInfo
 func (m *JobMetric) Reset()      { *m = JobMetric{} }
 func (*JobMetric) ProtoMessage() {}
 func (*JobMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{54}
+	return fileDescriptor_e0e705f843545fab, []int{64}
 }
 func (m *JobMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,10 +1867,94 @@ func (m *JobMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_JobMetric proto.InternalMessageInfo
 
+func (m *JudgeComparison) Reset()      { *m = JudgeComparison{} }
+func (*JudgeComparison) ProtoMessage() {}
+func (*JudgeComparison) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{65}
+}
+func (m *JudgeComparison) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JudgeComparison) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JudgeComparison) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JudgeComparison.Merge(m, src)
+}
+func (m *JudgeComparison) XXX_Size() int {
+	return m.Size()
+}
+func (m *JudgeComparison) XXX_DiscardUnknown() {
+	xxx_messageInfo_JudgeComparison.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JudgeComparison proto.InternalMessageInfo
+
+func (m *JudgeMetric) Reset()      { *m = JudgeMetric{} }
+func (*JudgeMetric) ProtoMessage() {}
+func (*JudgeMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{66}
+}
+func (m *JudgeMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JudgeMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JudgeMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JudgeMetric.Merge(m, src)
+}
+func (m *JudgeMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *JudgeMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_JudgeMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JudgeMetric proto.InternalMessageInfo
+
+func (m *JudgeQuery) Reset()      { *m = JudgeQuery{} }
+func (*JudgeQuery) ProtoMessage() {}
+func (*JudgeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{67}
+}
+func (m *JudgeQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JudgeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *JudgeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JudgeQuery.Merge(m, src)
+}
+func (m *JudgeQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *JudgeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_JudgeQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JudgeQuery proto.InternalMessageInfo
+
 func (m *KayentaMetric) Reset()      { *m = KayentaMetric{} }
 func (*KayentaMetric) ProtoMessage() {}
 func (*KayentaMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{55}
+	return fileDescriptor_e0e705f843545fab, []int{68}
 }
 func (m *KayentaMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1982,7 @@ var xxx_messageInfo_KayentaMetric proto.InternalMessageInfo
 func (m *KayentaScope) Reset()      { *m = KayentaScope{} }
 func (*KayentaScope) ProtoMessage() {}
 func (*KayentaScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{56}
+	return fileDescriptor_e0e705f843545fab, []int{69}
 }
 func (m *KayentaScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1646,7 +2010,7 @@ var xxx_messageInfo_KayentaScope proto.InternalMessageInfo
 func (m *KayentaThreshold) Reset()      { *m = KayentaThreshold{} }
 func (*KayentaThreshold) ProtoMessage() {}
 func (*KayentaThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{57}
+	return fileDescriptor_e0e705f843545fab, []int{70}
 }
 func (m *KayentaThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,10 +2035,66 @@ func (m *KayentaThreshold) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KayentaThreshold proto.InternalMessageInfo
 
+func (m *KubernetesMetric) Reset()      { *m = KubernetesMetric{} }
+func (*KubernetesMetric) ProtoMessage() {}
+func (*KubernetesMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{71}
+}
+func (m *KubernetesMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KubernetesMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KubernetesMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesMetric.Merge(m, src)
+}
+func (m *KubernetesMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *KubernetesMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubernetesMetric proto.InternalMessageInfo
+
+func (m *LokiMetric) Reset()      { *m = LokiMetric{} }
+func (*LokiMetric) ProtoMessage() {}
+func (*LokiMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{72}
+}
+func (m *LokiMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LokiMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LokiMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LokiMetric.Merge(m, src)
+}
+func (m *LokiMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *LokiMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_LokiMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LokiMetric proto.InternalMessageInfo
+
 func (m *MangedRoutes) Reset()      { *m = MangedRoutes{} }
 func (*MangedRoutes) ProtoMessage() {}
 func (*MangedRoutes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{58}
+	return fileDescriptor_e0e705f843545fab, []int{73}
 }
 func (m *MangedRoutes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +2122,7 @@ var xxx_messageInfo_MangedRoutes proto.InternalMessageInfo
 func (m *Measurement) Reset()      { *m = Measurement{} }
 func (*Measurement) ProtoMessage() {}
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{59}
+	return fileDescriptor_e0e705f843545fab, []int{74}
 }
 func (m *Measurement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +2150,7 @@ var xxx_messageInfo_Measurement proto.InternalMessageInfo
 func (m *MeasurementRetention) Reset()      { *m = MeasurementRetention{} }
 func (*MeasurementRetention) ProtoMessage() {}
 func (*MeasurementRetention) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{60}
+	return fileDescriptor_e0e705f843545fab, []int{75}
 }
 func (m *MeasurementRetention) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1758,7 +2178,7 @@ var xxx_messageInfo_MeasurementRetention proto.InternalMessageInfo
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{61}
+	return fileDescriptor_e0e705f843545fab, []int{76}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2206,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricProvider) Reset()      { *m = MetricProvider{} }
 func (*MetricProvider) ProtoMessage() {}
 func (*MetricProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{62}
+	return fileDescriptor_e0e705f843545fab, []int{77}
 }
 func (m *MetricProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1814,7 +2234,7 @@ var xxx_messageInfo_MetricProvider proto.InternalMessageInfo
 func (m *MetricResult) Reset()      { *m = MetricResult{} }
 func (*MetricResult) ProtoMessage() {}
 func (*MetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{63}
+	return fileDescriptor_e0e705f843545fab, []int{78}
 }
 func (m *MetricResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,7 +2262,7 @@ var xxx_messageInfo_MetricResult proto.InternalMessageInfo
 func (m *NewRelicMetric) Reset()      { *m = NewRelicMetric{} }
 func (*NewRelicMetric) ProtoMessage() {}
 func (*NewRelicMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{64}
+	return fileDescriptor_e0e705f843545fab, []int{79}
 }
 func (m *NewRelicMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1870,7 +2290,7 @@ var xxx_messageInfo_NewRelicMetric proto.InternalMessageInfo
 func (m *NginxTrafficRouting) Reset()      { *m = NginxTrafficRouting{} }
 func (*NginxTrafficRouting) ProtoMessage() {}
 func (*NginxTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{65}
+	return fileDescriptor_e0e705f843545fab, []int{80}
 }
 func (m *NginxTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,7 +2318,7 @@ var xxx_messageInfo_NginxTrafficRouting proto.InternalMessageInfo
 func (m *OAuth2Config) Reset()      { *m = OAuth2Config{} }
 func (*OAuth2Config) ProtoMessage() {}
 func (*OAuth2Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{66}
+	return fileDescriptor_e0e705f843545fab, []int{81}
 }
 func (m *OAuth2Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,10 +2343,38 @@ func (m *OAuth2Config) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OAuth2Config proto.InternalMessageInfo
 
+func (m *OTLPMetric) Reset()      { *m = OTLPMetric{} }
+func (*OTLPMetric) ProtoMessage() {}
+func (*OTLPMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{82}
+}
+func (m *OTLPMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OTLPMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OTLPMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OTLPMetric.Merge(m, src)
+}
+func (m *OTLPMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *OTLPMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_OTLPMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OTLPMetric proto.InternalMessageInfo
+
 func (m *ObjectRef) Reset()      { *m = ObjectRef{} }
 func (*ObjectRef) ProtoMessage() {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{67}
+	return fileDescriptor_e0e705f843545fab, []int{83}
 }
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1954,7 +2402,7 @@ var xxx_messageInfo_ObjectRef proto.InternalMessageInfo
 func (m *PauseCondition) Reset()      { *m = PauseCondition{} }
 func (*PauseCondition) ProtoMessage() {}
 func (*PauseCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{68}
+	return fileDescriptor_e0e705f843545fab, []int{84}
 }
 func (m *PauseCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1982,7 +2430,7 @@ var xxx_messageInfo_PauseCondition proto.InternalMessageInfo
 func (m *PingPongSpec) Reset()      { *m = PingPongSpec{} }
 func (*PingPongSpec) ProtoMessage() {}
 func (*PingPongSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{69}
+	return fileDescriptor_e0e705f843545fab, []int{85}
 }
 func (m *PingPongSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2010,7 +2458,7 @@ var xxx_messageInfo_PingPongSpec proto.InternalMessageInfo
 func (m *PluginStep) Reset()      { *m = PluginStep{} }
 func (*PluginStep) ProtoMessage() {}
 func (*PluginStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{70}
+	return fileDescriptor_e0e705f843545fab, []int{86}
 }
 func (m *PluginStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2038,7 +2486,7 @@ var xxx_messageInfo_PluginStep proto.InternalMessageInfo
 func (m *PodTemplateMetadata) Reset()      { *m = PodTemplateMetadata{} }
 func (*PodTemplateMetadata) ProtoMessage() {}
 func (*PodTemplateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{71}
+	return fileDescriptor_e0e705f843545fab, []int{87}
 }
 func (m *PodTemplateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2068,7 +2516,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*PreferredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*PreferredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{72}
+	return fileDescriptor_e0e705f843545fab, []int{88}
 }
 func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2096,7 +2544,7 @@ var xxx_messageInfo_PreferredDuringSchedulingIgnoredDuringExecution proto.Intern
 func (m *PrometheusMetric) Reset()      { *m = PrometheusMetric{} }
 func (*PrometheusMetric) ProtoMessage() {}
 func (*PrometheusMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{73}
+	return fileDescriptor_e0e705f843545fab, []int{89}
 }
 func (m *PrometheusMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2124,7 +2572,7 @@ var xxx_messageInfo_PrometheusMetric proto.InternalMessageInfo
 func (m *PrometheusRangeQueryArgs) Reset()      { *m = PrometheusRangeQueryArgs{} }
 func (*PrometheusRangeQueryArgs) ProtoMessage() {}
 func (*PrometheusRangeQueryArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{74}
+	return fileDescriptor_e0e705f843545fab, []int{90}
 }
 func (m *PrometheusRangeQueryArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2149,10 +2597,262 @@ func (m *PrometheusRangeQueryArgs) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PrometheusRangeQueryArgs proto.InternalMessageInfo
 
+func (m *ReleaseTrain) Reset()      { *m = ReleaseTrain{} }
+func (*ReleaseTrain) ProtoMessage() {}
+func (*ReleaseTrain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{91}
+}
+func (m *ReleaseTrain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrain.Merge(m, src)
+}
+func (m *ReleaseTrain) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrain) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrain proto.InternalMessageInfo
+
+func (m *ReleaseTrainAnalysis) Reset()      { *m = ReleaseTrainAnalysis{} }
+func (*ReleaseTrainAnalysis) ProtoMessage() {}
+func (*ReleaseTrainAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{92}
+}
+func (m *ReleaseTrainAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainAnalysis.Merge(m, src)
+}
+func (m *ReleaseTrainAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainAnalysis proto.InternalMessageInfo
+
+func (m *ReleaseTrainImage) Reset()      { *m = ReleaseTrainImage{} }
+func (*ReleaseTrainImage) ProtoMessage() {}
+func (*ReleaseTrainImage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{93}
+}
+func (m *ReleaseTrainImage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainImage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainImage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainImage.Merge(m, src)
+}
+func (m *ReleaseTrainImage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainImage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainImage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainImage proto.InternalMessageInfo
+
+func (m *ReleaseTrainList) Reset()      { *m = ReleaseTrainList{} }
+func (*ReleaseTrainList) ProtoMessage() {}
+func (*ReleaseTrainList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{94}
+}
+func (m *ReleaseTrainList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainList.Merge(m, src)
+}
+func (m *ReleaseTrainList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainList proto.InternalMessageInfo
+
+func (m *ReleaseTrainRolloutStatus) Reset()      { *m = ReleaseTrainRolloutStatus{} }
+func (*ReleaseTrainRolloutStatus) ProtoMessage() {}
+func (*ReleaseTrainRolloutStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{95}
+}
+func (m *ReleaseTrainRolloutStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainRolloutStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainRolloutStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainRolloutStatus.Merge(m, src)
+}
+func (m *ReleaseTrainRolloutStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainRolloutStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainRolloutStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainRolloutStatus proto.InternalMessageInfo
+
+func (m *ReleaseTrainSpec) Reset()      { *m = ReleaseTrainSpec{} }
+func (*ReleaseTrainSpec) ProtoMessage() {}
+func (*ReleaseTrainSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{96}
+}
+func (m *ReleaseTrainSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainSpec.Merge(m, src)
+}
+func (m *ReleaseTrainSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainSpec proto.InternalMessageInfo
+
+func (m *ReleaseTrainStatus) Reset()      { *m = ReleaseTrainStatus{} }
+func (*ReleaseTrainStatus) ProtoMessage() {}
+func (*ReleaseTrainStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{97}
+}
+func (m *ReleaseTrainStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainStatus.Merge(m, src)
+}
+func (m *ReleaseTrainStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainStatus proto.InternalMessageInfo
+
+func (m *ReleaseTrainWave) Reset()      { *m = ReleaseTrainWave{} }
+func (*ReleaseTrainWave) ProtoMessage() {}
+func (*ReleaseTrainWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{98}
+}
+func (m *ReleaseTrainWave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainWave.Merge(m, src)
+}
+func (m *ReleaseTrainWave) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainWave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainWave proto.InternalMessageInfo
+
+func (m *ReleaseTrainWaveStatus) Reset()      { *m = ReleaseTrainWaveStatus{} }
+func (*ReleaseTrainWaveStatus) ProtoMessage() {}
+func (*ReleaseTrainWaveStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{99}
+}
+func (m *ReleaseTrainWaveStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseTrainWaveStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ReleaseTrainWaveStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseTrainWaveStatus.Merge(m, src)
+}
+func (m *ReleaseTrainWaveStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseTrainWaveStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseTrainWaveStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseTrainWaveStatus proto.InternalMessageInfo
+
 func (m *ReplicaProgressThreshold) Reset()      { *m = ReplicaProgressThreshold{} }
 func (*ReplicaProgressThreshold) ProtoMessage() {}
 func (*ReplicaProgressThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{75}
+	return fileDescriptor_e0e705f843545fab, []int{100}
 }
 func (m *ReplicaProgressThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2182,7 +2882,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*RequiredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*RequiredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{76}
+	return fileDescriptor_e0e705f843545fab, []int{101}
 }
 func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2910,7 @@ var xxx_messageInfo_RequiredDuringSchedulingIgnoredDuringExecution proto.Interna
 func (m *RollbackWindowSpec) Reset()      { *m = RollbackWindowSpec{} }
 func (*RollbackWindowSpec) ProtoMessage() {}
 func (*RollbackWindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{77}
+	return fileDescriptor_e0e705f843545fab, []int{102}
 }
 func (m *RollbackWindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2238,7 +2938,7 @@ var xxx_messageInfo_RollbackWindowSpec proto.InternalMessageInfo
 func (m *Rollout) Reset()      { *m = Rollout{} }
 func (*Rollout) ProtoMessage() {}
 func (*Rollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{78}
+	return fileDescriptor_e0e705f843545fab, []int{103}
 }
 func (m *Rollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2966,7 @@ var xxx_messageInfo_Rollout proto.InternalMessageInfo
 func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
 func (*RolloutAnalysis) ProtoMessage() {}
 func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{79}
+	return fileDescriptor_e0e705f843545fab, []int{104}
 }
 func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2294,7 +2994,7 @@ var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
 func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
 func (*RolloutAnalysisBackground) ProtoMessage() {}
 func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{80}
+	return fileDescriptor_e0e705f843545fab, []int{105}
 }
 func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2322,7 +3022,7 @@ var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
 func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
 func (*RolloutAnalysisRunStatus) ProtoMessage() {}
 func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{81}
+	return fileDescriptor_e0e705f843545fab, []int{106}
 }
 func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2347,10 +3047,66 @@ func (m *RolloutAnalysisRunStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutAnalysisRunStatus proto.InternalMessageInfo
 
+func (m *RolloutCluster) Reset()      { *m = RolloutCluster{} }
+func (*RolloutCluster) ProtoMessage() {}
+func (*RolloutCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{107}
+}
+func (m *RolloutCluster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutCluster.Merge(m, src)
+}
+func (m *RolloutCluster) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutCluster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutCluster proto.InternalMessageInfo
+
+func (m *RolloutClusterStatus) Reset()      { *m = RolloutClusterStatus{} }
+func (*RolloutClusterStatus) ProtoMessage() {}
+func (*RolloutClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{108}
+}
+func (m *RolloutClusterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutClusterStatus.Merge(m, src)
+}
+func (m *RolloutClusterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutClusterStatus proto.InternalMessageInfo
+
 func (m *RolloutCondition) Reset()      { *m = RolloutCondition{} }
 func (*RolloutCondition) ProtoMessage() {}
 func (*RolloutCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{82}
+	return fileDescriptor_e0e705f843545fab, []int{109}
 }
 func (m *RolloutCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2375,10 +3131,38 @@ func (m *RolloutCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutCondition proto.InternalMessageInfo
 
+func (m *RolloutDependency) Reset()      { *m = RolloutDependency{} }
+func (*RolloutDependency) ProtoMessage() {}
+func (*RolloutDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{110}
+}
+func (m *RolloutDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutDependency.Merge(m, src)
+}
+func (m *RolloutDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutDependency proto.InternalMessageInfo
+
 func (m *RolloutDurationStatus) Reset()      { *m = RolloutDurationStatus{} }
 func (*RolloutDurationStatus) ProtoMessage() {}
 func (*RolloutDurationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{83}
+	return fileDescriptor_e0e705f843545fab, []int{111}
 }
 func (m *RolloutDurationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2406,7 +3190,7 @@ var xxx_messageInfo_RolloutDurationStatus proto.InternalMessageInfo
 func (m *RolloutExperimentStep) Reset()      { *m = RolloutExperimentStep{} }
 func (*RolloutExperimentStep) ProtoMessage() {}
 func (*RolloutExperimentStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{84}
+	return fileDescriptor_e0e705f843545fab, []int{112}
 }
 func (m *RolloutExperimentStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +3220,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Reset() {
 }
 func (*RolloutExperimentStepAnalysisTemplateRef) ProtoMessage() {}
 func (*RolloutExperimentStepAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{85}
+	return fileDescriptor_e0e705f843545fab, []int{113}
 }
 func (m *RolloutExperimentStepAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2464,7 +3248,7 @@ var xxx_messageInfo_RolloutExperimentStepAnalysisTemplateRef proto.InternalMessa
 func (m *RolloutExperimentTemplate) Reset()      { *m = RolloutExperimentTemplate{} }
 func (*RolloutExperimentTemplate) ProtoMessage() {}
 func (*RolloutExperimentTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{86}
+	return fileDescriptor_e0e705f843545fab, []int{114}
 }
 func (m *RolloutExperimentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +3276,7 @@ var xxx_messageInfo_RolloutExperimentTemplate proto.InternalMessageInfo
 func (m *RolloutList) Reset()      { *m = RolloutList{} }
 func (*RolloutList) ProtoMessage() {}
 func (*RolloutList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{87}
+	return fileDescriptor_e0e705f843545fab, []int{115}
 }
 func (m *RolloutList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2520,7 +3304,7 @@ var xxx_messageInfo_RolloutList proto.InternalMessageInfo
 func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
 func (*RolloutPause) ProtoMessage() {}
 func (*RolloutPause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{88}
+	return fileDescriptor_e0e705f843545fab, []int{116}
 }
 func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,10 +3329,122 @@ func (m *RolloutPause) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
 
+func (m *RolloutSchedule) Reset()      { *m = RolloutSchedule{} }
+func (*RolloutSchedule) ProtoMessage() {}
+func (*RolloutSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{117}
+}
+func (m *RolloutSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutSchedule.Merge(m, src)
+}
+func (m *RolloutSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutSchedule proto.InternalMessageInfo
+
+func (m *RolloutScheduleList) Reset()      { *m = RolloutScheduleList{} }
+func (*RolloutScheduleList) ProtoMessage() {}
+func (*RolloutScheduleList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{118}
+}
+func (m *RolloutScheduleList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutScheduleList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutScheduleList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutScheduleList.Merge(m, src)
+}
+func (m *RolloutScheduleList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutScheduleList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutScheduleList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutScheduleList proto.InternalMessageInfo
+
+func (m *RolloutScheduleRef) Reset()      { *m = RolloutScheduleRef{} }
+func (*RolloutScheduleRef) ProtoMessage() {}
+func (*RolloutScheduleRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{119}
+}
+func (m *RolloutScheduleRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutScheduleRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutScheduleRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutScheduleRef.Merge(m, src)
+}
+func (m *RolloutScheduleRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutScheduleRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutScheduleRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutScheduleRef proto.InternalMessageInfo
+
+func (m *RolloutScheduleSpec) Reset()      { *m = RolloutScheduleSpec{} }
+func (*RolloutScheduleSpec) ProtoMessage() {}
+func (*RolloutScheduleSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{120}
+}
+func (m *RolloutScheduleSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutScheduleSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutScheduleSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutScheduleSpec.Merge(m, src)
+}
+func (m *RolloutScheduleSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutScheduleSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutScheduleSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutScheduleSpec proto.InternalMessageInfo
+
 func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
 func (*RolloutSpec) ProtoMessage() {}
 func (*RolloutSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{89}
+	return fileDescriptor_e0e705f843545fab, []int{121}
 }
 func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +3472,7 @@ var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
 func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
 func (*RolloutStatus) ProtoMessage() {}
 func (*RolloutStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{90}
+	return fileDescriptor_e0e705f843545fab, []int{122}
 }
 func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +3500,7 @@ var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
 func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
 func (*RolloutStrategy) ProtoMessage() {}
 func (*RolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{91}
+	return fileDescriptor_e0e705f843545fab, []int{123}
 }
 func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +3528,7 @@ var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
 func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
 func (*RolloutTrafficRouting) ProtoMessage() {}
 func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{92}
+	return fileDescriptor_e0e705f843545fab, []int{124}
 }
 func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +3556,7 @@ var xxx_messageInfo_RolloutTrafficRouting proto.InternalMessageInfo
 func (m *RouteMatch) Reset()      { *m = RouteMatch{} }
 func (*RouteMatch) ProtoMessage() {}
 func (*RouteMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{93}
+	return fileDescriptor_e0e705f843545fab, []int{125}
 }
 func (m *RouteMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +3584,7 @@ var xxx_messageInfo_RouteMatch proto.InternalMessageInfo
 func (m *RunSummary) Reset()      { *m = RunSummary{} }
 func (*RunSummary) ProtoMessage() {}
 func (*RunSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{94}
+	return fileDescriptor_e0e705f843545fab, []int{126}
 }
 func (m *RunSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +3612,7 @@ var xxx_messageInfo_RunSummary proto.InternalMessageInfo
 func (m *SMITrafficRouting) Reset()      { *m = SMITrafficRouting{} }
 func (*SMITrafficRouting) ProtoMessage() {}
 func (*SMITrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{95}
+	return fileDescriptor_e0e705f843545fab, []int{127}
 }
 func (m *SMITrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,10 +3637,38 @@ func (m *SMITrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SMITrafficRouting proto.InternalMessageInfo
 
+func (m *SQLMetric) Reset()      { *m = SQLMetric{} }
+func (*SQLMetric) ProtoMessage() {}
+func (*SQLMetric) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{128}
+}
+func (m *SQLMetric) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLMetric) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SQLMetric) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLMetric.Merge(m, src)
+}
+func (m *SQLMetric) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLMetric) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLMetric.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLMetric proto.InternalMessageInfo
+
 func (m *ScopeDetail) Reset()      { *m = ScopeDetail{} }
 func (*ScopeDetail) ProtoMessage() {}
 func (*ScopeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{96}
+	return fileDescriptor_e0e705f843545fab, []int{129}
 }
 func (m *ScopeDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2772,7 +3696,7 @@ var xxx_messageInfo_ScopeDetail proto.InternalMessageInfo
 func (m *SecretKeyRef) Reset()      { *m = SecretKeyRef{} }
 func (*SecretKeyRef) ProtoMessage() {}
 func (*SecretKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{97}
+	return fileDescriptor_e0e705f843545fab, []int{130}
 }
 func (m *SecretKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +3724,7 @@ var xxx_messageInfo_SecretKeyRef proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{98}
+	return fileDescriptor_e0e705f843545fab, []int{131}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +3752,7 @@ var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 func (m *SetCanaryScale) Reset()      { *m = SetCanaryScale{} }
 func (*SetCanaryScale) ProtoMessage() {}
 func (*SetCanaryScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{99}
+	return fileDescriptor_e0e705f843545fab, []int{132}
 }
 func (m *SetCanaryScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2853,10 +3777,38 @@ func (m *SetCanaryScale) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetCanaryScale proto.InternalMessageInfo
 
+func (m *SetClusterWeight) Reset()      { *m = SetClusterWeight{} }
+func (*SetClusterWeight) ProtoMessage() {}
+func (*SetClusterWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{133}
+}
+func (m *SetClusterWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetClusterWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetClusterWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetClusterWeight.Merge(m, src)
+}
+func (m *SetClusterWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetClusterWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetClusterWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetClusterWeight proto.InternalMessageInfo
+
 func (m *SetHeaderRoute) Reset()      { *m = SetHeaderRoute{} }
 func (*SetHeaderRoute) ProtoMessage() {}
 func (*SetHeaderRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{100}
+	return fileDescriptor_e0e705f843545fab, []int{134}
 }
 func (m *SetHeaderRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2884,7 +3836,7 @@ var xxx_messageInfo_SetHeaderRoute proto.InternalMessageInfo
 func (m *SetMirrorRoute) Reset()      { *m = SetMirrorRoute{} }
 func (*SetMirrorRoute) ProtoMessage() {}
 func (*SetMirrorRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{101}
+	return fileDescriptor_e0e705f843545fab, []int{135}
 }
 func (m *SetMirrorRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,10 +3861,38 @@ func (m *SetMirrorRoute) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetMirrorRoute proto.InternalMessageInfo
 
+func (m *SetWeightRamp) Reset()      { *m = SetWeightRamp{} }
+func (*SetWeightRamp) ProtoMessage() {}
+func (*SetWeightRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{136}
+}
+func (m *SetWeightRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetWeightRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetWeightRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWeightRamp.Merge(m, src)
+}
+func (m *SetWeightRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetWeightRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWeightRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetWeightRamp proto.InternalMessageInfo
+
 func (m *Sigv4Config) Reset()      { *m = Sigv4Config{} }
 func (*Sigv4Config) ProtoMessage() {}
 func (*Sigv4Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{102}
+	return fileDescriptor_e0e705f843545fab, []int{137}
 }
 func (m *Sigv4Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,7 +3920,7 @@ var xxx_messageInfo_Sigv4Config proto.InternalMessageInfo
 func (m *SkyWalkingMetric) Reset()      { *m = SkyWalkingMetric{} }
 func (*SkyWalkingMetric) ProtoMessage() {}
 func (*SkyWalkingMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{103}
+	return fileDescriptor_e0e705f843545fab, []int{138}
 }
 func (m *SkyWalkingMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3948,7 @@ var xxx_messageInfo_SkyWalkingMetric proto.InternalMessageInfo
 func (m *StepPluginStatus) Reset()      { *m = StepPluginStatus{} }
 func (*StepPluginStatus) ProtoMessage() {}
 func (*StepPluginStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{104}
+	return fileDescriptor_e0e705f843545fab, []int{139}
 }
 func (m *StepPluginStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3976,7 @@ var xxx_messageInfo_StepPluginStatus proto.InternalMessageInfo
 func (m *StickinessConfig) Reset()      { *m = StickinessConfig{} }
 func (*StickinessConfig) ProtoMessage() {}
 func (*StickinessConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{105}
+	return fileDescriptor_e0e705f843545fab, []int{140}
 }
 func (m *StickinessConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +4004,7 @@ var xxx_messageInfo_StickinessConfig proto.InternalMessageInfo
 func (m *StringMatch) Reset()      { *m = StringMatch{} }
 func (*StringMatch) ProtoMessage() {}
 func (*StringMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{106}
+	return fileDescriptor_e0e705f843545fab, []int{141}
 }
 func (m *StringMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +4032,7 @@ var xxx_messageInfo_StringMatch proto.InternalMessageInfo
 func (m *TCPRoute) Reset()      { *m = TCPRoute{} }
 func (*TCPRoute) ProtoMessage() {}
 func (*TCPRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{107}
+	return fileDescriptor_e0e705f843545fab, []int{142}
 }
 func (m *TCPRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +4060,7 @@ var xxx_messageInfo_TCPRoute proto.InternalMessageInfo
 func (m *TLSRoute) Reset()      { *m = TLSRoute{} }
 func (*TLSRoute) ProtoMessage() {}
 func (*TLSRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{108}
+	return fileDescriptor_e0e705f843545fab, []int{143}
 }
 func (m *TLSRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +4088,7 @@ var xxx_messageInfo_TLSRoute proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{109}
+	return fileDescriptor_e0e705f843545fab, []int{144}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3136,7 +4116,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TemplateService) Reset()      { *m = TemplateService{} }
 func (*TemplateService) ProtoMessage() {}
 func (*TemplateService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{110}
+	return fileDescriptor_e0e705f843545fab, []int{145}
 }
 func (m *TemplateService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,7 +4144,7 @@ var xxx_messageInfo_TemplateService proto.InternalMessageInfo
 func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
 func (*TemplateSpec) ProtoMessage() {}
 func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{111}
+	return fileDescriptor_e0e705f843545fab, []int{146}
 }
 func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +4172,7 @@ var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
 func (m *TemplateStatus) Reset()      { *m = TemplateStatus{} }
 func (*TemplateStatus) ProtoMessage() {}
 func (*TemplateStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{112}
+	return fileDescriptor_e0e705f843545fab, []int{147}
 }
 func (m *TemplateStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3220,7 +4200,7 @@ var xxx_messageInfo_TemplateStatus proto.InternalMessageInfo
 func (m *TraefikTrafficRouting) Reset()      { *m = TraefikTrafficRouting{} }
 func (*TraefikTrafficRouting) ProtoMessage() {}
 func (*TraefikTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{113}
+	return fileDescriptor_e0e705f843545fab, []int{148}
 }
 func (m *TraefikTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +4228,7 @@ var xxx_messageInfo_TraefikTrafficRouting proto.InternalMessageInfo
 func (m *TrafficWeights) Reset()      { *m = TrafficWeights{} }
 func (*TrafficWeights) ProtoMessage() {}
 func (*TrafficWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{114}
+	return fileDescriptor_e0e705f843545fab, []int{149}
 }
 func (m *TrafficWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +4256,7 @@ var xxx_messageInfo_TrafficWeights proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{115}
+	return fileDescriptor_e0e705f843545fab, []int{150}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,7 +4284,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *WavefrontMetric) Reset()      { *m = WavefrontMetric{} }
 func (*WavefrontMetric) ProtoMessage() {}
 func (*WavefrontMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{116}
+	return fileDescriptor_e0e705f843545fab, []int{151}
 }
 func (m *WavefrontMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3332,7 +4312,7 @@ var xxx_messageInfo_WavefrontMetric proto.InternalMessageInfo
 func (m *WebMetric) Reset()      { *m = WebMetric{} }
 func (*WebMetric) ProtoMessage() {}
 func (*WebMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{117}
+	return fileDescriptor_e0e705f843545fab, []int{152}
 }
 func (m *WebMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3360,7 +4340,7 @@ var xxx_messageInfo_WebMetric proto.InternalMessageInfo
 func (m *WebMetricHeader) Reset()      { *m = WebMetricHeader{} }
 func (*WebMetricHeader) ProtoMessage() {}
 func (*WebMetricHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{118}
+	return fileDescriptor_e0e705f843545fab, []int{153}
 }
 func (m *WebMetricHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3388,7 +4368,7 @@ var xxx_messageInfo_WebMetricHeader proto.InternalMessageInfo
 func (m *WeightDestination) Reset()      { *m = WeightDestination{} }
 func (*WeightDestination) ProtoMessage() {}
 func (*WeightDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{119}
+	return fileDescriptor_e0e705f843545fab, []int{154}
 }
 func (m *WeightDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3413,6 +4393,90 @@ func (m *WeightDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WeightDestination proto.InternalMessageInfo
 
+func (m *WeightRampAnalysis) Reset()      { *m = WeightRampAnalysis{} }
+func (*WeightRampAnalysis) ProtoMessage() {}
+func (*WeightRampAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{155}
+}
+func (m *WeightRampAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightRampAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WeightRampAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightRampAnalysis.Merge(m, src)
+}
+func (m *WeightRampAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightRampAnalysis) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightRampAnalysis.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightRampAnalysis proto.InternalMessageInfo
+
+func (m *WeightRampIncrement) Reset()      { *m = WeightRampIncrement{} }
+func (*WeightRampIncrement) ProtoMessage() {}
+func (*WeightRampIncrement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{156}
+}
+func (m *WeightRampIncrement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightRampIncrement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WeightRampIncrement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightRampIncrement.Merge(m, src)
+}
+func (m *WeightRampIncrement) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightRampIncrement) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightRampIncrement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightRampIncrement proto.InternalMessageInfo
+
+func (m *WeightRampStatus) Reset()      { *m = WeightRampStatus{} }
+func (*WeightRampStatus) ProtoMessage() {}
+func (*WeightRampStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{157}
+}
+func (m *WeightRampStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WeightRampStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WeightRampStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WeightRampStatus.Merge(m, src)
+}
+func (m *WeightRampStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WeightRampStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WeightRampStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WeightRampStatus proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ALBStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ALBStatus")
 	proto.RegisterType((*ALBTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ALBTrafficRouting")
@@ -3454,9 +4518,17 @@ func init() {
 	proto.RegisterType((*CloudWatchMetricStatMetricDimension)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CloudWatchMetricStatMetricDimension")
 	proto.RegisterType((*ClusterAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplate")
 	proto.RegisterType((*ClusterAnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplateList")
+	proto.RegisterType((*CompositeConditions)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CompositeConditions")
+	proto.RegisterType((*DaemonSetCohort)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DaemonSetCohort")
+	proto.RegisterType((*DaemonSetStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DaemonSetStatus")
+	proto.RegisterType((*DaemonSetStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DaemonSetStep")
+	proto.RegisterType((*DaemonSetStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DaemonSetStrategy")
 	proto.RegisterType((*DatadogMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric.QueriesEntry")
+	proto.RegisterType((*DeploymentWindow)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DeploymentWindow")
+	proto.RegisterType((*DeploymentWindows)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DeploymentWindows")
 	proto.RegisterType((*DryRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DryRun")
+	proto.RegisterType((*ElasticsearchMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ElasticsearchMetric")
 	proto.RegisterType((*Experiment)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Experiment")
 	proto.RegisterType((*ExperimentAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisRunStatus")
 	proto.RegisterType((*ExperimentAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentAnalysisTemplateRef")
@@ -3465,6 +4537,8 @@ func init() {
 	proto.RegisterType((*ExperimentSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentSpec")
 	proto.RegisterType((*ExperimentStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentStatus")
 	proto.RegisterType((*FieldRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.FieldRef")
+	proto.RegisterType((*GatewayAPIRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GatewayAPIRoute")
+	proto.RegisterType((*GatewayAPITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GatewayAPITrafficRouting")
 	proto.RegisterType((*GraphiteMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GraphiteMetric")
 	proto.RegisterType((*HeaderRoutingMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.HeaderRoutingMatch")
 	proto.RegisterType((*InfluxdbMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InfluxdbMetric")
@@ -3472,9 +4546,14 @@ func init() {
 	proto.RegisterType((*IstioTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioTrafficRouting")
 	proto.RegisterType((*IstioVirtualService)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioVirtualService")
 	proto.RegisterType((*JobMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JobMetric")
+	proto.RegisterType((*JudgeComparison)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JudgeComparison")
+	proto.RegisterType((*JudgeMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JudgeMetric")
+	proto.RegisterType((*JudgeQuery)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JudgeQuery")
 	proto.RegisterType((*KayentaMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaMetric")
 	proto.RegisterType((*KayentaScope)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaScope")
 	proto.RegisterType((*KayentaThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaThreshold")
+	proto.RegisterType((*KubernetesMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KubernetesMetric")
+	proto.RegisterType((*LokiMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.LokiMetric")
 	proto.RegisterType((*MangedRoutes)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MangedRoutes")
 	proto.RegisterType((*Measurement)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement.MetadataEntry")
@@ -3489,6 +4568,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting.AdditionalIngressAnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting.CanaryIngressAnnotationsEntry")
 	proto.RegisterType((*OAuth2Config)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.OAuth2Config")
+	proto.RegisterType((*OTLPMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.OTLPMetric")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.OTLPMetric.LabelsEntry")
 	proto.RegisterType((*ObjectRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ObjectRef")
 	proto.RegisterType((*PauseCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PauseCondition")
 	proto.RegisterType((*PingPongSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PingPongSpec")
@@ -3499,6 +4580,15 @@ func init() {
 	proto.RegisterType((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution")
 	proto.RegisterType((*PrometheusMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusMetric")
 	proto.RegisterType((*PrometheusRangeQueryArgs)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusRangeQueryArgs")
+	proto.RegisterType((*ReleaseTrain)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrain")
+	proto.RegisterType((*ReleaseTrainAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainAnalysis")
+	proto.RegisterType((*ReleaseTrainImage)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainImage")
+	proto.RegisterType((*ReleaseTrainList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainList")
+	proto.RegisterType((*ReleaseTrainRolloutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainRolloutStatus")
+	proto.RegisterType((*ReleaseTrainSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainSpec")
+	proto.RegisterType((*ReleaseTrainStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainStatus")
+	proto.RegisterType((*ReleaseTrainWave)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainWave")
+	proto.RegisterType((*ReleaseTrainWaveStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReleaseTrainWaveStatus")
 	proto.RegisterType((*ReplicaProgressThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ReplicaProgressThreshold")
 	proto.RegisterType((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution")
 	proto.RegisterType((*RollbackWindowSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RollbackWindowSpec")
@@ -3506,13 +4596,20 @@ func init() {
 	proto.RegisterType((*RolloutAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysis")
 	proto.RegisterType((*RolloutAnalysisBackground)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisBackground")
 	proto.RegisterType((*RolloutAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisRunStatus")
+	proto.RegisterType((*RolloutCluster)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutCluster")
+	proto.RegisterType((*RolloutClusterStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutClusterStatus")
 	proto.RegisterType((*RolloutCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutCondition")
+	proto.RegisterType((*RolloutDependency)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutDependency")
 	proto.RegisterType((*RolloutDurationStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutDurationStatus")
 	proto.RegisterType((*RolloutExperimentStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStep")
 	proto.RegisterType((*RolloutExperimentStepAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStepAnalysisTemplateRef")
 	proto.RegisterType((*RolloutExperimentTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentTemplate")
 	proto.RegisterType((*RolloutList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutList")
 	proto.RegisterType((*RolloutPause)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPause")
+	proto.RegisterType((*RolloutSchedule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutSchedule")
+	proto.RegisterType((*RolloutScheduleList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutScheduleList")
+	proto.RegisterType((*RolloutScheduleRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutScheduleRef")
+	proto.RegisterType((*RolloutScheduleSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutScheduleSpec")
 	proto.RegisterType((*RolloutSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutSpec")
 	proto.RegisterType((*RolloutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStatus")
 	proto.RegisterType((*RolloutStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategy")
@@ -3522,12 +4619,15 @@ func init() {
 	proto.RegisterMapType((map[string]StringMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RouteMatch.HeadersEntry")
 	proto.RegisterType((*RunSummary)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RunSummary")
 	proto.RegisterType((*SMITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SMITrafficRouting")
+	proto.RegisterType((*SQLMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SQLMetric")
 	proto.RegisterType((*ScopeDetail)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ScopeDetail")
 	proto.RegisterType((*SecretKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretKeyRef")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretRef")
 	proto.RegisterType((*SetCanaryScale)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetCanaryScale")
+	proto.RegisterType((*SetClusterWeight)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetClusterWeight")
 	proto.RegisterType((*SetHeaderRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetHeaderRoute")
 	proto.RegisterType((*SetMirrorRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetMirrorRoute")
+	proto.RegisterType((*SetWeightRamp)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetWeightRamp")
 	proto.RegisterType((*Sigv4Config)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Sigv4Config")
 	proto.RegisterType((*SkyWalkingMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SkyWalkingMetric")
 	proto.RegisterType((*StepPluginStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepPluginStatus")
@@ -3546,6 +4646,9 @@ func init() {
 	proto.RegisterType((*WebMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetric")
 	proto.RegisterType((*WebMetricHeader)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetricHeader")
 	proto.RegisterType((*WeightDestination)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WeightDestination")
+	proto.RegisterType((*WeightRampAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WeightRampAnalysis")
+	proto.RegisterType((*WeightRampIncrement)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WeightRampIncrement")
+	proto.RegisterType((*WeightRampStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WeightRampStatus")
 }
 
 func init() {
@@ -3553,591 +4656,734 @@ func init() {
 }
 
 var fileDescriptor_e0e705f843545fab = []byte{
-	// 9336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xd9,
-	0x71, 0x98, 0x9a, 0xc3, 0x21, 0x67, 0x6a, 0xb8, 0x24, 0xf7, 0xed, 0xae, 0x96, 0xc7, 0xbb, 0xdd,
-	0x59, 0xf5, 0x39, 0xca, 0xca, 0x3a, 0x91, 0xd2, 0xea, 0xce, 0x39, 0xe9, 0x94, 0x4b, 0x66, 0xc8,
-	0xdd, 0x5b, 0xee, 0x91, 0xbb, 0xbc, 0x1a, 0xee, 0xad, 0xf5, 0x71, 0xb6, 0x9a, 0x33, 0x8f, 0xc3,
-	0x5e, 0xf6, 0x74, 0xcf, 0x75, 0xf7, 0x70, 0x97, 0xa7, 0x8b, 0x75, 0x92, 0x70, 0x92, 0x92, 0x48,
-	0xb0, 0x62, 0xfb, 0x10, 0x24, 0x31, 0x82, 0x4b, 0xe0, 0xc0, 0xf9, 0xfa, 0x61, 0x18, 0x0a, 0x92,
-	0x1f, 0x06, 0x1c, 0xc4, 0x70, 0x20, 0x23, 0x50, 0x20, 0x23, 0x48, 0xe4, 0x24, 0x30, 0x1d, 0xd1,
-	0xf9, 0x13, 0x23, 0x81, 0xe2, 0x20, 0x81, 0x90, 0xfd, 0x61, 0x04, 0xef, 0xa3, 0x5f, 0xbf, 0xee,
-	0xe9, 0x21, 0x39, 0x9c, 0xe6, 0x9e, 0x92, 0xf8, 0xdf, 0xcc, 0xab, 0x7a, 0x55, 0xd5, 0xef, 0xb3,
-	0x5e, 0xbd, 0xaa, 0x7a, 0xb0, 0xda, 0xb6, 0xc3, 0xed, 0xde, 0xe6, 0x42, 0xd3, 0xeb, 0x2c, 0x5a,
-	0x7e, 0xdb, 0xeb, 0xfa, 0xde, 0x7d, 0xfe, 0xe3, 0x23, 0xbe, 0xe7, 0x38, 0x5e, 0x2f, 0x0c, 0x16,
-	0xbb, 0x3b, 0xed, 0x45, 0xab, 0x6b, 0x07, 0x8b, 0xaa, 0x64, 0xf7, 0x63, 0x96, 0xd3, 0xdd, 0xb6,
-	0x3e, 0xb6, 0xd8, 0xa6, 0x2e, 0xf5, 0xad, 0x90, 0xb6, 0x16, 0xba, 0xbe, 0x17, 0x7a, 0xe4, 0x53,
-	0x31, 0xb5, 0x85, 0x88, 0x1a, 0xff, 0xf1, 0xb3, 0x51, 0xdd, 0x85, 0xee, 0x4e, 0x7b, 0x81, 0x51,
-	0x5b, 0x50, 0x25, 0x11, 0xb5, 0xf9, 0x8f, 0x68, 0xb2, 0xb4, 0xbd, 0xb6, 0xb7, 0xc8, 0x89, 0x6e,
-	0xf6, 0xb6, 0xf8, 0x3f, 0xfe, 0x87, 0xff, 0x12, 0xcc, 0xe6, 0x9f, 0xde, 0x79, 0x3e, 0x58, 0xb0,
-	0x3d, 0x26, 0xdb, 0xe2, 0xa6, 0x15, 0x36, 0xb7, 0x17, 0x77, 0xfb, 0x24, 0x9a, 0x37, 0x35, 0xa4,
-	0xa6, 0xe7, 0xd3, 0x2c, 0x9c, 0x67, 0x63, 0x9c, 0x8e, 0xd5, 0xdc, 0xb6, 0x5d, 0xea, 0xef, 0xc5,
-	0x5f, 0xdd, 0xa1, 0xa1, 0x95, 0x55, 0x6b, 0x71, 0x50, 0x2d, 0xbf, 0xe7, 0x86, 0x76, 0x87, 0xf6,
-	0x55, 0xf8, 0xa9, 0xa3, 0x2a, 0x04, 0xcd, 0x6d, 0xda, 0xb1, 0xfa, 0xea, 0x7d, 0x7c, 0x50, 0xbd,
-	0x5e, 0x68, 0x3b, 0x8b, 0xb6, 0x1b, 0x06, 0xa1, 0x9f, 0xae, 0x64, 0xfe, 0xb0, 0x00, 0xe5, 0xda,
-	0x6a, 0xbd, 0x11, 0x5a, 0x61, 0x2f, 0x20, 0x5f, 0x35, 0x60, 0xca, 0xf1, 0xac, 0x56, 0xdd, 0x72,
-	0x2c, 0xb7, 0x49, 0xfd, 0x39, 0xe3, 0x8a, 0x71, 0xb5, 0x72, 0x6d, 0x75, 0x61, 0x94, 0xfe, 0x5a,
-	0xa8, 0x3d, 0x08, 0x90, 0x06, 0x5e, 0xcf, 0x6f, 0x52, 0xa4, 0x5b, 0xf5, 0xf3, 0xdf, 0xd9, 0xaf,
-	0xbe, 0xef, 0x60, 0xbf, 0x3a, 0xb5, 0xaa, 0x71, 0xc2, 0x04, 0x5f, 0xf2, 0x8e, 0x01, 0x67, 0x9b,
-	0x96, 0x6b, 0xf9, 0x7b, 0x1b, 0x96, 0xdf, 0xa6, 0xe1, 0x4b, 0xbe, 0xd7, 0xeb, 0xce, 0x8d, 0x9d,
-	0x82, 0x34, 0x4f, 0x48, 0x69, 0xce, 0x2e, 0xa5, 0xd9, 0x61, 0xbf, 0x04, 0x5c, 0xae, 0x20, 0xb4,
-	0x36, 0x1d, 0xaa, 0xcb, 0x55, 0x38, 0x4d, 0xb9, 0x1a, 0x69, 0x76, 0xd8, 0x2f, 0x01, 0xf9, 0x10,
-	0x4c, 0xda, 0x6e, 0xdb, 0xa7, 0x41, 0x30, 0x37, 0x7e, 0xc5, 0xb8, 0x5a, 0xae, 0xcf, 0xc8, 0xea,
-	0x93, 0x2b, 0xa2, 0x18, 0x23, 0xb8, 0xf9, 0xeb, 0x05, 0x38, 0x5b, 0x5b, 0xad, 0x6f, 0xf8, 0xd6,
-	0xd6, 0x96, 0xdd, 0x44, 0xaf, 0x17, 0xda, 0x6e, 0x5b, 0x27, 0x60, 0x1c, 0x4e, 0x80, 0x3c, 0x07,
-	0x95, 0x80, 0xfa, 0xbb, 0x76, 0x93, 0xae, 0x7b, 0x7e, 0xc8, 0x3b, 0xa5, 0x58, 0x3f, 0x27, 0xd1,
-	0x2b, 0x8d, 0x18, 0x84, 0x3a, 0x1e, 0xab, 0xe6, 0x7b, 0x5e, 0x28, 0xe1, 0xbc, 0xcd, 0xca, 0x71,
-	0x35, 0x8c, 0x41, 0xa8, 0xe3, 0x91, 0x65, 0x98, 0xb5, 0x5c, 0xd7, 0x0b, 0xad, 0xd0, 0xf6, 0xdc,
-	0x75, 0x9f, 0x6e, 0xd9, 0x0f, 0xe5, 0x27, 0xce, 0xc9, 0xba, 0xb3, 0xb5, 0x14, 0x1c, 0xfb, 0x6a,
-	0x90, 0x6f, 0x19, 0x30, 0x1b, 0x84, 0x76, 0x73, 0xc7, 0x76, 0x69, 0x10, 0x2c, 0x79, 0xee, 0x96,
-	0xdd, 0x9e, 0x2b, 0xf2, 0x6e, 0xbb, 0x3d, 0x5a, 0xb7, 0x35, 0x52, 0x54, 0xeb, 0xe7, 0x99, 0x48,
-	0xe9, 0x52, 0xec, 0xe3, 0x4e, 0x3e, 0x0c, 0x65, 0xd9, 0xa2, 0x34, 0x98, 0x9b, 0xb8, 0x52, 0xb8,
-	0x5a, 0xae, 0x9f, 0x39, 0xd8, 0xaf, 0x96, 0x57, 0xa2, 0x42, 0x8c, 0xe1, 0xe6, 0x32, 0xcc, 0xd5,
-	0x3a, 0x9b, 0x56, 0x10, 0x58, 0x2d, 0xcf, 0x4f, 0x75, 0xdd, 0x55, 0x28, 0x75, 0xac, 0x6e, 0xd7,
-	0x76, 0xdb, 0xac, 0xef, 0x18, 0x9d, 0xa9, 0x83, 0xfd, 0x6a, 0x69, 0x4d, 0x96, 0xa1, 0x82, 0x9a,
-	0xff, 0x7e, 0x0c, 0x2a, 0x35, 0xd7, 0x72, 0xf6, 0x02, 0x3b, 0xc0, 0x9e, 0x4b, 0x3e, 0x0f, 0x25,
-	0xb6, 0x6a, 0xb5, 0xac, 0xd0, 0x92, 0x33, 0xfd, 0xa3, 0x0b, 0x62, 0x11, 0x59, 0xd0, 0x17, 0x91,
-	0xf8, 0xf3, 0x19, 0xf6, 0xc2, 0xee, 0xc7, 0x16, 0xee, 0x6c, 0xde, 0xa7, 0xcd, 0x70, 0x8d, 0x86,
-	0x56, 0x9d, 0xc8, 0x5e, 0x80, 0xb8, 0x0c, 0x15, 0x55, 0xe2, 0xc1, 0x78, 0xd0, 0xa5, 0x4d, 0x39,
-	0x73, 0xd7, 0x46, 0x9c, 0x21, 0xb1, 0xe8, 0x8d, 0x2e, 0x6d, 0xd6, 0xa7, 0x24, 0xeb, 0x71, 0xf6,
-	0x0f, 0x39, 0x23, 0xf2, 0x00, 0x26, 0x02, 0xbe, 0x96, 0xc9, 0x49, 0x79, 0x27, 0x3f, 0x96, 0x9c,
-	0x6c, 0x7d, 0x5a, 0x32, 0x9d, 0x10, 0xff, 0x51, 0xb2, 0x33, 0xff, 0x83, 0x01, 0xe7, 0x34, 0xec,
-	0x9a, 0xdf, 0xee, 0x75, 0xa8, 0x1b, 0x92, 0x2b, 0x30, 0xee, 0x5a, 0x1d, 0x2a, 0x67, 0x95, 0x12,
-	0xf9, 0xb6, 0xd5, 0xa1, 0xc8, 0x21, 0xe4, 0x69, 0x28, 0xee, 0x5a, 0x4e, 0x8f, 0xf2, 0x46, 0x2a,
-	0xd7, 0xcf, 0x48, 0x94, 0xe2, 0xab, 0xac, 0x10, 0x05, 0x8c, 0xbc, 0x09, 0x65, 0xfe, 0xe3, 0x86,
-	0xef, 0x75, 0x72, 0xfa, 0x34, 0x29, 0xe1, 0xab, 0x11, 0x59, 0x31, 0xfc, 0xd4, 0x5f, 0x8c, 0x19,
-	0x9a, 0x7f, 0x60, 0xc0, 0x8c, 0xf6, 0x71, 0xab, 0x76, 0x10, 0x92, 0xcf, 0xf5, 0x0d, 0x9e, 0x85,
-	0xe3, 0x0d, 0x1e, 0x56, 0x9b, 0x0f, 0x9d, 0x59, 0xf9, 0xa5, 0xa5, 0xa8, 0x44, 0x1b, 0x38, 0x2e,
-	0x14, 0xed, 0x90, 0x76, 0x82, 0xb9, 0xb1, 0x2b, 0x85, 0xab, 0x95, 0x6b, 0x2b, 0xb9, 0x75, 0x63,
-	0xdc, 0xbe, 0x2b, 0x8c, 0x3e, 0x0a, 0x36, 0xe6, 0xb7, 0x0b, 0x89, 0xee, 0x5b, 0x8b, 0xe4, 0x78,
-	0xdb, 0x80, 0x09, 0xc7, 0xda, 0xa4, 0x8e, 0x98, 0x5b, 0x95, 0x6b, 0xaf, 0xe5, 0x26, 0x49, 0xc4,
-	0x63, 0x61, 0x95, 0xd3, 0xbf, 0xee, 0x86, 0xfe, 0x5e, 0x3c, 0xbc, 0x44, 0x21, 0x4a, 0xe6, 0xe4,
-	0x6f, 0x18, 0x50, 0x89, 0x57, 0xb5, 0xa8, 0x59, 0x36, 0xf3, 0x17, 0x26, 0x5e, 0x4c, 0xa5, 0x44,
-	0x6a, 0x89, 0xd6, 0x20, 0xa8, 0xcb, 0x32, 0xff, 0x09, 0xa8, 0x68, 0x9f, 0x40, 0x66, 0xa1, 0xb0,
-	0x43, 0xf7, 0xc4, 0x80, 0x47, 0xf6, 0x93, 0x9c, 0x4f, 0x8c, 0x70, 0x39, 0xa4, 0x3f, 0x39, 0xf6,
-	0xbc, 0x31, 0xff, 0x22, 0xcc, 0xa6, 0x19, 0x0e, 0x53, 0xdf, 0xfc, 0xb5, 0x62, 0x62, 0x60, 0xb2,
-	0x85, 0x80, 0x78, 0x30, 0xd9, 0xa1, 0xa1, 0x6f, 0x37, 0xa3, 0x2e, 0x5b, 0x1e, 0xad, 0x95, 0xd6,
-	0x38, 0xb1, 0x78, 0x43, 0x14, 0xff, 0x03, 0x8c, 0xb8, 0x90, 0x6d, 0x18, 0xb7, 0xfc, 0x76, 0xd4,
-	0x27, 0x37, 0xf2, 0x99, 0x96, 0xf1, 0x52, 0x51, 0xf3, 0xdb, 0x01, 0x72, 0x0e, 0x64, 0x11, 0xca,
-	0x21, 0xf5, 0x3b, 0xb6, 0x6b, 0x85, 0x62, 0x07, 0x2d, 0xd5, 0xcf, 0x4a, 0xb4, 0xf2, 0x46, 0x04,
-	0xc0, 0x18, 0x87, 0x38, 0x30, 0xd1, 0xf2, 0xf7, 0xb0, 0xe7, 0xce, 0x8d, 0xe7, 0xd1, 0x14, 0xcb,
-	0x9c, 0x56, 0x3c, 0x48, 0xc5, 0x7f, 0x94, 0x3c, 0xc8, 0xaf, 0x18, 0x70, 0xbe, 0x43, 0xad, 0xa0,
-	0xe7, 0x53, 0xf6, 0x09, 0x48, 0x43, 0xea, 0xb2, 0x8e, 0x9d, 0x2b, 0x72, 0xe6, 0x38, 0x6a, 0x3f,
-	0xf4, 0x53, 0xae, 0x3f, 0x25, 0x45, 0x39, 0x9f, 0x05, 0xc5, 0x4c, 0x69, 0xc8, 0x9b, 0x50, 0x09,
-	0x43, 0xa7, 0x11, 0x32, 0x3d, 0xb8, 0xbd, 0x37, 0x37, 0xc1, 0x17, 0xaf, 0x11, 0x57, 0x98, 0x8d,
-	0x8d, 0xd5, 0x88, 0x60, 0x7d, 0x86, 0xcd, 0x16, 0xad, 0x00, 0x75, 0x76, 0xe6, 0x3f, 0x2b, 0xc2,
-	0xd9, 0xbe, 0x6d, 0x85, 0x3c, 0x0b, 0xc5, 0xee, 0xb6, 0x15, 0x44, 0xfb, 0xc4, 0xe5, 0x68, 0x91,
-	0x5a, 0x67, 0x85, 0x8f, 0xf6, 0xab, 0x67, 0xa2, 0x2a, 0xbc, 0x00, 0x05, 0x32, 0xd3, 0xda, 0x3a,
-	0x34, 0x08, 0xac, 0x76, 0xb4, 0x79, 0x68, 0x83, 0x94, 0x17, 0x63, 0x04, 0x27, 0x5f, 0x33, 0xe0,
-	0x8c, 0x18, 0xb0, 0x48, 0x83, 0x9e, 0x13, 0xb2, 0x0d, 0x92, 0x75, 0xca, 0xad, 0x3c, 0x26, 0x87,
-	0x20, 0x59, 0xbf, 0x20, 0xb9, 0x9f, 0xd1, 0x4b, 0x03, 0x4c, 0xf2, 0x25, 0xf7, 0xa0, 0x1c, 0x84,
-	0x96, 0x1f, 0xd2, 0x56, 0x2d, 0xe4, 0xaa, 0x5c, 0xe5, 0xda, 0x4f, 0x1e, 0x6f, 0xe7, 0xd8, 0xb0,
-	0x3b, 0x54, 0xec, 0x52, 0x8d, 0x88, 0x00, 0xc6, 0xb4, 0xc8, 0x9b, 0x00, 0x7e, 0xcf, 0x6d, 0xf4,
-	0x3a, 0x1d, 0xcb, 0xdf, 0x93, 0xda, 0xdd, 0xcd, 0xd1, 0x3e, 0x0f, 0x15, 0xbd, 0x58, 0xd1, 0x89,
-	0xcb, 0x50, 0xe3, 0x47, 0xbe, 0x64, 0xc0, 0x19, 0x31, 0x0f, 0x22, 0x09, 0x26, 0x72, 0x96, 0xe0,
-	0x2c, 0x6b, 0xda, 0x65, 0x9d, 0x05, 0x26, 0x39, 0x92, 0xd7, 0xa0, 0xd2, 0xf4, 0x3a, 0x5d, 0x87,
-	0x8a, 0xc6, 0x9d, 0x1c, 0xba, 0x71, 0xf9, 0xd0, 0x5d, 0x8a, 0x49, 0xa0, 0x4e, 0xcf, 0xfc, 0xb7,
-	0x49, 0x1d, 0x27, 0x1a, 0xd2, 0xe4, 0xb3, 0xf0, 0x44, 0xd0, 0x6b, 0x36, 0x69, 0x10, 0x6c, 0xf5,
-	0x1c, 0xec, 0xb9, 0x37, 0xed, 0x20, 0xf4, 0xfc, 0xbd, 0x55, 0xbb, 0x63, 0x87, 0x7c, 0x40, 0x17,
-	0xeb, 0x97, 0x0e, 0xf6, 0xab, 0x4f, 0x34, 0x06, 0x21, 0xe1, 0xe0, 0xfa, 0xc4, 0x82, 0x27, 0x7b,
-	0xee, 0x60, 0xf2, 0xe2, 0xf8, 0x51, 0x3d, 0xd8, 0xaf, 0x3e, 0x79, 0x77, 0x30, 0x1a, 0x1e, 0x46,
-	0xc3, 0xfc, 0x23, 0x83, 0x6d, 0x43, 0xe2, 0xbb, 0x36, 0x68, 0xa7, 0xeb, 0xb0, 0xa5, 0xf3, 0xf4,
-	0x95, 0xe3, 0x30, 0xa1, 0x1c, 0x63, 0x3e, 0x7b, 0x79, 0x24, 0xff, 0x20, 0x0d, 0xd9, 0xfc, 0x2f,
-	0x06, 0x9c, 0x4f, 0x23, 0x3f, 0x06, 0x85, 0x2e, 0x48, 0x2a, 0x74, 0xb7, 0xf3, 0xfd, 0xda, 0x01,
-	0x5a, 0xdd, 0xdb, 0xda, 0x80, 0x8d, 0x50, 0x91, 0x6e, 0x91, 0xe7, 0x61, 0x2a, 0x94, 0x7f, 0x6f,
-	0xc7, 0xca, 0xb9, 0x32, 0x4c, 0x6c, 0x68, 0x30, 0x4c, 0x60, 0x92, 0x67, 0x61, 0xaa, 0xe9, 0xf4,
-	0x82, 0x90, 0xfa, 0x8d, 0xa6, 0xd7, 0x15, 0xcb, 0x6e, 0xa9, 0x3e, 0xcb, 0x6a, 0x2d, 0x69, 0xe5,
-	0x98, 0xc0, 0x32, 0xff, 0x6a, 0xb1, 0xbf, 0xcd, 0xff, 0x5f, 0xd7, 0x55, 0x62, 0xd5, 0xa3, 0xf0,
-	0x5e, 0xaa, 0x1e, 0xe3, 0x3f, 0x56, 0xaa, 0xc7, 0x97, 0x0d, 0xa6, 0xc1, 0x89, 0x01, 0x10, 0x48,
-	0xb5, 0xe8, 0x95, 0x7c, 0xa7, 0x02, 0xd2, 0x2d, 0x5d, 0x29, 0x94, 0xbc, 0x30, 0x66, 0x6b, 0xfe,
-	0xfd, 0x71, 0x98, 0xaa, 0xb9, 0xa1, 0x5d, 0xdb, 0xda, 0xb2, 0x5d, 0x3b, 0xdc, 0x23, 0xdf, 0x18,
-	0x83, 0xc5, 0xae, 0x4f, 0xb7, 0xa8, 0xef, 0xd3, 0xd6, 0x72, 0xcf, 0xb7, 0xdd, 0x76, 0xa3, 0xb9,
-	0x4d, 0x5b, 0x3d, 0xc7, 0x76, 0xdb, 0x2b, 0x6d, 0xd7, 0x53, 0xc5, 0xd7, 0x1f, 0xd2, 0x66, 0x8f,
-	0xb7, 0xab, 0x58, 0x21, 0x3a, 0xa3, 0xc9, 0xbe, 0x3e, 0x1c, 0xd3, 0xfa, 0xc7, 0x0f, 0xf6, 0xab,
-	0x8b, 0x43, 0x56, 0xc2, 0x61, 0x3f, 0x8d, 0x7c, 0x7d, 0x0c, 0x16, 0x7c, 0xfa, 0x7a, 0xcf, 0x3e,
-	0x7e, 0x6b, 0x88, 0x25, 0xdc, 0x19, 0x71, 0xab, 0x1f, 0x8a, 0x67, 0xfd, 0xda, 0xc1, 0x7e, 0x75,
-	0xc8, 0x3a, 0x38, 0xe4, 0x77, 0x99, 0xeb, 0x50, 0xa9, 0x75, 0xed, 0xc0, 0x7e, 0x88, 0x5e, 0x2f,
-	0xa4, 0xc7, 0x30, 0x66, 0x54, 0xa1, 0xe8, 0xf7, 0x1c, 0x2a, 0x16, 0x98, 0x72, 0xbd, 0xcc, 0x96,
-	0x64, 0x64, 0x05, 0x28, 0xca, 0xcd, 0x2f, 0xb3, 0xed, 0x87, 0x93, 0x4c, 0x99, 0xb1, 0xee, 0x43,
-	0xd1, 0x67, 0x4c, 0xe4, 0xc8, 0x1a, 0xf5, 0xc4, 0x1f, 0x4b, 0x2d, 0x85, 0x60, 0x3f, 0x51, 0xb0,
-	0x30, 0x7f, 0x6b, 0x0c, 0x2e, 0xd4, 0xba, 0xdd, 0x35, 0x1a, 0x6c, 0xa7, 0xa4, 0xf8, 0x79, 0x03,
-	0xa6, 0x77, 0x6d, 0x3f, 0xec, 0x59, 0x4e, 0x64, 0xa9, 0x14, 0xf2, 0x34, 0x46, 0x95, 0x87, 0x73,
-	0x7b, 0x35, 0x41, 0xba, 0x4e, 0x0e, 0xf6, 0xab, 0xd3, 0xc9, 0x32, 0x4c, 0xb1, 0x27, 0x7f, 0xdd,
-	0x80, 0x59, 0x59, 0x74, 0xdb, 0x6b, 0x51, 0xdd, 0x12, 0x7e, 0x37, 0x4f, 0x99, 0x14, 0x71, 0x61,
-	0xc1, 0x4c, 0x97, 0x62, 0x9f, 0x10, 0xe6, 0x7f, 0x1b, 0x83, 0x8b, 0x03, 0x68, 0x90, 0x5f, 0x35,
-	0xe0, 0xbc, 0x30, 0x9f, 0x6b, 0x20, 0xa4, 0x5b, 0xb2, 0x35, 0x3f, 0x9d, 0xb7, 0xe4, 0xc8, 0xa6,
-	0x38, 0x75, 0x9b, 0xb4, 0x3e, 0xc7, 0x96, 0xe4, 0xa5, 0x0c, 0xd6, 0x98, 0x29, 0x10, 0x97, 0x54,
-	0x18, 0xd4, 0x53, 0x92, 0x8e, 0x3d, 0x16, 0x49, 0x1b, 0x19, 0xac, 0x31, 0x53, 0x20, 0xf3, 0x2f,
-	0xc0, 0x93, 0x87, 0x90, 0x3b, 0x7a, 0x72, 0x9a, 0xaf, 0xa9, 0x51, 0x9f, 0x1c, 0x73, 0xc7, 0x98,
-	0xd7, 0x26, 0x4c, 0xf0, 0xa9, 0x13, 0x4d, 0x6c, 0x60, 0x7b, 0x30, 0x9f, 0x53, 0x01, 0x4a, 0x88,
-	0xf9, 0x5b, 0x06, 0x94, 0x86, 0xb0, 0x7b, 0x56, 0x93, 0x76, 0xcf, 0x72, 0x9f, 0xcd, 0x33, 0xec,
-	0xb7, 0x79, 0xbe, 0x34, 0x5a, 0x6f, 0x1c, 0xc7, 0xd6, 0xf9, 0x43, 0x03, 0xce, 0xf6, 0xd9, 0x46,
-	0xc9, 0x36, 0x9c, 0xef, 0x7a, 0xad, 0x68, 0x3b, 0xbd, 0x69, 0x05, 0xdb, 0x1c, 0x26, 0x3f, 0xef,
-	0x59, 0xd6, 0x93, 0xeb, 0x19, 0xf0, 0x47, 0xfb, 0xd5, 0x39, 0x45, 0x24, 0x85, 0x80, 0x99, 0x14,
-	0x49, 0x17, 0x4a, 0x5b, 0x36, 0x75, 0x5a, 0xf1, 0x10, 0x1c, 0x51, 0x4b, 0xbb, 0x21, 0xa9, 0x89,
-	0x6b, 0x81, 0xe8, 0x1f, 0x2a, 0x2e, 0xe6, 0xff, 0x1c, 0x83, 0xe9, 0x5a, 0x2f, 0xdc, 0x66, 0x3a,
-	0x4a, 0x93, 0x5b, 0xe2, 0x88, 0x0b, 0xc5, 0xc0, 0x6e, 0xef, 0x3e, 0x9b, 0xcf, 0x62, 0xdc, 0x60,
-	0xa4, 0xe4, 0xf5, 0x88, 0x52, 0xd4, 0x79, 0x21, 0x0a, 0x36, 0xc4, 0x87, 0x09, 0xcf, 0xea, 0x85,
-	0xdb, 0xd7, 0xe4, 0x27, 0x8f, 0x68, 0x95, 0xb8, 0xc3, 0x3e, 0xe7, 0x9a, 0xe4, 0xa8, 0x54, 0x46,
-	0x51, 0x8a, 0x92, 0x13, 0xf9, 0x39, 0x28, 0x6f, 0x5a, 0x81, 0xdd, 0x64, 0xa5, 0x72, 0x78, 0x8d,
-	0x78, 0x41, 0x51, 0x8f, 0xc8, 0x49, 0xce, 0x4a, 0x0d, 0x53, 0x00, 0x8c, 0x59, 0x9a, 0x5f, 0x84,
-	0xe9, 0xe4, 0x9d, 0xdf, 0x31, 0xe6, 0xcc, 0x25, 0x28, 0x58, 0xbe, 0x2b, 0x67, 0x4c, 0x45, 0x22,
-	0x14, 0x6a, 0x78, 0x1b, 0x59, 0x39, 0x79, 0x06, 0x4a, 0x5b, 0x3d, 0xc7, 0xe1, 0x67, 0x1a, 0x71,
-	0xc1, 0xa6, 0x8e, 0x64, 0x37, 0x64, 0x39, 0x2a, 0x0c, 0xb3, 0x03, 0x33, 0x29, 0x89, 0x19, 0x81,
-	0x5e, 0x40, 0x7d, 0x4d, 0x0a, 0x45, 0xe0, 0xae, 0x2c, 0x47, 0x85, 0xc1, 0xb0, 0xbb, 0x56, 0x10,
-	0x3c, 0xf0, 0xfc, 0x96, 0x14, 0x49, 0x61, 0xaf, 0xcb, 0x72, 0x54, 0x18, 0xe6, 0xff, 0x1e, 0x87,
-	0x99, 0xba, 0xd3, 0xa3, 0x2f, 0xf9, 0x94, 0x46, 0x66, 0xaf, 0x1a, 0xcc, 0x74, 0x7d, 0xba, 0x6b,
-	0xd3, 0x07, 0x0d, 0xea, 0xd0, 0x66, 0xe8, 0xf9, 0x92, 0xed, 0x45, 0x49, 0x68, 0x66, 0x3d, 0x09,
-	0xc6, 0x34, 0x3e, 0x79, 0x11, 0xa6, 0xad, 0x66, 0x68, 0xef, 0x52, 0x45, 0x41, 0x88, 0xf2, 0x7e,
-	0x49, 0x61, 0xba, 0x96, 0x80, 0x62, 0x0a, 0x9b, 0x7c, 0x0e, 0xe6, 0x82, 0xa6, 0xe5, 0xd0, 0xbb,
-	0x5d, 0xc9, 0x6a, 0x69, 0x9b, 0x36, 0x77, 0xd6, 0x3d, 0xdb, 0x0d, 0xa5, 0x89, 0xf5, 0x8a, 0xa4,
-	0x34, 0xd7, 0x18, 0x80, 0x87, 0x03, 0x29, 0x90, 0xdf, 0x34, 0xe0, 0x52, 0xd7, 0xa7, 0xeb, 0xbe,
-	0xd7, 0xf1, 0xd8, 0xcc, 0xea, 0xb3, 0xfc, 0x49, 0x0b, 0xd8, 0xab, 0x23, 0xaa, 0x8e, 0xa2, 0xa4,
-	0xff, 0xba, 0xea, 0x03, 0x07, 0xfb, 0xd5, 0x4b, 0xeb, 0x87, 0x09, 0x80, 0x87, 0xcb, 0x47, 0xfe,
-	0x85, 0x01, 0x97, 0xbb, 0x5e, 0x10, 0x1e, 0xf2, 0x09, 0xc5, 0x53, 0xfd, 0x04, 0xf3, 0x60, 0xbf,
-	0x7a, 0x79, 0xfd, 0x50, 0x09, 0xf0, 0x08, 0x09, 0xcd, 0x83, 0x0a, 0x9c, 0xd5, 0xc6, 0x9e, 0xb4,
-	0x5b, 0xbd, 0x00, 0x67, 0xa2, 0xc1, 0x10, 0xab, 0x7a, 0xe5, 0xd8, 0x8c, 0x59, 0xd3, 0x81, 0x98,
-	0xc4, 0x65, 0xe3, 0x4e, 0x0d, 0x45, 0x51, 0x3b, 0x35, 0xee, 0xd6, 0x13, 0x50, 0x4c, 0x61, 0x93,
-	0x15, 0x38, 0x27, 0x4b, 0x90, 0x76, 0x1d, 0xbb, 0x69, 0x2d, 0x79, 0x3d, 0x39, 0xe4, 0x8a, 0xf5,
-	0x8b, 0x07, 0xfb, 0xd5, 0x73, 0xeb, 0xfd, 0x60, 0xcc, 0xaa, 0x43, 0x56, 0xe1, 0xbc, 0xd5, 0x0b,
-	0x3d, 0xf5, 0xfd, 0xd7, 0x5d, 0xa6, 0x3d, 0xb4, 0xf8, 0xd0, 0x2a, 0x09, 0x35, 0xa3, 0x96, 0x01,
-	0xc7, 0xcc, 0x5a, 0x64, 0x3d, 0x45, 0xad, 0x41, 0x9b, 0x9e, 0xdb, 0x12, 0xbd, 0x5c, 0x8c, 0x4f,
-	0xbd, 0xb5, 0x0c, 0x1c, 0xcc, 0xac, 0x49, 0x1c, 0x98, 0xee, 0x58, 0x0f, 0xef, 0xba, 0xd6, 0xae,
-	0x65, 0x3b, 0x8c, 0x89, 0x34, 0x8d, 0x0e, 0x36, 0xa8, 0xf5, 0x42, 0xdb, 0x59, 0x10, 0x2e, 0x2b,
-	0x0b, 0x2b, 0x6e, 0x78, 0xc7, 0x6f, 0x84, 0xec, 0x60, 0x22, 0x14, 0xe6, 0xb5, 0x04, 0x2d, 0x4c,
-	0xd1, 0x26, 0x77, 0xe0, 0x02, 0x9f, 0x8e, 0xcb, 0xde, 0x03, 0x77, 0x99, 0x3a, 0xd6, 0x5e, 0xf4,
-	0x01, 0x93, 0xfc, 0x03, 0x9e, 0x38, 0xd8, 0xaf, 0x5e, 0x68, 0x64, 0x21, 0x60, 0x76, 0x3d, 0x62,
-	0xc1, 0x93, 0x49, 0x00, 0xd2, 0x5d, 0x3b, 0xb0, 0x3d, 0x57, 0x58, 0x20, 0x4b, 0xb1, 0x05, 0xb2,
-	0x31, 0x18, 0x0d, 0x0f, 0xa3, 0x41, 0xfe, 0x96, 0x01, 0xe7, 0xb3, 0xa6, 0xe1, 0x5c, 0x39, 0x8f,
-	0x7d, 0x29, 0x35, 0xb5, 0xc4, 0x88, 0xc8, 0x5c, 0x14, 0x32, 0x85, 0x20, 0x6f, 0x19, 0x30, 0x65,
-	0x69, 0x06, 0x83, 0x39, 0xc8, 0x63, 0x93, 0xd6, 0x4d, 0x10, 0xc2, 0x82, 0xa6, 0x97, 0x60, 0x82,
-	0x23, 0xf9, 0xdb, 0x06, 0x5c, 0xc8, 0x9c, 0xe3, 0x73, 0x95, 0xd3, 0x68, 0x21, 0x3e, 0x48, 0xb2,
-	0xd7, 0x9c, 0x6c, 0x31, 0xc8, 0xb7, 0x0c, 0xb5, 0x95, 0x45, 0x77, 0xa9, 0x73, 0x53, 0x5c, 0xb4,
-	0x11, 0xed, 0x3b, 0x9a, 0xd6, 0x18, 0x11, 0xae, 0x9f, 0xd3, 0x76, 0xc6, 0xa8, 0x10, 0xd3, 0xec,
-	0xc9, 0x37, 0x8d, 0x68, 0x6b, 0x54, 0x12, 0x9d, 0x39, 0x2d, 0x89, 0x48, 0xbc, 0xd3, 0x2a, 0x81,
-	0x52, 0xcc, 0xc9, 0xcf, 0xc0, 0xbc, 0xb5, 0xe9, 0xf9, 0x61, 0xe6, 0xe4, 0x9b, 0x9b, 0xe6, 0xd3,
-	0xe8, 0xf2, 0xc1, 0x7e, 0x75, 0xbe, 0x36, 0x10, 0x0b, 0x0f, 0xa1, 0x60, 0xfe, 0xce, 0x04, 0x4c,
-	0x89, 0x83, 0x9f, 0xdc, 0xba, 0x7e, 0xc3, 0x80, 0xa7, 0x9a, 0x3d, 0xdf, 0xa7, 0x6e, 0xd8, 0x08,
-	0x69, 0xb7, 0x7f, 0xe3, 0x32, 0x4e, 0x75, 0xe3, 0xba, 0x72, 0xb0, 0x5f, 0x7d, 0x6a, 0xe9, 0x10,
-	0xfe, 0x78, 0xa8, 0x74, 0xe4, 0x5f, 0x1b, 0x60, 0x4a, 0x84, 0xba, 0xd5, 0xdc, 0x69, 0xfb, 0x5e,
-	0xcf, 0x6d, 0xf5, 0x7f, 0xc4, 0xd8, 0xa9, 0x7e, 0xc4, 0x07, 0x0f, 0xf6, 0xab, 0xe6, 0xd2, 0x91,
-	0x52, 0xe0, 0x31, 0x24, 0x25, 0x2f, 0xc1, 0x59, 0x89, 0x75, 0xfd, 0x61, 0x97, 0xfa, 0x36, 0x3b,
-	0x62, 0x49, 0x3d, 0x35, 0x76, 0xc3, 0x4b, 0x23, 0x60, 0x7f, 0x1d, 0x12, 0xc0, 0xe4, 0x03, 0x6a,
-	0xb7, 0xb7, 0xc3, 0x48, 0x7d, 0x1a, 0xd1, 0xf7, 0x4e, 0x1a, 0x81, 0xee, 0x09, 0x9a, 0xf5, 0xca,
-	0xc1, 0x7e, 0x75, 0x52, 0xfe, 0xc1, 0x88, 0x13, 0xb9, 0x0d, 0xd3, 0xe2, 0x58, 0xbe, 0x6e, 0xbb,
-	0xed, 0x75, 0xcf, 0x15, 0x0e, 0x64, 0xe5, 0xfa, 0x07, 0xa3, 0x0d, 0xbf, 0x91, 0x80, 0x3e, 0xda,
-	0xaf, 0x4e, 0x45, 0xbf, 0x37, 0xf6, 0xba, 0x14, 0x53, 0xb5, 0xc9, 0xdf, 0x34, 0x80, 0x04, 0x21,
-	0xed, 0xae, 0x3b, 0xbd, 0xb6, 0x2d, 0x9b, 0x48, 0xba, 0x82, 0xe5, 0xe0, 0x95, 0x96, 0xa4, 0x5b,
-	0x9f, 0x97, 0x42, 0x92, 0x46, 0x1f, 0x47, 0xcc, 0x90, 0xc2, 0xfc, 0xf6, 0x24, 0x40, 0x34, 0x97,
-	0x68, 0x97, 0x7c, 0x18, 0xca, 0x01, 0x0d, 0x45, 0x93, 0xc8, 0x1b, 0x3d, 0x71, 0x0f, 0x1b, 0x15,
-	0x62, 0x0c, 0x27, 0x3b, 0x50, 0xec, 0x5a, 0xbd, 0x80, 0xe6, 0x73, 0x96, 0x93, 0x23, 0x73, 0x9d,
-	0x51, 0x14, 0x46, 0x02, 0xfe, 0x13, 0x05, 0x0f, 0xf2, 0x15, 0x03, 0x80, 0x26, 0x47, 0xd3, 0xc8,
-	0xc6, 0x3a, 0xc9, 0x32, 0x1e, 0x70, 0xac, 0x0d, 0xea, 0xd3, 0x07, 0xfb, 0x55, 0xd0, 0xc6, 0xa5,
-	0xc6, 0x96, 0x3c, 0x80, 0x92, 0x15, 0x6d, 0x48, 0xe3, 0xa7, 0xb1, 0x21, 0xf1, 0xb3, 0xbb, 0x9a,
-	0x51, 0x8a, 0x19, 0xf9, 0xba, 0x01, 0xd3, 0x01, 0x0d, 0x65, 0x57, 0xb1, 0x65, 0x51, 0x6a, 0xe3,
-	0x23, 0xce, 0x88, 0x46, 0x82, 0xa6, 0x58, 0xde, 0x93, 0x65, 0x98, 0xe2, 0x1b, 0x89, 0x72, 0x93,
-	0x5a, 0x2d, 0xea, 0x73, 0xd3, 0x90, 0x54, 0xf3, 0x46, 0x17, 0x45, 0xa3, 0xa9, 0x44, 0xd1, 0xca,
-	0x30, 0xc5, 0x37, 0x12, 0x65, 0xcd, 0xf6, 0x7d, 0x4f, 0x8a, 0x52, 0xca, 0x49, 0x14, 0x8d, 0xa6,
-	0x12, 0x45, 0x2b, 0xc3, 0x14, 0x5f, 0xe2, 0xc0, 0x44, 0x97, 0x4f, 0x2d, 0xa9, 0xca, 0x8d, 0xe8,
-	0x0e, 0x10, 0x4d, 0x53, 0xda, 0x15, 0x26, 0x38, 0xf1, 0x1f, 0x25, 0x0f, 0xf3, 0xdf, 0x4c, 0xc3,
-	0x74, 0x34, 0x6d, 0xe3, 0x43, 0x8e, 0xb0, 0x7b, 0x0e, 0x38, 0xe4, 0x2c, 0xe9, 0x40, 0x4c, 0xe2,
-	0xb2, 0xca, 0x62, 0xd5, 0x4a, 0x9e, 0x71, 0x54, 0xe5, 0x86, 0x0e, 0xc4, 0x24, 0x2e, 0xe9, 0x40,
-	0x91, 0xad, 0x2c, 0x91, 0xa7, 0xc9, 0x88, 0x5f, 0x1e, 0xaf, 0x46, 0x9a, 0x0d, 0x89, 0x91, 0x47,
-	0xc1, 0x85, 0x9b, 0xee, 0xc3, 0x84, 0x35, 0x5f, 0x4e, 0xc5, 0x7c, 0x56, 0x83, 0xe4, 0x45, 0x81,
-	0xe8, 0xfb, 0x64, 0x19, 0xa6, 0xd8, 0x67, 0x9c, 0x7b, 0x8a, 0xa7, 0x78, 0xee, 0xf9, 0x0c, 0x94,
-	0x3a, 0xd6, 0xc3, 0x46, 0xcf, 0x6f, 0x9f, 0xfc, 0x7c, 0x25, 0x3d, 0x87, 0x05, 0x15, 0x54, 0xf4,
-	0xc8, 0x97, 0x0c, 0x6d, 0x81, 0x13, 0x6e, 0x25, 0xf7, 0xf2, 0x5d, 0xe0, 0x94, 0xda, 0x30, 0x70,
-	0xa9, 0xeb, 0x3b, 0x85, 0x94, 0x1e, 0xfb, 0x29, 0x84, 0x69, 0xd4, 0x62, 0x82, 0x28, 0x8d, 0xba,
-	0x7c, 0xaa, 0x1a, 0xf5, 0x52, 0x82, 0x19, 0xa6, 0x98, 0x73, 0x79, 0xc4, 0x9c, 0x53, 0xf2, 0xc0,
-	0xa9, 0xca, 0xd3, 0x48, 0x30, 0xc3, 0x14, 0xf3, 0xc1, 0x47, 0xef, 0xca, 0xe9, 0x1c, 0xbd, 0xa7,
-	0x72, 0x38, 0x7a, 0x1f, 0x7e, 0x2a, 0x39, 0x33, 0xea, 0xa9, 0x84, 0xdc, 0x02, 0xd2, 0xda, 0x73,
-	0xad, 0x8e, 0xdd, 0x94, 0x8b, 0x25, 0xdf, 0xa4, 0xa7, 0xb9, 0x69, 0x46, 0x69, 0x65, 0xcb, 0x7d,
-	0x18, 0x98, 0x51, 0x8b, 0x84, 0x50, 0xea, 0x46, 0xca, 0xe7, 0x4c, 0x1e, 0xa3, 0x3f, 0x52, 0x46,
-	0x85, 0xb7, 0x10, 0x37, 0xdc, 0xca, 0x12, 0x54, 0x9c, 0xc8, 0x2a, 0x9c, 0xef, 0xd8, 0xee, 0xba,
-	0xd7, 0x0a, 0xd6, 0xa9, 0x2f, 0x0d, 0x4f, 0x0d, 0x1a, 0xce, 0xcd, 0xf2, 0xb6, 0xe1, 0xc6, 0x84,
-	0xb5, 0x0c, 0x38, 0x66, 0xd6, 0x22, 0xbf, 0x66, 0xc0, 0x9c, 0x2f, 0xfe, 0xae, 0xfb, 0x1e, 0x0f,
-	0x70, 0xd8, 0xd8, 0xf6, 0x69, 0xb0, 0xed, 0x39, 0xad, 0xb9, 0xb3, 0xb9, 0x9c, 0x65, 0x06, 0x50,
-	0xaf, 0x3f, 0x75, 0xb0, 0x5f, 0x9d, 0x1b, 0x04, 0xc5, 0x81, 0x52, 0x99, 0xff, 0xcb, 0x80, 0xd9,
-	0x25, 0xc7, 0xeb, 0xb5, 0xee, 0x59, 0x61, 0x73, 0x5b, 0xf8, 0xd4, 0x90, 0x17, 0xa1, 0x64, 0xbb,
-	0x21, 0xf5, 0x77, 0x2d, 0x47, 0x6e, 0xa9, 0x66, 0x64, 0xfc, 0x5e, 0x91, 0xe5, 0x8f, 0xf6, 0xab,
-	0xd3, 0xcb, 0x3d, 0x9f, 0x5f, 0xa9, 0x88, 0x05, 0x16, 0x55, 0x1d, 0xf2, 0xae, 0x01, 0x67, 0x85,
-	0x57, 0xce, 0xb2, 0x15, 0x5a, 0xaf, 0xf4, 0xa8, 0x6f, 0xd3, 0xc8, 0x2f, 0x67, 0xc4, 0xb5, 0x35,
-	0x2d, 0x6b, 0xc4, 0x60, 0x2f, 0x3e, 0x66, 0xad, 0xa5, 0x39, 0x63, 0xbf, 0x30, 0xe6, 0x2f, 0x16,
-	0xe0, 0x89, 0x81, 0xb4, 0xc8, 0x3c, 0x8c, 0xd9, 0x2d, 0xf9, 0xe9, 0x20, 0xe9, 0x8e, 0xad, 0xb4,
-	0x70, 0xcc, 0x6e, 0x91, 0x05, 0xae, 0x94, 0xb3, 0x56, 0x8c, 0xbc, 0x23, 0xca, 0x4a, 0x7f, 0x96,
-	0xa5, 0xa8, 0x61, 0x90, 0x2a, 0x14, 0xb9, 0xa3, 0xbb, 0x3c, 0x0d, 0x72, 0x35, 0x9f, 0xfb, 0x94,
-	0xa3, 0x28, 0x27, 0x5f, 0x36, 0x00, 0x84, 0x80, 0xec, 0x88, 0x22, 0x37, 0x76, 0xcc, 0xb7, 0x99,
-	0x18, 0x65, 0x21, 0x65, 0xfc, 0x1f, 0x35, 0xae, 0x64, 0x03, 0x26, 0x98, 0xc6, 0xef, 0xb5, 0x4e,
-	0xbc, 0x8f, 0x0b, 0x9d, 0x8d, 0xd3, 0x40, 0x49, 0x8b, 0xb5, 0x95, 0x4f, 0xc3, 0x9e, 0xef, 0xb2,
-	0xa6, 0xe5, 0x3b, 0x77, 0x49, 0x48, 0x81, 0xaa, 0x14, 0x35, 0x0c, 0xf3, 0x9f, 0x8e, 0xc1, 0xf9,
-	0x2c, 0xd1, 0xd9, 0x06, 0x39, 0x21, 0xa4, 0x95, 0x86, 0x8d, 0x9f, 0xce, 0xbf, 0x7d, 0xa4, 0x83,
-	0x99, 0xba, 0x53, 0x93, 0x9e, 0xbe, 0x92, 0x2f, 0xf9, 0x69, 0xd5, 0x42, 0x63, 0x27, 0x6c, 0x21,
-	0x45, 0x39, 0xd5, 0x4a, 0x57, 0x60, 0x3c, 0x60, 0x3d, 0x5f, 0x48, 0xde, 0x8d, 0xf1, 0x3e, 0xe2,
-	0x10, 0x86, 0xd1, 0x73, 0xed, 0x50, 0x46, 0x87, 0x29, 0x8c, 0xbb, 0xae, 0x1d, 0x22, 0x87, 0x98,
-	0xef, 0x8c, 0xc1, 0xfc, 0xe0, 0x8f, 0x22, 0xef, 0x18, 0x00, 0x2d, 0x76, 0x9e, 0x0b, 0x78, 0x88,
-	0x85, 0x70, 0xc8, 0xb3, 0x4e, 0xab, 0x0d, 0x97, 0x23, 0x4e, 0xb1, 0x97, 0xa8, 0x2a, 0x0a, 0x50,
-	0x13, 0x84, 0x5c, 0x8b, 0x86, 0x3e, 0xbf, 0xd7, 0x13, 0x93, 0x49, 0xd5, 0x59, 0x53, 0x10, 0xd4,
-	0xb0, 0xd8, 0x81, 0xdd, 0xb5, 0x3a, 0x34, 0xe8, 0x5a, 0x2a, 0xd6, 0x8e, 0x1f, 0xd8, 0x6f, 0x47,
-	0x85, 0x18, 0xc3, 0x4d, 0x07, 0x9e, 0x3e, 0x86, 0x9c, 0x39, 0x85, 0x32, 0x99, 0x7f, 0x6c, 0xc0,
-	0x45, 0xe9, 0x2b, 0xf9, 0xff, 0x8d, 0xd3, 0xed, 0x8f, 0x0c, 0x78, 0x72, 0xc0, 0x37, 0x3f, 0x06,
-	0xdf, 0xdb, 0x37, 0x92, 0xbe, 0xb7, 0x77, 0x47, 0x1d, 0xd2, 0x99, 0xdf, 0x31, 0xc0, 0x05, 0xf7,
-	0x9d, 0x22, 0x9c, 0x61, 0xcb, 0x56, 0xcb, 0x6b, 0xe7, 0xb4, 0x71, 0x3e, 0x0d, 0xc5, 0xd7, 0xd9,
-	0x06, 0x94, 0x1e, 0x64, 0x7c, 0x57, 0x42, 0x01, 0x23, 0x5f, 0x31, 0x60, 0xf2, 0x75, 0xb9, 0xa7,
-	0x8a, 0xe3, 0xe7, 0x88, 0x8b, 0x61, 0xe2, 0x1b, 0x16, 0xe4, 0x0e, 0x29, 0x22, 0xa4, 0x94, 0xb7,
-	0x6d, 0xb4, 0x95, 0x46, 0x9c, 0xc9, 0x87, 0x60, 0x72, 0xcb, 0xf3, 0x3b, 0x3d, 0xc7, 0x4a, 0x87,
-	0xe5, 0xde, 0x10, 0xc5, 0x18, 0xc1, 0xd9, 0x24, 0xb7, 0xba, 0xf6, 0xab, 0xd4, 0x0f, 0x44, 0xc0,
-	0x4c, 0x62, 0x92, 0xd7, 0x14, 0x04, 0x35, 0x2c, 0x5e, 0xa7, 0xdd, 0xf6, 0x69, 0xdb, 0x0a, 0x3d,
-	0x9f, 0xef, 0x1c, 0x7a, 0x1d, 0x05, 0x41, 0x0d, 0x8b, 0x3c, 0x84, 0x72, 0x40, 0x9b, 0x3e, 0x0d,
-	0x91, 0x6e, 0xc9, 0x93, 0xdc, 0x4b, 0xa3, 0x1a, 0x45, 0x24, 0xb9, 0xd8, 0xdf, 0x41, 0x15, 0x61,
-	0xcc, 0x8c, 0xac, 0xc3, 0xb4, 0x4f, 0x5f, 0xef, 0xd1, 0x20, 0xdc, 0xb0, 0x3b, 0xd4, 0xeb, 0x89,
-	0x9b, 0xb3, 0x72, 0xfd, 0x6a, 0x64, 0x3f, 0xc5, 0x04, 0x34, 0x63, 0x0c, 0xa4, 0xea, 0xcf, 0x7f,
-	0x12, 0xa6, 0xf4, 0x8e, 0x18, 0x2a, 0x72, 0xec, 0x53, 0x20, 0x5d, 0x88, 0x53, 0xcb, 0xab, 0x71,
-	0x9c, 0xe5, 0xd5, 0xfc, 0x77, 0x63, 0xa0, 0x99, 0x02, 0x1f, 0xc3, 0xb2, 0xe5, 0x26, 0x96, 0xad,
-	0x11, 0xcd, 0x58, 0x9a, 0x61, 0x73, 0x50, 0x1c, 0xed, 0x6e, 0x2a, 0x8e, 0xf6, 0x76, 0x6e, 0x1c,
-	0x0f, 0x0f, 0xa3, 0xfd, 0xbe, 0x01, 0x4f, 0xc6, 0xc8, 0xfd, 0x57, 0x08, 0x47, 0xef, 0x41, 0xcf,
-	0x41, 0xc5, 0x8a, 0xab, 0xc9, 0x45, 0x42, 0x0b, 0x62, 0x54, 0x20, 0xd4, 0xf1, 0xe2, 0x00, 0xac,
-	0xc2, 0x09, 0x03, 0xb0, 0xc6, 0x0f, 0x0f, 0xc0, 0x32, 0xff, 0xfb, 0x18, 0x5c, 0xea, 0xff, 0x32,
-	0x3d, 0x2a, 0xe1, 0xe8, 0x6f, 0x4b, 0xc7, 0x2d, 0x8c, 0x9d, 0x38, 0x6e, 0xa1, 0x70, 0x9c, 0xb8,
-	0x05, 0x15, 0x2d, 0x30, 0x7e, 0xea, 0xd1, 0x02, 0x0d, 0xb8, 0x10, 0xb9, 0x26, 0xdf, 0xf0, 0x7c,
-	0x19, 0x81, 0x14, 0xad, 0x84, 0xa5, 0xfa, 0x25, 0x59, 0xe5, 0x02, 0x66, 0x21, 0x61, 0x76, 0x5d,
-	0xf3, 0xfb, 0x05, 0x38, 0x17, 0x37, 0xf9, 0x92, 0xe7, 0xb6, 0x6c, 0xee, 0xdd, 0xf6, 0x02, 0x8c,
-	0x87, 0x7b, 0xdd, 0xa8, 0xa1, 0xff, 0x6c, 0x24, 0xce, 0xc6, 0x5e, 0x97, 0xf5, 0xf4, 0xc5, 0x8c,
-	0x2a, 0xfc, 0x02, 0x87, 0x57, 0x22, 0xab, 0x6a, 0x66, 0x88, 0xd6, 0x7f, 0x36, 0x39, 0x92, 0x1f,
-	0xed, 0x57, 0x33, 0x72, 0x89, 0x2c, 0x28, 0x4a, 0xc9, 0xf1, 0x4e, 0xee, 0xc3, 0xb4, 0x63, 0x05,
-	0xe1, 0xdd, 0x6e, 0xcb, 0x0a, 0x29, 0x5b, 0xd7, 0xe4, 0x7c, 0x1b, 0x26, 0x68, 0x4b, 0x79, 0x9c,
-	0xac, 0x26, 0x28, 0x61, 0x8a, 0x32, 0xd9, 0x05, 0xc2, 0x4a, 0x36, 0x7c, 0xcb, 0x0d, 0xc4, 0x57,
-	0x31, 0x7e, 0xc3, 0x47, 0xe0, 0x29, 0xab, 0xc5, 0x6a, 0x1f, 0x35, 0xcc, 0xe0, 0x40, 0x3e, 0x08,
-	0x13, 0x3e, 0xb5, 0x02, 0xb5, 0xad, 0xa9, 0xb9, 0x8f, 0xbc, 0x14, 0x25, 0x54, 0x9f, 0x4c, 0x13,
-	0x47, 0x4c, 0xa6, 0xdf, 0x37, 0x60, 0x3a, 0xee, 0xa6, 0xc7, 0xa0, 0x42, 0x75, 0x92, 0x2a, 0xd4,
-	0xcd, 0xbc, 0x96, 0xc3, 0x01, 0x5a, 0xd3, 0x1f, 0x4d, 0xea, 0xdf, 0xc7, 0x43, 0x85, 0xbe, 0xa0,
-	0x47, 0x8e, 0x18, 0x79, 0xc4, 0x6e, 0x26, 0xb4, 0xd6, 0x43, 0x43, 0x46, 0x98, 0xce, 0xd6, 0x92,
-	0x7b, 0xb1, 0x1c, 0xf6, 0x4a, 0x67, 0x8b, 0xf6, 0xe8, 0x2c, 0x9d, 0x2d, 0xaa, 0x43, 0xee, 0xc2,
-	0xc5, 0xae, 0x34, 0xab, 0x2c, 0x53, 0xab, 0xe5, 0xd8, 0x2e, 0x8d, 0x2c, 0x6c, 0xc2, 0xe1, 0xe9,
-	0xc9, 0x83, 0xfd, 0xea, 0xc5, 0xf5, 0x6c, 0x14, 0x1c, 0x54, 0x37, 0x19, 0x0f, 0x3d, 0x7e, 0x8c,
-	0x78, 0xe8, 0xbf, 0xac, 0xec, 0xd8, 0x2a, 0xfc, 0xe6, 0xb3, 0x79, 0x75, 0x65, 0x56, 0x20, 0x8e,
-	0x1a, 0x52, 0x35, 0xc9, 0x14, 0x15, 0xfb, 0xc1, 0xc6, 0xd2, 0x89, 0x13, 0x1a, 0x4b, 0xe3, 0x88,
-	0xab, 0xc9, 0xf7, 0x32, 0xe2, 0xaa, 0xf4, 0x63, 0x15, 0x71, 0xf5, 0xae, 0x01, 0xe7, 0xac, 0xfe,
-	0x3c, 0x07, 0xf9, 0xd8, 0xed, 0x33, 0x12, 0x28, 0xd4, 0x9f, 0x94, 0x42, 0x66, 0xa5, 0x93, 0xc0,
-	0x2c, 0x51, 0xcc, 0xb7, 0x8b, 0x30, 0x9b, 0x56, 0x90, 0x4e, 0x3f, 0x20, 0xfc, 0x17, 0x0c, 0x98,
-	0x8d, 0x26, 0xb8, 0x72, 0x3e, 0x10, 0x47, 0xa5, 0xd5, 0x9c, 0xd6, 0x15, 0xa1, 0xea, 0xa9, 0x3c,
-	0x3d, 0x1b, 0x29, 0x6e, 0xd8, 0xc7, 0x9f, 0xbc, 0x06, 0x15, 0x75, 0xa1, 0x75, 0xa2, 0xe8, 0x70,
-	0x1e, 0xc0, 0x5c, 0x8b, 0x49, 0xa0, 0x4e, 0x8f, 0xbc, 0x6d, 0x00, 0x34, 0xa3, 0x9d, 0x38, 0xa7,
-	0xf8, 0xbb, 0x0c, 0x6d, 0x21, 0xd6, 0xe5, 0x55, 0x51, 0x80, 0x1a, 0x63, 0xf2, 0x8b, 0xfc, 0x2a,
-	0x4b, 0x8d, 0x84, 0xc8, 0xe9, 0xe3, 0xd3, 0x79, 0x2f, 0x45, 0xb1, 0x1b, 0x8f, 0xd2, 0x11, 0x35,
-	0x50, 0x80, 0x09, 0x21, 0xcc, 0x17, 0x40, 0x45, 0x07, 0xb0, 0x95, 0x95, 0xc7, 0x07, 0xac, 0x5b,
-	0xe1, 0xb6, 0x1c, 0x82, 0x6a, 0x65, 0xbd, 0x11, 0x01, 0x30, 0xc6, 0x31, 0x3f, 0x0f, 0xd3, 0x2f,
-	0xf9, 0x56, 0x77, 0xdb, 0xe6, 0x57, 0x46, 0xec, 0x9c, 0xff, 0x21, 0x98, 0xb4, 0x5a, 0xad, 0xac,
-	0x94, 0x52, 0x35, 0x51, 0x8c, 0x11, 0xfc, 0x58, 0x47, 0x7a, 0xf3, 0x5f, 0x1a, 0x40, 0xe2, 0x4b,
-	0x7e, 0xdb, 0x6d, 0xaf, 0x59, 0x61, 0x73, 0x9b, 0x1d, 0xdf, 0xb6, 0x79, 0x69, 0xd6, 0xf1, 0xed,
-	0xa6, 0x82, 0xa0, 0x86, 0x45, 0xde, 0x84, 0x8a, 0xf8, 0xf7, 0xaa, 0x3a, 0x1c, 0x8e, 0x1e, 0xe4,
-	0xc0, 0xf7, 0x3c, 0x2e, 0x93, 0x18, 0x85, 0x37, 0x63, 0x0e, 0xa8, 0xb3, 0x63, 0x4d, 0xb5, 0xe2,
-	0x6e, 0x39, 0xbd, 0x87, 0xad, 0xcd, 0xb8, 0xa9, 0xba, 0xbe, 0xb7, 0x65, 0x3b, 0x34, 0xdd, 0x54,
-	0xeb, 0xa2, 0x18, 0x23, 0xf8, 0xf1, 0x9a, 0xea, 0x9d, 0x31, 0x38, 0xbf, 0x12, 0x84, 0xb6, 0xb7,
-	0x4c, 0x83, 0x90, 0xed, 0x7c, 0x6c, 0x7d, 0xec, 0x39, 0xc7, 0x09, 0xf4, 0x59, 0x86, 0x59, 0xe9,
-	0x02, 0xd0, 0xdb, 0x0c, 0x68, 0xa8, 0x1d, 0x33, 0xd4, 0x3c, 0x5e, 0x4a, 0xc1, 0xb1, 0xaf, 0x06,
-	0xa3, 0x22, 0x7d, 0x01, 0x62, 0x2a, 0x85, 0x24, 0x95, 0x46, 0x0a, 0x8e, 0x7d, 0x35, 0xd8, 0x0e,
-	0x69, 0xb5, 0xc4, 0x9c, 0xb1, 0x9c, 0xb8, 0x5c, 0x9c, 0x47, 0xca, 0x62, 0x87, 0xac, 0x65, 0x21,
-	0x60, 0x76, 0x3d, 0xf3, 0x7b, 0x05, 0x38, 0xc7, 0xdb, 0x25, 0x15, 0xf5, 0xf7, 0xcd, 0x41, 0x51,
-	0x7f, 0x23, 0xae, 0x0d, 0x9c, 0xd7, 0x09, 0x62, 0xfe, 0xfe, 0x9a, 0x01, 0x33, 0xad, 0x64, 0xd7,
-	0xe5, 0x63, 0xb0, 0xcc, 0x1a, 0x14, 0xc2, 0x9b, 0x34, 0x55, 0x88, 0x69, 0xfe, 0xe4, 0x97, 0x0c,
-	0x98, 0x49, 0x8a, 0x19, 0x6d, 0x17, 0xa7, 0xd0, 0x48, 0x2a, 0xfc, 0x23, 0x59, 0x1e, 0x60, 0x5a,
-	0x04, 0xf3, 0xbb, 0x63, 0xb2, 0x4b, 0x4f, 0x23, 0xa4, 0x8d, 0x3c, 0x80, 0x72, 0xe8, 0x04, 0xa2,
-	0x50, 0x7e, 0xed, 0x88, 0xa7, 0xe0, 0x8d, 0xd5, 0x86, 0x70, 0x1e, 0x8a, 0x15, 0x55, 0x59, 0xc2,
-	0x14, 0xee, 0x88, 0x17, 0x67, 0xdc, 0xec, 0x4a, 0xc6, 0xb9, 0x1c, 0xbf, 0x37, 0x96, 0xd6, 0xd3,
-	0x8c, 0x65, 0x09, 0x63, 0x1c, 0xf1, 0x32, 0xff, 0x91, 0x01, 0xe5, 0x5b, 0x5e, 0xb4, 0x30, 0xfd,
-	0x4c, 0x0e, 0x86, 0x2d, 0xa5, 0x03, 0x2b, 0x2d, 0x28, 0x3e, 0x56, 0xbd, 0x98, 0x30, 0x6b, 0x3d,
-	0xa5, 0xd1, 0x5e, 0xe0, 0xa9, 0x3a, 0x19, 0xa9, 0x5b, 0xde, 0xe6, 0x40, 0xbb, 0xfa, 0xf7, 0x8a,
-	0x70, 0xe6, 0x65, 0x6b, 0x8f, 0xba, 0xa1, 0x35, 0xfc, 0xae, 0xf3, 0x1c, 0x54, 0xac, 0x2e, 0xbf,
-	0xf2, 0xd5, 0xce, 0x35, 0xb1, 0xa5, 0x28, 0x06, 0xa1, 0x8e, 0x17, 0xaf, 0x90, 0x22, 0x66, 0x2a,
-	0x6b, 0x6d, 0x5b, 0x4a, 0xc1, 0xb1, 0xaf, 0x06, 0xb9, 0x05, 0x44, 0xe6, 0x64, 0xa8, 0x35, 0x9b,
-	0x5e, 0xcf, 0x15, 0x6b, 0xa4, 0x30, 0x22, 0xa9, 0x03, 0xf6, 0x5a, 0x1f, 0x06, 0x66, 0xd4, 0x22,
-	0x9f, 0x83, 0xb9, 0x26, 0xa7, 0x2c, 0x8f, 0x5b, 0x3a, 0x45, 0x71, 0xe4, 0x56, 0x21, 0x4c, 0x4b,
-	0x03, 0xf0, 0x70, 0x20, 0x05, 0x26, 0x69, 0x10, 0x7a, 0xbe, 0xd5, 0xa6, 0x3a, 0xdd, 0x89, 0xa4,
-	0xa4, 0x8d, 0x3e, 0x0c, 0xcc, 0xa8, 0x45, 0xbe, 0x08, 0xe5, 0x50, 0x5d, 0xf6, 0x4f, 0xe6, 0x61,
-	0x59, 0x94, 0xbd, 0x1f, 0x5f, 0xf2, 0xc7, 0xc3, 0x5b, 0xdd, 0xec, 0xc7, 0x3c, 0x89, 0x0f, 0x13,
-	0x41, 0xd3, 0xeb, 0xd2, 0x40, 0x1e, 0x53, 0x6e, 0xe5, 0xc2, 0x9d, 0x5b, 0xcb, 0x34, 0x9b, 0x26,
-	0xe7, 0x80, 0x92, 0x13, 0x79, 0x06, 0x4a, 0x8e, 0xe7, 0xed, 0x6c, 0x5a, 0xcd, 0x1d, 0x7e, 0xec,
-	0x28, 0x69, 0x96, 0x06, 0x59, 0x8e, 0x0a, 0xc3, 0xfc, 0xed, 0x31, 0x98, 0xd2, 0xc9, 0x1e, 0x63,
-	0x25, 0xfb, 0x8a, 0x01, 0x53, 0x4d, 0xcf, 0x0d, 0x7d, 0xcf, 0x89, 0xb3, 0x92, 0x8c, 0xae, 0xd0,
-	0x30, 0x52, 0xcb, 0x34, 0xb4, 0x6c, 0x27, 0x56, 0x1f, 0x97, 0x34, 0x36, 0x98, 0x60, 0x4a, 0xbe,
-	0x61, 0xc0, 0x4c, 0xec, 0x12, 0x1b, 0x9b, 0x19, 0x73, 0x15, 0x44, 0x6d, 0x0c, 0xd7, 0x93, 0x9c,
-	0x30, 0xcd, 0xda, 0xdc, 0x84, 0xd9, 0xf4, 0xd8, 0x60, 0x4d, 0xd9, 0xb5, 0xe4, 0xca, 0x50, 0x88,
-	0x9b, 0x72, 0xdd, 0x0a, 0x02, 0xe4, 0x10, 0xd6, 0x57, 0x1d, 0xcb, 0x6f, 0xdb, 0xae, 0xe5, 0xf0,
-	0x56, 0x2c, 0x68, 0xcb, 0x97, 0x2c, 0x47, 0x85, 0x61, 0x7e, 0x14, 0xa6, 0xd6, 0x2c, 0xb7, 0x4d,
-	0x5b, 0x72, 0xd5, 0x3e, 0x3a, 0x04, 0xfb, 0x0f, 0xc7, 0xa1, 0xa2, 0x9d, 0x5e, 0x4f, 0xff, 0x98,
-	0x97, 0xc8, 0xb6, 0x55, 0xc8, 0x31, 0xdb, 0xd6, 0x67, 0x00, 0xb6, 0x6c, 0xd7, 0x0e, 0xb6, 0x4f,
-	0x98, 0xc7, 0x8b, 0xbb, 0x38, 0xdc, 0x50, 0x14, 0x50, 0xa3, 0x16, 0xdf, 0x23, 0x17, 0x0f, 0x49,
-	0x89, 0xf9, 0xb6, 0xa1, 0x6d, 0x4e, 0x13, 0x79, 0xf8, 0xcd, 0x68, 0x1d, 0xb3, 0x10, 0x6d, 0x56,
-	0xe2, 0x8a, 0xef, 0xb0, 0x3d, 0x6c, 0x03, 0x4a, 0x3e, 0x0d, 0x7a, 0x1d, 0x7a, 0xa2, 0x8c, 0x5b,
-	0xdc, 0xe9, 0x0a, 0x65, 0x7d, 0x54, 0x94, 0xe6, 0x5f, 0x80, 0x33, 0x09, 0x11, 0x86, 0xba, 0xdc,
-	0xf2, 0x20, 0xd3, 0x44, 0x72, 0x92, 0xab, 0x2e, 0xd6, 0x17, 0x8e, 0x96, 0x69, 0x4b, 0xf5, 0x85,
-	0x70, 0xad, 0x13, 0x30, 0xf3, 0x4f, 0x26, 0x41, 0xba, 0x82, 0x1c, 0x63, 0xb9, 0xd2, 0x2f, 0x80,
-	0xc7, 0x4e, 0x70, 0x01, 0x7c, 0x0b, 0xa6, 0x6c, 0xd7, 0x0e, 0x6d, 0xcb, 0xe1, 0xe6, 0x2f, 0xb9,
-	0xf9, 0x46, 0x61, 0x18, 0x53, 0x2b, 0x1a, 0x2c, 0x83, 0x4e, 0xa2, 0x2e, 0x79, 0x05, 0x8a, 0x7c,
-	0x77, 0x92, 0x03, 0x78, 0x78, 0x7f, 0x15, 0xee, 0xaa, 0x24, 0x62, 0x33, 0x05, 0x25, 0x7e, 0xf6,
-	0x11, 0xa9, 0xc6, 0xd4, 0xe9, 0x5f, 0x8e, 0xe3, 0xf8, 0xec, 0x93, 0x82, 0x63, 0x5f, 0x0d, 0x46,
-	0x65, 0xcb, 0xb2, 0x9d, 0x9e, 0x4f, 0x63, 0x2a, 0x13, 0x49, 0x2a, 0x37, 0x52, 0x70, 0xec, 0xab,
-	0x41, 0xb6, 0x60, 0x4a, 0x96, 0x09, 0x87, 0xc9, 0xc9, 0x13, 0x7e, 0x25, 0xbf, 0x28, 0xba, 0xa1,
-	0x51, 0xc2, 0x04, 0x5d, 0xd2, 0x83, 0xb3, 0xb6, 0xdb, 0xf4, 0xdc, 0xa6, 0xd3, 0x0b, 0xec, 0x5d,
-	0x1a, 0x07, 0x46, 0x9e, 0x84, 0xd9, 0x85, 0x83, 0xfd, 0xea, 0xd9, 0x95, 0x34, 0x39, 0xec, 0xe7,
-	0x40, 0xbe, 0x64, 0xc0, 0x85, 0xa6, 0xe7, 0x06, 0x3c, 0x5d, 0xcd, 0x2e, 0xbd, 0xee, 0xfb, 0x9e,
-	0x2f, 0x78, 0x97, 0x4f, 0xc8, 0x9b, 0x9f, 0x29, 0x97, 0xb2, 0x48, 0x62, 0x36, 0x27, 0xf2, 0x06,
-	0x94, 0xba, 0xbe, 0xb7, 0x6b, 0xb7, 0xa8, 0x2f, 0x9d, 0x6f, 0x57, 0xf3, 0xc8, 0xe1, 0xb5, 0x2e,
-	0x69, 0x6a, 0x21, 0xf5, 0xb2, 0x04, 0x15, 0x3f, 0xf2, 0x35, 0x03, 0x2e, 0x6a, 0x52, 0xc9, 0x61,
-	0x25, 0x5a, 0xa0, 0x72, 0xc2, 0x16, 0xe0, 0x96, 0xf8, 0xa5, 0x6c, 0xa2, 0x38, 0x88, 0x9b, 0xf9,
-	0x27, 0x15, 0x98, 0x4e, 0x0a, 0x4e, 0x7e, 0x0e, 0xa0, 0xeb, 0x7b, 0x1d, 0x1a, 0x6e, 0x53, 0x15,
-	0x6a, 0x77, 0x7b, 0xd4, 0x7c, 0x51, 0x11, 0xbd, 0xc8, 0x0f, 0x8d, 0x2d, 0x5c, 0x71, 0x29, 0x6a,
-	0x1c, 0x89, 0x0f, 0x93, 0x3b, 0x42, 0x01, 0x90, 0xfa, 0xd0, 0xcb, 0xb9, 0xe8, 0x7a, 0x92, 0x33,
-	0x8f, 0x11, 0x93, 0x45, 0x18, 0x31, 0x22, 0x9b, 0x50, 0x78, 0x40, 0x37, 0xf3, 0x49, 0x56, 0x72,
-	0x8f, 0xca, 0x53, 0x58, 0x7d, 0xf2, 0x60, 0xbf, 0x5a, 0xb8, 0x47, 0x37, 0x91, 0x11, 0x67, 0xdf,
-	0xd5, 0x12, 0xce, 0x28, 0x72, 0xd1, 0x7a, 0x39, 0x47, 0xcf, 0x16, 0xf1, 0x5d, 0xb2, 0x08, 0x23,
-	0x46, 0xe4, 0x0d, 0x28, 0x3f, 0xb0, 0x76, 0xe9, 0x96, 0xef, 0xb9, 0xa1, 0x74, 0x7e, 0x1c, 0x31,
-	0xc0, 0xe9, 0x5e, 0x44, 0x4e, 0xf2, 0xe5, 0x8a, 0x86, 0x2a, 0xc4, 0x98, 0x1d, 0xd9, 0x85, 0x92,
-	0x4b, 0x1f, 0x20, 0x75, 0xec, 0x66, 0x3e, 0x01, 0x45, 0xb7, 0x25, 0x35, 0xc9, 0x99, 0xef, 0xc0,
-	0x51, 0x19, 0x2a, 0x5e, 0xac, 0x2f, 0xef, 0x7b, 0x9b, 0xf9, 0xf8, 0xc8, 0xa8, 0x13, 0xb5, 0xe8,
-	0xcb, 0x5b, 0xde, 0x26, 0x32, 0xe2, 0x6c, 0x8e, 0x34, 0x95, 0xe7, 0x9d, 0x5c, 0x30, 0x6f, 0xe7,
-	0xeb, 0x71, 0x28, 0xe6, 0x48, 0x5c, 0x8a, 0x1a, 0x47, 0xd6, 0xb6, 0x6d, 0x69, 0xb5, 0x95, 0x4b,
-	0xe6, 0x88, 0x6d, 0x9b, 0xb4, 0x01, 0x8b, 0xb6, 0x8d, 0xca, 0x50, 0xf1, 0x62, 0x7c, 0x6d, 0x69,
-	0x02, 0xcd, 0x67, 0xd1, 0x4c, 0x1a, 0x54, 0x05, 0xdf, 0xa8, 0x0c, 0x15, 0x2f, 0xd6, 0xde, 0xc1,
-	0xce, 0xde, 0x03, 0xcb, 0xd9, 0xb1, 0xdd, 0xb6, 0x5c, 0x22, 0x47, 0x0d, 0xb5, 0xdc, 0xd9, 0xbb,
-	0x27, 0xe8, 0xe9, 0xed, 0x1d, 0x97, 0xa2, 0xc6, 0x91, 0xfc, 0xb2, 0xa1, 0xc2, 0xc1, 0xa6, 0xf2,
-	0xf0, 0x4a, 0x4b, 0x2e, 0xb9, 0x32, 0x3a, 0x4c, 0xa8, 0xac, 0x3f, 0xa9, 0x1c, 0x69, 0x79, 0xe1,
-	0x5f, 0xf9, 0x83, 0xea, 0x1c, 0x75, 0x9b, 0x5e, 0xcb, 0x76, 0xdb, 0x8b, 0xf7, 0x03, 0xcf, 0x5d,
-	0x40, 0xeb, 0x41, 0x74, 0x5a, 0x90, 0x32, 0xcd, 0x7f, 0x02, 0x2a, 0x1a, 0x89, 0xa3, 0x54, 0xce,
-	0x29, 0x5d, 0xe5, 0xfc, 0xd1, 0x04, 0x4c, 0xe9, 0x69, 0x7f, 0x8f, 0xa1, 0x07, 0xaa, 0xb3, 0xcf,
-	0xd8, 0x30, 0x67, 0x1f, 0x76, 0xd8, 0xd5, 0x6e, 0xfa, 0x22, 0xb3, 0xdc, 0x4a, 0x6e, 0xaa, 0x7f,
-	0x7c, 0xd8, 0xd5, 0x0a, 0x03, 0x4c, 0x30, 0x1d, 0xc2, 0xf1, 0x87, 0x29, 0xd0, 0x42, 0xc5, 0x2c,
-	0x26, 0x15, 0xe8, 0x84, 0xd2, 0x78, 0x0d, 0x20, 0xce, 0x4f, 0x2b, 0x6f, 0x80, 0x95, 0x66, 0xae,
-	0xe5, 0xcd, 0xd5, 0xb0, 0xc8, 0x07, 0x61, 0x82, 0x29, 0x61, 0xb4, 0x25, 0x33, 0x5b, 0x28, 0xfb,
-	0xc3, 0x0d, 0x5e, 0x8a, 0x12, 0x4a, 0x9e, 0x67, 0xfa, 0x72, 0xac, 0x3a, 0xc9, 0x84, 0x15, 0xe7,
-	0x63, 0x7d, 0x39, 0x86, 0x61, 0x02, 0x93, 0x89, 0x4e, 0x99, 0xa6, 0xc3, 0xd7, 0x06, 0x4d, 0x74,
-	0xae, 0xfe, 0xa0, 0x80, 0x71, 0x7b, 0x58, 0x4a, 0x33, 0xe2, 0x73, 0xba, 0xa8, 0xd9, 0xc3, 0x52,
-	0x70, 0xec, 0xab, 0xc1, 0x3e, 0x46, 0x5e, 0x5e, 0x57, 0x84, 0x07, 0xfc, 0x80, 0x6b, 0xe7, 0xaf,
-	0xea, 0xa7, 0xbe, 0x1c, 0xe7, 0x90, 0x18, 0xb5, 0x43, 0x1c, 0xfb, 0x6e, 0x01, 0xe9, 0x57, 0x86,
-	0x64, 0xbc, 0x90, 0x32, 0x8b, 0xf5, 0xeb, 0x51, 0x98, 0x51, 0x6b, 0xb4, 0xc3, 0xde, 0xd7, 0x0c,
-	0x98, 0x4e, 0x6e, 0x69, 0x79, 0xdf, 0x27, 0x91, 0x3f, 0x03, 0x93, 0xa1, 0xf4, 0xd9, 0x2c, 0x70,
-	0xa3, 0x08, 0xd7, 0x12, 0xa4, 0x1b, 0x26, 0x46, 0x30, 0xf3, 0xef, 0x4d, 0xc0, 0xb9, 0xdb, 0x6d,
-	0xdb, 0x4d, 0xa7, 0x76, 0xcc, 0x7a, 0xc3, 0xc5, 0x18, 0xfa, 0x0d, 0x17, 0x15, 0x8b, 0x2a, 0x5f,
-	0x48, 0xc9, 0x8e, 0x45, 0x8d, 0x9e, 0xab, 0x49, 0xe2, 0x92, 0xdf, 0x37, 0xe0, 0xa9, 0xf8, 0x4e,
-	0x48, 0x96, 0x6a, 0x4f, 0x0f, 0xc8, 0x55, 0x24, 0x18, 0x51, 0xb3, 0xe8, 0xff, 0xf8, 0x85, 0xda,
-	0x21, 0x5c, 0xc5, 0x28, 0xfb, 0x09, 0xf9, 0x05, 0x4f, 0x1d, 0x86, 0x8a, 0x87, 0x8a, 0x4f, 0xfe,
-	0x3c, 0xcc, 0x24, 0x3e, 0x58, 0x5d, 0x92, 0xf1, 0xcb, 0x9d, 0x46, 0x12, 0x84, 0x69, 0x5c, 0xf2,
-	0x5d, 0x03, 0xe6, 0x84, 0x89, 0x3a, 0xa3, 0x69, 0xc4, 0x35, 0xb9, 0x97, 0x7f, 0xd3, 0x2c, 0x0d,
-	0xe0, 0x28, 0x9a, 0x25, 0xb6, 0x59, 0x0f, 0x40, 0xc3, 0x81, 0x22, 0xcf, 0xdf, 0x81, 0x0f, 0x1c,
-	0xd9, 0xee, 0x43, 0x3d, 0x54, 0xf1, 0x32, 0x5c, 0x3a, 0x54, 0xda, 0xa1, 0x66, 0xec, 0x77, 0x0c,
-	0x98, 0xd2, 0x53, 0xd4, 0x91, 0x67, 0xa0, 0x14, 0x7a, 0x3b, 0xd4, 0xbd, 0xeb, 0x3b, 0xe9, 0xb4,
-	0x6b, 0x1b, 0xbc, 0x1c, 0x57, 0x51, 0x61, 0x30, 0xec, 0xa6, 0x63, 0x53, 0x37, 0x5c, 0xe9, 0x4b,
-	0xbb, 0xb6, 0x24, 0xca, 0x97, 0x51, 0x61, 0xb0, 0xd5, 0x5f, 0xfc, 0x16, 0x4e, 0xd9, 0xd2, 0x5a,
-	0x12, 0x1b, 0x74, 0x35, 0x18, 0x26, 0x30, 0x89, 0xa9, 0x6c, 0xe5, 0xe3, 0xf1, 0x05, 0x59, 0xd2,
-	0xb6, 0x6d, 0x7e, 0xdb, 0x80, 0xb2, 0xb8, 0xeb, 0x41, 0xba, 0x95, 0x72, 0x62, 0x4f, 0xd9, 0x97,
-	0x6a, 0xeb, 0x2b, 0x59, 0x4e, 0xec, 0x57, 0x60, 0x7c, 0xc7, 0x76, 0xa3, 0x2f, 0x51, 0x7a, 0xc2,
-	0xcb, 0xb6, 0xdb, 0x42, 0x0e, 0x51, 0x9a, 0x44, 0x61, 0xa0, 0x26, 0xb1, 0x08, 0x65, 0xe5, 0x12,
-	0x25, 0xf7, 0xe3, 0xd8, 0x17, 0x3d, 0x02, 0x60, 0x8c, 0x63, 0xfe, 0x8a, 0x01, 0xd3, 0x3c, 0x8d,
-	0x44, 0x6c, 0x2a, 0x79, 0x4e, 0x79, 0x29, 0x0a, 0xb9, 0x2f, 0x25, 0xbd, 0x14, 0x1f, 0xed, 0x57,
-	0x2b, 0x22, 0xf1, 0x44, 0xd2, 0x69, 0xf1, 0xb3, 0xd2, 0xbe, 0xca, 0x7d, 0x29, 0xc7, 0x86, 0x36,
-	0xff, 0xc5, 0x62, 0x46, 0x44, 0x30, 0xa6, 0x67, 0xbe, 0x09, 0x53, 0x7a, 0x84, 0x26, 0x79, 0x0e,
-	0x2a, 0x5d, 0xdb, 0x6d, 0x27, 0x23, 0xf9, 0xd5, 0x8d, 0xd5, 0x7a, 0x0c, 0x42, 0x1d, 0x8f, 0x57,
-	0xf3, 0xe2, 0x6a, 0xa9, 0x8b, 0xae, 0x75, 0x4f, 0xaf, 0x16, 0xff, 0x31, 0x5d, 0x80, 0x38, 0xdd,
-	0xc0, 0xb1, 0xec, 0x7a, 0x13, 0xe2, 0x12, 0x49, 0x68, 0x87, 0x3c, 0x75, 0xcc, 0x84, 0x18, 0xe1,
-	0x8f, 0xf6, 0x0f, 0xd3, 0x3e, 0x45, 0x2d, 0xfe, 0x06, 0x4f, 0x46, 0xe4, 0x71, 0xee, 0x6f, 0xf0,
-	0x64, 0xf0, 0x78, 0xef, 0xde, 0xe0, 0xc9, 0x12, 0xe6, 0xff, 0xae, 0x37, 0x78, 0x3e, 0x0d, 0xc3,
-	0xa6, 0xe4, 0x66, 0xca, 0xde, 0x03, 0x3d, 0x97, 0x8c, 0x6a, 0x71, 0x99, 0x4c, 0x46, 0x42, 0xcd,
-	0xdf, 0x19, 0x87, 0xd9, 0xb4, 0xcd, 0x27, 0x6f, 0xbf, 0x22, 0xf2, 0x0d, 0x03, 0xa6, 0xad, 0x44,
-	0xfa, 0xd3, 0x9c, 0x1e, 0xf4, 0x4b, 0xd0, 0xd4, 0xf2, 0x51, 0x26, 0xca, 0x31, 0xc5, 0x5b, 0xd7,
-	0xb5, 0xc6, 0x07, 0xeb, 0x5a, 0x6c, 0x13, 0xb0, 0xb9, 0x1e, 0xe9, 0x53, 0xe9, 0x23, 0x3f, 0x1b,
-	0x1b, 0xd1, 0x45, 0x39, 0x2a, 0x0c, 0xf2, 0x10, 0x26, 0x85, 0x07, 0x52, 0xe4, 0x6a, 0xb6, 0x96,
-	0x93, 0x6d, 0x4a, 0x38, 0x39, 0xc5, 0x5d, 0x20, 0xfe, 0x07, 0x18, 0xb1, 0x63, 0xfa, 0x3a, 0xf8,
-	0x96, 0xdb, 0xa6, 0xbc, 0xcd, 0xa5, 0x35, 0xe5, 0xd5, 0xbc, 0xcc, 0x80, 0xa8, 0x28, 0xd7, 0xfc,
-	0x76, 0x20, 0xc3, 0x66, 0x55, 0x19, 0x6a, 0x9c, 0xcd, 0x5f, 0x30, 0x60, 0x6e, 0x50, 0x45, 0x36,
-	0x50, 0xf8, 0xaa, 0x2b, 0x47, 0x94, 0x96, 0x60, 0xc4, 0xf2, 0x43, 0x14, 0x30, 0x72, 0x09, 0x0a,
-	0x54, 0x6d, 0x54, 0x2a, 0xf9, 0xea, 0x75, 0xb7, 0x85, 0xac, 0x9c, 0x5c, 0x83, 0xf1, 0x20, 0xa4,
-	0xdd, 0x54, 0x00, 0xc9, 0x38, 0x5b, 0x3c, 0x33, 0xae, 0x21, 0x38, 0xae, 0xf9, 0x3a, 0x0c, 0x8c,
-	0x47, 0x27, 0x1f, 0x4d, 0x44, 0x29, 0x3c, 0x95, 0x8a, 0x52, 0x98, 0x52, 0x15, 0xe2, 0xd0, 0x84,
-	0x44, 0xf8, 0x65, 0x71, 0x40, 0xf8, 0xe5, 0x47, 0x61, 0xc8, 0xa4, 0xf1, 0xe6, 0x75, 0x20, 0xe8,
-	0x39, 0xce, 0xa6, 0xd5, 0xdc, 0xb9, 0x67, 0xbb, 0x2d, 0xef, 0x01, 0xdf, 0x8b, 0x16, 0xa1, 0xec,
-	0xcb, 0x44, 0x0a, 0x81, 0x9c, 0xc6, 0x6a, 0x33, 0x8b, 0x32, 0x2c, 0x04, 0x18, 0xe3, 0x98, 0xdf,
-	0x1d, 0x83, 0x49, 0x99, 0xf5, 0xe3, 0x31, 0x04, 0x4c, 0xed, 0x24, 0x3c, 0x4b, 0x56, 0x72, 0x49,
-	0x56, 0x32, 0x30, 0x5a, 0x2a, 0x48, 0x45, 0x4b, 0xbd, 0x9c, 0x0f, 0xbb, 0xc3, 0x43, 0xa5, 0x7e,
-	0xb3, 0x08, 0x33, 0xa9, 0x2c, 0x2a, 0xa9, 0xf7, 0x25, 0x8c, 0xf7, 0xe4, 0x7d, 0x09, 0x12, 0x24,
-	0xde, 0x18, 0xc9, 0xcf, 0xc5, 0xfa, 0x4f, 0x9f, 0x1b, 0x19, 0xd6, 0xf9, 0xfd, 0x97, 0x07, 0x38,
-	0xbf, 0x17, 0x4f, 0xcb, 0xf9, 0xfd, 0xe2, 0x50, 0x8e, 0xef, 0xff, 0xd9, 0x80, 0x27, 0x06, 0xe6,
-	0x01, 0xe2, 0x19, 0x35, 0xfd, 0x24, 0x54, 0xae, 0x15, 0x39, 0xe7, 0x56, 0x53, 0x3e, 0x25, 0xe9,
-	0x24, 0x88, 0x69, 0xf6, 0xe4, 0x59, 0x98, 0xe2, 0x5b, 0x01, 0x5b, 0x35, 0xd9, 0x52, 0x2f, 0xd6,
-	0x59, 0x7e, 0x39, 0xda, 0xd0, 0xca, 0x31, 0x81, 0x65, 0xbe, 0x6b, 0xc0, 0xdc, 0xa0, 0xfc, 0x8a,
-	0xc7, 0x50, 0xab, 0xff, 0x5c, 0x2a, 0xe0, 0xac, 0xda, 0x17, 0x70, 0x96, 0x32, 0x94, 0x46, 0xb1,
-	0x65, 0x9a, 0x8d, 0xb2, 0x70, 0x44, 0x3c, 0xd5, 0xef, 0x16, 0x60, 0x56, 0x8a, 0x18, 0x9f, 0x88,
-	0x9e, 0x4f, 0x6c, 0x40, 0x3f, 0x91, 0xda, 0x80, 0xce, 0xa7, 0xf1, 0xff, 0x34, 0x46, 0xee, 0xc7,
-	0x2b, 0x46, 0xee, 0xdd, 0x71, 0xb8, 0x20, 0xfb, 0x28, 0xd6, 0x3d, 0x78, 0x83, 0x3a, 0x30, 0xeb,
-	0xab, 0x2d, 0x46, 0xba, 0x06, 0x19, 0x43, 0x7f, 0x22, 0x7f, 0x26, 0x04, 0x53, 0x74, 0xb0, 0x8f,
-	0x32, 0x79, 0x08, 0xe7, 0x3b, 0x96, 0xdb, 0xb3, 0x1c, 0x7e, 0x7c, 0x8e, 0x39, 0x0e, 0x7f, 0x58,
-	0x16, 0xa9, 0x86, 0x32, 0x68, 0x61, 0x26, 0x07, 0xd2, 0x81, 0x6a, 0xe8, 0x85, 0x96, 0xa3, 0x55,
-	0x51, 0x2d, 0xa1, 0x45, 0x9f, 0x15, 0xea, 0x4f, 0x1f, 0xec, 0x57, 0xab, 0x1b, 0x87, 0xa3, 0xe2,
-	0x51, 0xb4, 0x4e, 0xd5, 0x23, 0x6a, 0x03, 0x66, 0x9b, 0x2a, 0xb0, 0x55, 0x4b, 0xbb, 0x5e, 0xae,
-	0x5f, 0x15, 0x06, 0xf6, 0x24, 0xec, 0x51, 0x46, 0x19, 0xf6, 0x51, 0x30, 0xff, 0x63, 0x51, 0x0d,
-	0x91, 0x64, 0xb2, 0x4b, 0xf2, 0xf5, 0x0c, 0x45, 0xe2, 0x5e, 0xce, 0x59, 0x35, 0x55, 0xe6, 0x88,
-	0xd3, 0x8d, 0x3d, 0xfc, 0x25, 0x3d, 0xe6, 0x4f, 0x28, 0x07, 0x5b, 0xa7, 0x90, 0x1f, 0x74, 0xd8,
-	0xf0, 0xbf, 0xc7, 0xfb, 0x34, 0xeb, 0xbb, 0x8f, 0x5b, 0x13, 0x18, 0x3a, 0x0c, 0x2e, 0xf7, 0x78,
-	0x48, 0xf3, 0xab, 0x05, 0xb8, 0x7a, 0xdc, 0xae, 0xfa, 0x31, 0x0c, 0xbe, 0x0f, 0x12, 0xc1, 0xf7,
-	0x8f, 0x49, 0x8d, 0x3e, 0x95, 0x38, 0xfc, 0xbf, 0x33, 0xae, 0xf4, 0xbc, 0xfe, 0xd9, 0x7f, 0x2c,
-	0xc3, 0xe2, 0x24, 0x3b, 0x66, 0x45, 0x2f, 0xe2, 0xc4, 0xba, 0xc8, 0x64, 0x43, 0x14, 0x3f, 0xda,
-	0xaf, 0x9e, 0x8d, 0x53, 0xcc, 0xc9, 0x42, 0x8c, 0x2a, 0x91, 0xab, 0x50, 0x92, 0xb9, 0xdd, 0xa2,
-	0x70, 0x63, 0xe9, 0x75, 0x29, 0xca, 0x50, 0x41, 0xc9, 0x17, 0xb5, 0x73, 0xe9, 0xf8, 0x69, 0x65,
-	0x52, 0x3c, 0xec, 0x56, 0xf1, 0x35, 0x28, 0x05, 0xd1, 0x3b, 0x26, 0x62, 0x6e, 0x7e, 0xfc, 0x98,
-	0x51, 0xec, 0xd6, 0x26, 0x75, 0xa2, 0x47, 0x4d, 0xc4, 0xf7, 0xa9, 0x27, 0x4f, 0x14, 0x49, 0x62,
-	0x2a, 0xc3, 0x9b, 0x98, 0x54, 0xd0, 0x6f, 0x74, 0x23, 0x21, 0x4c, 0x06, 0xd2, 0x52, 0x3c, 0x99,
-	0x87, 0xba, 0xad, 0xc2, 0x3e, 0x65, 0x6c, 0x0f, 0xb7, 0x67, 0x45, 0x06, 0xe7, 0x88, 0x95, 0xf9,
-	0x7d, 0x03, 0x2a, 0x72, 0x8c, 0x3c, 0x86, 0x70, 0xfe, 0xfb, 0xc9, 0x70, 0xfe, 0xeb, 0xb9, 0xec,
-	0x07, 0x03, 0x62, 0xf9, 0xef, 0xc3, 0x94, 0x9e, 0xc3, 0x9a, 0x7c, 0x46, 0xdb, 0xcf, 0x8c, 0x51,
-	0xf2, 0xb4, 0x46, 0x3b, 0x5e, 0xbc, 0xd7, 0x99, 0xff, 0xb8, 0xac, 0x5a, 0x91, 0x1b, 0x69, 0xf4,
-	0x91, 0x6f, 0x1c, 0x3a, 0xf2, 0xf5, 0x81, 0x37, 0x96, 0xff, 0xc0, 0x7b, 0x05, 0x4a, 0xd1, 0x92,
-	0x28, 0xb5, 0xf7, 0xa7, 0xf5, 0x60, 0x1f, 0x76, 0x04, 0x60, 0xc4, 0xb4, 0xe9, 0xc2, 0x8d, 0x2d,
-	0xf1, 0x35, 0x58, 0xb4, 0x54, 0x2b, 0x32, 0xe4, 0x0d, 0xa8, 0x3c, 0xf0, 0xfc, 0x1d, 0xc7, 0xb3,
-	0xf8, 0x5b, 0x59, 0x90, 0x87, 0x9f, 0x96, 0xba, 0xca, 0x12, 0x21, 0x9c, 0xf7, 0x62, 0xfa, 0xa8,
-	0x33, 0x23, 0x35, 0x98, 0xe9, 0xd8, 0x2e, 0x52, 0xab, 0xa5, 0x76, 0xa9, 0x71, 0xf1, 0x70, 0x4b,
-	0x74, 0x96, 0x5c, 0x4b, 0x82, 0x31, 0x8d, 0xcf, 0xcd, 0xce, 0x7e, 0xc2, 0xac, 0x26, 0x5f, 0x67,
-	0x58, 0x1f, 0x7d, 0x30, 0x26, 0x4d, 0x75, 0x22, 0xe4, 0x30, 0x59, 0x8e, 0x29, 0xde, 0xe4, 0x0b,
-	0x50, 0x0a, 0xa2, 0x17, 0xd1, 0x8b, 0x39, 0x9e, 0xb2, 0xd5, 0xab, 0xe8, 0xaa, 0x2b, 0xd5, 0xb3,
-	0xe8, 0x8a, 0x21, 0x59, 0x85, 0xf3, 0x91, 0x9d, 0x30, 0xf1, 0xb8, 0xf3, 0x44, 0x9c, 0x61, 0x14,
-	0x33, 0xe0, 0x98, 0x59, 0x8b, 0x9d, 0xa5, 0x78, 0x6e, 0x78, 0xe1, 0x17, 0xa3, 0xb9, 0x92, 0xf0,
-	0xf9, 0xd7, 0x42, 0x09, 0x3d, 0x2c, 0x29, 0x45, 0x69, 0x84, 0xa4, 0x14, 0x0d, 0xb8, 0x90, 0x06,
-	0xf1, 0xd4, 0xb1, 0x3c, 0x5b, 0xad, 0xb6, 0x85, 0xae, 0x67, 0x21, 0x61, 0x76, 0x5d, 0x72, 0x0f,
-	0xca, 0x3e, 0xe5, 0x56, 0x85, 0x5a, 0xe4, 0xdc, 0x3c, 0x74, 0x18, 0x07, 0x46, 0x04, 0x30, 0xa6,
-	0xc5, 0xfa, 0xdd, 0x4a, 0x3e, 0xa5, 0x92, 0x9f, 0xa6, 0xa1, 0xfa, 0x7e, 0x40, 0x4a, 0x67, 0xf3,
-	0x5f, 0xcd, 0xc2, 0x99, 0x84, 0xb1, 0x93, 0x3c, 0x0d, 0x45, 0x9e, 0x4b, 0x97, 0xaf, 0x56, 0xa5,
-	0x78, 0x45, 0x15, 0x8d, 0x23, 0x60, 0xe4, 0xe7, 0x0d, 0x98, 0xe9, 0x26, 0x6e, 0x6f, 0xa3, 0x85,
-	0x7c, 0xc4, 0x2b, 0x9b, 0xe4, 0x95, 0xb0, 0xf6, 0x08, 0x59, 0x92, 0x19, 0xa6, 0xb9, 0xb3, 0xf5,
-	0x40, 0xc6, 0x42, 0x39, 0xd4, 0xe7, 0xd8, 0x52, 0xc9, 0x53, 0x24, 0x96, 0x92, 0x60, 0x4c, 0xe3,
-	0xb3, 0x1e, 0xe6, 0x5f, 0x37, 0xca, 0xb3, 0xf8, 0xb5, 0x88, 0x00, 0xc6, 0xb4, 0xc8, 0x8b, 0x30,
-	0x2d, 0x5f, 0xd0, 0x58, 0xf7, 0x5a, 0x37, 0xad, 0x60, 0x5b, 0x1e, 0x1c, 0x95, 0x49, 0x64, 0x29,
-	0x01, 0xc5, 0x14, 0x36, 0xff, 0xb6, 0xf8, 0x99, 0x12, 0x4e, 0x60, 0x22, 0xf9, 0x46, 0xdb, 0x52,
-	0x12, 0x8c, 0x69, 0x7c, 0xf2, 0x8c, 0xb6, 0x0d, 0x09, 0x5f, 0x35, 0xb5, 0x1a, 0x64, 0x6c, 0x45,
-	0x35, 0x98, 0xe9, 0x71, 0x8b, 0x4c, 0x2b, 0x02, 0xca, 0xf9, 0xa8, 0x18, 0xde, 0x4d, 0x82, 0x31,
-	0x8d, 0x4f, 0x5e, 0x80, 0x33, 0x3e, 0x5b, 0x6c, 0x15, 0x01, 0xe1, 0xc0, 0xa6, 0x7c, 0x85, 0x50,
-	0x07, 0x62, 0x12, 0x97, 0xbc, 0x04, 0x67, 0xe3, 0x2c, 0xeb, 0x11, 0x01, 0xe1, 0xd1, 0xa6, 0xf2,
-	0xe7, 0xd6, 0xd2, 0x08, 0xd8, 0x5f, 0x87, 0xfc, 0x45, 0x98, 0xd5, 0x5a, 0x62, 0xc5, 0x6d, 0xd1,
-	0x87, 0x32, 0x13, 0x36, 0xb7, 0x9d, 0x2c, 0xa5, 0x60, 0xd8, 0x87, 0x4d, 0x3e, 0x09, 0xd3, 0x4d,
-	0xcf, 0x71, 0xf8, 0x1a, 0x27, 0xde, 0x07, 0x13, 0x29, 0xaf, 0x45, 0x72, 0xf0, 0x04, 0x04, 0x53,
-	0x98, 0xe4, 0x16, 0x10, 0x6f, 0x93, 0xa9, 0x57, 0xb4, 0xf5, 0x12, 0x75, 0xa9, 0xd4, 0x38, 0xce,
-	0x24, 0xe3, 0x36, 0xef, 0xf4, 0x61, 0x60, 0x46, 0x2d, 0x9e, 0x7e, 0x57, 0x4b, 0x9c, 0x31, 0x9d,
-	0xc7, 0x1b, 0x25, 0x69, 0xfb, 0xe1, 0x91, 0x59, 0x33, 0x7c, 0x98, 0x10, 0x0e, 0x3f, 0xf9, 0xe4,
-	0xbe, 0xd6, 0x9f, 0x0a, 0x8a, 0xf7, 0x08, 0x51, 0x8a, 0x92, 0x13, 0x7f, 0x24, 0x32, 0x7a, 0x37,
-	0x8e, 0x27, 0xbc, 0x1e, 0xfd, 0x91, 0xc8, 0xe4, 0x13, 0x88, 0xda, 0x23, 0x91, 0x11, 0x00, 0x63,
-	0x96, 0xe4, 0x83, 0x50, 0xb9, 0xb9, 0x5e, 0x53, 0xa3, 0xf0, 0x2c, 0xef, 0xfd, 0x71, 0x56, 0x05,
-	0x75, 0x00, 0x9b, 0x61, 0x4a, 0x7d, 0x23, 0x49, 0x9f, 0xa0, 0x0c, 0x6d, 0x8c, 0x61, 0x73, 0x0f,
-	0x30, 0x6c, 0xcc, 0x9d, 0x4b, 0x61, 0xcb, 0x72, 0x54, 0x18, 0xe4, 0x35, 0xa8, 0xc8, 0xfd, 0x82,
-	0xaf, 0x4d, 0xe7, 0x4f, 0x96, 0x94, 0x05, 0x63, 0x12, 0xa8, 0xd3, 0xe3, 0xde, 0x29, 0xfc, 0x39,
-	0x2d, 0x7a, 0xa3, 0xe7, 0x38, 0x73, 0x17, 0xf8, 0xba, 0x19, 0x7b, 0xa7, 0xc4, 0x20, 0xd4, 0xf1,
-	0xc8, 0xc7, 0x23, 0xef, 0xe1, 0xf7, 0x27, 0xdc, 0x75, 0x94, 0xf7, 0xb0, 0x52, 0xba, 0x07, 0x04,
-	0x4e, 0x5e, 0x3c, 0xc2, 0x6d, 0x77, 0x13, 0xe6, 0x23, 0x8d, 0xaf, 0x7f, 0x92, 0xcc, 0xcd, 0x25,
-	0x0c, 0x51, 0xf3, 0xf7, 0x06, 0x62, 0xe2, 0x21, 0x54, 0xc8, 0x26, 0x14, 0x2c, 0x67, 0x73, 0xee,
-	0x89, 0x3c, 0x54, 0xd7, 0xda, 0x6a, 0x5d, 0x8e, 0x28, 0x1e, 0x62, 0x50, 0x5b, 0xad, 0x23, 0x23,
-	0x4e, 0x6c, 0x18, 0xb7, 0x9c, 0xcd, 0x60, 0x6e, 0x9e, 0xcf, 0xd9, 0xdc, 0x98, 0xc4, 0xc6, 0x83,
-	0xd5, 0x7a, 0x80, 0x9c, 0x05, 0xf9, 0x4b, 0xda, 0xc9, 0xe6, 0xc9, 0x1c, 0x9f, 0xde, 0x48, 0x9a,
-	0xaf, 0x07, 0x1e, 0x7e, 0xbe, 0x34, 0xa6, 0x2e, 0x44, 0xd5, 0xeb, 0x27, 0x6f, 0xea, 0xf3, 0x57,
-	0x9c, 0xb6, 0xee, 0xe4, 0x36, 0x7f, 0xa5, 0x76, 0x73, 0x66, 0xe0, 0xec, 0xed, 0xaa, 0x15, 0x2b,
-	0x97, 0xbc, 0x9d, 0xc9, 0x97, 0x5d, 0xc4, 0xe1, 0x3d, 0xb9, 0x5e, 0x99, 0x5f, 0xae, 0x28, 0x8b,
-	0x6e, 0xca, 0x09, 0xd7, 0x87, 0xa2, 0x1d, 0x84, 0xb6, 0x97, 0x63, 0x66, 0x93, 0xd4, 0x93, 0x28,
-	0x3c, 0x14, 0x92, 0x03, 0x50, 0xb0, 0x62, 0x3c, 0xdd, 0xb6, 0xed, 0x3e, 0x94, 0x9f, 0xff, 0x4a,
-	0xee, 0x2e, 0xa4, 0x82, 0x27, 0x07, 0xa0, 0x60, 0x45, 0xee, 0x8b, 0x39, 0x55, 0xc8, 0xa3, 0xaf,
-	0x6b, 0xab, 0xf5, 0x14, 0xbf, 0xe4, 0xdc, 0xba, 0x0f, 0x85, 0xa0, 0x63, 0x4b, 0x6d, 0x6d, 0x44,
-	0x5e, 0x8d, 0xb5, 0x95, 0x2c, 0x5e, 0x8d, 0xb5, 0x15, 0x64, 0x4c, 0xb8, 0x23, 0x8d, 0xd5, 0xd9,
-	0xb4, 0x82, 0xc0, 0x6a, 0x29, 0xe3, 0xd0, 0x88, 0x8e, 0x34, 0x35, 0x45, 0x2f, 0xc5, 0x9a, 0x5f,
-	0x45, 0xc4, 0x50, 0xd4, 0x38, 0x93, 0x37, 0x60, 0xd2, 0x12, 0xaf, 0x88, 0xcb, 0x70, 0xac, 0x7c,
-	0x9e, 0xc6, 0x4f, 0x49, 0xc0, 0xad, 0x44, 0x12, 0x84, 0x11, 0x43, 0xc6, 0x3b, 0xf4, 0x2d, 0xba,
-	0x65, 0xef, 0x48, 0xdb, 0x54, 0x63, 0xe4, 0x87, 0xdf, 0x18, 0xb1, 0x2c, 0xde, 0x12, 0x84, 0x11,
-	0x43, 0xf2, 0x35, 0x03, 0xce, 0x74, 0x2c, 0xd7, 0x52, 0xe1, 0xfe, 0xf9, 0xa4, 0x90, 0xd0, 0x13,
-	0x08, 0xc4, 0x0a, 0xea, 0x9a, 0xce, 0x08, 0x93, 0x7c, 0xc9, 0x2e, 0x4c, 0x30, 0x62, 0xf6, 0x43,
-	0x79, 0x12, 0x1c, 0x35, 0x8b, 0x39, 0xa7, 0x95, 0x6a, 0x03, 0xbe, 0xb8, 0x08, 0x08, 0x4a, 0x6e,
-	0xe4, 0x57, 0x0d, 0x98, 0x14, 0x91, 0x42, 0x4c, 0x1f, 0x66, 0xdf, 0xfe, 0xf9, 0x53, 0x78, 0x5a,
-	0x49, 0x46, 0x31, 0x49, 0xd7, 0xc7, 0x0f, 0xab, 0xc8, 0x05, 0x51, 0x7a, 0x68, 0x1c, 0x53, 0x24,
-	0x1d, 0xd3, 0xbc, 0x3b, 0xd6, 0xc3, 0xc4, 0xb3, 0x7e, 0xba, 0xe6, 0xbd, 0x96, 0x82, 0x61, 0x1f,
-	0xf6, 0xfc, 0x27, 0x61, 0x4a, 0x97, 0x63, 0xa8, 0x58, 0xa8, 0x1f, 0x16, 0x00, 0x78, 0x57, 0x89,
-	0x0c, 0x65, 0x1d, 0xfe, 0x2c, 0xc3, 0xb6, 0xd7, 0xca, 0xe9, 0x35, 0x75, 0x2d, 0xd1, 0x18, 0xc8,
-	0x37, 0x18, 0xb6, 0xbd, 0x16, 0x4a, 0x26, 0xa4, 0x0d, 0xe3, 0x5d, 0x2b, 0xdc, 0xce, 0x3f, 0xab,
-	0x59, 0x49, 0xe4, 0xca, 0x08, 0xb7, 0x91, 0x33, 0x20, 0x6f, 0x19, 0xb1, 0x57, 0x61, 0x21, 0x8f,
-	0xcc, 0xf2, 0x71, 0x9b, 0x2d, 0x48, 0x3f, 0xc2, 0x54, 0x82, 0xf5, 0xb4, 0x77, 0xe1, 0xfc, 0xdb,
-	0x06, 0x4c, 0xe9, 0xa8, 0x19, 0xdd, 0xf4, 0xb3, 0x7a, 0x37, 0xe5, 0xd9, 0x1e, 0x7a, 0x8f, 0xff,
-	0x57, 0x03, 0x00, 0x7b, 0x6e, 0xa3, 0xd7, 0xe9, 0xb0, 0x53, 0x83, 0x0a, 0xf9, 0x32, 0x8e, 0x1d,
-	0xf2, 0x35, 0x36, 0x64, 0xc8, 0x57, 0x61, 0xa8, 0x90, 0xaf, 0xf1, 0xe1, 0x43, 0xbe, 0x8a, 0x83,
-	0x43, 0xbe, 0xcc, 0x6f, 0x19, 0x70, 0xb6, 0x6f, 0xbf, 0x62, 0x8a, 0xbc, 0xef, 0x79, 0xe1, 0x00,
-	0xef, 0x74, 0x8c, 0x41, 0xa8, 0xe3, 0x91, 0x65, 0x98, 0x95, 0xef, 0xa6, 0x35, 0xba, 0x8e, 0x9d,
-	0x99, 0x71, 0x6e, 0x23, 0x05, 0xc7, 0xbe, 0x1a, 0xe6, 0x3f, 0x37, 0xa0, 0xa2, 0x25, 0x8a, 0xe1,
-	0x1e, 0x9d, 0xfc, 0xb2, 0x2d, 0xed, 0xd1, 0xc9, 0x6f, 0xda, 0x04, 0x4c, 0x78, 0x5d, 0xb4, 0xb5,
-	0x27, 0x6a, 0x62, 0xaf, 0x0b, 0x56, 0x8a, 0x12, 0x2a, 0x1e, 0x1f, 0x91, 0xae, 0x9d, 0x05, 0xfd,
-	0xf1, 0x11, 0xda, 0x15, 0x8e, 0x9c, 0xb1, 0x03, 0xe9, 0xf8, 0xd1, 0x0e, 0xa4, 0xc5, 0x6c, 0x07,
-	0x52, 0xf3, 0x0e, 0x4c, 0x89, 0xc8, 0x8b, 0x97, 0xe9, 0xde, 0xf1, 0xae, 0x24, 0x2f, 0x89, 0xd1,
-	0x9e, 0xf2, 0x48, 0x65, 0xd5, 0x59, 0xb9, 0x69, 0x41, 0x9c, 0x89, 0xff, 0x18, 0xd4, 0xae, 0x01,
-	0xa8, 0x37, 0x41, 0x84, 0x9b, 0x6b, 0x29, 0x1e, 0x90, 0xea, 0xe1, 0x90, 0x16, 0x6a, 0x58, 0xe6,
-	0x3f, 0x34, 0x20, 0xf5, 0x2e, 0xa4, 0x76, 0xc7, 0x64, 0x0c, 0xbc, 0x63, 0xd2, 0xef, 0x25, 0xc6,
-	0x0e, 0xbd, 0x97, 0xb8, 0x05, 0xa4, 0xc3, 0x66, 0x5b, 0x72, 0x2d, 0x2f, 0x24, 0x9f, 0xcf, 0x5a,
-	0xeb, 0xc3, 0xc0, 0x8c, 0x5a, 0xe6, 0x3f, 0x10, 0xc2, 0xea, 0x2f, 0x45, 0x1e, 0xdd, 0x2a, 0x3d,
-	0x28, 0x72, 0x52, 0xd2, 0xc2, 0x38, 0xa2, 0x75, 0xbe, 0x3f, 0x81, 0x65, 0x3c, 0x56, 0xe4, 0xaa,
-	0xc2, 0xb9, 0x99, 0xbf, 0x2b, 0x64, 0xd5, 0x9f, 0x92, 0x3c, 0x5a, 0xd6, 0x4e, 0x52, 0xd6, 0x9b,
-	0x79, 0x2d, 0xc7, 0xd9, 0x32, 0x92, 0x05, 0x80, 0x2e, 0xf5, 0x9b, 0xd4, 0x0d, 0xa3, 0x38, 0xd8,
-	0xa2, 0xcc, 0xc8, 0xa0, 0x4a, 0x51, 0xc3, 0x30, 0xbf, 0xc9, 0xe6, 0xa8, 0xdd, 0xde, 0x7d, 0x56,
-	0x86, 0x3d, 0x5d, 0x4d, 0x7b, 0xf2, 0xa7, 0xe7, 0x9f, 0x72, 0xe4, 0xd7, 0x02, 0x1a, 0xc7, 0x8e,
-	0x08, 0x68, 0xfc, 0x10, 0x4c, 0xfa, 0x9e, 0x43, 0x6b, 0xbe, 0x9b, 0xf6, 0x7a, 0x43, 0x56, 0x8c,
-	0xb7, 0x31, 0x82, 0x9b, 0x7f, 0xd7, 0x80, 0xd9, 0x74, 0xf8, 0x76, 0xee, 0xe1, 0x05, 0x7a, 0xb6,
-	0x9b, 0xc2, 0xf0, 0xd9, 0x6e, 0xcc, 0x3f, 0x2e, 0xc2, 0x6c, 0xfa, 0xd1, 0x5e, 0xc6, 0xd9, 0xe6,
-	0xe6, 0xc4, 0xd4, 0x06, 0x23, 0xec, 0x88, 0x02, 0xa6, 0xc6, 0xcb, 0xd8, 0xc0, 0xf1, 0x72, 0x03,
-	0xca, 0x5e, 0x37, 0x32, 0x69, 0x14, 0x12, 0xaf, 0x71, 0x94, 0xef, 0x44, 0x80, 0x47, 0xfb, 0xd5,
-	0x73, 0xb1, 0x00, 0xaa, 0x18, 0xe3, 0xaa, 0xe4, 0xa7, 0x22, 0x5b, 0xcc, 0x78, 0x22, 0xdb, 0x9c,
-	0xb2, 0xc5, 0xcc, 0xc4, 0xf5, 0x07, 0x99, 0x63, 0x8a, 0xc3, 0xe4, 0xb1, 0x9a, 0xc8, 0x31, 0x8f,
-	0xd5, 0x3d, 0x28, 0x4b, 0xeb, 0xf1, 0x89, 0xf2, 0x37, 0x71, 0xc2, 0x77, 0x23, 0x02, 0x18, 0xd3,
-	0x4a, 0xb9, 0x83, 0x95, 0x72, 0x75, 0x07, 0x7b, 0x01, 0x26, 0x37, 0xad, 0xe6, 0x8e, 0xb7, 0xb5,
-	0xc5, 0x8f, 0x00, 0xe5, 0xfa, 0x07, 0xa2, 0x86, 0xab, 0x8b, 0xe2, 0x8c, 0x21, 0x15, 0xd5, 0x60,
-	0xeb, 0x3c, 0x8d, 0x9c, 0xfb, 0x23, 0xc3, 0xb6, 0x5a, 0xe7, 0x95, 0xdb, 0x7f, 0x80, 0x1a, 0x16,
-	0x79, 0x06, 0x4a, 0x2d, 0x3b, 0xb0, 0x36, 0x99, 0xea, 0x51, 0x49, 0x86, 0x9b, 0x2c, 0xcb, 0x72,
-	0x54, 0x18, 0xe4, 0x45, 0xe5, 0xff, 0x39, 0x15, 0x47, 0x82, 0x29, 0xcf, 0xb4, 0x43, 0x22, 0xc1,
-	0xa4, 0x6b, 0xfb, 0x5b, 0x6c, 0x62, 0x86, 0x76, 0x73, 0xc7, 0x76, 0x45, 0x52, 0x24, 0xb6, 0x5a,
-	0x7c, 0x08, 0x26, 0xa9, 0x2b, 0x24, 0x10, 0x97, 0x43, 0x6a, 0xb0, 0x5c, 0x17, 0xc5, 0x18, 0xc1,
-	0x49, 0x0d, 0x66, 0x5a, 0x29, 0x47, 0x3f, 0x91, 0xcc, 0x4d, 0xdd, 0x20, 0xa4, 0x9d, 0xfb, 0xd2,
-	0xf8, 0xe6, 0x17, 0xa1, 0xa2, 0xe9, 0x7a, 0x5c, 0x2d, 0x7a, 0x68, 0x35, 0xfb, 0x02, 0x44, 0xae,
-	0xb3, 0x42, 0x14, 0x30, 0x7e, 0xf1, 0x28, 0xa2, 0x9b, 0x53, 0xea, 0x84, 0x8c, 0x69, 0x96, 0x50,
-	0x46, 0xcc, 0xa7, 0x6d, 0xfa, 0x30, 0x7a, 0x98, 0x2b, 0x22, 0x86, 0xac, 0x10, 0x05, 0xcc, 0x7c,
-	0x06, 0x4a, 0x51, 0x82, 0x4e, 0x9e, 0xb7, 0x2e, 0xba, 0x14, 0xd3, 0xf3, 0xd6, 0x79, 0x7e, 0x88,
-	0x1c, 0x62, 0xbe, 0x0a, 0xa5, 0x28, 0x8f, 0xe8, 0xd1, 0xd8, 0x6c, 0xfb, 0x0d, 0x5c, 0xfb, 0xa6,
-	0x17, 0x84, 0x51, 0xf2, 0x53, 0x71, 0x6f, 0x7f, 0x7b, 0x85, 0x97, 0xa1, 0x82, 0x9a, 0x3f, 0x32,
-	0xa0, 0xb2, 0xb1, 0xb1, 0xaa, 0xec, 0x69, 0x08, 0xef, 0x0f, 0x44, 0x0b, 0xd5, 0xb6, 0x42, 0xaa,
-	0x3b, 0x08, 0x89, 0x95, 0x68, 0xfe, 0x60, 0xbf, 0xfa, 0xfe, 0x46, 0x26, 0x06, 0x0e, 0xa8, 0x49,
-	0x56, 0xe0, 0x9c, 0x0e, 0x91, 0x69, 0xa6, 0xa4, 0x5e, 0xc0, 0x3d, 0xca, 0x1b, 0xfd, 0x60, 0xcc,
-	0xaa, 0x93, 0x26, 0x15, 0x45, 0xe5, 0x17, 0xb2, 0x49, 0x45, 0x21, 0xf9, 0x59, 0x75, 0xcc, 0x8f,
-	0xc3, 0x4c, 0xca, 0x73, 0xe5, 0x18, 0xe9, 0xfd, 0x7e, 0xbb, 0x00, 0x53, 0xba, 0x03, 0xc3, 0x31,
-	0xf6, 0xec, 0xe3, 0xab, 0x42, 0x19, 0x4e, 0x07, 0x85, 0x21, 0x9d, 0x0e, 0x74, 0x2f, 0x8f, 0xf1,
-	0xd3, 0xf5, 0xf2, 0x28, 0xe6, 0xe3, 0xe5, 0xa1, 0x79, 0x23, 0x4d, 0x3c, 0x3e, 0x6f, 0xa4, 0xdf,
-	0x28, 0xc2, 0x74, 0x32, 0x5d, 0xfd, 0x31, 0x7a, 0xf2, 0x99, 0xbe, 0x9e, 0x1c, 0xf2, 0x96, 0xb3,
-	0x30, 0xea, 0x2d, 0xe7, 0xf8, 0xa8, 0xb7, 0x9c, 0xc5, 0x13, 0xdc, 0x72, 0xf6, 0xdf, 0x51, 0x4e,
-	0x1c, 0xfb, 0x8e, 0xf2, 0x53, 0x6a, 0xa3, 0x98, 0x4c, 0x38, 0xf6, 0xc5, 0x9b, 0x05, 0x49, 0x76,
-	0xc3, 0x92, 0xd7, 0xca, 0x0c, 0x70, 0x28, 0x1d, 0xa1, 0x3e, 0xf8, 0x99, 0x7e, 0xfd, 0xc3, 0x3b,
-	0x52, 0xbc, 0x7f, 0x08, 0x9f, 0xfe, 0xe7, 0xa0, 0x22, 0xc7, 0x13, 0x3f, 0xd3, 0x42, 0xf2, 0x3c,
-	0xdc, 0x88, 0x41, 0xa8, 0xe3, 0xb1, 0x81, 0xd1, 0x8d, 0x27, 0x08, 0xbf, 0x6f, 0xaf, 0x24, 0xef,
-	0xdb, 0xd7, 0x93, 0x60, 0x4c, 0xe3, 0x9b, 0x5f, 0x80, 0x0b, 0x99, 0x96, 0x4d, 0x7e, 0xa9, 0xc5,
-	0xcf, 0x42, 0xb4, 0x25, 0x11, 0x34, 0x31, 0x52, 0xaf, 0xf1, 0xcd, 0xdf, 0x1b, 0x88, 0x89, 0x87,
-	0x50, 0x31, 0x7f, 0xbd, 0x00, 0xd3, 0x89, 0x73, 0x57, 0x40, 0x1e, 0xa8, 0x7b, 0x90, 0x5c, 0xae,
-	0x60, 0x04, 0x59, 0x2d, 0x63, 0xf9, 0xc0, 0xeb, 0xdb, 0x07, 0x7c, 0x7c, 0x6d, 0xaa, 0xf4, 0xe9,
-	0xa7, 0xc7, 0x58, 0xde, 0x9b, 0x4a, 0x76, 0xe4, 0x2b, 0x06, 0x40, 0x9c, 0xb0, 0x43, 0x9a, 0xc7,
-	0x72, 0xe7, 0x1e, 0xe7, 0x56, 0x50, 0xac, 0x50, 0x63, 0xcb, 0xf6, 0x96, 0x5d, 0xea, 0xdb, 0x5b,
-	0x36, 0x6d, 0xc9, 0xe7, 0x71, 0xf8, 0xca, 0xfd, 0xaa, 0x2c, 0x43, 0x05, 0x35, 0xdf, 0x1a, 0x83,
-	0x32, 0x0f, 0x13, 0xbd, 0xe1, 0x7b, 0x1d, 0xfe, 0xd4, 0x7a, 0xa0, 0x99, 0x22, 0x64, 0xb7, 0xdd,
-	0xca, 0xe3, 0xa1, 0x40, 0x41, 0x51, 0x06, 0x4d, 0x69, 0x25, 0x98, 0xe0, 0x48, 0xba, 0x50, 0xda,
-	0x92, 0x8f, 0x51, 0xc8, 0xbe, 0x1b, 0x31, 0xff, 0x79, 0xf4, 0xb4, 0x85, 0x68, 0x82, 0xe8, 0x1f,
-	0x2a, 0x2e, 0xa6, 0x05, 0x33, 0xa9, 0xa4, 0x74, 0xb9, 0x3f, 0x61, 0xf1, 0x3f, 0xc6, 0xa1, 0xac,
-	0x42, 0xa7, 0xc9, 0x27, 0x12, 0x76, 0xe1, 0x58, 0x87, 0x97, 0x06, 0x5d, 0x76, 0x6e, 0x52, 0xc8,
-	0x29, 0x1b, 0xef, 0x25, 0x28, 0xf4, 0x7c, 0x27, 0x6d, 0xf8, 0xb9, 0x8b, 0xab, 0xc8, 0xca, 0xf5,
-	0x70, 0xef, 0xc2, 0xe3, 0x0d, 0xf7, 0xbe, 0x02, 0xe3, 0x9b, 0x5e, 0x6b, 0x2f, 0xfd, 0x08, 0x6f,
-	0xdd, 0x6b, 0xed, 0x21, 0x87, 0x90, 0x17, 0x61, 0x5a, 0xc6, 0xb0, 0x47, 0x4a, 0x4c, 0x91, 0xeb,
-	0xa9, 0xca, 0x1d, 0x69, 0x23, 0x01, 0xc5, 0x14, 0x36, 0xdb, 0x65, 0xd9, 0xb1, 0x81, 0x3f, 0x4c,
-	0x32, 0x91, 0xf4, 0x5d, 0xb8, 0xd5, 0xb8, 0x73, 0x9b, 0xdb, 0xa7, 0x15, 0x46, 0x22, 0x4c, 0x7e,
-	0xf2, 0xc8, 0x30, 0xf9, 0x65, 0x41, 0x9b, 0x49, 0xcb, 0x77, 0x94, 0x29, 0x1e, 0x5d, 0xc3, 0xe9,
-	0xb2, 0xb2, 0x43, 0xcf, 0x2e, 0xaa, 0x66, 0x56, 0x42, 0x81, 0xf2, 0x7b, 0x97, 0x50, 0xc0, 0xbc,
-	0x0b, 0x33, 0xa9, 0xfe, 0x8b, 0xec, 0x86, 0x46, 0xb6, 0xdd, 0xf0, 0x78, 0xcf, 0xf8, 0xfe, 0x13,
-	0x03, 0xce, 0xf6, 0xad, 0x48, 0xc7, 0xcd, 0xec, 0x90, 0xde, 0x1b, 0xc7, 0x4e, 0xbe, 0x37, 0x16,
-	0x86, 0xdb, 0x1b, 0xeb, 0x9b, 0xdf, 0xf9, 0xc1, 0xe5, 0xf7, 0x7d, 0xef, 0x07, 0x97, 0xdf, 0xf7,
-	0x7b, 0x3f, 0xb8, 0xfc, 0xbe, 0xb7, 0x0e, 0x2e, 0x1b, 0xdf, 0x39, 0xb8, 0x6c, 0x7c, 0xef, 0xe0,
-	0xb2, 0xf1, 0x7b, 0x07, 0x97, 0x8d, 0xff, 0x74, 0x70, 0xd9, 0xf8, 0xd6, 0x1f, 0x5e, 0x7e, 0xdf,
-	0x67, 0x3e, 0x15, 0xf7, 0xd4, 0x62, 0xd4, 0x53, 0xfc, 0xc7, 0x47, 0xa2, 0x7e, 0x59, 0xec, 0xee,
-	0xb4, 0x17, 0x59, 0x4f, 0x2d, 0xaa, 0x92, 0xa8, 0xa7, 0xfe, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe1, 0x83, 0x41, 0x34, 0x2e, 0xb5, 0x00, 0x00,
+	// 11622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x6c, 0x24, 0xd9,
+	0x75, 0x18, 0xac, 0xea, 0x07, 0xc9, 0xbe, 0x7c, 0xd7, 0xcc, 0xec, 0xf6, 0x72, 0x77, 0x86, 0xa3,
+	0x5a, 0x7f, 0xfa, 0x46, 0xb6, 0xc4, 0x91, 0x66, 0x57, 0xf2, 0x5a, 0xab, 0x28, 0xe9, 0x26, 0x67,
+	0x76, 0x38, 0x4b, 0xce, 0x70, 0x4f, 0x73, 0x76, 0xf4, 0xf0, 0xda, 0x2a, 0x76, 0x5f, 0x36, 0x6b,
+	0xd9, 0x5d, 0xd5, 0x5b, 0x55, 0xcd, 0x19, 0xae, 0x36, 0xd2, 0x5a, 0xf6, 0xca, 0x8e, 0x63, 0xc1,
+	0x8a, 0x2d, 0x25, 0x70, 0x6c, 0x18, 0x92, 0x63, 0xc3, 0x49, 0x94, 0x1f, 0x81, 0xa1, 0x20, 0x40,
+	0x20, 0xc0, 0x81, 0x05, 0x07, 0xeb, 0x1f, 0x36, 0x64, 0xe4, 0x61, 0xc7, 0x89, 0x69, 0x89, 0x0e,
+	0x60, 0xd9, 0x40, 0xa0, 0x28, 0x48, 0x20, 0x64, 0x7f, 0x04, 0xc1, 0x7d, 0xdf, 0x5b, 0x5d, 0x4d,
+	0x76, 0xb3, 0x8b, 0x9c, 0x45, 0xe2, 0x5f, 0x64, 0xdf, 0x73, 0xee, 0x39, 0xb7, 0xee, 0xfb, 0x3c,
+	0x2f, 0x5a, 0x6b, 0x7a, 0xf1, 0x4e, 0x77, 0x6b, 0xa9, 0x1e, 0xb4, 0xaf, 0xba, 0x61, 0x33, 0xe8,
+	0x84, 0xc1, 0xcb, 0xf4, 0x9f, 0xf7, 0x86, 0x41, 0xab, 0x15, 0x74, 0xe3, 0xe8, 0x6a, 0x67, 0xb7,
+	0x79, 0xd5, 0xed, 0x78, 0xd1, 0x55, 0x59, 0xb2, 0xf7, 0x7e, 0xb7, 0xd5, 0xd9, 0x71, 0xdf, 0x7f,
+	0xb5, 0x89, 0x7d, 0x1c, 0xba, 0x31, 0x6e, 0x2c, 0x75, 0xc2, 0x20, 0x0e, 0xec, 0x0f, 0x2b, 0x6a,
+	0x4b, 0x82, 0x1a, 0xfd, 0xe7, 0xc7, 0x45, 0xdd, 0xa5, 0xce, 0x6e, 0x73, 0x89, 0x50, 0x5b, 0x92,
+	0x25, 0x82, 0xda, 0xc2, 0x7b, 0xb5, 0xb6, 0x34, 0x83, 0x66, 0x70, 0x95, 0x12, 0xdd, 0xea, 0x6e,
+	0xd3, 0x5f, 0xf4, 0x07, 0xfd, 0x8f, 0x31, 0x5b, 0x78, 0x72, 0xf7, 0x99, 0x68, 0xc9, 0x0b, 0x48,
+	0xdb, 0xae, 0x6e, 0xb9, 0x71, 0x7d, 0xe7, 0xea, 0x5e, 0x4f, 0x8b, 0x16, 0x1c, 0x0d, 0xa9, 0x1e,
+	0x84, 0x38, 0x0d, 0xe7, 0x69, 0x85, 0xd3, 0x76, 0xeb, 0x3b, 0x9e, 0x8f, 0xc3, 0x7d, 0xf5, 0xd5,
+	0x6d, 0x1c, 0xbb, 0x69, 0xb5, 0xae, 0xf6, 0xab, 0x15, 0x76, 0xfd, 0xd8, 0x6b, 0xe3, 0x9e, 0x0a,
+	0x1f, 0x3c, 0xae, 0x42, 0x54, 0xdf, 0xc1, 0x6d, 0xb7, 0xa7, 0xde, 0x53, 0xfd, 0xea, 0x75, 0x63,
+	0xaf, 0x75, 0xd5, 0xf3, 0xe3, 0x28, 0x0e, 0x93, 0x95, 0x9c, 0xef, 0xe6, 0x51, 0xa9, 0xb2, 0x56,
+	0xad, 0xc5, 0x6e, 0xdc, 0x8d, 0xec, 0xcf, 0x59, 0x68, 0xaa, 0x15, 0xb8, 0x8d, 0xaa, 0xdb, 0x72,
+	0xfd, 0x3a, 0x0e, 0xcb, 0xd6, 0x65, 0xeb, 0xca, 0xe4, 0xb5, 0xb5, 0xa5, 0x51, 0xc6, 0x6b, 0xa9,
+	0x72, 0x3f, 0x02, 0x1c, 0x05, 0xdd, 0xb0, 0x8e, 0x01, 0x6f, 0x57, 0xcf, 0xbf, 0x79, 0xb0, 0xf8,
+	0x8e, 0xc3, 0x83, 0xc5, 0xa9, 0x35, 0x8d, 0x13, 0x18, 0x7c, 0xed, 0x2f, 0x59, 0x68, 0xbe, 0xee,
+	0xfa, 0x6e, 0xb8, 0xbf, 0xe9, 0x86, 0x4d, 0x1c, 0x3f, 0x17, 0x06, 0xdd, 0x4e, 0x39, 0x77, 0x0a,
+	0xad, 0x79, 0x8c, 0xb7, 0x66, 0x7e, 0x39, 0xc9, 0x0e, 0x7a, 0x5b, 0x40, 0xdb, 0x15, 0xc5, 0xee,
+	0x56, 0x0b, 0xeb, 0xed, 0xca, 0x9f, 0x66, 0xbb, 0x6a, 0x49, 0x76, 0xd0, 0xdb, 0x02, 0xfb, 0xdd,
+	0x68, 0xdc, 0xf3, 0x9b, 0x21, 0x8e, 0xa2, 0x72, 0xe1, 0xb2, 0x75, 0xa5, 0x54, 0x9d, 0xe5, 0xd5,
+	0xc7, 0x57, 0x59, 0x31, 0x08, 0xb8, 0xf3, 0x5b, 0x79, 0x34, 0x5f, 0x59, 0xab, 0x6e, 0x86, 0xee,
+	0xf6, 0xb6, 0x57, 0x87, 0xa0, 0x1b, 0x7b, 0x7e, 0x53, 0x27, 0x60, 0x1d, 0x4d, 0xc0, 0xfe, 0x00,
+	0x9a, 0x8c, 0x70, 0xb8, 0xe7, 0xd5, 0xf1, 0x46, 0x10, 0xc6, 0x74, 0x50, 0x8a, 0xd5, 0x73, 0x1c,
+	0x7d, 0xb2, 0xa6, 0x40, 0xa0, 0xe3, 0x91, 0x6a, 0x61, 0x10, 0xc4, 0x1c, 0x4e, 0xfb, 0xac, 0xa4,
+	0xaa, 0x81, 0x02, 0x81, 0x8e, 0x67, 0xaf, 0xa0, 0x39, 0xd7, 0xf7, 0x83, 0xd8, 0x8d, 0xbd, 0xc0,
+	0xdf, 0x08, 0xf1, 0xb6, 0xf7, 0x80, 0x7f, 0x62, 0x99, 0xd7, 0x9d, 0xab, 0x24, 0xe0, 0xd0, 0x53,
+	0xc3, 0xfe, 0x82, 0x85, 0xe6, 0xa2, 0xd8, 0xab, 0xef, 0x7a, 0x3e, 0x8e, 0xa2, 0xe5, 0xc0, 0xdf,
+	0xf6, 0x9a, 0xe5, 0x22, 0x1d, 0xb6, 0xdb, 0xa3, 0x0d, 0x5b, 0x2d, 0x41, 0xb5, 0x7a, 0x9e, 0x34,
+	0x29, 0x59, 0x0a, 0x3d, 0xdc, 0xed, 0x1f, 0x42, 0x25, 0xde, 0xa3, 0x38, 0x2a, 0x8f, 0x5d, 0xce,
+	0x5f, 0x29, 0x55, 0xa7, 0x0f, 0x0f, 0x16, 0x4b, 0xab, 0xa2, 0x10, 0x14, 0xdc, 0x59, 0x41, 0xe5,
+	0x4a, 0x7b, 0xcb, 0x8d, 0x22, 0xb7, 0x11, 0x84, 0x89, 0xa1, 0xbb, 0x82, 0x26, 0xda, 0x6e, 0xa7,
+	0xe3, 0xf9, 0x4d, 0x32, 0x76, 0x84, 0xce, 0xd4, 0xe1, 0xc1, 0xe2, 0xc4, 0x3a, 0x2f, 0x03, 0x09,
+	0x75, 0xfe, 0x63, 0x0e, 0x4d, 0x56, 0x7c, 0xb7, 0xb5, 0x1f, 0x79, 0x11, 0x74, 0x7d, 0xfb, 0x93,
+	0x68, 0x82, 0xec, 0x5a, 0x0d, 0x37, 0x76, 0xf9, 0x4a, 0x7f, 0xdf, 0x12, 0xdb, 0x44, 0x96, 0xf4,
+	0x4d, 0x44, 0x7d, 0x3e, 0xc1, 0x5e, 0xda, 0x7b, 0xff, 0xd2, 0x9d, 0xad, 0x97, 0x71, 0x3d, 0x5e,
+	0xc7, 0xb1, 0x5b, 0xb5, 0xf9, 0x28, 0x20, 0x55, 0x06, 0x92, 0xaa, 0x1d, 0xa0, 0x42, 0xd4, 0xc1,
+	0x75, 0xbe, 0x72, 0xd7, 0x47, 0x5c, 0x21, 0xaa, 0xe9, 0xb5, 0x0e, 0xae, 0x57, 0xa7, 0x38, 0xeb,
+	0x02, 0xf9, 0x05, 0x94, 0x91, 0x7d, 0x1f, 0x8d, 0x45, 0x74, 0x2f, 0xe3, 0x8b, 0xf2, 0x4e, 0x76,
+	0x2c, 0x29, 0xd9, 0xea, 0x0c, 0x67, 0x3a, 0xc6, 0x7e, 0x03, 0x67, 0xe7, 0xfc, 0x89, 0x85, 0xce,
+	0x69, 0xd8, 0x95, 0xb0, 0xd9, 0x6d, 0x63, 0x3f, 0xb6, 0x2f, 0xa3, 0x82, 0xef, 0xb6, 0x31, 0x5f,
+	0x55, 0xb2, 0xc9, 0xb7, 0xdd, 0x36, 0x06, 0x0a, 0xb1, 0x9f, 0x44, 0xc5, 0x3d, 0xb7, 0xd5, 0xc5,
+	0xb4, 0x93, 0x4a, 0xd5, 0x69, 0x8e, 0x52, 0x7c, 0x91, 0x14, 0x02, 0x83, 0xd9, 0xaf, 0xa1, 0x12,
+	0xfd, 0xe7, 0x46, 0x18, 0xb4, 0x33, 0xfa, 0x34, 0xde, 0xc2, 0x17, 0x05, 0x59, 0x36, 0xfd, 0xe4,
+	0x4f, 0x50, 0x0c, 0x9d, 0x3f, 0xb3, 0xd0, 0xac, 0xf6, 0x71, 0x6b, 0x5e, 0x14, 0xdb, 0x3f, 0xda,
+	0x33, 0x79, 0x96, 0x06, 0x9b, 0x3c, 0xa4, 0x36, 0x9d, 0x3a, 0x73, 0xfc, 0x4b, 0x27, 0x44, 0x89,
+	0x36, 0x71, 0x7c, 0x54, 0xf4, 0x62, 0xdc, 0x8e, 0xca, 0xb9, 0xcb, 0xf9, 0x2b, 0x93, 0xd7, 0x56,
+	0x33, 0x1b, 0x46, 0xd5, 0xbf, 0xab, 0x84, 0x3e, 0x30, 0x36, 0xce, 0xd7, 0xf2, 0xc6, 0xf0, 0xad,
+	0x8b, 0x76, 0xbc, 0x61, 0xa1, 0xb1, 0x96, 0xbb, 0x85, 0x5b, 0x6c, 0x6d, 0x4d, 0x5e, 0x7b, 0x29,
+	0xb3, 0x96, 0x08, 0x1e, 0x4b, 0x6b, 0x94, 0xfe, 0x75, 0x3f, 0x0e, 0xf7, 0xd5, 0xf4, 0x62, 0x85,
+	0xc0, 0x99, 0xdb, 0xbf, 0x64, 0xa1, 0x49, 0xb5, 0xab, 0x89, 0x6e, 0xd9, 0xca, 0xbe, 0x31, 0x6a,
+	0x33, 0xe5, 0x2d, 0x92, 0x5b, 0xb4, 0x06, 0x01, 0xbd, 0x2d, 0x0b, 0x3f, 0x82, 0x26, 0xb5, 0x4f,
+	0xb0, 0xe7, 0x50, 0x7e, 0x17, 0xef, 0xb3, 0x09, 0x0f, 0xe4, 0x5f, 0xfb, 0xbc, 0x31, 0xc3, 0xf9,
+	0x94, 0xfe, 0x50, 0xee, 0x19, 0x6b, 0xe1, 0x23, 0x68, 0x2e, 0xc9, 0x70, 0x98, 0xfa, 0xce, 0x3f,
+	0x1a, 0x37, 0x26, 0x26, 0xd9, 0x08, 0xec, 0x00, 0x8d, 0xb7, 0x71, 0x1c, 0x7a, 0x75, 0x31, 0x64,
+	0x2b, 0xa3, 0xf5, 0xd2, 0x3a, 0x25, 0xa6, 0x0e, 0x44, 0xf6, 0x3b, 0x02, 0xc1, 0xc5, 0xde, 0x41,
+	0x05, 0x37, 0x6c, 0x8a, 0x31, 0xb9, 0x91, 0xcd, 0xb2, 0x54, 0x5b, 0x45, 0x25, 0x6c, 0x46, 0x40,
+	0x39, 0xd8, 0x57, 0x51, 0x29, 0xc6, 0x61, 0xdb, 0xf3, 0xdd, 0x98, 0x9d, 0xa0, 0x13, 0xd5, 0x79,
+	0x8e, 0x56, 0xda, 0x14, 0x00, 0x50, 0x38, 0x76, 0x0b, 0x8d, 0x35, 0xc2, 0x7d, 0xe8, 0xfa, 0xe5,
+	0x42, 0x16, 0x5d, 0xb1, 0x42, 0x69, 0xa9, 0x49, 0xca, 0x7e, 0x03, 0xe7, 0x61, 0xff, 0xba, 0x85,
+	0xce, 0xb7, 0xb1, 0x1b, 0x75, 0x43, 0x4c, 0x3e, 0x01, 0x70, 0x8c, 0x7d, 0x32, 0xb0, 0xe5, 0x22,
+	0x65, 0x0e, 0xa3, 0x8e, 0x43, 0x2f, 0xe5, 0xea, 0x13, 0xbc, 0x29, 0xe7, 0xd3, 0xa0, 0x90, 0xda,
+	0x1a, 0xfb, 0x35, 0x34, 0x19, 0xc7, 0xad, 0x5a, 0x1c, 0xba, 0x31, 0x6e, 0xee, 0x97, 0xc7, 0x2e,
+	0x5b, 0xa3, 0xef, 0x30, 0x9b, 0x9b, 0x6b, 0x82, 0x60, 0x75, 0x96, 0xac, 0x16, 0xad, 0x00, 0x74,
+	0x76, 0xf6, 0xaf, 0x58, 0xe8, 0x5c, 0x3d, 0x68, 0x77, 0x82, 0xc8, 0x8b, 0xf1, 0x72, 0xe0, 0x37,
+	0x3c, 0xb6, 0xa2, 0xc7, 0x69, 0x33, 0x5e, 0x18, 0xad, 0x19, 0xcb, 0xbd, 0x84, 0xab, 0x8f, 0x1e,
+	0x1e, 0x2c, 0x9e, 0x4b, 0x01, 0x40, 0x5a, 0x33, 0x6c, 0x40, 0x8f, 0x44, 0xdd, 0x7a, 0x1d, 0x47,
+	0xd1, 0x3d, 0xec, 0x35, 0x77, 0xe2, 0xcd, 0x9d, 0x10, 0x47, 0x3b, 0x41, 0xab, 0x51, 0x9e, 0xa0,
+	0x17, 0xbd, 0x85, 0xc3, 0x83, 0xc5, 0x47, 0x6a, 0xa9, 0x18, 0xd0, 0xa7, 0xa6, 0xf3, 0x3b, 0x63,
+	0x68, 0xbe, 0xe7, 0x24, 0xb5, 0x9f, 0x46, 0xc5, 0xce, 0x8e, 0x1b, 0x89, 0xa3, 0xf1, 0x92, 0xd8,
+	0x97, 0x37, 0x48, 0xe1, 0x5b, 0x07, 0x8b, 0xd3, 0xa2, 0x0a, 0x2d, 0x00, 0x86, 0x4c, 0x2e, 0xaa,
+	0x6d, 0x1c, 0x45, 0x6e, 0x53, 0x9c, 0x97, 0xda, 0xba, 0xa4, 0xc5, 0x20, 0xe0, 0xf6, 0x4f, 0x5b,
+	0x68, 0x9a, 0xad, 0x51, 0xc0, 0x51, 0xb7, 0x15, 0x93, 0x3b, 0x01, 0x99, 0x87, 0xb7, 0xb2, 0xd8,
+	0x0f, 0x18, 0xc9, 0xea, 0x05, 0xce, 0x7d, 0x5a, 0x2f, 0x8d, 0xc0, 0xe4, 0x6b, 0xdf, 0x43, 0xa5,
+	0x28, 0x76, 0xc3, 0x18, 0x37, 0x2a, 0x31, 0xbd, 0xbd, 0x4e, 0x5e, 0xfb, 0xc1, 0xc1, 0x0e, 0xcb,
+	0x4d, 0xaf, 0x8d, 0xd9, 0xc1, 0x5c, 0x13, 0x04, 0x40, 0xd1, 0xb2, 0x5f, 0x43, 0x28, 0xec, 0xfa,
+	0xb5, 0x6e, 0xbb, 0xed, 0x86, 0xfb, 0xfc, 0x42, 0x7b, 0x73, 0xb4, 0xcf, 0x03, 0x49, 0x4f, 0xdd,
+	0xed, 0x54, 0x19, 0x68, 0xfc, 0xec, 0x9f, 0xb0, 0xd0, 0x34, 0x5b, 0xfa, 0xa2, 0x05, 0x63, 0x19,
+	0xb7, 0x60, 0x9e, 0x74, 0xed, 0x8a, 0xce, 0x02, 0x4c, 0x8e, 0xf6, 0x4b, 0x68, 0x92, 0x4c, 0xe3,
+	0x16, 0x66, 0x9d, 0x3b, 0x3e, 0x74, 0xe7, 0xd2, 0xd5, 0xba, 0xac, 0x48, 0x80, 0x4e, 0x8f, 0xcc,
+	0xa1, 0x59, 0xb7, 0xb1, 0xe7, 0x45, 0x41, 0xb8, 0x2f, 0x3e, 0x72, 0x22, 0xe3, 0x8f, 0x3c, 0x77,
+	0x78, 0xb0, 0x38, 0x5b, 0x31, 0x99, 0x40, 0x92, 0xab, 0xf3, 0xef, 0xcd, 0x0b, 0xa6, 0xdc, 0x4f,
+	0x3e, 0x81, 0x1e, 0xe3, 0xcb, 0x6e, 0xbb, 0xdb, 0x82, 0xae, 0x7f, 0xd3, 0x8b, 0xe2, 0x20, 0xdc,
+	0x5f, 0xf3, 0xda, 0x5e, 0x4c, 0x97, 0x56, 0xb1, 0x7a, 0xf1, 0xf0, 0x60, 0xf1, 0xb1, 0x5a, 0x3f,
+	0x24, 0xe8, 0x5f, 0xdf, 0x76, 0xd1, 0xe3, 0x5d, 0xbf, 0x3f, 0x79, 0x26, 0xfb, 0x2d, 0x1e, 0x1e,
+	0x2c, 0x3e, 0x7e, 0xb7, 0x3f, 0x1a, 0x1c, 0x45, 0xc3, 0xf9, 0x2b, 0x0b, 0xcd, 0x89, 0xef, 0xda,
+	0xc4, 0xed, 0x4e, 0x8b, 0x9c, 0x5b, 0xa7, 0x2f, 0x99, 0xc4, 0x86, 0x64, 0x02, 0xd9, 0x5c, 0xa4,
+	0x44, 0xfb, 0xfb, 0x89, 0x27, 0xce, 0x5f, 0x5a, 0xe8, 0x7c, 0x12, 0xf9, 0x0c, 0x6e, 0xd3, 0x91,
+	0x79, 0x9b, 0xbe, 0x9d, 0xed, 0xd7, 0xf6, 0xb9, 0x52, 0xbf, 0xa1, 0x4d, 0x58, 0x81, 0x0a, 0x78,
+	0xdb, 0x7e, 0x06, 0x4d, 0xc5, 0xfc, 0xe7, 0x6d, 0x25, 0x19, 0x49, 0xad, 0xd0, 0xa6, 0x06, 0x03,
+	0x03, 0xd3, 0x7e, 0x1a, 0x4d, 0xd5, 0x5b, 0xdd, 0x28, 0xc6, 0x61, 0xad, 0x1e, 0x74, 0xd8, 0x01,
+	0x30, 0x51, 0x9d, 0x23, 0xb5, 0x96, 0xb5, 0x72, 0x30, 0xb0, 0x9c, 0xff, 0x3d, 0xd6, 0xdb, 0xe7,
+	0xff, 0xb7, 0x5f, 0x14, 0xd5, 0xbd, 0x2f, 0xff, 0x30, 0xef, 0x7d, 0x85, 0xb7, 0xd5, 0xbd, 0xef,
+	0xb3, 0x16, 0xb9, 0x3e, 0xb3, 0x09, 0x10, 0xf1, 0x3b, 0xe9, 0x0b, 0xd9, 0x2e, 0x05, 0xa2, 0xb9,
+	0xd3, 0x6e, 0xe4, 0x9c, 0x17, 0x28, 0xb6, 0x7d, 0xaf, 0x7f, 0x63, 0x6f, 0xf7, 0xeb, 0xdf, 0xf8,
+	0x89, 0xaf, 0x7f, 0xff, 0xb8, 0x80, 0xa6, 0x2a, 0x7e, 0xec, 0x55, 0xb6, 0xb7, 0x3d, 0xdf, 0x8b,
+	0xf7, 0xed, 0x9f, 0xcb, 0xa1, 0xab, 0x9d, 0x10, 0x6f, 0xe3, 0x30, 0xc4, 0x8d, 0x95, 0x6e, 0xe8,
+	0xf9, 0xcd, 0x5a, 0x7d, 0x07, 0x37, 0xba, 0x2d, 0xcf, 0x6f, 0xae, 0x36, 0xfd, 0x40, 0x16, 0x5f,
+	0x7f, 0x80, 0xeb, 0x5d, 0x3a, 0x95, 0xd8, 0xa6, 0xd8, 0x1e, 0xad, 0x7f, 0x36, 0x86, 0x63, 0x5a,
+	0x7d, 0xea, 0xf0, 0x60, 0xf1, 0xea, 0x90, 0x95, 0x60, 0xd8, 0x4f, 0xb3, 0x7f, 0x26, 0x87, 0x96,
+	0x42, 0xfc, 0x4a, 0xd7, 0x1b, 0xbc, 0x37, 0xd8, 0xa9, 0xd5, 0x1a, 0xf1, 0x0a, 0x32, 0x14, 0xcf,
+	0xea, 0xb5, 0xc3, 0x83, 0xc5, 0x21, 0xeb, 0xc0, 0x90, 0xdf, 0xe5, 0x6c, 0xa0, 0xc9, 0x4a, 0xc7,
+	0x8b, 0xbc, 0x07, 0x44, 0xb9, 0x89, 0x07, 0x50, 0x9e, 0x2d, 0xa2, 0x62, 0xd8, 0x6d, 0x61, 0xb6,
+	0xa7, 0x96, 0xaa, 0x25, 0x72, 0x0a, 0x01, 0x29, 0x00, 0x56, 0xee, 0x7c, 0x96, 0x9c, 0xb8, 0x94,
+	0x64, 0x42, 0x6d, 0xfa, 0x32, 0x2a, 0x86, 0x84, 0x49, 0xd9, 0xca, 0x42, 0xfe, 0xd3, 0x5a, 0xcd,
+	0x1b, 0x41, 0xfe, 0x05, 0xc6, 0xc2, 0xf9, 0x46, 0x0e, 0x5d, 0xa8, 0x74, 0x3a, 0xeb, 0x38, 0xda,
+	0x49, 0xb4, 0xe2, 0xe7, 0x2d, 0x34, 0xb3, 0xe7, 0x85, 0x71, 0xd7, 0x6d, 0x09, 0xcd, 0x38, 0x6b,
+	0x4f, 0x6d, 0xd4, 0xf6, 0x50, 0x6e, 0x2f, 0x1a, 0xa4, 0xab, 0xf6, 0xe1, 0xc1, 0xe2, 0x8c, 0x59,
+	0x06, 0x09, 0xf6, 0xf6, 0x3f, 0xb0, 0xd0, 0x1c, 0x2f, 0xba, 0x1d, 0x34, 0xb0, 0x6e, 0x79, 0xb9,
+	0x9b, 0x65, 0x9b, 0x24, 0x71, 0xa6, 0x31, 0x4f, 0x96, 0x42, 0x4f, 0x23, 0x9c, 0xff, 0x9a, 0x43,
+	0x8f, 0xf6, 0xa1, 0x61, 0xff, 0xa6, 0x85, 0xce, 0x33, 0x73, 0x8d, 0x06, 0x02, 0xbc, 0xcd, 0x7b,
+	0xf3, 0x63, 0x59, 0xb7, 0x1c, 0xc8, 0x12, 0xc7, 0x7e, 0x1d, 0x57, 0xcb, 0xe4, 0x14, 0x5a, 0x4e,
+	0x61, 0x0d, 0xa9, 0x0d, 0xa2, 0x2d, 0x65, 0x06, 0x9c, 0x44, 0x4b, 0x73, 0x67, 0xd2, 0xd2, 0x5a,
+	0x0a, 0x6b, 0x48, 0x6d, 0x90, 0xf3, 0x37, 0xd1, 0xe3, 0x47, 0x90, 0x3b, 0x7e, 0x71, 0x3a, 0x2f,
+	0xa1, 0x0b, 0x26, 0x01, 0x31, 0xc7, 0x8e, 0x5f, 0xd7, 0x0e, 0x1a, 0xa3, 0x4b, 0x47, 0x2c, 0x6c,
+	0x44, 0xae, 0x1d, 0x74, 0x4d, 0x45, 0xc0, 0x21, 0xce, 0x37, 0x2c, 0x34, 0x31, 0x84, 0x9e, 0x7d,
+	0xd1, 0xd4, 0xb3, 0x97, 0x7a, 0x74, 0xec, 0x71, 0xaf, 0x8e, 0xfd, 0xb9, 0xd1, 0x46, 0x63, 0x10,
+	0xdd, 0xfa, 0x77, 0x2d, 0x34, 0xdf, 0xa3, 0x8b, 0xb7, 0x77, 0xd0, 0xf9, 0x4e, 0xd0, 0x10, 0x37,
+	0x88, 0x9b, 0x6e, 0xb4, 0x43, 0x61, 0xfc, 0xf3, 0x9e, 0x26, 0x23, 0xb9, 0x91, 0x02, 0x7f, 0xeb,
+	0x60, 0xb1, 0x2c, 0x89, 0x24, 0x10, 0x20, 0x95, 0xa2, 0xdd, 0x41, 0x13, 0xdb, 0x1e, 0x6e, 0x35,
+	0xd4, 0x14, 0x1c, 0xf1, 0x62, 0x7a, 0x83, 0x53, 0x63, 0x66, 0x28, 0xf1, 0x0b, 0x24, 0x17, 0xe7,
+	0x7f, 0xe4, 0xd0, 0x4c, 0xa5, 0x1b, 0xef, 0x90, 0x6b, 0x59, 0x9d, 0x6a, 0x7e, 0x89, 0xba, 0x3f,
+	0xf2, 0x9a, 0x7b, 0x4f, 0x67, 0xb3, 0x19, 0xd7, 0x08, 0x29, 0x6e, 0x8e, 0x93, 0xb2, 0x09, 0x2d,
+	0x04, 0xc6, 0xc6, 0x0e, 0xd1, 0x58, 0xe0, 0x76, 0xe3, 0x9d, 0x6b, 0xfc, 0x93, 0x47, 0x54, 0x09,
+	0xdd, 0x21, 0x9f, 0x73, 0x8d, 0x73, 0x94, 0xb7, 0x64, 0x56, 0x0a, 0x9c, 0x93, 0xfd, 0x69, 0x54,
+	0xda, 0x72, 0x23, 0xaf, 0x4e, 0x4a, 0xcb, 0xf9, 0x2c, 0x0c, 0x62, 0x55, 0x41, 0x8e, 0x73, 0x96,
+	0x37, 0x4f, 0x09, 0x00, 0xc5, 0xd2, 0xf9, 0x0c, 0x9a, 0x31, 0x6d, 0xcc, 0x03, 0xac, 0x99, 0x8b,
+	0x28, 0xef, 0x86, 0x3e, 0x5f, 0x31, 0x93, 0x1c, 0x21, 0x5f, 0x81, 0xdb, 0x40, 0xca, 0xed, 0xf7,
+	0xa0, 0x89, 0xed, 0x6e, 0xab, 0x45, 0xc5, 0x38, 0x66, 0xd0, 0x95, 0x52, 0xe8, 0x0d, 0x5e, 0x0e,
+	0x12, 0xc3, 0x69, 0xa3, 0xd9, 0x44, 0x8b, 0x09, 0x81, 0x6e, 0x84, 0x43, 0xad, 0x15, 0x92, 0xc0,
+	0x5d, 0x5e, 0x0e, 0x12, 0x83, 0x60, 0x77, 0xdc, 0x28, 0xba, 0x1f, 0x84, 0x8d, 0x72, 0xce, 0xc4,
+	0xde, 0xe0, 0xe5, 0x20, 0x31, 0x9c, 0xff, 0x55, 0x40, 0xb3, 0xd5, 0x56, 0x17, 0x3f, 0x17, 0x62,
+	0x2c, 0x74, 0x8e, 0x15, 0x34, 0xdb, 0x09, 0xf1, 0x9e, 0x87, 0xef, 0xd7, 0x70, 0x0b, 0xd7, 0xe3,
+	0x20, 0xe4, 0x6c, 0x1f, 0xe5, 0x84, 0x66, 0x37, 0x4c, 0x30, 0x24, 0xf1, 0xed, 0x8f, 0xa0, 0x19,
+	0xb7, 0x1e, 0x7b, 0x7b, 0x58, 0x52, 0x60, 0x4d, 0x79, 0x84, 0x53, 0x98, 0xa9, 0x18, 0x50, 0x48,
+	0x60, 0xdb, 0x3f, 0x8a, 0xca, 0x51, 0xdd, 0x6d, 0xe1, 0xbb, 0x1d, 0xce, 0x6a, 0x79, 0x07, 0xd7,
+	0x77, 0x37, 0x02, 0xcf, 0x8f, 0xb9, 0x4a, 0xff, 0x32, 0xa7, 0x54, 0xae, 0xf5, 0xc1, 0x83, 0xbe,
+	0x14, 0xec, 0xdf, 0xb6, 0xd0, 0xc5, 0x4e, 0x88, 0x37, 0xc2, 0xa0, 0x1d, 0x90, 0x95, 0xd5, 0xa3,
+	0x76, 0xe5, 0xea, 0xc7, 0x17, 0x47, 0xbc, 0x3a, 0xb2, 0x92, 0x5e, 0xf3, 0xe8, 0x3b, 0x0f, 0x0f,
+	0x16, 0x2f, 0x6e, 0x1c, 0xd5, 0x00, 0x38, 0xba, 0x7d, 0xf6, 0xef, 0x58, 0xe8, 0x52, 0x27, 0x88,
+	0xe2, 0x23, 0x3e, 0xa1, 0x78, 0xaa, 0x9f, 0xe0, 0x1c, 0x1e, 0x2c, 0x5e, 0xda, 0x38, 0xb2, 0x05,
+	0x70, 0x4c, 0x0b, 0x9d, 0xc3, 0x49, 0x34, 0xaf, 0xcd, 0x3d, 0xae, 0xaa, 0x7b, 0x16, 0x4d, 0x8b,
+	0xc9, 0xa0, 0xae, 0x7a, 0x25, 0xa5, 0x43, 0xae, 0xe8, 0x40, 0x30, 0x71, 0xc9, 0xbc, 0x93, 0x53,
+	0x91, 0xd5, 0x4e, 0xcc, 0xbb, 0x0d, 0x03, 0x0a, 0x09, 0x6c, 0x7b, 0x15, 0x9d, 0xe3, 0x25, 0x80,
+	0x3b, 0x2d, 0xaf, 0xee, 0x2e, 0x07, 0x5d, 0x3e, 0xe5, 0x8a, 0x4c, 0x48, 0xdc, 0xe8, 0x05, 0x43,
+	0x5a, 0x1d, 0x7b, 0x0d, 0x9d, 0x77, 0xbb, 0x71, 0x20, 0xbf, 0xff, 0xba, 0x4f, 0x6e, 0x0f, 0x0d,
+	0x3a, 0xb5, 0x26, 0xd8, 0x35, 0xa3, 0x92, 0x02, 0x87, 0xd4, 0x5a, 0xf6, 0x46, 0x82, 0x5a, 0x0d,
+	0xd7, 0x03, 0xbf, 0xc1, 0x46, 0xb9, 0xa8, 0x04, 0xfd, 0x4a, 0x0a, 0x0e, 0xa4, 0xd6, 0xb4, 0x5b,
+	0x68, 0xa6, 0xed, 0x3e, 0xb8, 0xeb, 0xbb, 0x7b, 0xae, 0xd7, 0x22, 0x4c, 0xca, 0x63, 0xc7, 0xe8,
+	0x10, 0xbb, 0xb1, 0xd7, 0x5a, 0x62, 0x2e, 0x52, 0x4b, 0xab, 0x7e, 0x7c, 0x27, 0xac, 0xc5, 0x44,
+	0x30, 0x61, 0x17, 0xe6, 0x75, 0x83, 0x16, 0x24, 0x68, 0xdb, 0x77, 0xd0, 0x05, 0xba, 0x1c, 0x57,
+	0x82, 0xfb, 0xfe, 0x0a, 0x6e, 0xb9, 0xfb, 0xe2, 0x03, 0x98, 0xc4, 0xfc, 0xd8, 0xe1, 0xc1, 0xe2,
+	0x85, 0x5a, 0x1a, 0x02, 0xa4, 0xd7, 0x23, 0x4a, 0x57, 0x13, 0x00, 0x78, 0xcf, 0x8b, 0xbc, 0xc0,
+	0x67, 0x4a, 0xd7, 0x09, 0xa5, 0x74, 0xad, 0xf5, 0x47, 0x83, 0xa3, 0x68, 0xd8, 0xbf, 0x6c, 0xa1,
+	0xf3, 0x69, 0xcb, 0xb0, 0x5c, 0xca, 0xe2, 0x5c, 0x4a, 0x2c, 0x2d, 0x36, 0x23, 0x52, 0x37, 0x85,
+	0xd4, 0x46, 0xd8, 0xaf, 0x5b, 0x68, 0xca, 0xd5, 0x14, 0x06, 0x65, 0x94, 0xc5, 0x21, 0xad, 0xab,
+	0x20, 0x98, 0xd2, 0x50, 0x2f, 0x01, 0x83, 0xa3, 0xfd, 0xab, 0x16, 0xba, 0x90, 0xba, 0xc6, 0xcb,
+	0x93, 0xa7, 0xd1, 0x43, 0x74, 0x92, 0xa4, 0xef, 0x39, 0xe9, 0xcd, 0x20, 0x1e, 0x4d, 0xe2, 0x68,
+	0x12, 0xb6, 0xfb, 0xf2, 0x54, 0x16, 0x3a, 0x24, 0xed, 0xd6, 0x28, 0x08, 0x33, 0x0b, 0xc5, 0x86,
+	0xc9, 0x0d, 0x92, 0xec, 0xed, 0xcf, 0x5b, 0xe2, 0x68, 0x94, 0x2d, 0x9a, 0x3e, 0xad, 0x16, 0xd9,
+	0xea, 0xa4, 0x95, 0x0d, 0x4a, 0x30, 0xb7, 0x7f, 0x0c, 0x2d, 0xb8, 0x5b, 0x41, 0x18, 0xa7, 0x2e,
+	0xbe, 0xf2, 0x0c, 0x5d, 0x46, 0x97, 0x0e, 0x0f, 0x16, 0x17, 0x2a, 0x7d, 0xb1, 0xe0, 0x08, 0x0a,
+	0xce, 0xef, 0x8d, 0xa3, 0x29, 0x26, 0xf8, 0xf1, 0xa3, 0xeb, 0xeb, 0x16, 0x7a, 0xa2, 0xde, 0x0d,
+	0x43, 0xec, 0xc7, 0xb5, 0x18, 0x77, 0x7a, 0x0f, 0x2e, 0xeb, 0x54, 0x0f, 0xae, 0xcb, 0x87, 0x07,
+	0x8b, 0x4f, 0x2c, 0x1f, 0xc1, 0x1f, 0x8e, 0x6c, 0x9d, 0xfd, 0x07, 0x16, 0x72, 0x38, 0x42, 0xd5,
+	0xad, 0xef, 0x36, 0xc3, 0xa0, 0xeb, 0x37, 0x7a, 0x3f, 0x22, 0x77, 0xaa, 0x1f, 0xf1, 0xae, 0xc3,
+	0x83, 0x45, 0x67, 0xf9, 0xd8, 0x56, 0xc0, 0x00, 0x2d, 0xb5, 0x9f, 0x43, 0xf3, 0x1c, 0xeb, 0xfa,
+	0x83, 0x0e, 0x0e, 0xbd, 0x36, 0xe6, 0x07, 0x5e, 0x49, 0x73, 0xfb, 0x4c, 0x22, 0x40, 0x6f, 0x1d,
+	0x3b, 0x42, 0xe3, 0xf7, 0xa9, 0x52, 0x53, 0x5c, 0x9f, 0x46, 0xf4, 0xf5, 0xe4, 0x4a, 0x20, 0xa6,
+	0x28, 0x8d, 0xaa, 0x93, 0xc4, 0x5a, 0xc0, 0x7f, 0x80, 0xe0, 0x64, 0xdf, 0x46, 0x33, 0x4c, 0x2c,
+	0xdf, 0xf0, 0xfc, 0xe6, 0x46, 0xe0, 0x33, 0x87, 0xc5, 0x52, 0xf5, 0x5d, 0xe2, 0xc0, 0xaf, 0x19,
+	0xd0, 0xb7, 0x0e, 0x16, 0xa7, 0xc4, 0xff, 0x9b, 0xfb, 0x1d, 0x0c, 0x89, 0xda, 0xf6, 0x3f, 0xb4,
+	0x90, 0x1d, 0xc5, 0xb8, 0xb3, 0xd1, 0xea, 0x36, 0x3d, 0xde, 0x45, 0xdc, 0xf5, 0x30, 0x03, 0x2f,
+	0x48, 0x93, 0x6e, 0x75, 0x81, 0x37, 0xd2, 0xae, 0xf5, 0x70, 0x84, 0x94, 0x56, 0xd8, 0x9f, 0x46,
+	0x88, 0x7d, 0x37, 0xb8, 0xed, 0x0e, 0xb7, 0xe2, 0x8e, 0xd8, 0xa6, 0x7b, 0x92, 0x9e, 0x70, 0xdd,
+	0x23, 0xc6, 0x40, 0x55, 0x0a, 0x1a, 0x47, 0xe7, 0x0f, 0x4a, 0x08, 0x89, 0xb5, 0x8c, 0x3b, 0xc4,
+	0x39, 0x33, 0xc2, 0x31, 0xc3, 0xe5, 0x46, 0x54, 0x66, 0x84, 0x17, 0x85, 0xa0, 0xe0, 0xf6, 0x2e,
+	0x2a, 0x76, 0xdc, 0x6e, 0x84, 0xb3, 0x91, 0x25, 0xf9, 0xca, 0xd8, 0x20, 0x14, 0x99, 0x92, 0x82,
+	0xfe, 0x0b, 0x8c, 0x87, 0xfd, 0x93, 0x16, 0x42, 0xd8, 0x9c, 0xcd, 0x23, 0x2b, 0x0b, 0x39, 0x4b,
+	0x35, 0xe1, 0x49, 0x1f, 0xb0, 0xee, 0x52, 0x65, 0xa0, 0xb1, 0xb5, 0xef, 0xa3, 0x09, 0x57, 0x1c,
+	0x88, 0x85, 0xd3, 0x38, 0x10, 0xa9, 0xee, 0x40, 0xfc, 0x02, 0xc9, 0xcc, 0xfe, 0x19, 0x0b, 0xcd,
+	0x44, 0x38, 0xe6, 0x43, 0x45, 0xb6, 0xe5, 0x72, 0x31, 0x8b, 0x15, 0x59, 0x33, 0x68, 0xb2, 0xe3,
+	0xc5, 0x2c, 0x83, 0x04, 0x5f, 0xd1, 0x94, 0x9b, 0xd8, 0x6d, 0xe0, 0x90, 0xaa, 0xa6, 0xca, 0x63,
+	0x19, 0x35, 0x45, 0xa3, 0x29, 0x9b, 0xa2, 0x95, 0x41, 0x82, 0xaf, 0x68, 0xca, 0xba, 0x17, 0x86,
+	0x01, 0x6f, 0xca, 0x44, 0x46, 0x4d, 0xd1, 0x68, 0xca, 0xa6, 0x68, 0x65, 0x90, 0xe0, 0x4b, 0x2c,
+	0x8f, 0x1d, 0xba, 0xb4, 0xcb, 0xa5, 0x2c, 0xdc, 0x24, 0xc4, 0x36, 0x81, 0x3b, 0x4c, 0x05, 0xc8,
+	0x7e, 0x03, 0xe7, 0x61, 0xff, 0x94, 0x85, 0xa6, 0xe5, 0x42, 0xa4, 0x5b, 0x07, 0xbb, 0x2a, 0x3e,
+	0x3f, 0xf2, 0x77, 0x2b, 0x92, 0xcc, 0x09, 0xc5, 0x28, 0x02, 0x93, 0x29, 0x73, 0x2f, 0xc7, 0x31,
+	0x37, 0x42, 0xf3, 0x6d, 0x63, 0x32, 0x13, 0xf7, 0xf2, 0x04, 0x55, 0xee, 0x5e, 0x9e, 0x28, 0x85,
+	0x1e, 0xee, 0xce, 0xbf, 0x9d, 0x41, 0x33, 0x62, 0x43, 0x53, 0xe2, 0x27, 0xd3, 0x48, 0xf7, 0x11,
+	0x3f, 0x97, 0x75, 0x20, 0x98, 0xb8, 0xa4, 0x32, 0x3b, 0x4f, 0x4c, 0xe9, 0x53, 0x56, 0xae, 0xe9,
+	0x40, 0x30, 0x71, 0xed, 0x36, 0x2a, 0x92, 0x3d, 0x5f, 0x38, 0x60, 0x8d, 0x38, 0x27, 0xd4, 0x3e,
+	0xad, 0x69, 0xf7, 0x08, 0x79, 0x60, 0x5c, 0xa8, 0x51, 0x25, 0x36, 0xec, 0x2c, 0xe5, 0x42, 0x86,
+	0xfb, 0xa4, 0x69, 0xc2, 0x61, 0xab, 0xc2, 0x2c, 0x83, 0x04, 0xfb, 0x14, 0x89, 0xb4, 0x78, 0x8a,
+	0x12, 0xe9, 0xc7, 0x49, 0x44, 0xc0, 0x83, 0x5a, 0x37, 0x6c, 0x9e, 0x5c, 0xf2, 0xe5, 0x31, 0x04,
+	0x8c, 0x0a, 0x48, 0x7a, 0xc4, 0xe7, 0x4b, 0x6d, 0xfd, 0xec, 0x9c, 0xbe, 0x97, 0xed, 0xd6, 0x2f,
+	0x2f, 0x74, 0x7d, 0x0f, 0x81, 0x1e, 0xf9, 0x70, 0xe2, 0xcc, 0xe5, 0x43, 0x22, 0xeb, 0xb0, 0x05,
+	0x22, 0x65, 0x9d, 0xd2, 0xa9, 0xca, 0x3a, 0xcb, 0x06, 0x33, 0x48, 0x30, 0xa7, 0xed, 0x61, 0x6b,
+	0x4e, 0xb6, 0x07, 0x9d, 0x6a, 0x7b, 0x6a, 0x06, 0x33, 0x48, 0x30, 0xef, 0xaf, 0x14, 0x99, 0x3c,
+	0x1d, 0xa5, 0xc8, 0x54, 0x06, 0x4a, 0x91, 0xa3, 0xe5, 0xc5, 0xe9, 0x51, 0xe5, 0x45, 0xfb, 0x16,
+	0xb2, 0x1b, 0xfb, 0xbe, 0xdb, 0xf6, 0xea, 0x7c, 0xb3, 0xa4, 0xd7, 0x97, 0x19, 0xaa, 0x34, 0x93,
+	0xf7, 0xe5, 0x95, 0x1e, 0x0c, 0x48, 0xa9, 0x65, 0xc7, 0x68, 0xa2, 0x23, 0xc4, 0x82, 0xd9, 0x2c,
+	0x66, 0xbf, 0x10, 0x13, 0x98, 0xeb, 0x1a, 0x55, 0xa9, 0xf3, 0x12, 0x90, 0x9c, 0x88, 0xe2, 0xaf,
+	0xed, 0xf9, 0x1b, 0x41, 0x23, 0xda, 0xc0, 0x21, 0x57, 0x09, 0xd6, 0x70, 0x5c, 0x9e, 0xa3, 0x7d,
+	0x43, 0xd5, 0x3c, 0xeb, 0x29, 0x70, 0x48, 0xad, 0x65, 0xff, 0x73, 0x0b, 0x95, 0x43, 0xf6, 0x73,
+	0x23, 0x0c, 0x68, 0xa8, 0x93, 0x72, 0x37, 0x99, 0xcf, 0x44, 0xca, 0xec, 0x43, 0xbd, 0xfa, 0x04,
+	0x51, 0xaf, 0xf7, 0x83, 0x42, 0xdf, 0x56, 0x39, 0xff, 0xd3, 0x42, 0x73, 0xcb, 0xad, 0xa0, 0xdb,
+	0xb8, 0x47, 0x02, 0x49, 0x99, 0x83, 0x97, 0xfd, 0x11, 0x34, 0xe1, 0xf9, 0x31, 0x0e, 0xf7, 0xdc,
+	0x16, 0x3f, 0x52, 0x1d, 0x61, 0x96, 0x58, 0xe5, 0xe5, 0x6f, 0x1d, 0x2c, 0xce, 0xac, 0x74, 0x43,
+	0x6a, 0xec, 0x62, 0x1b, 0x2c, 0xc8, 0x3a, 0xf6, 0x97, 0x2d, 0x34, 0xcf, 0x5c, 0xc4, 0x56, 0xdc,
+	0xd8, 0x7d, 0xa1, 0x8b, 0x43, 0x0f, 0x0b, 0x27, 0xb1, 0x11, 0xf7, 0xd6, 0x64, 0x5b, 0x05, 0x83,
+	0x7d, 0x25, 0x00, 0xaf, 0x27, 0x39, 0x43, 0x6f, 0x63, 0x9c, 0x5f, 0xcc, 0xa3, 0xc7, 0xfa, 0xd2,
+	0xb2, 0x17, 0x50, 0xce, 0x6b, 0xf0, 0x4f, 0x47, 0x9c, 0x6e, 0x6e, 0xb5, 0x01, 0x39, 0xaf, 0x61,
+	0x2f, 0x51, 0x71, 0x85, 0xf4, 0xa2, 0xf0, 0x5b, 0x29, 0x49, 0xc9, 0x82, 0x97, 0x82, 0x86, 0x41,
+	0xac, 0xb4, 0x34, 0xe4, 0x85, 0xcb, 0xe9, 0x54, 0x00, 0xa2, 0xd1, 0x25, 0xc0, 0xca, 0x89, 0x17,
+	0x17, 0x62, 0x0d, 0x24, 0x62, 0x1d, 0x3f, 0xd8, 0x21, 0xdb, 0x6e, 0x22, 0x94, 0x59, 0x2b, 0xd5,
+	0x6f, 0xd0, 0xb8, 0xda, 0x9b, 0x68, 0x8c, 0xc8, 0x42, 0x41, 0xe3, 0xc4, 0xe7, 0x38, 0xbb, 0xcd,
+	0x52, 0x1a, 0xc0, 0x69, 0x91, 0xbe, 0x0a, 0x71, 0xdc, 0x0d, 0x7d, 0xd2, 0xb5, 0xf4, 0xe4, 0x9e,
+	0x60, 0xad, 0x00, 0x59, 0x0a, 0x1a, 0x86, 0xf3, 0x2f, 0x73, 0xe8, 0x7c, 0x5a, 0xd3, 0xc9, 0x01,
+	0x39, 0xc6, 0x5a, 0xcb, 0x55, 0x4e, 0x1f, 0xcd, 0xbe, 0x7f, 0xd8, 0x7f, 0xca, 0xda, 0xc9, 0x7e,
+	0x03, 0xe7, 0x6b, 0x7f, 0x54, 0xf6, 0x50, 0xee, 0x84, 0x3d, 0x24, 0x29, 0x27, 0x7a, 0xe9, 0x32,
+	0x2a, 0x44, 0x64, 0xe4, 0xf3, 0xa6, 0xd5, 0x92, 0x8e, 0x11, 0x85, 0x10, 0x8c, 0xae, 0xef, 0xc5,
+	0xe5, 0x82, 0x89, 0x71, 0xd7, 0xf7, 0x62, 0xa0, 0x10, 0xe7, 0x4b, 0x39, 0xb4, 0xd0, 0xff, 0xa3,
+	0x48, 0x98, 0x2f, 0x6a, 0x10, 0x49, 0x37, 0xa2, 0xbe, 0x79, 0xcc, 0x3b, 0xd4, 0x3d, 0xad, 0x3e,
+	0x5c, 0x11, 0x9c, 0x94, 0xcb, 0xb2, 0x2c, 0x8a, 0x40, 0x6b, 0x88, 0x7d, 0x4d, 0x4c, 0x7d, 0x6a,
+	0x71, 0x65, 0x8b, 0x49, 0xd6, 0x59, 0x97, 0x10, 0xd0, 0xb0, 0x88, 0x2a, 0x83, 0x18, 0x4f, 0xa3,
+	0x8e, 0x2b, 0xa3, 0x6e, 0xa9, 0x2a, 0xe3, 0xb6, 0x28, 0x04, 0x05, 0x77, 0x5a, 0xe8, 0xc9, 0x01,
+	0xda, 0x99, 0x51, 0x50, 0xa3, 0xf3, 0x3d, 0x0b, 0x3d, 0xca, 0xa5, 0x96, 0xff, 0x67, 0x3c, 0xc0,
+	0xbf, 0x6f, 0xa1, 0xc7, 0xfb, 0x7c, 0xf3, 0x19, 0x38, 0x82, 0xbf, 0x6a, 0x3a, 0x82, 0xdf, 0x1d,
+	0x75, 0x4a, 0xa7, 0x7e, 0x47, 0x1f, 0x7f, 0xf0, 0xaf, 0x58, 0x28, 0xcd, 0x0f, 0x95, 0x44, 0x78,
+	0x73, 0xc7, 0x51, 0x59, 0x58, 0xb6, 0xcc, 0x08, 0xef, 0x5a, 0x02, 0x0e, 0x3d, 0x35, 0x08, 0x95,
+	0x6d, 0xd7, 0x6b, 0x75, 0x43, 0x45, 0xba, 0x9c, 0x33, 0xa9, 0xdc, 0x48, 0xc0, 0xa1, 0xa7, 0x86,
+	0xf3, 0xcf, 0x2c, 0x34, 0xbb, 0xe2, 0xe2, 0x36, 0xb1, 0x25, 0xc6, 0xcb, 0xc1, 0x4e, 0x10, 0xc6,
+	0xb6, 0x87, 0xa6, 0xfc, 0xa0, 0x81, 0x0d, 0x87, 0x81, 0xc9, 0x6b, 0x4f, 0x0d, 0x38, 0x2a, 0xe4,
+	0xcc, 0x12, 0x55, 0x99, 0x54, 0x71, 0x5b, 0x23, 0x06, 0x06, 0x69, 0x72, 0x00, 0x74, 0x70, 0x58,
+	0xc7, 0x7e, 0x2c, 0xe2, 0x9b, 0x8a, 0xec, 0x00, 0xd8, 0x90, 0xa5, 0xa0, 0x61, 0x38, 0xaf, 0x17,
+	0xb4, 0xe6, 0xfe, 0xb5, 0x11, 0xe2, 0x6d, 0x69, 0x84, 0x78, 0x12, 0x15, 0xc9, 0x18, 0x47, 0xdc,
+	0xd2, 0x2e, 0xd7, 0x06, 0x99, 0x06, 0x11, 0x30, 0x18, 0x89, 0x89, 0xe8, 0x76, 0x1a, 0x6e, 0x8c,
+	0x1b, 0xb4, 0x98, 0x9e, 0x5c, 0x45, 0x15, 0x13, 0x71, 0x57, 0x83, 0x81, 0x81, 0xe9, 0x7c, 0x27,
+	0x87, 0xa6, 0xb5, 0x29, 0x80, 0x3b, 0xf6, 0x2b, 0x68, 0xac, 0x4e, 0x67, 0x6e, 0xd9, 0xca, 0x42,
+	0x33, 0x9b, 0x58, 0x0e, 0xec, 0xe2, 0xc2, 0xfe, 0x07, 0xce, 0xe8, 0x6c, 0x35, 0xe0, 0xba, 0xee,
+	0x39, 0x7f, 0x86, 0xba, 0x67, 0xe7, 0xfb, 0x39, 0x34, 0xaf, 0x75, 0x35, 0xd7, 0xaa, 0x75, 0x84,
+	0x6e, 0x8b, 0xdd, 0x12, 0x9e, 0xcf, 0xa8, 0xb7, 0x8f, 0x50, 0x6f, 0x19, 0x2a, 0x98, 0xdc, 0xc3,
+	0x51, 0xc1, 0xf4, 0x2a, 0xb4, 0xf2, 0xa7, 0xa7, 0xd0, 0x72, 0xbe, 0x54, 0x24, 0x93, 0x3c, 0x76,
+	0x1b, 0x41, 0x33, 0x23, 0x99, 0xeb, 0x49, 0x54, 0x7c, 0x85, 0xc8, 0x2e, 0xc9, 0xfb, 0x09, 0x15,
+	0x68, 0x80, 0xc1, 0x88, 0xad, 0x65, 0xfc, 0x15, 0x2e, 0x8e, 0x31, 0xcd, 0xe5, 0x47, 0x47, 0x1d,
+	0x5d, 0xed, 0x1b, 0x96, 0xb8, 0x70, 0xc5, 0xc2, 0xec, 0x65, 0xd4, 0x10, 0x2f, 0x05, 0xc1, 0x99,
+	0x44, 0xbc, 0x6e, 0x07, 0x61, 0xbb, 0xdb, 0x72, 0x93, 0xb9, 0x5d, 0x6e, 0xb0, 0x62, 0x10, 0x70,
+	0x72, 0x3f, 0x74, 0x3b, 0xde, 0x8b, 0x38, 0x8c, 0x58, 0xd4, 0xb5, 0x71, 0x3f, 0xac, 0x48, 0x08,
+	0x68, 0x58, 0xb4, 0x4e, 0xb3, 0x19, 0xe2, 0xa6, 0x4b, 0x0e, 0xb7, 0xb1, 0x44, 0x1d, 0x09, 0x01,
+	0x0d, 0xcb, 0x7e, 0x40, 0xcc, 0x63, 0xf5, 0x10, 0xc7, 0xc4, 0x69, 0x74, 0x3c, 0x0b, 0x4f, 0xd9,
+	0x9a, 0x20, 0xa7, 0x9c, 0x18, 0x65, 0x11, 0x28, 0x66, 0xf6, 0x06, 0x9a, 0x21, 0x21, 0x05, 0x38,
+	0x8a, 0x49, 0xf0, 0x66, 0xd0, 0x65, 0xee, 0x30, 0xa5, 0xea, 0x15, 0x61, 0x14, 0x05, 0x03, 0x9a,
+	0x32, 0x07, 0x12, 0xf5, 0x17, 0x3e, 0x84, 0xa6, 0xf4, 0x81, 0x18, 0x2a, 0xfd, 0xc0, 0xe7, 0x73,
+	0x68, 0x6e, 0x05, 0x77, 0x5a, 0xc1, 0x3e, 0xb1, 0x8a, 0xdd, 0xf3, 0xfc, 0x46, 0x70, 0xdf, 0x7e,
+	0x06, 0x15, 0x76, 0x3d, 0x5f, 0xc8, 0xc3, 0x3f, 0x20, 0xee, 0x80, 0xcf, 0x7b, 0x7e, 0xe3, 0xad,
+	0x83, 0xc5, 0xf3, 0x49, 0x7c, 0x52, 0x0e, 0xb4, 0x06, 0xf1, 0x6f, 0x8c, 0x58, 0x84, 0x04, 0x4e,
+	0xfa, 0x37, 0xf2, 0xc8, 0x09, 0x0c, 0x12, 0x83, 0x4c, 0xe1, 0x30, 0xec, 0xb6, 0xe4, 0xa5, 0x5e,
+	0x4c, 0x61, 0x20, 0xf1, 0x0f, 0xc0, 0x60, 0x64, 0x9d, 0x34, 0xf8, 0xf7, 0x97, 0x0b, 0xe6, 0x3a,
+	0x11, 0xfd, 0x92, 0xb6, 0x4e, 0x44, 0x1d, 0xd2, 0xa4, 0xd8, 0x6b, 0xe3, 0x8f, 0x07, 0x3e, 0x2e,
+	0x17, 0xcd, 0x26, 0x6d, 0xf2, 0x72, 0x90, 0x18, 0xce, 0x2f, 0x93, 0x1d, 0x32, 0xf1, 0x7d, 0x91,
+	0xbd, 0x8f, 0xc6, 0xef, 0xb3, 0x7f, 0xf9, 0x1e, 0x39, 0xa2, 0x4d, 0x24, 0xc9, 0x41, 0x2d, 0x08,
+	0xce, 0x11, 0x04, 0x3f, 0xfb, 0x67, 0x2d, 0x34, 0x25, 0x3a, 0x0c, 0xf0, 0xb6, 0xb8, 0xf7, 0x6e,
+	0x64, 0xb2, 0x5d, 0xd6, 0x14, 0x61, 0x75, 0x54, 0x6b, 0x85, 0x11, 0x18, 0xbc, 0x9d, 0x0f, 0x23,
+	0x1e, 0x38, 0x97, 0x90, 0xe3, 0xac, 0x41, 0xe4, 0x38, 0xe7, 0x2f, 0xf3, 0xe8, 0xdc, 0xf5, 0x96,
+	0x1b, 0xc5, 0x5e, 0x3d, 0xc2, 0x6e, 0x28, 0xb5, 0x4f, 0xef, 0x46, 0xe3, 0x6e, 0xa3, 0x91, 0x96,
+	0xb9, 0xa9, 0xc2, 0x8a, 0x41, 0xc0, 0xc9, 0x8c, 0xf1, 0xfc, 0x06, 0x7e, 0x90, 0xdc, 0xf4, 0x56,
+	0x49, 0x21, 0x30, 0x98, 0xda, 0x19, 0xf3, 0x47, 0xec, 0x8c, 0x1f, 0x40, 0x93, 0x62, 0x3b, 0x50,
+	0x33, 0x4b, 0x65, 0x0a, 0x51, 0x20, 0xd0, 0xf1, 0x88, 0x0f, 0x23, 0x99, 0x2b, 0x51, 0xec, 0xb6,
+	0x3b, 0xd4, 0x31, 0xbc, 0x5c, 0x34, 0x7d, 0x18, 0x37, 0x0d, 0x28, 0x24, 0xb0, 0xed, 0x07, 0x68,
+	0x7c, 0x87, 0x9a, 0x3d, 0x85, 0xdb, 0xc2, 0xfa, 0xa8, 0x2e, 0x02, 0x5b, 0xac, 0x17, 0x99, 0x31,
+	0x55, 0x75, 0x1d, 0xfb, 0x1d, 0x81, 0x60, 0x27, 0x5a, 0x4e, 0x46, 0x5d, 0xf3, 0xee, 0xcb, 0x9b,
+	0x2d, 0x57, 0x50, 0x48, 0x60, 0x93, 0x75, 0xe4, 0xf9, 0x11, 0xae, 0x77, 0x43, 0x66, 0x9a, 0x9d,
+	0x50, 0xeb, 0x68, 0x95, 0x97, 0x83, 0xc4, 0x70, 0xfe, 0x43, 0x0e, 0x69, 0x96, 0xf7, 0x33, 0x90,
+	0x85, 0x7d, 0x43, 0x16, 0x1e, 0xd1, 0x6a, 0xac, 0x5a, 0xde, 0x37, 0x4d, 0xd3, 0x5e, 0x22, 0x4d,
+	0xd3, 0xed, 0xcc, 0x38, 0x1e, 0x9d, 0xa5, 0xe9, 0x8f, 0x2c, 0xf4, 0xb8, 0x42, 0xee, 0xbd, 0xac,
+	0x1f, 0xaf, 0xd8, 0x20, 0x33, 0x5f, 0x55, 0x2b, 0xe7, 0x12, 0x33, 0x5f, 0x81, 0x40, 0xc7, 0x53,
+	0xc9, 0x2e, 0xf2, 0x27, 0x4c, 0x76, 0x51, 0x38, 0x3a, 0xd9, 0x85, 0xf3, 0xdf, 0x72, 0xe8, 0x62,
+	0xef, 0x97, 0xe9, 0x71, 0xd7, 0xc7, 0x7f, 0x5b, 0x32, 0x32, 0x3b, 0x77, 0xe2, 0xc8, 0xec, 0xfc,
+	0x20, 0x91, 0xd9, 0x32, 0x1e, 0xba, 0x70, 0xea, 0xf1, 0xd0, 0x35, 0x74, 0x41, 0x44, 0x22, 0xde,
+	0x08, 0x42, 0x9e, 0xed, 0x41, 0xdc, 0x91, 0x26, 0xaa, 0x17, 0x79, 0x95, 0x0b, 0x90, 0x86, 0x04,
+	0xe9, 0x75, 0x9d, 0x3f, 0x22, 0x3b, 0xb2, 0xec, 0x72, 0xa5, 0x8a, 0x78, 0x16, 0x15, 0xe2, 0xfd,
+	0x8e, 0xe8, 0xe8, 0xff, 0x5f, 0x34, 0x87, 0x38, 0x65, 0xbd, 0x75, 0xb0, 0xf8, 0x68, 0x4a, 0x15,
+	0x02, 0x02, 0x5a, 0xc9, 0x5e, 0x93, 0x2b, 0x83, 0xf5, 0xfe, 0xd3, 0xe6, 0x4c, 0x7e, 0xeb, 0x60,
+	0x31, 0x25, 0x55, 0xe5, 0x92, 0xa4, 0x64, 0xce, 0x77, 0xfb, 0x65, 0x34, 0x43, 0x8e, 0x0c, 0x26,
+	0x3f, 0x92, 0x2d, 0xaa, 0x9c, 0x1f, 0x3a, 0x41, 0x86, 0xdc, 0xe2, 0xd6, 0x0c, 0x4a, 0x90, 0xa0,
+	0x6c, 0xef, 0x21, 0x9b, 0x94, 0x6c, 0x86, 0xae, 0x1f, 0xb1, 0xaf, 0xf2, 0xda, 0x6c, 0xde, 0x0e,
+	0xc7, 0x4f, 0x9a, 0xc2, 0xd6, 0x7a, 0xa8, 0x41, 0x0a, 0x07, 0xfb, 0x5d, 0x68, 0x2c, 0xc4, 0x6e,
+	0x24, 0x2f, 0xbc, 0x72, 0xed, 0x03, 0x2d, 0x05, 0x0e, 0xd5, 0x17, 0xd3, 0xd8, 0x31, 0x8b, 0xe9,
+	0x4f, 0x2d, 0x34, 0xa3, 0x86, 0xe9, 0x0c, 0xf4, 0x72, 0x6d, 0x53, 0x2f, 0x77, 0x33, 0xab, 0xed,
+	0xb0, 0x8f, 0x2a, 0xee, 0xaf, 0xc6, 0xf5, 0xef, 0xa3, 0xc9, 0x10, 0x3e, 0xa5, 0xc7, 0xc6, 0x5b,
+	0x59, 0xe4, 0xc9, 0x31, 0x54, 0xa1, 0x47, 0x07, 0xc5, 0xeb, 0xb7, 0xd4, 0xdc, 0x09, 0x6e, 0xa9,
+	0x77, 0xd1, 0xa3, 0x1d, 0x6e, 0xab, 0x5b, 0xc1, 0x6e, 0xa3, 0xe5, 0xf9, 0x58, 0x1c, 0xd3, 0x4c,
+	0xeb, 0xf2, 0xf8, 0xe1, 0xc1, 0xe2, 0xa3, 0x1b, 0xe9, 0x28, 0xd0, 0xaf, 0xae, 0x99, 0x6e, 0xab,
+	0x30, 0x40, 0xba, 0xad, 0xbf, 0x23, 0x25, 0x73, 0x99, 0x60, 0xe0, 0x13, 0x59, 0x0d, 0x65, 0x5a,
+	0xaa, 0x01, 0x39, 0xa5, 0x2a, 0x9c, 0x29, 0x48, 0xf6, 0xfd, 0x2d, 0xf0, 0x63, 0x27, 0xb4, 0xc0,
+	0xab, 0x9c, 0x12, 0xe3, 0x0f, 0x33, 0xa7, 0xc4, 0xc4, 0xdb, 0x2a, 0xa7, 0xc4, 0x97, 0x2d, 0x74,
+	0xce, 0xed, 0x4d, 0xa3, 0x97, 0x8d, 0x33, 0x48, 0x4a, 0x7e, 0xbe, 0xea, 0xe3, 0xbc, 0x91, 0x69,
+	0xd9, 0x0a, 0x21, 0xad, 0x29, 0xce, 0x1b, 0x45, 0x34, 0x97, 0xbc, 0x20, 0x9d, 0x7e, 0xf2, 0xad,
+	0x5f, 0xb0, 0xd0, 0x9c, 0x58, 0xe0, 0xd2, 0xd7, 0x98, 0x29, 0x51, 0xd6, 0x32, 0xda, 0x57, 0xd8,
+	0x55, 0x4f, 0xaa, 0xf7, 0x37, 0x13, 0xdc, 0xa0, 0x87, 0x3f, 0x49, 0x16, 0x25, 0x95, 0x4a, 0x27,
+	0xca, 0xc4, 0x45, 0x93, 0x45, 0x55, 0x14, 0x09, 0xd0, 0xe9, 0x91, 0x64, 0x91, 0xa8, 0xae, 0x52,
+	0x7a, 0x64, 0x92, 0x61, 0x24, 0xe5, 0xb6, 0xa0, 0xee, 0xf2, 0xb2, 0x28, 0x02, 0x8d, 0xb1, 0xfd,
+	0x8b, 0xd4, 0x3f, 0x4a, 0xce, 0x04, 0x21, 0x2c, 0x7d, 0x2c, 0xeb, 0xad, 0x48, 0x29, 0xcc, 0xe5,
+	0x1d, 0x51, 0x03, 0x45, 0x60, 0x34, 0xc2, 0x79, 0x16, 0xc9, 0x60, 0x60, 0xb2, 0xb3, 0xd2, 0x70,
+	0xe0, 0x0d, 0x37, 0xde, 0xe1, 0x53, 0x50, 0xee, 0xac, 0x37, 0x04, 0x00, 0x14, 0x8e, 0xf3, 0x14,
+	0x9a, 0x7d, 0xce, 0x8d, 0xf1, 0x7d, 0x77, 0xbf, 0xb2, 0xb1, 0x3a, 0x60, 0x72, 0x08, 0xe7, 0xd7,
+	0xf2, 0xa8, 0xac, 0x6a, 0x25, 0x32, 0x2f, 0xfc, 0x84, 0x85, 0xd0, 0x4e, 0x1c, 0x77, 0x20, 0xe8,
+	0xaa, 0x23, 0x6f, 0x44, 0x79, 0x32, 0xd1, 0x44, 0x35, 0x50, 0x37, 0x37, 0x37, 0x37, 0x18, 0x23,
+	0xd0, 0x98, 0xd2, 0x36, 0x34, 0xc3, 0x4e, 0x1d, 0x54, 0xa8, 0xfb, 0xe9, 0xb5, 0xe1, 0x39, 0xd8,
+	0x58, 0x16, 0x6d, 0x50, 0x4c, 0x49, 0x58, 0x72, 0x5c, 0x17, 0xbd, 0x90, 0x3f, 0x8d, 0x16, 0xa8,
+	0x33, 0x73, 0x59, 0x74, 0x82, 0x62, 0xe9, 0x7c, 0x12, 0xcd, 0x3c, 0x17, 0xba, 0x9d, 0x1d, 0x2f,
+	0xc6, 0x27, 0xd2, 0x68, 0x1c, 0xab, 0xc6, 0x75, 0xfe, 0x8d, 0x85, 0x6c, 0xe5, 0x2d, 0xed, 0xf9,
+	0xcd, 0x75, 0x62, 0xdd, 0x26, 0x4a, 0x18, 0x26, 0xdd, 0xa7, 0x29, 0x61, 0x6e, 0x4a, 0x08, 0x68,
+	0x58, 0x24, 0x75, 0x24, 0xfb, 0xf5, 0xa2, 0x54, 0x08, 0x8e, 0x1e, 0xad, 0x1e, 0x87, 0xa2, 0x4d,
+	0x6c, 0x7f, 0xb9, 0xa9, 0x38, 0x80, 0xce, 0x8e, 0x74, 0xd5, 0xaa, 0xbf, 0xdd, 0xea, 0x3e, 0x68,
+	0x6c, 0xa9, 0xae, 0xea, 0x84, 0xc1, 0xb6, 0xd7, 0xc2, 0xc9, 0xae, 0xda, 0x60, 0xc5, 0x20, 0xe0,
+	0x83, 0x75, 0xd5, 0x97, 0x72, 0xe8, 0xfc, 0x6a, 0x14, 0x7b, 0xc1, 0x0a, 0x8e, 0x62, 0x72, 0xa7,
+	0x21, 0x27, 0x5f, 0xb7, 0x35, 0xc0, 0x62, 0x23, 0x06, 0x58, 0xee, 0x31, 0xdc, 0xdd, 0x8a, 0x70,
+	0xac, 0x09, 0x90, 0x72, 0x87, 0x5e, 0x4e, 0xc0, 0xa1, 0xa7, 0x06, 0xa1, 0xc2, 0x5d, 0x87, 0x15,
+	0x95, 0x7c, 0xc2, 0x18, 0x9c, 0x80, 0x43, 0x4f, 0x0d, 0x72, 0xf7, 0x71, 0x1b, 0x6c, 0x37, 0x74,
+	0x5b, 0xaa, 0x9c, 0x49, 0x9a, 0x25, 0x76, 0xf7, 0xa9, 0xa4, 0x21, 0x40, 0x7a, 0x3d, 0xe7, 0x9b,
+	0x79, 0x74, 0x8e, 0xf6, 0x4b, 0x62, 0x13, 0xf9, 0x7c, 0xbf, 0xf4, 0x2d, 0x23, 0xee, 0xfa, 0x94,
+	0xd7, 0x09, 0x92, 0xb7, 0xfc, 0x3d, 0x0b, 0xcd, 0x36, 0xcc, 0xa1, 0xcb, 0xc6, 0xbf, 0x21, 0x6d,
+	0x52, 0xb0, 0xb0, 0xc0, 0x44, 0x21, 0x24, 0xf9, 0xdb, 0x5f, 0xb4, 0xd0, 0xac, 0xd9, 0x4c, 0xb1,
+	0xcf, 0x9c, 0x42, 0x27, 0xc9, 0x38, 0x7e, 0xb3, 0x3c, 0x82, 0x64, 0x13, 0x9c, 0xdf, 0xcf, 0xf1,
+	0x21, 0x3d, 0x8d, 0xdc, 0x24, 0xf6, 0x7d, 0x54, 0x8a, 0x5b, 0x91, 0xb1, 0xab, 0x8e, 0xa8, 0xdf,
+	0xd8, 0x5c, 0xab, 0x25, 0xb7, 0xd3, 0xb5, 0x9a, 0xdc, 0x4e, 0x05, 0x2f, 0xca, 0x58, 0x6e, 0xe7,
+	0x99, 0x28, 0x56, 0xc4, 0xae, 0x7d, 0xcc, 0x3e, 0xfe, 0x55, 0x0b, 0x95, 0x6e, 0x05, 0x62, 0x63,
+	0xfa, 0xb1, 0x0c, 0x54, 0x96, 0x52, 0xba, 0x91, 0xf7, 0x5b, 0x25, 0x30, 0x7f, 0xc4, 0x50, 0x58,
+	0x3e, 0xa1, 0xd1, 0x5e, 0xa2, 0x6f, 0x7c, 0x10, 0x52, 0xb7, 0x82, 0xad, 0xbe, 0x6e, 0x38, 0xdf,
+	0xca, 0xa1, 0xd9, 0x5b, 0xdd, 0x46, 0x13, 0x13, 0x7d, 0x8e, 0x1b, 0x7a, 0xd1, 0x40, 0x5e, 0x4d,
+	0x1d, 0x34, 0xc6, 0x76, 0x2c, 0xce, 0x77, 0x44, 0x39, 0x9d, 0x36, 0x80, 0xb9, 0x63, 0x4a, 0x31,
+	0x88, 0xed, 0x91, 0xc0, 0xf9, 0xd8, 0x7b, 0x68, 0x62, 0xcb, 0x8d, 0x30, 0x91, 0x4a, 0xcb, 0xf9,
+	0x8c, 0x79, 0xca, 0xfe, 0xad, 0x72, 0x0e, 0x20, 0x79, 0xd9, 0x15, 0x54, 0x6a, 0x78, 0x21, 0xae,
+	0x6b, 0xea, 0xfd, 0x27, 0xc5, 0xf0, 0xaf, 0x08, 0x00, 0x91, 0xc9, 0x29, 0x3d, 0x59, 0x02, 0xaa,
+	0x96, 0xf3, 0xef, 0x72, 0x68, 0x92, 0x42, 0xf9, 0x94, 0xf8, 0x29, 0x8b, 0xa5, 0x6a, 0x65, 0xbd,
+	0x9d, 0xd1, 0x8d, 0x2b, 0x31, 0x86, 0x4a, 0x13, 0xab, 0xca, 0x22, 0xd0, 0xd9, 0xda, 0x9f, 0x41,
+	0xa5, 0x58, 0x7a, 0x19, 0xe7, 0xb2, 0xd0, 0x3e, 0x3f, 0xef, 0xee, 0x63, 0x3f, 0x76, 0x95, 0x77,
+	0xb1, 0x5a, 0x28, 0xa2, 0x08, 0x14, 0x4f, 0xfb, 0x6f, 0xa0, 0xd9, 0x3a, 0xc9, 0x7e, 0xd2, 0xc0,
+	0x7e, 0x1d, 0xaf, 0xe1, 0x3d, 0xee, 0xeb, 0x5a, 0x64, 0xdb, 0xe9, 0xb2, 0x09, 0x82, 0x24, 0xae,
+	0xf3, 0xe6, 0x04, 0x42, 0x6a, 0x10, 0x49, 0xe0, 0x64, 0x27, 0x0c, 0xda, 0x38, 0xde, 0xc1, 0xd2,
+	0xb7, 0xe7, 0xf6, 0xa8, 0x59, 0xf2, 0x04, 0x3d, 0xe1, 0xe3, 0x49, 0x5d, 0x90, 0x64, 0x29, 0x68,
+	0x1c, 0xed, 0x2d, 0x94, 0xbf, 0x8f, 0xb7, 0x78, 0x47, 0x3e, 0x97, 0x91, 0x39, 0xa6, 0x3a, 0x4e,
+	0xd2, 0xcc, 0xdc, 0xc3, 0x5b, 0x40, 0x88, 0xdb, 0x21, 0x1a, 0x6f, 0x30, 0xcb, 0x39, 0x5f, 0x03,
+	0xcf, 0x67, 0x68, 0x86, 0x67, 0xd1, 0xb7, 0xbc, 0x08, 0x04, 0x23, 0xfb, 0x55, 0x54, 0xba, 0xef,
+	0xee, 0xe1, 0xed, 0x30, 0xf0, 0xe3, 0x6c, 0x42, 0x1c, 0xef, 0x09, 0x72, 0x9c, 0x2f, 0xf5, 0xc2,
+	0x94, 0x85, 0xa0, 0xd8, 0x91, 0x45, 0xef, 0x93, 0x94, 0x1b, 0x2d, 0xaf, 0x9e, 0x4d, 0x74, 0xe3,
+	0x6d, 0x4e, 0x8d, 0x73, 0xa6, 0x4e, 0x1d, 0xa2, 0x0c, 0x24, 0x2f, 0x32, 0x97, 0xea, 0xd2, 0xfb,
+	0xb3, 0x3c, 0x96, 0xc5, 0x5c, 0x4a, 0x7a, 0x93, 0xb2, 0xb9, 0xa4, 0x4a, 0x41, 0xe3, 0x48, 0xbe,
+	0xbb, 0xc9, 0x45, 0x81, 0xf2, 0x78, 0x16, 0xdf, 0x6d, 0x0a, 0x16, 0xec, 0xbb, 0x45, 0x19, 0x48,
+	0x5e, 0x84, 0xaf, 0xc7, 0xef, 0xd5, 0xd9, 0xc4, 0x4d, 0x9a, 0xb7, 0x74, 0xc6, 0x57, 0x94, 0x81,
+	0xe4, 0x45, 0xfa, 0x3b, 0xda, 0xdd, 0xbf, 0xef, 0xb6, 0x76, 0x49, 0x88, 0x5a, 0x29, 0x93, 0x78,
+	0xc1, 0xdd, 0xfd, 0x7b, 0x8c, 0x9e, 0xde, 0xdf, 0xaa, 0x14, 0x34, 0x8e, 0xce, 0x37, 0x8b, 0x68,
+	0x9a, 0x6f, 0x5e, 0xc3, 0x8b, 0x5e, 0xc4, 0x10, 0xd6, 0xa1, 0x61, 0x12, 0x9a, 0xda, 0x56, 0x19,
+	0xc2, 0x14, 0x08, 0x74, 0x3c, 0x25, 0x26, 0xb0, 0x0c, 0x50, 0x69, 0x17, 0xfc, 0xe5, 0x04, 0x1c,
+	0x7a, 0x6a, 0x90, 0x50, 0x1a, 0x9e, 0x54, 0xb7, 0x52, 0xaf, 0x07, 0x5d, 0x9f, 0x09, 0x0a, 0xec,
+	0x9c, 0x92, 0xf6, 0x83, 0xf5, 0x1e, 0x0c, 0x48, 0xa9, 0x45, 0x12, 0x32, 0xd1, 0x3d, 0xb6, 0xc9,
+	0xb5, 0xc9, 0x3a, 0x45, 0x66, 0x51, 0x90, 0x09, 0x99, 0x96, 0xfb, 0xe0, 0x41, 0x5f, 0x0a, 0xa4,
+	0xa5, 0x51, 0x1c, 0x84, 0x6e, 0x13, 0xeb, 0x74, 0xc7, 0xcc, 0x96, 0xd6, 0x7a, 0x30, 0x20, 0xa5,
+	0x96, 0x79, 0x74, 0x8d, 0x3f, 0x84, 0xa3, 0x2b, 0x44, 0x63, 0x11, 0xb1, 0xdc, 0x45, 0xe5, 0x89,
+	0x2c, 0x2c, 0x04, 0x9c, 0x3b, 0x35, 0x06, 0x6a, 0x26, 0x5b, 0xca, 0x01, 0x38, 0x27, 0x62, 0x39,
+	0x6f, 0x05, 0xc1, 0xee, 0x96, 0x5b, 0xdf, 0x2d, 0x97, 0x4c, 0xcb, 0xf9, 0x1a, 0x2f, 0x07, 0x89,
+	0xe1, 0xfc, 0x6e, 0x0e, 0x4d, 0xe9, 0x64, 0x07, 0xb8, 0xd4, 0xfd, 0xa4, 0x85, 0xa6, 0xea, 0x81,
+	0x1f, 0x87, 0x41, 0x4b, 0xa5, 0x95, 0x1e, 0x5d, 0xaa, 0x27, 0xa4, 0x56, 0x70, 0xec, 0x7a, 0x2d,
+	0xa5, 0x1d, 0x5b, 0xd6, 0xd8, 0x80, 0xc1, 0xd4, 0xfe, 0x39, 0x0b, 0xcd, 0xaa, 0x00, 0x7b, 0x65,
+	0x45, 0xcd, 0xb4, 0x21, 0x52, 0x3a, 0xba, 0x6e, 0x72, 0x82, 0x24, 0x6b, 0x67, 0x0b, 0xcd, 0x25,
+	0xe7, 0x06, 0xe9, 0xca, 0x8e, 0xcb, 0x77, 0x86, 0xbc, 0xea, 0x4a, 0x92, 0x7a, 0x0d, 0x28, 0x84,
+	0x8c, 0x55, 0xdb, 0x0d, 0x9b, 0x9e, 0xef, 0xb6, 0x68, 0x2f, 0xe6, 0xb5, 0x3b, 0x3c, 0x2f, 0x07,
+	0x89, 0xe1, 0xdc, 0x45, 0x73, 0xcf, 0x77, 0xb7, 0x70, 0xe8, 0xe3, 0x18, 0xf3, 0xab, 0x06, 0x4d,
+	0xd0, 0x66, 0x66, 0x29, 0xec, 0x49, 0xd0, 0x66, 0x82, 0x21, 0x89, 0xef, 0x7c, 0xaf, 0x80, 0xd0,
+	0x5a, 0xb0, 0xeb, 0x9d, 0x8e, 0x36, 0x89, 0x7c, 0x63, 0x8c, 0x7d, 0xd7, 0x8f, 0x57, 0x57, 0x92,
+	0x39, 0xef, 0x36, 0x79, 0x39, 0x48, 0x0c, 0x32, 0xac, 0x33, 0xae, 0x91, 0xeb, 0x30, 0x9b, 0x0c,
+	0x22, 0x66, 0xfe, 0x44, 0x2d, 0xf9, 0x9c, 0x51, 0x0e, 0x09, 0xde, 0xf6, 0xff, 0x87, 0xc6, 0xb9,
+	0x63, 0x0a, 0xdd, 0xda, 0xf2, 0xec, 0xf2, 0xc3, 0x7d, 0x57, 0x40, 0xc0, 0x0c, 0x6f, 0x95, 0xb1,
+	0xe3, 0xbc, 0x55, 0x74, 0xaf, 0x9c, 0xf1, 0xb3, 0xf5, 0xca, 0xf9, 0x9c, 0x85, 0x50, 0xe8, 0xfa,
+	0xfc, 0x2e, 0x5c, 0x9e, 0xc8, 0xc2, 0x29, 0x5c, 0xbb, 0xe7, 0x4a, 0xca, 0xc4, 0x93, 0x80, 0x47,
+	0x62, 0xc9, 0x32, 0xd0, 0x38, 0x3b, 0xef, 0x43, 0x53, 0xeb, 0xe4, 0x57, 0x83, 0x4b, 0xe1, 0xc7,
+	0xeb, 0xa6, 0xff, 0xbc, 0x80, 0x26, 0x35, 0x3b, 0xd3, 0xe9, 0x1b, 0x64, 0x8c, 0x37, 0x48, 0xf2,
+	0x19, 0xbe, 0x41, 0xf2, 0x71, 0x84, 0x48, 0x50, 0x74, 0xb4, 0x73, 0xc2, 0xd7, 0x4d, 0x68, 0xbf,
+	0xde, 0x90, 0x14, 0x40, 0xa3, 0xa6, 0xc2, 0x88, 0x8a, 0x47, 0xbc, 0x8d, 0xf6, 0x86, 0xa5, 0x29,
+	0x1b, 0xc6, 0xb2, 0x08, 0x9b, 0xd4, 0x06, 0x66, 0x49, 0x28, 0x1f, 0x98, 0x9b, 0xee, 0x51, 0x3a,
+	0x89, 0x4d, 0x34, 0x11, 0xe2, 0xa8, 0xdb, 0xc6, 0x27, 0x7a, 0x87, 0x84, 0x5e, 0x12, 0x81, 0xd7,
+	0x07, 0x49, 0x69, 0xe1, 0x59, 0x34, 0x6d, 0x34, 0x61, 0x28, 0x07, 0xd5, 0x00, 0xa5, 0x1a, 0x33,
+	0x4f, 0xe2, 0x80, 0x48, 0xc6, 0xa2, 0xa5, 0xbd, 0xfa, 0x21, 0xc7, 0x82, 0x45, 0x56, 0x33, 0x98,
+	0xf3, 0xcd, 0x09, 0xc4, 0x23, 0x01, 0x07, 0x38, 0x79, 0x75, 0x27, 0xee, 0xdc, 0x09, 0x9c, 0xb8,
+	0x6f, 0xa1, 0x29, 0xcf, 0xf7, 0x62, 0xcf, 0x6d, 0x51, 0x43, 0x75, 0x39, 0x6f, 0xe4, 0x47, 0x9a,
+	0x5a, 0xd5, 0x60, 0x29, 0x74, 0x8c, 0xba, 0xf6, 0x0b, 0xa8, 0x48, 0x2f, 0x5a, 0xe5, 0xc2, 0x31,
+	0xda, 0xaa, 0x7e, 0x7e, 0xec, 0x34, 0x50, 0x81, 0x25, 0x4d, 0x64, 0x94, 0x52, 0x03, 0x9b, 0x8a,
+	0x99, 0x04, 0x36, 0x8d, 0x0d, 0x1b, 0xd8, 0x64, 0x6f, 0xa3, 0x29, 0x5e, 0xc6, 0xe2, 0xe5, 0xc7,
+	0x4f, 0xf8, 0x95, 0xd4, 0xa5, 0xeb, 0x86, 0x46, 0x09, 0x0c, 0xba, 0x76, 0x17, 0xcd, 0x7b, 0x7e,
+	0x3d, 0xf0, 0x89, 0x9f, 0x97, 0xb7, 0x87, 0x55, 0xc6, 0xc2, 0x93, 0x30, 0xbb, 0x40, 0xe2, 0x93,
+	0x57, 0x93, 0xe4, 0xa0, 0x97, 0x03, 0x31, 0xa4, 0x5d, 0xa8, 0x07, 0xf4, 0x40, 0x8a, 0xbd, 0x3d,
+	0x7c, 0x3d, 0x0c, 0x83, 0x90, 0xf1, 0x2e, 0x9d, 0x90, 0x37, 0xb5, 0x11, 0x2c, 0xa7, 0x91, 0x84,
+	0x74, 0x4e, 0xf6, 0xab, 0x68, 0xa2, 0x13, 0x06, 0x7b, 0x5e, 0x03, 0x87, 0x65, 0x94, 0xc5, 0x19,
+	0xcf, 0xd6, 0xd1, 0x06, 0xa7, 0xa9, 0xe5, 0xba, 0xe5, 0x25, 0x20, 0xf9, 0x91, 0x67, 0x8a, 0x1e,
+	0xd5, 0x5a, 0xc5, 0xa7, 0x15, 0xeb, 0x81, 0xc9, 0x13, 0xf6, 0x00, 0xf5, 0x99, 0x59, 0x4e, 0x27,
+	0x0a, 0xfd, 0xb8, 0x91, 0xab, 0x83, 0x78, 0xb9, 0xa8, 0x3c, 0x65, 0x5e, 0x1d, 0xc4, 0x13, 0x47,
+	0x20, 0x31, 0x88, 0x2a, 0x9d, 0x25, 0xe1, 0xe2, 0xe9, 0x15, 0xa8, 0x2a, 0x9d, 0xe7, 0xb4, 0xe1,
+	0x10, 0xe7, 0xab, 0xf3, 0x68, 0xc6, 0xec, 0x8a, 0x87, 0xae, 0xf4, 0x0a, 0xd1, 0xf8, 0x2e, 0xbb,
+	0x1d, 0x97, 0x73, 0x59, 0x28, 0xa4, 0x0c, 0x21, 0x9c, 0xdd, 0xc9, 0x78, 0x11, 0x08, 0x46, 0x42,
+	0xd1, 0x96, 0x3f, 0x23, 0x45, 0x5b, 0xe1, 0xa1, 0x28, 0xda, 0x8a, 0x0f, 0x4f, 0xd1, 0x36, 0x76,
+	0x86, 0x8a, 0xb6, 0x2d, 0x94, 0x7f, 0x39, 0xd8, 0xca, 0x26, 0x72, 0xe6, 0x56, 0x60, 0x8c, 0xe5,
+	0xad, 0x60, 0x0b, 0x08, 0xf1, 0x84, 0x32, 0x6f, 0xe2, 0xa1, 0x2a, 0xf3, 0x4a, 0x0f, 0x49, 0x99,
+	0x87, 0x1e, 0x9a, 0x32, 0x6f, 0xf2, 0xac, 0x95, 0x79, 0xe4, 0x3d, 0x93, 0x97, 0x89, 0x59, 0xa0,
+	0x3c, 0x9d, 0x85, 0xd6, 0x40, 0x33, 0xdc, 0xb0, 0x9b, 0x0d, 0x2d, 0x00, 0xc6, 0xc2, 0xde, 0x46,
+	0x85, 0x20, 0x6e, 0x75, 0xca, 0x33, 0x59, 0x58, 0xa4, 0xee, 0x6c, 0xae, 0x6d, 0x70, 0x4e, 0x13,
+	0xe4, 0x82, 0x48, 0x7e, 0x03, 0xa5, 0x4f, 0xc2, 0x77, 0xa6, 0xb1, 0x1e, 0xf3, 0xc2, 0x73, 0xdd,
+	0x8c, 0xea, 0x53, 0xd5, 0x1b, 0x46, 0xc3, 0x92, 0xbc, 0x19, 0x00, 0x30, 0x59, 0x93, 0x8f, 0x6e,
+	0x05, 0xbb, 0x5e, 0x79, 0x2e, 0x8b, 0x8f, 0x56, 0x0a, 0x0a, 0xf6, 0xd1, 0xe4, 0x37, 0x50, 0xfa,
+	0x64, 0x73, 0x88, 0x5e, 0x69, 0x95, 0xe7, 0xb3, 0xd8, 0x1c, 0x6a, 0x2f, 0xac, 0xe9, 0x9b, 0x43,
+	0xed, 0x85, 0x35, 0x20, 0xc4, 0xc9, 0x64, 0xdd, 0x95, 0xaa, 0x97, 0xb2, 0x9d, 0x89, 0x2a, 0x31,
+	0xa1, 0xca, 0x61, 0x93, 0x55, 0x95, 0x82, 0xc6, 0x91, 0xbc, 0x82, 0x25, 0xd2, 0x04, 0x4e, 0x65,
+	0x11, 0x58, 0x69, 0xde, 0x0f, 0x78, 0xd6, 0x40, 0x26, 0xb1, 0xfd, 0xa0, 0x4c, 0x23, 0x42, 0x0b,
+	0x7f, 0xf6, 0xcf, 0x16, 0xcb, 0xd8, 0xaf, 0x07, 0x0d, 0xcf, 0x6f, 0x5e, 0x7d, 0x39, 0x0a, 0xfc,
+	0x25, 0x70, 0xef, 0x0b, 0x61, 0x99, 0xb7, 0x89, 0xbc, 0x68, 0xac, 0x91, 0x38, 0x4e, 0xe2, 0x9a,
+	0xd2, 0x25, 0xae, 0xdf, 0x18, 0x47, 0x53, 0xfa, 0x5b, 0xa0, 0x03, 0x88, 0x41, 0x52, 0xf4, 0xcf,
+	0x0d, 0x23, 0xfa, 0x13, 0xb5, 0xa5, 0xe6, 0x92, 0x2a, 0xbc, 0x0c, 0x56, 0x33, 0x93, 0x7c, 0x95,
+	0xda, 0x52, 0x2b, 0x8c, 0xc0, 0x60, 0x3a, 0x44, 0x84, 0x0a, 0x91, 0x1f, 0x99, 0x84, 0x55, 0x34,
+	0xe5, 0x47, 0x43, 0x66, 0xba, 0x86, 0x90, 0x7a, 0x2a, 0x92, 0xbb, 0x2a, 0x4b, 0xc1, 0x54, 0x7b,
+	0xc2, 0x52, 0xc3, 0x22, 0x01, 0x00, 0x44, 0x06, 0xc1, 0xe2, 0x8d, 0x32, 0xa9, 0x49, 0xbe, 0x41,
+	0x4b, 0x81, 0x43, 0x49, 0x78, 0x8b, 0x2e, 0x39, 0xf0, 0x44, 0xea, 0xe7, 0x95, 0xb8, 0xa8, 0x60,
+	0x60, 0x60, 0x92, 0xa6, 0xe3, 0x30, 0x0c, 0xc2, 0x72, 0xc9, 0x6c, 0x3a, 0xbd, 0xfd, 0x03, 0x83,
+	0x51, 0xcb, 0x46, 0x42, 0x30, 0xa0, 0x07, 0x50, 0x51, 0xb3, 0x6c, 0x24, 0xe0, 0xd0, 0x53, 0x83,
+	0x7c, 0x0c, 0xf7, 0xb2, 0x9e, 0x64, 0xf9, 0x7f, 0xfa, 0xf8, 0x47, 0x7f, 0x4e, 0x57, 0x7a, 0x64,
+	0xb8, 0x86, 0xd8, 0xac, 0x1d, 0x42, 0xeb, 0x71, 0x0b, 0xd9, 0xbd, 0xb2, 0x00, 0xbf, 0xce, 0x4b,
+	0x03, 0x47, 0xaf, 0x18, 0x01, 0x29, 0xb5, 0x0c, 0xe1, 0x61, 0xe6, 0x38, 0xe1, 0x61, 0x34, 0xcd,
+	0xc8, 0x4f, 0x5b, 0x68, 0xc6, 0xbc, 0xad, 0x65, 0xed, 0x4c, 0xa7, 0x2b, 0x5b, 0xf3, 0xfd, 0x95,
+	0xad, 0xce, 0x6f, 0x8c, 0xa1, 0x73, 0xb7, 0x9b, 0x9e, 0x9f, 0x7c, 0xa0, 0x6c, 0x05, 0xcd, 0xa9,
+	0x57, 0xd6, 0xc9, 0x23, 0x74, 0xde, 0x83, 0x64, 0x5e, 0x94, 0x4a, 0x02, 0x0e, 0x3d, 0x35, 0x54,
+	0xde, 0xce, 0x55, 0x9f, 0xc6, 0x38, 0xa4, 0xe7, 0xed, 0xe4, 0x40, 0x30, 0x71, 0xed, 0x3f, 0xb5,
+	0xd0, 0x13, 0xca, 0x21, 0x8e, 0x97, 0x56, 0xb4, 0x67, 0xe8, 0xd9, 0x9e, 0x13, 0x8d, 0x78, 0x69,
+	0xee, 0xfd, 0xf8, 0xa5, 0xca, 0x11, 0x5c, 0xd9, 0x9c, 0x14, 0x81, 0xd5, 0x4f, 0x1c, 0x85, 0x0a,
+	0x47, 0x36, 0x9f, 0xb8, 0x62, 0x18, 0x1f, 0x2c, 0x3d, 0x04, 0xa9, 0x2b, 0x46, 0xcd, 0x04, 0x41,
+	0x12, 0xd7, 0xfe, 0x7d, 0x0b, 0x95, 0x99, 0x69, 0x32, 0xa5, 0x6b, 0x98, 0xf7, 0x77, 0x90, 0x7d,
+	0xd7, 0x2c, 0xf7, 0xe1, 0xc8, 0xba, 0x45, 0xd9, 0x2a, 0xfb, 0xa0, 0x41, 0xdf, 0x26, 0x2f, 0xdc,
+	0x41, 0xef, 0x3c, 0xb6, 0xdf, 0x87, 0x7a, 0xde, 0xff, 0x79, 0x74, 0xf1, 0xc8, 0xd6, 0x0e, 0xb5,
+	0x62, 0xdf, 0xb4, 0xd0, 0x94, 0xfe, 0xd0, 0x12, 0xb5, 0xc4, 0x04, 0xbb, 0xd8, 0xbf, 0x1b, 0xb6,
+	0x92, 0x8f, 0x07, 0x6d, 0xd2, 0x72, 0x58, 0x03, 0x89, 0x41, 0xb0, 0xeb, 0x2d, 0x0f, 0xfb, 0xf1,
+	0x6a, 0xcf, 0xe3, 0x41, 0xcb, 0xac, 0x7c, 0x05, 0x24, 0x06, 0x39, 0x2b, 0xd8, 0xff, 0x2c, 0x0b,
+	0x01, 0x57, 0x2d, 0x2a, 0x43, 0x9e, 0x06, 0x03, 0x03, 0x93, 0xa8, 0x34, 0xb8, 0x8d, 0xb4, 0xa0,
+	0xbc, 0x03, 0x4d, 0x9b, 0xa6, 0xf3, 0xab, 0x05, 0x84, 0xd4, 0xb5, 0x77, 0x18, 0x13, 0xd5, 0x49,
+	0x32, 0x80, 0xbd, 0x86, 0xc6, 0x68, 0xea, 0x3c, 0xb1, 0x5c, 0x37, 0xb3, 0xba, 0xaf, 0xb3, 0xec,
+	0x46, 0x7c, 0xe2, 0xc9, 0x83, 0x8a, 0x15, 0x02, 0xe7, 0x49, 0x94, 0xbc, 0xd2, 0x7e, 0x9b, 0xc8,
+	0x40, 0x20, 0xec, 0xb7, 0x69, 0x4a, 0x5e, 0x51, 0x27, 0x25, 0xf2, 0xba, 0x38, 0x54, 0xe4, 0xf5,
+	0x43, 0x8b, 0x19, 0x27, 0xb7, 0x48, 0xad, 0x83, 0x86, 0x9a, 0xeb, 0x5f, 0xb3, 0x50, 0x89, 0x79,
+	0x42, 0x92, 0x68, 0x09, 0x33, 0xad, 0x47, 0x42, 0x5b, 0x5f, 0xd9, 0x58, 0x4d, 0x4b, 0xeb, 0x71,
+	0x99, 0x67, 0xa1, 0xc8, 0x99, 0xd7, 0x4e, 0x2d, 0xdb, 0x84, 0xb8, 0x98, 0xe6, 0xfb, 0x5e, 0x4c,
+	0xaf, 0xa2, 0x92, 0x0c, 0x05, 0xe3, 0x63, 0xa7, 0xb2, 0x73, 0x08, 0x00, 0x28, 0x1c, 0xe7, 0xd7,
+	0x2d, 0x34, 0x43, 0x73, 0xf5, 0x28, 0xc5, 0xf3, 0x07, 0x64, 0x74, 0x26, 0x6b, 0xf7, 0x45, 0x33,
+	0x3a, 0xf3, 0xad, 0x83, 0xc5, 0x49, 0x5a, 0x23, 0x11, 0xac, 0xf9, 0x09, 0x6e, 0xad, 0xa2, 0x31,
+	0xa4, 0xb9, 0xa1, 0x8d, 0x29, 0xaa, 0x99, 0x82, 0x08, 0x28, 0x7a, 0xce, 0x6b, 0x68, 0x4a, 0x4f,
+	0x77, 0x4a, 0x5c, 0x59, 0x3a, 0xe4, 0x75, 0x52, 0x23, 0x2d, 0xb6, 0x74, 0x65, 0xd9, 0x50, 0x20,
+	0xd0, 0xf1, 0x68, 0xb5, 0x40, 0x55, 0x4b, 0x78, 0xc0, 0x6c, 0x04, 0x7a, 0x35, 0xf5, 0xc3, 0xf1,
+	0x11, 0x52, 0x59, 0xcd, 0x07, 0xb2, 0x92, 0x8c, 0x31, 0xef, 0x12, 0x26, 0x6c, 0xd0, 0xe4, 0x54,
+	0x63, 0x6c, 0x0b, 0x7c, 0xeb, 0xe0, 0x28, 0x61, 0x86, 0xd5, 0x72, 0xbe, 0x96, 0x47, 0xe7, 0x52,
+	0xd2, 0xf8, 0x12, 0xb3, 0x99, 0xd8, 0x17, 0x98, 0x2b, 0xe6, 0x4b, 0x99, 0xa7, 0x0a, 0x1e, 0x68,
+	0x83, 0xf8, 0x25, 0x8b, 0x84, 0xd4, 0xab, 0x83, 0x93, 0x45, 0xc1, 0x6c, 0x65, 0xdf, 0x98, 0x9e,
+	0xb3, 0x52, 0x0b, 0xdb, 0x97, 0x10, 0xd0, 0xdb, 0x32, 0xc2, 0x12, 0x5e, 0xf8, 0x08, 0x9a, 0x1b,
+	0xe9, 0xb8, 0xfb, 0x18, 0x1a, 0xf6, 0xe5, 0x61, 0x22, 0x3b, 0xdc, 0xd7, 0x9f, 0xac, 0x90, 0x3d,
+	0x9e, 0xd0, 0xa8, 0xff, 0x5e, 0x01, 0xcd, 0x25, 0xf5, 0xdd, 0x99, 0xfb, 0x49, 0xa4, 0x78, 0x3e,
+	0xe4, 0xdf, 0x1e, 0x9e, 0x0f, 0x85, 0x01, 0x3d, 0x1f, 0x8a, 0xc3, 0x78, 0x3e, 0x8c, 0x3d, 0x54,
+	0xcf, 0x87, 0xf1, 0x87, 0xe6, 0xf9, 0xf0, 0x0b, 0x16, 0x2a, 0xf7, 0xab, 0x48, 0x26, 0x0a, 0xdd,
+	0x75, 0xcb, 0x96, 0x39, 0x51, 0xe8, 0xae, 0x0c, 0x0c, 0x46, 0xde, 0x98, 0xc4, 0x7e, 0x23, 0xf9,
+	0xc6, 0xe4, 0x75, 0xbf, 0x01, 0xa4, 0xdc, 0xbe, 0x46, 0xd2, 0xbd, 0xe2, 0x4e, 0x22, 0x71, 0x46,
+	0x81, 0x6c, 0x9e, 0x29, 0xf7, 0x06, 0x8a, 0xeb, 0xfc, 0xe7, 0x1c, 0x9a, 0x02, 0xdc, 0xc2, 0x6e,
+	0x84, 0x37, 0x43, 0xd7, 0xf3, 0xcf, 0x20, 0x83, 0x4a, 0xc7, 0x08, 0x48, 0xb8, 0x3d, 0x6a, 0xde,
+	0x6a, 0xd5, 0xf6, 0xbe, 0x39, 0x54, 0x1e, 0x24, 0x72, 0xa8, 0x6c, 0x64, 0xc8, 0xf3, 0xe8, 0x2c,
+	0x2a, 0x6f, 0xe4, 0xd0, 0x79, 0x1d, 0x5d, 0x3e, 0x4a, 0x66, 0xbe, 0xb0, 0x6f, 0x3d, 0x9c, 0x17,
+	0xf6, 0x45, 0x56, 0x91, 0xdc, 0x69, 0x67, 0x15, 0x71, 0x3c, 0x34, 0xaf, 0x77, 0xc3, 0x6a, 0x9b,
+	0xa8, 0xb9, 0xae, 0xa2, 0x52, 0x3d, 0xf0, 0x63, 0x97, 0x4c, 0xa8, 0x64, 0x68, 0xeb, 0xb2, 0x00,
+	0x80, 0xc2, 0x21, 0x8b, 0xc4, 0x6b, 0x2b, 0x0f, 0x1e, 0x95, 0xb1, 0x81, 0x14, 0x02, 0x83, 0x39,
+	0xdf, 0xb6, 0xd0, 0x9c, 0xce, 0xeb, 0x0c, 0x72, 0x52, 0x04, 0x66, 0x4e, 0x8a, 0x5b, 0xd9, 0x4d,
+	0xaf, 0x3e, 0x59, 0x29, 0xbe, 0x63, 0xa1, 0xc7, 0x74, 0x34, 0x91, 0x66, 0x6b, 0xd0, 0xd4, 0x3c,
+	0xcf, 0x98, 0x7a, 0x54, 0x27, 0xa9, 0x47, 0x35, 0x06, 0xab, 0x9f, 0x1b, 0x55, 0xfe, 0x18, 0x2d,
+	0xe6, 0x0a, 0x9a, 0xa3, 0xef, 0xde, 0x05, 0xdd, 0x48, 0xbc, 0x1e, 0x50, 0x2e, 0x98, 0x5a, 0x99,
+	0x8d, 0x04, 0x1c, 0x7a, 0x6a, 0x38, 0xdf, 0xc8, 0x9b, 0xc3, 0x49, 0xaf, 0xa1, 0xf7, 0xd1, 0x18,
+	0x1d, 0x6c, 0xb1, 0x72, 0xee, 0x64, 0xd7, 0xe3, 0x74, 0x2e, 0xa9, 0xf5, 0x4c, 0x7f, 0x46, 0xc0,
+	0xd9, 0xd9, 0x35, 0x34, 0x2d, 0x96, 0xcf, 0x06, 0xb5, 0x16, 0xb2, 0x8b, 0xe6, 0x7b, 0x89, 0x7e,
+	0x68, 0x53, 0x07, 0x1c, 0x79, 0xdf, 0x34, 0x69, 0xd8, 0x11, 0x2a, 0x12, 0x43, 0xab, 0x10, 0x3a,
+	0x33, 0xdc, 0x11, 0x89, 0x29, 0x57, 0x4d, 0x21, 0xf2, 0x2b, 0x02, 0xc6, 0x8b, 0xbc, 0xf8, 0x46,
+	0xea, 0x12, 0xc1, 0xf1, 0x8e, 0xcf, 0xdd, 0x54, 0x78, 0xe6, 0x0e, 0x99, 0xf0, 0x1e, 0x92, 0x08,
+	0xd0, 0x5b, 0x87, 0x2c, 0x4a, 0xfa, 0x92, 0x03, 0xbf, 0x04, 0x48, 0x6e, 0xf4, 0xe9, 0x07, 0x60,
+	0x30, 0xe7, 0x5b, 0x79, 0x64, 0xf7, 0x6e, 0x9b, 0x6a, 0x1e, 0x5a, 0x23, 0xcc, 0xc3, 0xe3, 0xdc,
+	0xf9, 0x3e, 0x80, 0x26, 0x79, 0xf2, 0x59, 0xd2, 0x01, 0x7c, 0xda, 0xaa, 0xe8, 0x27, 0x05, 0x02,
+	0x1d, 0xcf, 0xde, 0x17, 0xa3, 0x52, 0xc8, 0x42, 0x15, 0x90, 0x1c, 0x15, 0x7e, 0x6e, 0xa4, 0x8f,
+	0x8d, 0xe1, 0x80, 0x58, 0x3c, 0x35, 0x07, 0xc4, 0xb1, 0x2c, 0x1d, 0x10, 0x49, 0x40, 0xf4, 0x5c,
+	0xf2, 0x2b, 0x07, 0xd8, 0x8a, 0xae, 0xa0, 0x09, 0xd1, 0x5b, 0x3c, 0x48, 0x94, 0xb9, 0xf7, 0xf1,
+	0x32, 0x90, 0x50, 0xfb, 0x87, 0xd1, 0x74, 0xdb, 0x7d, 0xb0, 0x1c, 0xf8, 0x7c, 0x94, 0x78, 0x2c,
+	0x18, 0x35, 0x47, 0xae, 0xeb, 0x00, 0x30, 0xf1, 0xec, 0xd7, 0x7a, 0x9e, 0x60, 0x83, 0xec, 0x06,
+	0xf3, 0xd8, 0x5c, 0xb8, 0x07, 0x39, 0xf4, 0x48, 0xfa, 0xe8, 0xbf, 0x5d, 0x36, 0x6a, 0xe2, 0x15,
+	0x2a, 0xc7, 0xa0, 0x90, 0x85, 0x57, 0x68, 0xdf, 0xb3, 0x49, 0x1d, 0xa3, 0x29, 0x03, 0x9c, 0x48,
+	0x18, 0x57, 0x1c, 0x2c, 0x61, 0x9c, 0xf3, 0x0a, 0xea, 0xfb, 0x3e, 0x89, 0xfd, 0x3e, 0x23, 0xc1,
+	0xd8, 0x13, 0x89, 0x04, 0x63, 0x53, 0xb2, 0x82, 0xca, 0x2a, 0x66, 0xa4, 0xe3, 0x2f, 0xf6, 0x49,
+	0xc7, 0xff, 0x3e, 0xb4, 0x24, 0xf2, 0x9f, 0x0d, 0x26, 0x70, 0x3a, 0xd7, 0x91, 0x2d, 0x76, 0x53,
+	0x96, 0x7a, 0x93, 0x9e, 0x63, 0x57, 0x51, 0x29, 0xe4, 0x07, 0x5d, 0xc4, 0x25, 0x51, 0x79, 0x03,
+	0x12, 0x27, 0x60, 0x04, 0x0a, 0x87, 0x84, 0x62, 0x8f, 0xf3, 0x9e, 0x3b, 0x83, 0x9b, 0xfa, 0xae,
+	0x71, 0x53, 0x5f, 0xcd, 0x26, 0x15, 0x68, 0xbf, 0x4b, 0x7a, 0x94, 0xb8, 0xa4, 0x3f, 0x9f, 0x0d,
+	0xbb, 0xa3, 0xef, 0xe7, 0xbf, 0x5d, 0x44, 0xb3, 0x89, 0x94, 0xce, 0x6f, 0x8f, 0xab, 0x79, 0x64,
+	0x5c, 0xcd, 0xb3, 0xcb, 0x8e, 0x74, 0x64, 0xee, 0x3f, 0x95, 0xb7, 0x2a, 0xff, 0x30, 0xf3, 0x56,
+	0x15, 0xde, 0x56, 0x79, 0xab, 0x7e, 0xa5, 0x4f, 0xde, 0xaa, 0xe2, 0x69, 0xe5, 0xad, 0x7a, 0x74,
+	0xa8, 0x9c, 0x55, 0xff, 0x85, 0x88, 0x02, 0xfd, 0x92, 0x92, 0xd3, 0xb7, 0xaf, 0x43, 0x13, 0x9a,
+	0x4d, 0xae, 0xfb, 0x24, 0x4b, 0x19, 0x74, 0x94, 0x00, 0x40, 0x92, 0x3d, 0x49, 0x80, 0x49, 0x2f,
+	0x24, 0x64, 0xd7, 0x24, 0xda, 0x0a, 0xb6, 0xcf, 0x52, 0x6f, 0xe9, 0x9a, 0x56, 0x0e, 0x06, 0x96,
+	0xf3, 0x65, 0x0b, 0x95, 0xfb, 0x3d, 0x42, 0x30, 0xc0, 0x39, 0xfa, 0xc3, 0x89, 0x5c, 0x91, 0x8b,
+	0x3d, 0xb9, 0x22, 0x13, 0xae, 0x23, 0x1c, 0x7d, 0x88, 0x63, 0xd4, 0x21, 0x19, 0x4e, 0x78, 0x13,
+	0x79, 0x26, 0xcf, 0x01, 0x1a, 0x76, 0x55, 0x7f, 0x73, 0x26, 0x67, 0xca, 0xc0, 0x69, 0xef, 0xce,
+	0xd0, 0xfb, 0x12, 0x3b, 0xed, 0x44, 0xc6, 0x3e, 0x1e, 0x0e, 0xc1, 0xca, 0x40, 0x42, 0x9d, 0xaf,
+	0xe4, 0xd1, 0x79, 0xb3, 0x3d, 0x03, 0x77, 0x97, 0x52, 0x8f, 0xe6, 0x8e, 0x52, 0x8f, 0xd2, 0x34,
+	0xdc, 0xd4, 0xd2, 0x0b, 0xb5, 0x64, 0x84, 0x57, 0x8d, 0x97, 0x83, 0xc4, 0x20, 0xd8, 0xcc, 0xa0,
+	0x0a, 0xb5, 0x72, 0xc1, 0xc4, 0xe6, 0xb9, 0x1c, 0x6a, 0x20, 0x31, 0x08, 0xb6, 0xfc, 0x50, 0xe6,
+	0x07, 0x23, 0xb1, 0x7b, 0x3f, 0x96, 0x44, 0xc3, 0xf1, 0xc7, 0x1e, 0x04, 0x90, 0xbb, 0xc4, 0xc8,
+	0x89, 0x79, 0xd7, 0x04, 0x43, 0x12, 0x9f, 0x48, 0x44, 0x2a, 0xaf, 0xbe, 0x20, 0xc2, 0xfc, 0x64,
+	0xa4, 0x44, 0x54, 0x49, 0x22, 0x40, 0x6f, 0x1d, 0x7d, 0xce, 0x4c, 0x1c, 0x33, 0x67, 0xfe, 0x90,
+	0x48, 0xb7, 0x7c, 0x8c, 0xa4, 0x21, 0xe8, 0x19, 0xe3, 0xd2, 0xf2, 0x03, 0x89, 0x4b, 0xcb, 0xf9,
+	0x24, 0xfe, 0x5f, 0xa7, 0x44, 0x7d, 0x7b, 0xa5, 0x44, 0xfd, 0x0b, 0x0b, 0xcd, 0xf3, 0x31, 0x5a,
+	0xc1, 0x1d, 0xec, 0x93, 0x8c, 0x14, 0xfb, 0xa7, 0xb1, 0x15, 0x3c, 0x65, 0x66, 0x4a, 0xbe, 0x98,
+	0x14, 0x0e, 0xa6, 0xc4, 0xf3, 0x20, 0xba, 0x5c, 0x70, 0x0b, 0xd9, 0xe2, 0x3a, 0xa9, 0x8c, 0x2e,
+	0xc9, 0x78, 0x70, 0xe8, 0xc1, 0x80, 0x94, 0x5a, 0xce, 0x97, 0x0b, 0xe8, 0x82, 0xf8, 0x52, 0xa9,
+	0x5c, 0xa6, 0x53, 0xa7, 0x85, 0xe6, 0x42, 0x79, 0x01, 0xe3, 0x82, 0xac, 0x35, 0xf4, 0x60, 0xd2,
+	0x17, 0x7c, 0x21, 0x41, 0x07, 0x7a, 0x28, 0xdb, 0x0f, 0xd0, 0xf9, 0xb6, 0xeb, 0x77, 0xdd, 0x16,
+	0xb5, 0x8f, 0x2a, 0x8e, 0xc3, 0x5b, 0x43, 0xd9, 0xc3, 0x8c, 0x29, 0xb4, 0x20, 0x95, 0x83, 0xdd,
+	0x46, 0x8b, 0x71, 0x10, 0xbb, 0x2d, 0xad, 0x8a, 0xec, 0x09, 0x2d, 0xad, 0x6a, 0xbe, 0xfa, 0xe4,
+	0xe1, 0xc1, 0xe2, 0xe2, 0xe6, 0xd1, 0xa8, 0x70, 0x1c, 0xad, 0x53, 0x0d, 0x20, 0xdc, 0x24, 0x4e,
+	0x79, 0x22, 0x63, 0x33, 0x7f, 0x3b, 0x88, 0xad, 0x89, 0x2b, 0xcc, 0x21, 0xcf, 0x84, 0xbd, 0x95,
+	0x52, 0x06, 0x3d, 0x14, 0x9c, 0xff, 0x54, 0x94, 0x53, 0xc4, 0x7c, 0x34, 0x9d, 0xbc, 0xc4, 0xdd,
+	0x73, 0xcd, 0xbe, 0x97, 0xf1, 0xeb, 0xec, 0xf2, 0x9d, 0xad, 0xd3, 0x4d, 0xaa, 0xfb, 0x45, 0x3d,
+	0x99, 0x2d, 0xbb, 0x3a, 0x6f, 0x9f, 0xc2, 0x3b, 0xf3, 0xc3, 0xe6, 0xb5, 0x55, 0xd7, 0xf9, 0xc2,
+	0x19, 0x5c, 0xe7, 0xbf, 0x7c, 0xd6, 0xf7, 0xe4, 0xa1, 0xf3, 0xbb, 0x66, 0x9e, 0xe8, 0xd7, 0xf9,
+	0x5c, 0x1e, 0x5d, 0x19, 0x74, 0xa8, 0xde, 0x86, 0x59, 0xe5, 0x23, 0x23, 0xab, 0xfc, 0x19, 0x09,
+	0x99, 0xa7, 0x92, 0x60, 0xfe, 0x2b, 0x05, 0xf4, 0x58, 0xcf, 0x40, 0x88, 0xfe, 0x1a, 0xc8, 0x73,
+	0x64, 0x9c, 0x28, 0x21, 0xc8, 0x23, 0x3d, 0x39, 0xe3, 0xd6, 0x35, 0x5e, 0x63, 0xc5, 0x4c, 0xd7,
+	0x26, 0x1e, 0xe4, 0xe5, 0x85, 0x20, 0x2a, 0x0d, 0x7e, 0x2b, 0xb7, 0x3f, 0xa3, 0x69, 0x6d, 0x0a,
+	0xa7, 0xf5, 0xee, 0xf4, 0x51, 0x5e, 0xc8, 0x2f, 0xa1, 0x89, 0x48, 0x3c, 0xd0, 0x57, 0x3c, 0xf9,
+	0x03, 0x7d, 0xf4, 0xfb, 0xc4, 0x2f, 0x90, 0x24, 0xb5, 0x38, 0xc5, 0xb1, 0x7e, 0x71, 0x8a, 0x76,
+	0x8c, 0xc6, 0x23, 0xee, 0x0a, 0x34, 0x9e, 0x85, 0x30, 0x2a, 0xf3, 0x19, 0x33, 0xa2, 0xcc, 0x61,
+	0x81, 0xff, 0x00, 0xc1, 0x8a, 0xbc, 0x68, 0x31, 0xc9, 0xe7, 0xc8, 0x19, 0xd8, 0x04, 0x5f, 0x36,
+	0x6d, 0x82, 0xd7, 0x33, 0x39, 0x0f, 0xfa, 0x98, 0x03, 0x5f, 0x46, 0x53, 0xfa, 0x4b, 0x70, 0xe4,
+	0x55, 0x7b, 0x79, 0x9e, 0x59, 0xa3, 0xbc, 0x6a, 0x2f, 0x4e, 0x3c, 0x75, 0xd6, 0x11, 0xd3, 0xe3,
+	0x6c, 0xe2, 0x55, 0x9f, 0x33, 0xd0, 0x44, 0x46, 0x86, 0x26, 0xf2, 0x85, 0x4c, 0x1f, 0x25, 0xea,
+	0x9b, 0xf9, 0xf0, 0x2f, 0x2c, 0x74, 0x2e, 0x81, 0x7b, 0x06, 0x13, 0x27, 0x34, 0x27, 0xce, 0x7a,
+	0xa6, 0xdf, 0xda, 0x67, 0x02, 0x7d, 0x10, 0xd9, 0x09, 0xc4, 0x81, 0x0e, 0x2c, 0xe7, 0x0b, 0xbd,
+	0x3d, 0x44, 0xf5, 0xda, 0x0f, 0xef, 0x1d, 0x2b, 0xe7, 0xab, 0x93, 0x72, 0x95, 0xd3, 0xa6, 0xe8,
+	0x3b, 0xb3, 0x75, 0xe4, 0xce, 0xac, 0x6f, 0x8c, 0xb9, 0xec, 0x37, 0xc6, 0x17, 0x48, 0x36, 0x1c,
+	0xb6, 0x4f, 0x71, 0x39, 0xfa, 0x49, 0x8d, 0xfc, 0x12, 0x11, 0xc6, 0x97, 0xf6, 0x8c, 0xed, 0x9c,
+	0x4e, 0x4c, 0x2d, 0x65, 0x0e, 0x2b, 0x05, 0x49, 0xc6, 0x7e, 0x15, 0x4d, 0xde, 0x0f, 0xc2, 0xdd,
+	0x56, 0xe0, 0x92, 0x54, 0xe1, 0x65, 0x94, 0x45, 0x1c, 0x9c, 0xf4, 0xa5, 0x65, 0x19, 0x96, 0xef,
+	0x29, 0xfa, 0xa0, 0x33, 0x23, 0x0a, 0x97, 0xb6, 0xe7, 0x03, 0x76, 0x1b, 0xf2, 0x16, 0x55, 0x30,
+	0x15, 0x2e, 0xeb, 0x26, 0x18, 0x92, 0xf8, 0xd4, 0xef, 0x2d, 0x34, 0x8c, 0x22, 0xe5, 0xe9, 0x4c,
+	0xfc, 0x73, 0x7a, 0x0c, 0x2d, 0x2c, 0x23, 0xb0, 0x59, 0x0e, 0x09, 0xde, 0xf6, 0xa7, 0x88, 0x32,
+	0x8b, 0x3d, 0x55, 0x99, 0x4d, 0x74, 0xb5, 0x94, 0x5c, 0x19, 0x51, 0x5d, 0x37, 0xc6, 0x4a, 0x40,
+	0x32, 0xb4, 0xd7, 0xd0, 0x79, 0x21, 0x60, 0xdf, 0xf4, 0xa2, 0x38, 0x08, 0xf7, 0x59, 0x4a, 0x02,
+	0x76, 0x88, 0x52, 0xb1, 0x14, 0x52, 0xe0, 0x90, 0x5a, 0x8b, 0x68, 0x35, 0xe8, 0x0b, 0xa0, 0x2c,
+	0xce, 0x4b, 0x0b, 0x8d, 0xa2, 0xe7, 0x03, 0x79, 0x20, 0x9c, 0xfe, 0x3d, 0xea, 0x35, 0x90, 0x89,
+	0x11, 0x5e, 0x03, 0xa9, 0xa1, 0x0b, 0x49, 0x10, 0xf5, 0x06, 0x28, 0x4f, 0x99, 0x57, 0xbc, 0x8d,
+	0x34, 0x24, 0x48, 0xaf, 0x4b, 0x8c, 0xe2, 0x21, 0xa6, 0x3a, 0xe1, 0x8a, 0xc8, 0x55, 0x31, 0xb4,
+	0x51, 0x1c, 0x04, 0x01, 0x50, 0xb4, 0xc8, 0xb8, 0x4b, 0xf3, 0xf0, 0x64, 0xc6, 0xc2, 0x8a, 0x1c,
+	0xfb, 0x7e, 0xaf, 0x83, 0xbe, 0x6e, 0xa1, 0x52, 0x83, 0x6a, 0x89, 0xa2, 0x3b, 0x7e, 0x79, 0x26,
+	0x13, 0x6f, 0x96, 0xa4, 0xee, 0x49, 0x49, 0xbf, 0x2b, 0x82, 0x13, 0x28, 0xa6, 0x24, 0x1b, 0x07,
+	0x97, 0x0a, 0xa2, 0xf2, 0x6c, 0x16, 0xcf, 0x4e, 0x98, 0x4a, 0x67, 0x3d, 0x78, 0x84, 0x71, 0x01,
+	0xc9, 0xcf, 0x79, 0xf3, 0x1c, 0x9a, 0x36, 0x9d, 0x97, 0xa4, 0x3b, 0x89, 0xd5, 0xdf, 0x9d, 0xc4,
+	0xfe, 0x79, 0x0b, 0xcd, 0x76, 0x0c, 0xef, 0x79, 0x71, 0x5c, 0x8e, 0xd8, 0x74, 0xd3, 0x25, 0x5f,
+	0x4b, 0xa5, 0x66, 0x32, 0x83, 0x24, 0x77, 0xb2, 0x1d, 0xf2, 0x24, 0x75, 0x2d, 0x1c, 0x52, 0x6c,
+	0x2e, 0x83, 0x49, 0x12, 0xcb, 0x26, 0x18, 0x92, 0xf8, 0x64, 0x82, 0xd3, 0xaf, 0x3b, 0xa1, 0x6e,
+	0x87, 0x4e, 0xf0, 0x8a, 0x20, 0x00, 0x8a, 0x16, 0x89, 0x0b, 0xe1, 0xae, 0x10, 0x1b, 0x41, 0x83,
+	0x26, 0x8a, 0x4b, 0xbc, 0x25, 0xb8, 0x6c, 0x40, 0x21, 0x81, 0x4d, 0xbf, 0x4d, 0x3d, 0x44, 0x4d,
+	0x09, 0x8c, 0x99, 0x99, 0xe6, 0x96, 0x4d, 0x30, 0x24, 0xf1, 0x0d, 0x65, 0xfe, 0xf8, 0x49, 0x94,
+	0xf9, 0x13, 0x43, 0x2a, 0xf3, 0x9f, 0x45, 0xd3, 0x21, 0x39, 0x6b, 0x24, 0x01, 0x16, 0x8f, 0x2a,
+	0x83, 0xf9, 0x40, 0x07, 0x82, 0x89, 0x9b, 0x6e, 0x09, 0x40, 0x27, 0xb0, 0x04, 0xfc, 0x2d, 0x34,
+	0xa7, 0xf5, 0x04, 0x7d, 0x3c, 0x92, 0x6e, 0x31, 0x45, 0xa6, 0xda, 0x5c, 0x4e, 0xc0, 0xa0, 0x07,
+	0xdb, 0xfe, 0x10, 0x9a, 0xa9, 0x07, 0xad, 0x16, 0xdd, 0xe2, 0x69, 0xf8, 0x2f, 0xdd, 0x43, 0x8b,
+	0xec, 0x40, 0x5b, 0x36, 0x20, 0x90, 0xc0, 0x24, 0xaa, 0xde, 0x60, 0x8b, 0x48, 0x3f, 0xb8, 0xf1,
+	0x1c, 0xf6, 0x31, 0x17, 0x08, 0xa6, 0x4d, 0x55, 0xef, 0x9d, 0x1e, 0x0c, 0x48, 0xa9, 0x45, 0x8c,
+	0xe2, 0xfa, 0x83, 0x2d, 0x33, 0x99, 0x78, 0xaa, 0x25, 0x0c, 0x19, 0xc7, 0xbe, 0xd6, 0x12, 0xca,
+	0xa4, 0xe2, 0xb3, 0x59, 0xbc, 0x9e, 0xcd, 0x1f, 0x59, 0x48, 0x78, 0x08, 0x24, 0xd2, 0x8a, 0x7f,
+	0x1a, 0x95, 0xb6, 0x5a, 0x5d, 0xfc, 0x5c, 0x88, 0xb1, 0x5f, 0x9e, 0xcb, 0xe2, 0x5a, 0x50, 0x15,
+	0xe4, 0x38, 0x67, 0xb9, 0x3b, 0x4b, 0x00, 0x28, 0x96, 0xf6, 0xbb, 0xd0, 0xe4, 0xcd, 0x8d, 0x8a,
+	0x9c, 0x85, 0xf3, 0x74, 0xf4, 0x0b, 0xa4, 0x0a, 0xe8, 0x00, 0x6a, 0x8a, 0x13, 0xb7, 0x57, 0x3b,
+	0x61, 0x8a, 0xeb, 0xbd, 0x8c, 0xea, 0x86, 0xbb, 0x73, 0xc7, 0x1a, 0xee, 0x5e, 0x42, 0x93, 0xfc,
+	0xb8, 0xa4, 0x7b, 0xd3, 0xf9, 0x93, 0x3d, 0x06, 0x04, 0x8a, 0x04, 0xe8, 0xf4, 0x68, 0x74, 0x50,
+	0x18, 0xb4, 0x83, 0x18, 0xdf, 0xe8, 0xb6, 0x5a, 0xe5, 0x0b, 0x74, 0xdf, 0x54, 0xd1, 0x41, 0x0a,
+	0x04, 0x3a, 0x9e, 0x32, 0x7f, 0x3c, 0x32, 0x84, 0xf9, 0x43, 0xb3, 0xe3, 0x3c, 0x7a, 0x8c, 0x5b,
+	0xd4, 0x16, 0x5a, 0x10, 0x17, 0xde, 0xde, 0x45, 0x52, 0x2e, 0x1b, 0x7a, 0xe2, 0x85, 0x7b, 0x7d,
+	0x31, 0xe1, 0x08, 0x2a, 0x24, 0x83, 0x85, 0xdb, 0xda, 0x2a, 0x3f, 0x96, 0xc5, 0xcd, 0xbd, 0xb2,
+	0x56, 0xe5, 0x33, 0x8a, 0x66, 0xb0, 0xa8, 0xac, 0x55, 0x81, 0x10, 0xb7, 0x3d, 0x54, 0x70, 0x5b,
+	0x5b, 0x51, 0x79, 0xe1, 0x72, 0x3e, 0x4b, 0x26, 0x4a, 0xb7, 0xb7, 0x56, 0x25, 0xba, 0xbd, 0xd6,
+	0x56, 0x64, 0xff, 0x6d, 0x4d, 0xf1, 0xf0, 0x38, 0xfd, 0xa6, 0x5a, 0x36, 0x77, 0x19, 0xc3, 0xba,
+	0xd4, 0x4f, 0x37, 0x41, 0xec, 0x40, 0x3e, 0x7e, 0x10, 0x27, 0xa5, 0xc5, 0xf2, 0x13, 0x27, 0xb3,
+	0x03, 0xdd, 0x4e, 0xa1, 0x05, 0xa9, 0x1c, 0xc8, 0x35, 0x4e, 0x5d, 0xa2, 0x2e, 0x66, 0xe1, 0xbf,
+	0x92, 0x66, 0xb9, 0x3f, 0xea, 0x2a, 0x45, 0x36, 0xaa, 0x86, 0x78, 0x12, 0xbf, 0x7c, 0x29, 0x8b,
+	0x8d, 0x4a, 0x7b, 0x61, 0xdf, 0xdc, 0xa8, 0x24, 0x00, 0x14, 0x4b, 0xe7, 0xef, 0x17, 0x94, 0x62,
+	0x48, 0x48, 0x35, 0xaf, 0xe9, 0x9b, 0x27, 0x53, 0x95, 0xdc, 0xc9, 0x6c, 0xf3, 0xe4, 0x37, 0xeb,
+	0xe9, 0xbe, 0x5b, 0x67, 0xf2, 0x0d, 0x8a, 0xb5, 0x6c, 0x8e, 0x0b, 0xce, 0x17, 0xa5, 0x1c, 0x16,
+	0x5f, 0xb4, 0xd0, 0x7c, 0x23, 0x31, 0x37, 0x84, 0x3f, 0xdb, 0x9d, 0x6c, 0x55, 0x20, 0x11, 0xcb,
+	0xf9, 0xd7, 0x53, 0x0c, 0xbd, 0x0d, 0x20, 0xc3, 0xa0, 0xa6, 0x46, 0x21, 0x93, 0xd6, 0xa8, 0xa9,
+	0xa1, 0x0f, 0x43, 0xea, 0xc4, 0xf8, 0xbb, 0x53, 0xd2, 0x04, 0x98, 0xc8, 0xdb, 0x40, 0x54, 0x5d,
+	0x51, 0xec, 0x05, 0x19, 0xbe, 0x04, 0x64, 0x72, 0x60, 0x09, 0x99, 0x28, 0x00, 0x18, 0x2b, 0xc2,
+	0xd3, 0x27, 0xa9, 0x02, 0xb2, 0x51, 0x25, 0xa6, 0x64, 0x1d, 0x60, 0x3c, 0x29, 0x00, 0x18, 0x2b,
+	0xfb, 0x65, 0xb6, 0xcb, 0x67, 0x32, 0x0f, 0x2a, 0x6b, 0xd5, 0x04, 0x3f, 0x73, 0xb7, 0x7f, 0x19,
+	0xe5, 0xa3, 0xb6, 0x97, 0xcd, 0x28, 0xd7, 0xd6, 0x57, 0xd3, 0x78, 0xd5, 0xd6, 0x57, 0x81, 0x30,
+	0xa1, 0xa1, 0x75, 0x6e, 0x7b, 0xcb, 0x8d, 0x22, 0xb7, 0x21, 0xad, 0x09, 0x23, 0x86, 0xd6, 0x55,
+	0x24, 0xbd, 0x04, 0x6b, 0x6a, 0xbb, 0x56, 0x50, 0xd0, 0x38, 0xdb, 0xaf, 0xa2, 0x71, 0xb7, 0xd3,
+	0x59, 0xc7, 0x91, 0x78, 0x8b, 0x61, 0xc4, 0x63, 0xa7, 0xc2, 0x88, 0x25, 0x5a, 0x40, 0xcd, 0x0a,
+	0x1c, 0x04, 0x82, 0x21, 0xe1, 0x1d, 0x87, 0x2e, 0xde, 0xf6, 0x76, 0xcb, 0xe3, 0x59, 0xf0, 0xde,
+	0x64, 0xc4, 0xd2, 0x78, 0x73, 0x10, 0x08, 0x86, 0x24, 0x99, 0xe5, 0x74, 0xdb, 0xf5, 0x5d, 0x99,
+	0x4e, 0x39, 0x9b, 0x6c, 0xf3, 0x7a, 0x82, 0x66, 0x25, 0x32, 0xad, 0xeb, 0x8c, 0xc0, 0xe4, 0x4b,
+	0x9e, 0x29, 0x27, 0xc4, 0xbc, 0x07, 0x5c, 0x35, 0x03, 0xa3, 0x0e, 0x00, 0xa1, 0x95, 0xe8, 0x03,
+	0xba, 0xe3, 0x32, 0x08, 0x70, 0x6e, 0xf6, 0x6f, 0x5a, 0x68, 0x9c, 0xa5, 0xa2, 0x22, 0x12, 0x1a,
+	0xf9, 0xf6, 0x4f, 0x66, 0x72, 0xee, 0x9a, 0xac, 0x79, 0x9a, 0x2c, 0x1e, 0x0c, 0xfd, 0x43, 0x32,
+	0xd9, 0x0d, 0x2b, 0x3d, 0x32, 0x51, 0x96, 0x68, 0x1d, 0x91, 0x05, 0xdb, 0xae, 0xf8, 0x24, 0x66,
+	0x10, 0xd3, 0x65, 0xc1, 0xf5, 0x04, 0x0c, 0x7a, 0xb0, 0xe9, 0x72, 0x6b, 0xca, 0x17, 0x03, 0xcb,
+	0x53, 0x59, 0x2c, 0xb7, 0x7e, 0x8f, 0x3e, 0xb2, 0xe5, 0xa6, 0xa0, 0xa0, 0x71, 0x5e, 0xf8, 0x10,
+	0x9a, 0xd2, 0x3b, 0x64, 0xa8, 0xac, 0x5f, 0xdf, 0xcd, 0x23, 0x44, 0xe7, 0x0c, 0x7b, 0x5a, 0xb0,
+	0x8d, 0xc6, 0x48, 0x40, 0x6c, 0xd0, 0x28, 0x5b, 0x59, 0x38, 0x99, 0xeb, 0x2f, 0x04, 0xd2, 0xe9,
+	0xb2, 0x4e, 0x89, 0x03, 0x67, 0x62, 0x37, 0x49, 0x62, 0xfe, 0x78, 0x27, 0xfb, 0xe7, 0x08, 0x27,
+	0x58, 0x7e, 0xff, 0x78, 0x07, 0x28, 0x03, 0x72, 0x21, 0x94, 0x01, 0xcf, 0xcc, 0x23, 0xe4, 0xee,
+	0xa8, 0xf3, 0x52, 0xf4, 0xd9, 0x12, 0x0f, 0x71, 0x66, 0x93, 0xb1, 0x7f, 0x52, 0x8d, 0x37, 0x2c,
+	0x34, 0xa5, 0xa3, 0xa6, 0x0c, 0xd3, 0x8f, 0xeb, 0xc3, 0x94, 0x65, 0x7f, 0xe8, 0x23, 0xfe, 0x27,
+	0x39, 0x84, 0x88, 0x2a, 0xb4, 0xdb, 0x6e, 0x93, 0x3b, 0x92, 0x4c, 0x6e, 0x66, 0x0d, 0x9c, 0xdc,
+	0x2c, 0x37, 0x64, 0x72, 0xb3, 0xfc, 0x50, 0xc9, 0xcd, 0x0a, 0xc3, 0x27, 0x37, 0x2b, 0x1e, 0x91,
+	0xdc, 0xec, 0x5d, 0x09, 0x03, 0x78, 0x3f, 0xdf, 0x59, 0x95, 0xf3, 0x7a, 0xbb, 0xdb, 0xe2, 0xfb,
+	0xc1, 0xb8, 0x99, 0x04, 0xad, 0x96, 0x80, 0x43, 0x4f, 0x0d, 0x62, 0x81, 0x9b, 0xef, 0x39, 0xa6,
+	0x89, 0x44, 0x1d, 0x06, 0x41, 0xdc, 0x27, 0x4d, 0x07, 0x28, 0x10, 0xe8, 0x78, 0xa4, 0x49, 0x31,
+	0x23, 0x54, 0xeb, 0xb4, 0xbc, 0xd4, 0x87, 0x29, 0x37, 0x13, 0x70, 0xe8, 0xa9, 0x41, 0xcc, 0xa6,
+	0x25, 0x99, 0x4d, 0x91, 0x65, 0x69, 0xf3, 0xf6, 0x64, 0x84, 0xaf, 0xe6, 0x3e, 0x44, 0x4a, 0x81,
+	0x43, 0x49, 0x6c, 0x7b, 0x23, 0xf2, 0x93, 0xb1, 0xed, 0x2b, 0xb5, 0xdb, 0x40, 0xca, 0x55, 0x22,
+	0x85, 0xfc, 0x11, 0x89, 0x14, 0x96, 0x10, 0xea, 0xb8, 0xa1, 0xdb, 0xc6, 0x54, 0x0c, 0x63, 0x49,
+	0x85, 0x58, 0x72, 0x62, 0x59, 0x0a, 0x1a, 0xc6, 0xa8, 0x09, 0x73, 0x9c, 0x7f, 0x6d, 0xa1, 0x49,
+	0xed, 0xd1, 0x10, 0xd2, 0x48, 0x9a, 0xb6, 0xa8, 0x27, 0x88, 0x9f, 0x14, 0x02, 0x83, 0x31, 0x8f,
+	0xd3, 0xa6, 0x72, 0x35, 0xd3, 0x3c, 0x4e, 0x9b, 0x1e, 0xf3, 0x38, 0x6d, 0xf2, 0xb4, 0x34, 0x32,
+	0x9a, 0x5f, 0x7b, 0x43, 0x84, 0xfa, 0xc6, 0x53, 0x88, 0xca, 0x19, 0x50, 0x38, 0x3e, 0x67, 0x40,
+	0x31, 0x3d, 0x67, 0x80, 0x73, 0x07, 0x4d, 0xb1, 0x6c, 0x4c, 0xcf, 0xe3, 0xfd, 0xc1, 0x9c, 0x94,
+	0x2e, 0xb2, 0x5d, 0x24, 0x31, 0x50, 0xa4, 0x3a, 0x29, 0x77, 0x5c, 0x54, 0x62, 0x04, 0x07, 0xa3,
+	0x76, 0x0d, 0x21, 0xe9, 0xd0, 0xca, 0x32, 0x1b, 0x4c, 0xa8, 0x85, 0x2e, 0xbd, 0x5e, 0x1b, 0xa0,
+	0x61, 0x39, 0xff, 0xd4, 0x42, 0x33, 0x35, 0x1c, 0x73, 0x79, 0xac, 0xee, 0xb6, 0xb0, 0xe6, 0x75,
+	0x62, 0xf5, 0xf5, 0x3a, 0xd1, 0x2d, 0xc1, 0xb9, 0x23, 0x2d, 0xc1, 0xe4, 0xcd, 0x24, 0xb2, 0x8b,
+	0x99, 0x87, 0x35, 0xd3, 0xe7, 0xab, 0x37, 0x93, 0x7a, 0x30, 0x20, 0xa5, 0x96, 0xd3, 0x40, 0x73,
+	0xa4, 0xad, 0x4c, 0x4a, 0xbf, 0x27, 0x5b, 0x22, 0x95, 0x05, 0x96, 0x8a, 0x79, 0x4c, 0x11, 0xea,
+	0x07, 0x74, 0xc4, 0x77, 0xfe, 0x09, 0xeb, 0x12, 0xf5, 0x76, 0xef, 0x20, 0x4e, 0x4f, 0x5d, 0x54,
+	0x6c, 0xf3, 0x20, 0xe6, 0xfc, 0xe8, 0x56, 0xd7, 0xde, 0x77, 0x83, 0xd5, 0x8c, 0xe4, 0x67, 0x02,
+	0xe5, 0xe6, 0xfc, 0x21, 0x6b, 0xeb, 0xba, 0x47, 0x77, 0xcd, 0x01, 0xdb, 0xda, 0x36, 0xdb, 0x7a,
+	0x33, 0xab, 0xc3, 0x34, 0xbd, 0x8d, 0x74, 0x27, 0xc1, 0x61, 0x1d, 0xfb, 0xb1, 0xc8, 0xd7, 0x59,
+	0xe4, 0x3b, 0x89, 0x2c, 0x05, 0x0d, 0xc3, 0xf9, 0x57, 0x39, 0x34, 0x5d, 0xc3, 0x31, 0x1f, 0x15,
+	0xb7, 0x4d, 0xb3, 0x15, 0x6d, 0x87, 0x41, 0x5b, 0xf8, 0x1c, 0x88, 0x4f, 0xba, 0x11, 0x06, 0x6d,
+	0xa0, 0x10, 0x7b, 0x01, 0xe5, 0xe2, 0x80, 0x8f, 0x2b, 0xe2, 0xf0, 0xdc, 0x66, 0x00, 0xb9, 0x38,
+	0x20, 0xbe, 0xe0, 0x9e, 0x5f, 0x67, 0xa1, 0x48, 0x22, 0xce, 0x95, 0xa3, 0x94, 0x56, 0x05, 0x00,
+	0x14, 0x8e, 0xf1, 0x40, 0x44, 0xe1, 0x04, 0x0f, 0x44, 0xbc, 0xaa, 0x19, 0x41, 0x8b, 0x59, 0x18,
+	0xe1, 0x55, 0x57, 0x1c, 0x1b, 0x21, 0xfb, 0x79, 0xb2, 0x8d, 0x7a, 0xcd, 0xbd, 0xa7, 0x79, 0xb6,
+	0xba, 0x2b, 0xc9, 0xfc, 0x3a, 0xc9, 0x2d, 0x52, 0x80, 0xf5, 0x3c, 0x94, 0xb9, 0x63, 0xf2, 0x50,
+	0xbe, 0x1b, 0x8d, 0x87, 0x41, 0x0b, 0x57, 0x42, 0x3f, 0x19, 0xc8, 0x03, 0xa4, 0x18, 0x6e, 0x83,
+	0x80, 0x3b, 0xbf, 0x66, 0xa1, 0xb9, 0x64, 0x42, 0xe9, 0xcc, 0x93, 0xfe, 0xe8, 0x03, 0x96, 0x1f,
+	0x7e, 0xc0, 0x9c, 0xef, 0x15, 0xd1, 0x1c, 0x39, 0x0b, 0x44, 0x82, 0x2c, 0x61, 0x3c, 0xf5, 0xa8,
+	0x91, 0x29, 0x71, 0xb7, 0x62, 0xd6, 0x25, 0x06, 0x93, 0x8b, 0x2d, 0xd7, 0x77, 0xb1, 0xdd, 0x40,
+	0xa5, 0xa0, 0x23, 0x14, 0xdd, 0xac, 0x71, 0x57, 0xc4, 0xec, 0xbb, 0x23, 0x00, 0x6f, 0x1d, 0x2c,
+	0x9e, 0x53, 0x0d, 0x90, 0xc5, 0xa0, 0xaa, 0xda, 0x1f, 0x14, 0x1a, 0xfa, 0x82, 0xf1, 0x38, 0x9c,
+	0xd4, 0xd0, 0xcf, 0xaa, 0xfa, 0xfd, 0x94, 0xf4, 0xc5, 0x61, 0xde, 0xea, 0x19, 0xcb, 0x30, 0x54,
+	0xfe, 0x1e, 0x2a, 0x71, 0x9b, 0xe2, 0x89, 0xde, 0xa8, 0xa1, 0x84, 0xef, 0x0a, 0x02, 0xa0, 0x68,
+	0x25, 0x7c, 0xf8, 0x27, 0x32, 0xf5, 0xe1, 0x7f, 0x16, 0x8d, 0x13, 0x87, 0x96, 0x60, 0x7b, 0x9b,
+	0x8a, 0xe1, 0xa5, 0xea, 0x3b, 0x45, 0xc7, 0x55, 0x59, 0x71, 0xca, 0x94, 0x12, 0x35, 0xc8, 0x51,
+	0x8c, 0x45, 0xbc, 0xb2, 0x30, 0x77, 0xca, 0xa3, 0x58, 0x46, 0x32, 0x47, 0xa0, 0x61, 0x11, 0x3b,
+	0x52, 0xc3, 0x8b, 0x88, 0x99, 0xa8, 0xc1, 0xb3, 0xf0, 0x4a, 0x15, 0xf5, 0x0a, 0x2f, 0x07, 0x89,
+	0x41, 0xf2, 0xb3, 0xf1, 0xf0, 0xa4, 0x29, 0x95, 0x9f, 0x4d, 0x86, 0x13, 0x1c, 0x91, 0x9f, 0x8d,
+	0xd5, 0x72, 0x5e, 0x27, 0x0b, 0x33, 0xf6, 0xea, 0xbb, 0x9e, 0xcf, 0x1e, 0x7e, 0x21, 0xbb, 0xc5,
+	0xbb, 0xd1, 0x38, 0xf6, 0x59, 0x0b, 0x98, 0xcb, 0x80, 0x9c, 0x2c, 0xd7, 0x59, 0x31, 0x08, 0x38,
+	0xb1, 0x2b, 0x37, 0x12, 0xd1, 0x19, 0xec, 0xed, 0x35, 0x69, 0x57, 0x4e, 0x46, 0x64, 0x24, 0xf1,
+	0x9d, 0xcf, 0xa0, 0x49, 0x4d, 0xcc, 0xa1, 0x12, 0xc1, 0x03, 0xb7, 0xde, 0x93, 0xb6, 0xe9, 0x3a,
+	0x29, 0x04, 0x06, 0xa3, 0xde, 0x38, 0x2c, 0x29, 0x6d, 0xe2, 0xc6, 0xc7, 0x53, 0xd1, 0x72, 0x28,
+	0x21, 0x16, 0xe2, 0x26, 0x7e, 0x90, 0xbc, 0xe3, 0x02, 0x29, 0x04, 0x06, 0x73, 0xde, 0x83, 0x26,
+	0xc4, 0xa3, 0xd2, 0x64, 0x25, 0x77, 0x84, 0xab, 0x84, 0xfe, 0xcc, 0x5c, 0x10, 0xc6, 0x40, 0x21,
+	0xce, 0x8b, 0x68, 0x42, 0xbc, 0x7d, 0x7d, 0x3c, 0x36, 0xb9, 0x97, 0x44, 0xbe, 0x77, 0x33, 0x88,
+	0xcc, 0x5c, 0x0c, 0xb5, 0xdb, 0xab, 0xb4, 0x0c, 0x24, 0xd4, 0xf9, 0xbe, 0x85, 0x26, 0x37, 0x37,
+	0xd7, 0xa4, 0xa2, 0x1f, 0xd0, 0x23, 0x11, 0xeb, 0xa1, 0xca, 0x76, 0x8c, 0x75, 0xaf, 0x6e, 0xb6,
+	0x13, 0x2d, 0x1c, 0x1e, 0x2c, 0x3e, 0x52, 0x4b, 0xc5, 0x80, 0x3e, 0x35, 0xed, 0x55, 0x74, 0x4e,
+	0x87, 0x88, 0x1c, 0x25, 0xec, 0xc0, 0xa4, 0x41, 0xb2, 0xb5, 0x5e, 0x30, 0xa4, 0xd5, 0x49, 0x92,
+	0x12, 0xa9, 0x97, 0xf3, 0xe9, 0xa4, 0x38, 0x18, 0xd2, 0xea, 0x38, 0x4f, 0xa1, 0xd9, 0x84, 0xbb,
+	0xf1, 0x00, 0x7e, 0x92, 0xbf, 0x9b, 0x47, 0x53, 0xba, 0x57, 0xdf, 0x80, 0x79, 0x31, 0x06, 0xbb,
+	0xad, 0xa6, 0x78, 0xe2, 0xe5, 0x87, 0xf4, 0xc4, 0xd3, 0x5d, 0x1f, 0x0b, 0xa7, 0xeb, 0xfa, 0x58,
+	0xcc, 0xc6, 0xf5, 0x51, 0x73, 0x21, 0x1f, 0x3b, 0x3b, 0x17, 0xf2, 0xaf, 0x17, 0xd1, 0x8c, 0xc4,
+	0x1c, 0x34, 0x98, 0xf6, 0x3d, 0x3d, 0x23, 0x39, 0xa4, 0xef, 0x4b, 0x7e, 0x54, 0xdf, 0x97, 0xc2,
+	0xa8, 0xbe, 0x2f, 0xc5, 0x13, 0xf8, 0xbe, 0xf4, 0x7a, 0xae, 0x8c, 0x0d, 0xec, 0xb9, 0xf2, 0x61,
+	0x79, 0x50, 0x8c, 0x1b, 0xd1, 0x18, 0xea, 0xb0, 0xb0, 0xcd, 0x61, 0x58, 0x0e, 0x1a, 0xa9, 0x31,
+	0xdb, 0xc7, 0xc4, 0xdf, 0xda, 0x61, 0x6a, 0xd8, 0xe9, 0xf0, 0xde, 0x85, 0x8f, 0x0c, 0x11, 0x72,
+	0xfa, 0x01, 0x34, 0xc9, 0xe7, 0x13, 0x55, 0xb0, 0x20, 0x53, 0x39, 0x53, 0x53, 0x20, 0xd0, 0xf1,
+	0xd2, 0xde, 0xfb, 0x9c, 0x1c, 0xf2, 0xbd, 0xcf, 0x4f, 0xa1, 0x0b, 0xa9, 0xd6, 0x05, 0xea, 0xea,
+	0x40, 0xef, 0xeb, 0xb8, 0xc1, 0x11, 0xb4, 0x66, 0x24, 0x92, 0x33, 0x2d, 0xdc, 0xeb, 0x8b, 0x09,
+	0x47, 0x50, 0x71, 0x7e, 0x2b, 0x8f, 0x66, 0x0c, 0xd1, 0x38, 0x22, 0x69, 0xbc, 0xb8, 0x81, 0x36,
+	0x13, 0xdb, 0x30, 0x23, 0xbb, 0x82, 0xa3, 0xd8, 0xf3, 0x59, 0xca, 0xcb, 0x7e, 0x4e, 0x3d, 0xf7,
+	0xe9, 0xfc, 0xda, 0x6a, 0x09, 0xc5, 0xe7, 0xe9, 0x31, 0xe6, 0xde, 0x34, 0x9c, 0x1d, 0x79, 0x8a,
+	0x02, 0xa9, 0x3c, 0xeb, 0x5c, 0x33, 0x9c, 0x39, 0x77, 0x95, 0xf1, 0x58, 0xb2, 0x02, 0x8d, 0x2d,
+	0x39, 0x5b, 0xf6, 0x70, 0xe8, 0x6d, 0x7b, 0xb8, 0xc1, 0x53, 0x7e, 0xd1, 0x9d, 0xfb, 0x45, 0x5e,
+	0x06, 0x12, 0xea, 0xbc, 0x9e, 0x43, 0x25, 0x9a, 0xf9, 0x86, 0xc8, 0xad, 0x44, 0xa9, 0x3d, 0x15,
+	0x69, 0xda, 0x22, 0x3e, 0x6c, 0x23, 0x5a, 0x9b, 0x74, 0xfd, 0x13, 0xcf, 0x03, 0xa1, 0x95, 0x80,
+	0xc1, 0xd1, 0xee, 0xa0, 0x89, 0x6d, 0x0f, 0xb7, 0x1a, 0x22, 0x52, 0x6b, 0xe4, 0xb4, 0x85, 0x37,
+	0x38, 0x35, 0xd6, 0x05, 0xe2, 0x17, 0x48, 0x2e, 0x8e, 0x8b, 0x66, 0x13, 0xcf, 0x64, 0x65, 0x2d,
+	0x0b, 0x3a, 0xff, 0xbd, 0x80, 0x4a, 0x32, 0xa1, 0xa9, 0xfd, 0x23, 0x86, 0x49, 0x44, 0xdd, 0xe1,
+	0xb9, 0x2d, 0x83, 0xc8, 0x4d, 0x12, 0x39, 0x61, 0xde, 0xb8, 0x88, 0xf2, 0xdd, 0xb0, 0x95, 0xd4,
+	0xcd, 0x91, 0xec, 0xee, 0xa4, 0x5c, 0x4f, 0xc2, 0x9a, 0x3f, 0xdb, 0x24, 0xac, 0x97, 0x51, 0x61,
+	0x2b, 0x68, 0xec, 0x97, 0x0b, 0xe6, 0x29, 0x59, 0x0d, 0x1a, 0xfb, 0x40, 0x21, 0x23, 0x27, 0x2f,
+	0x7f, 0x0f, 0x9a, 0x20, 0x62, 0x03, 0x31, 0xc1, 0x70, 0xef, 0x54, 0x79, 0xca, 0xde, 0xaa, 0xdd,
+	0xb9, 0x4d, 0xca, 0x41, 0x62, 0x18, 0xc9, 0x6b, 0xc7, 0x8f, 0x4d, 0x5e, 0xbb, 0xc2, 0x68, 0x93,
+	0xd6, 0xd2, 0x13, 0x65, 0xaa, 0x7a, 0x45, 0xd0, 0x25, 0x65, 0x47, 0xca, 0x2e, 0xb2, 0x66, 0x5a,
+	0x9a, 0xdf, 0xd2, 0xc3, 0x4b, 0xf3, 0xeb, 0xdc, 0x45, 0xb3, 0x89, 0xf1, 0x13, 0xaa, 0x5d, 0x2b,
+	0x5d, 0xb5, 0x6b, 0xa6, 0xc6, 0xea, 0xf3, 0xc4, 0xac, 0xf3, 0x2f, 0x2c, 0x34, 0xdf, 0xb3, 0x23,
+	0x0d, 0x9a, 0x6f, 0x39, 0x79, 0x36, 0xe6, 0x4e, 0x7e, 0x36, 0xe6, 0x87, 0x3c, 0x1b, 0xbf, 0x6e,
+	0x21, 0xbb, 0x57, 0x67, 0x75, 0xa2, 0xe7, 0x5f, 0x9f, 0x41, 0x53, 0x6d, 0xcf, 0x97, 0x6a, 0xba,
+	0x72, 0xce, 0x34, 0x30, 0xad, 0x6b, 0x30, 0x30, 0x30, 0x69, 0x4d, 0xf7, 0xc1, 0x6a, 0x42, 0xf3,
+	0xa7, 0x6a, 0x6a, 0x30, 0x30, 0x30, 0x9d, 0xcf, 0xe6, 0xd0, 0x39, 0xd5, 0x7c, 0x45, 0xd1, 0x50,
+	0x24, 0x5a, 0x03, 0x28, 0x12, 0x07, 0x4d, 0xfd, 0xb2, 0x82, 0xe6, 0xb4, 0x74, 0x49, 0x74, 0x0a,
+	0x94, 0xf3, 0xa6, 0xad, 0x68, 0x3d, 0x01, 0x87, 0x9e, 0x1a, 0xf6, 0x1a, 0x2a, 0xc4, 0x27, 0x4b,
+	0xf4, 0x21, 0xf7, 0x10, 0xf2, 0x0b, 0x28, 0x15, 0xe7, 0x3b, 0x39, 0x34, 0xa7, 0x3a, 0x81, 0x5f,
+	0xd0, 0x49, 0x6a, 0x7e, 0xe9, 0x7c, 0x9d, 0xe8, 0x01, 0xe5, 0x79, 0xad, 0x70, 0x06, 0xee, 0x81,
+	0x08, 0xcd, 0x93, 0xdb, 0x9d, 0xec, 0xc5, 0x13, 0x66, 0x48, 0x91, 0xb7, 0xea, 0xb5, 0x24, 0x31,
+	0xe8, 0xa5, 0x4f, 0x72, 0xf5, 0x21, 0x39, 0x58, 0x19, 0x45, 0x6e, 0xa7, 0xcc, 0x1b, 0x35, 0xc7,
+	0x65, 0x51, 0x04, 0x1a, 0xe3, 0xea, 0xd6, 0x9b, 0xdf, 0xbe, 0xf4, 0x8e, 0x6f, 0x7e, 0xfb, 0xd2,
+	0x3b, 0xfe, 0xf8, 0xdb, 0x97, 0xde, 0xf1, 0xfa, 0xe1, 0x25, 0xeb, 0xcd, 0xc3, 0x4b, 0xd6, 0x37,
+	0x0f, 0x2f, 0x59, 0x7f, 0x7c, 0x78, 0xc9, 0xfa, 0xd6, 0xe1, 0x25, 0xeb, 0x0b, 0x7f, 0x7e, 0xe9,
+	0x1d, 0x1f, 0xff, 0xb0, 0x6a, 0xd7, 0x55, 0xd1, 0x2e, 0xfa, 0xcf, 0x7b, 0x45, 0x2b, 0xae, 0x76,
+	0x76, 0x9b, 0x24, 0x19, 0x4d, 0x74, 0x55, 0x96, 0x88, 0x76, 0xfd, 0x9f, 0x01, 0x00, 0xb8, 0x5b,
+	0x21, 0x8c, 0x4a, 0xef, 0x00, 0x00,
 }
 
 func (m *ALBStatus) Marshal() (dAtA []byte, err error) {
@@ -4528,6 +5774,23 @@ func (m *AnalysisRunSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SuccessWeightThreshold != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SuccessWeightThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CompositeConditions != nil {
+		{
+			size, err := m.CompositeConditions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.TTLStrategy != nil {
 		{
 			size, err := m.TTLStrategy.MarshalToSizedBuffer(dAtA[:i])
@@ -4627,6 +5890,18 @@ func (m *AnalysisRunStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AdvisorySummary != nil {
+		{
+			size, err := m.AdvisorySummary.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CompletedAt != nil {
 		{
 			size, err := m.CompletedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -4881,6 +6156,23 @@ func (m *AnalysisTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SuccessWeightThreshold != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SuccessWeightThreshold))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CompositeConditions != nil {
+		{
+			size, err := m.CompositeConditions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Templates) > 0 {
 		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5668,6 +6960,18 @@ func (m *CanaryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WeightRamp != nil {
+		{
+			size, err := m.WeightRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.StepPluginStatuses) > 0 {
 		for iNdEx := len(m.StepPluginStatuses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5751,6 +7055,30 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SetClusterWeight != nil {
+		{
+			size, err := m.SetClusterWeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SetWeightRamp != nil {
+		{
+			size, err := m.SetWeightRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -6373,6 +7701,252 @@ func (m *ClusterAnalysisTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *CompositeConditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompositeConditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompositeConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.FailureCondition)
+	copy(dAtA[i:], m.FailureCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureCondition)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonSetCohort) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonSetCohort) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonSetCohort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Percentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Percentage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NodeSelector != nil {
+		{
+			size, err := m.NodeSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonSetStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonSetStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedNodes))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Nodes))
+	i--
+	dAtA[i] = 0x18
+	if m.CurrentBackgroundAnalysisRunStatus != nil {
+		{
+			size, err := m.CurrentBackgroundAnalysisRunStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CurrentStepAnalysisRunStatus != nil {
+		{
+			size, err := m.CurrentStepAnalysisRunStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonSetStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonSetStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonSetStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pause != nil {
+		{
+			size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Cohort != nil {
+		{
+			size, err := m.Cohort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonSetStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonSetStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonSetStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnavailable != nil {
+		{
+			size, err := m.MaxUnavailable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6460,6 +8034,105 @@ func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeploymentWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.RRule)
+	copy(dAtA[i:], m.RRule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RRule)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DeploymentWindows) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentWindows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleRefs) > 0 {
+		for iNdEx := len(m.ScheduleRefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduleRefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DryRun) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6488,6 +8161,79 @@ func (m *DryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ElasticsearchMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ElasticsearchMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ElasticsearchMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x38
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.TimestampField)
+	copy(dAtA[i:], m.TimestampField)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimestampField)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Aggregation)
+	copy(dAtA[i:], m.Aggregation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Aggregation)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Index)
+	copy(dAtA[i:], m.Index)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Index)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Experiment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6986,6 +8732,99 @@ func (m *FieldRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GatewayAPIRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayAPIRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayAPIRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GatewayAPITrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GatewayAPITrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GatewayAPITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TCPRoutes) > 0 {
+		for iNdEx := len(m.TCPRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TCPRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.GRPCRoutes) > 0 {
+		for iNdEx := len(m.GRPCRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GRPCRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.HTTPRoutes) > 0 {
+		for iNdEx := len(m.HTTPRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HTTPRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GraphiteMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7308,6 +9147,242 @@ func (m *JobMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JudgeComparison) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JudgeComparison) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JudgeComparison) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Direction)
+	copy(dAtA[i:], m.Direction)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Direction)))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Baseline.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *JudgeMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JudgeMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JudgeMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfidenceLevel != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ConfidenceLevel))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Threshold.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Comparisons) > 0 {
+		for iNdEx := len(m.Comparisons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Comparisons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JudgeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JudgeQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JudgeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkyWalking != nil {
+		{
+			size, err := m.SkyWalking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Influxdb != nil {
+		{
+			size, err := m.Influxdb.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Graphite != nil {
+		{
+			size, err := m.Graphite.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CloudWatch != nil {
+		{
+			size, err := m.CloudWatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NewRelic != nil {
+		{
+			size, err := m.NewRelic.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Wavefront != nil {
+		{
+			size, err := m.Wavefront.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Datadog != nil {
+		{
+			size, err := m.Datadog.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Web != nil {
+		{
+			size, err := m.Web.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Prometheus != nil {
+		{
+			size, err := m.Prometheus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *KayentaMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7470,6 +9545,121 @@ func (m *KayentaThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KubernetesMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KubernetesMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LokiMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LokiMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LokiMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RangeQuery != nil {
+		{
+			size, err := m.RangeQuery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i--
+	if m.Insecure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if m.Timeout != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Timeout))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Authentication.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MangedRoutes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7647,6 +9837,19 @@ func (m *Metric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Weight))
+		i--
+		dAtA[i] = 0x68
+	}
+	i--
+	if m.Advisory {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x60
 	if m.ConsecutiveSuccessLimit != nil {
 		{
 			size, err := m.ConsecutiveSuccessLimit.MarshalToSizedBuffer(dAtA[:i])
@@ -7765,6 +9968,84 @@ func (m *MetricProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kubernetes != nil {
+		{
+			size, err := m.Kubernetes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.SQL != nil {
+		{
+			size, err := m.SQL.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Loki != nil {
+		{
+			size, err := m.Loki.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Elasticsearch != nil {
+		{
+			size, err := m.Elasticsearch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.OTLP != nil {
+		{
+			size, err := m.OTLP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Judge != nil {
+		{
+			size, err := m.Judge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.Plugin) > 0 {
 		keysForPlugin := make([]string, 0, len(m.Plugin))
 		for k := range m.Plugin {
@@ -7946,6 +10227,14 @@ func (m *MetricResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i--
+	if m.Advisory {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x70
 	i = encodeVarintGenerated(dAtA, i, uint64(m.ConsecutiveSuccess))
 	i--
 	dAtA[i] = 0x68
@@ -8206,6 +10495,85 @@ func (m *OAuth2Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OTLPMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OTLPMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OTLPMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.Lookback)
+	copy(dAtA[i:], m.Lookback)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Lookback)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.MetricName)
+	copy(dAtA[i:], m.MetricName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MetricName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Address)
+	copy(dAtA[i:], m.Address)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Address)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectRef) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8572,6 +10940,494 @@ func (m *PrometheusRangeQueryArgs) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ReleaseTrain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainImage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainImage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainImage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Image)
+	copy(dAtA[i:], m.Image)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Image)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainRolloutStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainRolloutStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainRolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PreviousRevision)
+	copy(dAtA[i:], m.PreviousRevision)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PreviousRevision)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Abort {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	i--
+	if m.RollbackOnFailure {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TemplatePatch != nil {
+		i -= len(m.TemplatePatch)
+		copy(dAtA[i:], m.TemplatePatch)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.TemplatePatch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Images) > 0 {
+		for iNdEx := len(m.Images) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Images[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.CurrentWave)
+	copy(dAtA[i:], m.CurrentWave)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CurrentWave)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainWave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainWave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainWave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxConcurrent != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxConcurrent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rollouts) > 0 {
+		for iNdEx := len(m.Rollouts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Rollouts[iNdEx])
+			copy(dAtA[i:], m.Rollouts[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Rollouts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseTrainWaveStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseTrainWaveStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseTrainWaveStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.AnalysisRun)
+	copy(dAtA[i:], m.AnalysisRun)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AnalysisRun)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Rollouts) > 0 {
+		for iNdEx := len(m.Rollouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rollouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ReplicaProgressThreshold) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8872,6 +11728,99 @@ func (m *RolloutAnalysisRunStatus) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutCluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutCluster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutCluster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Replicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Replicas))
+		i--
+		dAtA[i] = 0x18
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutClusterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutClusterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutClusterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x42
+	i = encodeVarintGenerated(dAtA, i, uint64(m.AvailableReplicas))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedReplicas))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.CanaryRS)
+	copy(dAtA[i:], m.CanaryRS)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CanaryRS)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.StableRS)
+	copy(dAtA[i:], m.StableRS)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StableRS)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutCondition) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8935,7 +11884,7 @@ func (m *RolloutCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutDurationStatus) Marshal() (dAtA []byte, err error) {
+func (m *RolloutDependency) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8945,19 +11894,62 @@ func (m *RolloutDurationStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutDurationStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutDependency) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutDurationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CompletionStatus != nil {
-		i -= len(*m.CompletionStatus)
-		copy(dAtA[i:], *m.CompletionStatus)
+	i -= len(m.RevisionAnnotation)
+	copy(dAtA[i:], m.RevisionAnnotation)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RevisionAnnotation)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutDurationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutDurationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutDurationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionStatus != nil {
+		i -= len(*m.CompletionStatus)
+		copy(dAtA[i:], *m.CompletionStatus)
 		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.CompletionStatus)))
 		i--
 		dAtA[i] = 0x2a
@@ -9315,6 +12307,161 @@ func (m *RolloutPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutScheduleList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutScheduleList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutScheduleList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutScheduleRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutScheduleRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutScheduleRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutScheduleSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutScheduleSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutScheduleSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for iNdEx := len(m.Windows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Windows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9335,6 +12482,34 @@ func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DependsOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.RollbackWindow != nil {
 		{
 			size, err := m.RollbackWindow.MarshalToSizedBuffer(dAtA[:i])
@@ -9472,6 +12647,48 @@ func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.DaemonSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.NextDeploymentWindow != nil {
+		{
+			size, err := m.NextDeploymentWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
 	if m.Duration != nil {
 		{
 			size, err := m.Duration.MarshalToSizedBuffer(dAtA[:i])
@@ -9718,6 +12935,30 @@ func (m *RolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DaemonSet != nil {
+		{
+			size, err := m.DaemonSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeploymentWindows != nil {
+		{
+			size, err := m.DeploymentWindows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Canary != nil {
 		{
 			size, err := m.Canary.MarshalToSizedBuffer(dAtA[:i])
@@ -9765,6 +13006,18 @@ func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GatewayAPI != nil {
+		{
+			size, err := m.GatewayAPI.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.MaxTrafficWeight != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxTrafficWeight))
 		i--
@@ -10005,6 +13258,12 @@ func (m *RunSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SuccessfulWeight))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x30
 	i = encodeVarintGenerated(dAtA, i, uint64(m.Error))
 	i--
 	dAtA[i] = 0x28
@@ -10056,6 +13315,56 @@ func (m *SMITrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.TimeoutSeconds))
+	i--
+	dAtA[i] = 0x28
+	if len(m.Parameters) > 0 {
+		for iNdEx := len(m.Parameters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Parameters[iNdEx])
+			copy(dAtA[i:], m.Parameters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parameters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.DSN)
+	copy(dAtA[i:], m.DSN)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DSN)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Driver)
+	copy(dAtA[i:], m.Driver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Driver)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ScopeDetail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10212,6 +13521,41 @@ func (m *SetCanaryScale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetClusterWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetClusterWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetClusterWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Clusters[iNdEx])
+			copy(dAtA[i:], m.Clusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Clusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SetHeaderRoute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10301,6 +13645,55 @@ func (m *SetMirrorRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetWeightRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetWeightRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetWeightRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Increment))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.To))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.From))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Sigv4Config) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11157,6 +14550,137 @@ func (m *WeightDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WeightRampAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightRampAnalysis) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightRampAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxIncrement))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinIncrement))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.MetricName)
+	copy(dAtA[i:], m.MetricName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MetricName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightRampIncrement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightRampIncrement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightRampIncrement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.MeasurementValue)
+	copy(dAtA[i:], m.MeasurementValue)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MeasurementValue)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Increment))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *WeightRampStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WeightRampStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WeightRampStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Increments) > 0 {
+		for iNdEx := len(m.Increments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Increments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.LastIncrementTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.StepIndex))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenerated(v)
 	base := offset
@@ -11335,6 +14859,13 @@ func (m *AnalysisRunSpec) Size() (n int) {
 		l = m.TTLStrategy.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.CompositeConditions != nil {
+		l = m.CompositeConditions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SuccessWeightThreshold != nil {
+		n += 1 + sovGenerated(uint64(*m.SuccessWeightThreshold))
+	}
 	return n
 }
 
@@ -11368,6 +14899,10 @@ func (m *AnalysisRunStatus) Size() (n int) {
 		l = m.CompletedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.AdvisorySummary != nil {
+		l = m.AdvisorySummary.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11466,6 +15001,13 @@ func (m *AnalysisTemplateSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.CompositeConditions != nil {
+		l = m.CompositeConditions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SuccessWeightThreshold != nil {
+		n += 1 + sovGenerated(uint64(*m.SuccessWeightThreshold))
+	}
 	return n
 }
 
@@ -11760,6 +15302,10 @@ func (m *CanaryStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.WeightRamp != nil {
+		l = m.WeightRamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11800,6 +15346,14 @@ func (m *CanaryStep) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SetWeightRamp != nil {
+		l = m.SetWeightRamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SetClusterWeight != nil {
+		l = m.SetClusterWeight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11999,6 +15553,98 @@ func (m *ClusterAnalysisTemplateList) Size() (n int) {
 	return n
 }
 
+func (m *CompositeConditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FailureCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DaemonSetCohort) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeSelector != nil {
+		l = m.NodeSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Percentage != nil {
+		n += 1 + sovGenerated(uint64(*m.Percentage))
+	}
+	return n
+}
+
+func (m *DaemonSetStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentStepAnalysisRunStatus != nil {
+		l = m.CurrentStepAnalysisRunStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CurrentBackgroundAnalysisRunStatus != nil {
+		l = m.CurrentBackgroundAnalysisRunStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Nodes))
+	n += 1 + sovGenerated(uint64(m.UpdatedNodes))
+	return n
+}
+
+func (m *DaemonSetStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cohort != nil {
+		l = m.Cohort.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Pause != nil {
+		l = m.Pause.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *DaemonSetStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.MaxUnavailable != nil {
+		l = m.MaxUnavailable.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *DatadogMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12030,6 +15676,46 @@ func (m *DatadogMetric) Size() (n int) {
 	return n
 }
 
+func (m *DeploymentWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RRule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *DeploymentWindows) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ScheduleRefs) > 0 {
+		for _, e := range m.ScheduleRefs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DryRun) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12041,6 +15727,33 @@ func (m *DryRun) Size() (n int) {
 	return n
 }
 
+func (m *ElasticsearchMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Index)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Aggregation)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimestampField)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	n += 2
+	return n
+}
+
 func (m *Experiment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12224,6 +15937,44 @@ func (m *FieldRef) Size() (n int) {
 	return n
 }
 
+func (m *GatewayAPIRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *GatewayAPITrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HTTPRoutes) > 0 {
+		for _, e := range m.HTTPRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.GRPCRoutes) > 0 {
+		for _, e := range m.GRPCRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.TCPRoutes) > 0 {
+		for _, e := range m.TCPRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GraphiteMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12351,6 +16102,88 @@ func (m *JobMetric) Size() (n int) {
 	return n
 }
 
+func (m *JudgeComparison) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Canary.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Baseline.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Direction)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *JudgeMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Comparisons) > 0 {
+		for _, e := range m.Comparisons {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ConfidenceLevel != nil {
+		n += 1 + sovGenerated(uint64(*m.ConfidenceLevel))
+	}
+	return n
+}
+
+func (m *JudgeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Web != nil {
+		l = m.Web.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Datadog != nil {
+		l = m.Datadog.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Wavefront != nil {
+		l = m.Wavefront.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NewRelic != nil {
+		l = m.NewRelic.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CloudWatch != nil {
+		l = m.CloudWatch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Graphite != nil {
+		l = m.Graphite.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Influxdb != nil {
+		l = m.Influxdb.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SkyWalking != nil {
+		l = m.SkyWalking.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *KayentaMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12407,6 +16240,48 @@ func (m *KayentaThreshold) Size() (n int) {
 	return n
 }
 
+func (m *KubernetesMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *LokiMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Authentication.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Timeout != nil {
+		n += 1 + sovGenerated(uint64(*m.Timeout))
+	}
+	n += 2
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RangeQuery != nil {
+		l = m.RangeQuery.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *MangedRoutes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12503,6 +16378,10 @@ func (m *Metric) Size() (n int) {
 		l = m.ConsecutiveSuccessLimit.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 2
+	if m.Weight != nil {
+		n += 1 + sovGenerated(uint64(*m.Weight))
+	}
 	return n
 }
 
@@ -12568,6 +16447,30 @@ func (m *MetricProvider) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
+	if m.Judge != nil {
+		l = m.Judge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OTLP != nil {
+		l = m.OTLP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Elasticsearch != nil {
+		l = m.Elasticsearch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Loki != nil {
+		l = m.Loki.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.SQL != nil {
+		l = m.SQL.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Kubernetes != nil {
+		l = m.Kubernetes.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12605,6 +16508,7 @@ func (m *MetricResult) Size() (n int) {
 		}
 	}
 	n += 1 + sovGenerated(uint64(m.ConsecutiveSuccess))
+	n += 2
 	return n
 }
 
@@ -12680,6 +16584,36 @@ func (m *OAuth2Config) Size() (n int) {
 	return n
 }
 
+func (m *OTLPMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MetricName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Lookback)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ObjectRef) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12817,6 +16751,192 @@ func (m *PrometheusRangeQueryArgs) Size() (n int) {
 	return n
 }
 
+func (m *ReleaseTrain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReleaseTrainAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseTrainImage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Image)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReleaseTrainList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseTrainRolloutStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PreviousRevision)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ReleaseTrainSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TemplatePatch != nil {
+		l = len(m.TemplatePatch)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *ReleaseTrainStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CurrentWave)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleaseTrainWave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rollouts) > 0 {
+		for _, s := range m.Rollouts {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.MaxConcurrent != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxConcurrent))
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleaseTrainWaveStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rollouts) > 0 {
+		for _, e := range m.Rollouts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.AnalysisRun)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ReplicaProgressThreshold) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12929,6 +17049,43 @@ func (m *RolloutAnalysisRunStatus) Size() (n int) {
 	return n
 }
 
+func (m *RolloutCluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Replicas != nil {
+		n += 1 + sovGenerated(uint64(*m.Replicas))
+	}
+	return n
+}
+
+func (m *RolloutClusterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Weight))
+	l = len(m.StableRS)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CanaryRS)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	n += 1 + sovGenerated(uint64(m.UpdatedReplicas))
+	n += 1 + sovGenerated(uint64(m.AvailableReplicas))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutCondition) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12950,6 +17107,23 @@ func (m *RolloutCondition) Size() (n int) {
 	return n
 }
 
+func (m *RolloutDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.RevisionAnnotation)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutDurationStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13094,6 +17268,62 @@ func (m *RolloutPause) Size() (n int) {
 	return n
 }
 
+func (m *RolloutSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutScheduleList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutScheduleRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutScheduleSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Windows) > 0 {
+		for _, e := range m.Windows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *RolloutSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13136,6 +17366,18 @@ func (m *RolloutSpec) Size() (n int) {
 		l = m.RollbackWindow.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.DependsOn) > 0 {
+		for _, e := range m.DependsOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -13213,6 +17455,18 @@ func (m *RolloutStatus) Size() (n int) {
 		l = m.Duration.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.NextDeploymentWindow != nil {
+		l = m.NextDeploymentWindow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.DaemonSet.Size()
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -13230,6 +17484,14 @@ func (m *RolloutStrategy) Size() (n int) {
 		l = m.Canary.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.DeploymentWindows != nil {
+		l = m.DeploymentWindows.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DaemonSet != nil {
+		l = m.DaemonSet.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13292,6 +17554,10 @@ func (m *RolloutTrafficRouting) Size() (n int) {
 	if m.MaxTrafficWeight != nil {
 		n += 1 + sovGenerated(uint64(*m.MaxTrafficWeight))
 	}
+	if m.GatewayAPI != nil {
+		l = m.GatewayAPI.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -13332,6 +17598,8 @@ func (m *RunSummary) Size() (n int) {
 	n += 1 + sovGenerated(uint64(m.Failed))
 	n += 1 + sovGenerated(uint64(m.Inconclusive))
 	n += 1 + sovGenerated(uint64(m.Error))
+	n += 1 + sovGenerated(uint64(m.Weight))
+	n += 1 + sovGenerated(uint64(m.SuccessfulWeight))
 	return n
 }
 
@@ -13348,6 +17616,28 @@ func (m *SMITrafficRouting) Size() (n int) {
 	return n
 }
 
+func (m *SQLMetric) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Driver)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DSN)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Query)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Parameters) > 0 {
+		for _, s := range m.Parameters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.TimeoutSeconds))
+	return n
+}
+
 func (m *ScopeDetail) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13407,6 +17697,22 @@ func (m *SetCanaryScale) Size() (n int) {
 	return n
 }
 
+func (m *SetClusterWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clusters) > 0 {
+		for _, s := range m.Clusters {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.Weight))
+	return n
+}
+
 func (m *SetHeaderRoute) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13444,6 +17750,24 @@ func (m *SetMirrorRoute) Size() (n int) {
 	return n
 }
 
+func (m *SetWeightRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.From))
+	n += 1 + sovGenerated(uint64(m.To))
+	n += 1 + sovGenerated(uint64(m.Increment))
+	l = len(m.Interval)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Sigv4Config) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13769,6 +18093,53 @@ func (m *WeightDestination) Size() (n int) {
 	return n
 }
 
+func (m *WeightRampAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetricName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MinIncrement))
+	n += 1 + sovGenerated(uint64(m.MaxIncrement))
+	return n
+}
+
+func (m *WeightRampIncrement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Increment))
+	n += 1 + sovGenerated(uint64(m.Weight))
+	l = len(m.MeasurementValue)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WeightRampStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.StepIndex))
+	n += 1 + sovGenerated(uint64(m.Weight))
+	l = m.LastIncrementTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Increments) > 0 {
+		for _, e := range m.Increments {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -13915,6 +18286,8 @@ func (this *AnalysisRunSpec) String() string {
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
 		`TTLStrategy:` + strings.Replace(this.TTLStrategy.String(), "TTLStrategy", "TTLStrategy", 1) + `,`,
+		`CompositeConditions:` + strings.Replace(this.CompositeConditions.String(), "CompositeConditions", "CompositeConditions", 1) + `,`,
+		`SuccessWeightThreshold:` + valueToStringGenerated(this.SuccessWeightThreshold) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13936,6 +18309,7 @@ func (this *AnalysisRunStatus) String() string {
 		`RunSummary:` + strings.Replace(strings.Replace(this.RunSummary.String(), "RunSummary", "RunSummary", 1), `&`, ``, 1) + `,`,
 		`DryRunSummary:` + strings.Replace(this.DryRunSummary.String(), "RunSummary", "RunSummary", 1) + `,`,
 		`CompletedAt:` + strings.Replace(fmt.Sprintf("%v", this.CompletedAt), "Time", "v1.Time", 1) + `,`,
+		`AdvisorySummary:` + strings.Replace(this.AdvisorySummary.String(), "RunSummary", "RunSummary", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14024,6 +18398,8 @@ func (this *AnalysisTemplateSpec) String() string {
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
 		`Templates:` + repeatedStringForTemplates + `,`,
+		`CompositeConditions:` + strings.Replace(this.CompositeConditions.String(), "CompositeConditions", "CompositeConditions", 1) + `,`,
+		`SuccessWeightThreshold:` + valueToStringGenerated(this.SuccessWeightThreshold) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14214,6 +18590,7 @@ func (this *CanaryStatus) String() string {
 		`Weights:` + strings.Replace(this.Weights.String(), "TrafficWeights", "TrafficWeights", 1) + `,`,
 		`StablePingPong:` + fmt.Sprintf("%v", this.StablePingPong) + `,`,
 		`StepPluginStatuses:` + repeatedStringForStepPluginStatuses + `,`,
+		`WeightRamp:` + strings.Replace(this.WeightRamp.String(), "WeightRampStatus", "WeightRampStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14231,6 +18608,8 @@ func (this *CanaryStep) String() string {
 		`SetHeaderRoute:` + strings.Replace(this.SetHeaderRoute.String(), "SetHeaderRoute", "SetHeaderRoute", 1) + `,`,
 		`SetMirrorRoute:` + strings.Replace(this.SetMirrorRoute.String(), "SetMirrorRoute", "SetMirrorRoute", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginStep", "PluginStep", 1) + `,`,
+		`SetWeightRamp:` + strings.Replace(this.SetWeightRamp.String(), "SetWeightRamp", "SetWeightRamp", 1) + `,`,
+		`SetClusterWeight:` + strings.Replace(this.SetClusterWeight.String(), "SetClusterWeight", "SetClusterWeight", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14365,6 +18744,70 @@ func (this *ClusterAnalysisTemplateList) String() string {
 	}, "")
 	return s
 }
+func (this *CompositeConditions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CompositeConditions{`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`FailureCondition:` + fmt.Sprintf("%v", this.FailureCondition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DaemonSetCohort) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DaemonSetCohort{`,
+		`NodeSelector:` + strings.Replace(fmt.Sprintf("%v", this.NodeSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`Percentage:` + valueToStringGenerated(this.Percentage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DaemonSetStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DaemonSetStatus{`,
+		`CurrentStepAnalysisRunStatus:` + strings.Replace(this.CurrentStepAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`CurrentBackgroundAnalysisRunStatus:` + strings.Replace(this.CurrentBackgroundAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`UpdatedNodes:` + fmt.Sprintf("%v", this.UpdatedNodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DaemonSetStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DaemonSetStep{`,
+		`Cohort:` + strings.Replace(this.Cohort.String(), "DaemonSetCohort", "DaemonSetCohort", 1) + `,`,
+		`Pause:` + strings.Replace(this.Pause.String(), "RolloutPause", "RolloutPause", 1) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DaemonSetStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]DaemonSetStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "DaemonSetStep", "DaemonSetStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&DaemonSetStrategy{`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysisBackground", "RolloutAnalysisBackground", 1) + `,`,
+		`MaxUnavailable:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailable), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DatadogMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14392,6 +18835,41 @@ func (this *DatadogMetric) String() string {
 	}, "")
 	return s
 }
+func (this *DeploymentWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploymentWindow{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`RRule:` + fmt.Sprintf("%v", this.RRule) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeploymentWindows) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWindows := "[]DeploymentWindow{"
+	for _, f := range this.Windows {
+		repeatedStringForWindows += strings.Replace(strings.Replace(f.String(), "DeploymentWindow", "DeploymentWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWindows += "}"
+	repeatedStringForScheduleRefs := "[]RolloutScheduleRef{"
+	for _, f := range this.ScheduleRefs {
+		repeatedStringForScheduleRefs += strings.Replace(strings.Replace(f.String(), "RolloutScheduleRef", "RolloutScheduleRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForScheduleRefs += "}"
+	s := strings.Join([]string{`&DeploymentWindows{`,
+		`Windows:` + repeatedStringForWindows + `,`,
+		`ScheduleRefs:` + repeatedStringForScheduleRefs + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DryRun) String() string {
 	if this == nil {
 		return "nil"
@@ -14402,6 +18880,28 @@ func (this *DryRun) String() string {
 	}, "")
 	return s
 }
+func (this *ElasticsearchMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&ElasticsearchMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Aggregation:` + fmt.Sprintf("%v", this.Aggregation) + `,`,
+		`TimestampField:` + fmt.Sprintf("%v", this.TimestampField) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Experiment) String() string {
 	if this == nil {
 		return "nil"
@@ -14555,6 +19055,43 @@ func (this *FieldRef) String() string {
 	}, "")
 	return s
 }
+func (this *GatewayAPIRoute) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GatewayAPIRoute{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GatewayAPITrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHTTPRoutes := "[]GatewayAPIRoute{"
+	for _, f := range this.HTTPRoutes {
+		repeatedStringForHTTPRoutes += strings.Replace(strings.Replace(f.String(), "GatewayAPIRoute", "GatewayAPIRoute", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHTTPRoutes += "}"
+	repeatedStringForGRPCRoutes := "[]GatewayAPIRoute{"
+	for _, f := range this.GRPCRoutes {
+		repeatedStringForGRPCRoutes += strings.Replace(strings.Replace(f.String(), "GatewayAPIRoute", "GatewayAPIRoute", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGRPCRoutes += "}"
+	repeatedStringForTCPRoutes := "[]GatewayAPIRoute{"
+	for _, f := range this.TCPRoutes {
+		repeatedStringForTCPRoutes += strings.Replace(strings.Replace(f.String(), "GatewayAPIRoute", "GatewayAPIRoute", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTCPRoutes += "}"
+	s := strings.Join([]string{`&GatewayAPITrafficRouting{`,
+		`HTTPRoutes:` + repeatedStringForHTTPRoutes + `,`,
+		`GRPCRoutes:` + repeatedStringForGRPCRoutes + `,`,
+		`TCPRoutes:` + repeatedStringForTCPRoutes + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GraphiteMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14652,6 +19189,54 @@ func (this *JobMetric) String() string {
 	}, "")
 	return s
 }
+func (this *JudgeComparison) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JudgeComparison{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Canary:` + strings.Replace(strings.Replace(this.Canary.String(), "JudgeQuery", "JudgeQuery", 1), `&`, ``, 1) + `,`,
+		`Baseline:` + strings.Replace(strings.Replace(this.Baseline.String(), "JudgeQuery", "JudgeQuery", 1), `&`, ``, 1) + `,`,
+		`Direction:` + fmt.Sprintf("%v", this.Direction) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JudgeMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForComparisons := "[]JudgeComparison{"
+	for _, f := range this.Comparisons {
+		repeatedStringForComparisons += strings.Replace(strings.Replace(f.String(), "JudgeComparison", "JudgeComparison", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForComparisons += "}"
+	s := strings.Join([]string{`&JudgeMetric{`,
+		`Comparisons:` + repeatedStringForComparisons + `,`,
+		`Threshold:` + strings.Replace(strings.Replace(this.Threshold.String(), "KayentaThreshold", "KayentaThreshold", 1), `&`, ``, 1) + `,`,
+		`ConfidenceLevel:` + valueToStringGenerated(this.ConfidenceLevel) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JudgeQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JudgeQuery{`,
+		`Prometheus:` + strings.Replace(this.Prometheus.String(), "PrometheusMetric", "PrometheusMetric", 1) + `,`,
+		`Web:` + strings.Replace(this.Web.String(), "WebMetric", "WebMetric", 1) + `,`,
+		`Datadog:` + strings.Replace(this.Datadog.String(), "DatadogMetric", "DatadogMetric", 1) + `,`,
+		`Wavefront:` + strings.Replace(this.Wavefront.String(), "WavefrontMetric", "WavefrontMetric", 1) + `,`,
+		`NewRelic:` + strings.Replace(this.NewRelic.String(), "NewRelicMetric", "NewRelicMetric", 1) + `,`,
+		`CloudWatch:` + strings.Replace(this.CloudWatch.String(), "CloudWatchMetric", "CloudWatchMetric", 1) + `,`,
+		`Graphite:` + strings.Replace(this.Graphite.String(), "GraphiteMetric", "GraphiteMetric", 1) + `,`,
+		`Influxdb:` + strings.Replace(this.Influxdb.String(), "InfluxdbMetric", "InfluxdbMetric", 1) + `,`,
+		`SkyWalking:` + strings.Replace(this.SkyWalking.String(), "SkyWalkingMetric", "SkyWalkingMetric", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KayentaMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14698,6 +19283,38 @@ func (this *KayentaThreshold) String() string {
 	}, "")
 	return s
 }
+func (this *KubernetesMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KubernetesMetric{`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LokiMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&LokiMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`Authentication:` + strings.Replace(strings.Replace(this.Authentication.String(), "Authentication", "Authentication", 1), `&`, ``, 1) + `,`,
+		`Timeout:` + valueToStringGenerated(this.Timeout) + `,`,
+		`Insecure:` + fmt.Sprintf("%v", this.Insecure) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`RangeQuery:` + strings.Replace(this.RangeQuery.String(), "PrometheusRangeQueryArgs", "PrometheusRangeQueryArgs", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MangedRoutes) String() string {
 	if this == nil {
 		return "nil"
@@ -14761,6 +19378,8 @@ func (this *Metric) String() string {
 		`ConsecutiveErrorLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveErrorLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`Provider:` + strings.Replace(strings.Replace(this.Provider.String(), "MetricProvider", "MetricProvider", 1), `&`, ``, 1) + `,`,
 		`ConsecutiveSuccessLimit:` + strings.Replace(fmt.Sprintf("%v", this.ConsecutiveSuccessLimit), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`Advisory:` + fmt.Sprintf("%v", this.Advisory) + `,`,
+		`Weight:` + valueToStringGenerated(this.Weight) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14792,6 +19411,12 @@ func (this *MetricProvider) String() string {
 		`Influxdb:` + strings.Replace(this.Influxdb.String(), "InfluxdbMetric", "InfluxdbMetric", 1) + `,`,
 		`SkyWalking:` + strings.Replace(this.SkyWalking.String(), "SkyWalkingMetric", "SkyWalkingMetric", 1) + `,`,
 		`Plugin:` + mapStringForPlugin + `,`,
+		`Judge:` + strings.Replace(this.Judge.String(), "JudgeMetric", "JudgeMetric", 1) + `,`,
+		`OTLP:` + strings.Replace(this.OTLP.String(), "OTLPMetric", "OTLPMetric", 1) + `,`,
+		`Elasticsearch:` + strings.Replace(this.Elasticsearch.String(), "ElasticsearchMetric", "ElasticsearchMetric", 1) + `,`,
+		`Loki:` + strings.Replace(this.Loki.String(), "LokiMetric", "LokiMetric", 1) + `,`,
+		`SQL:` + strings.Replace(this.SQL.String(), "SQLMetric", "SQLMetric", 1) + `,`,
+		`Kubernetes:` + strings.Replace(this.Kubernetes.String(), "KubernetesMetric", "KubernetesMetric", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14829,6 +19454,7 @@ func (this *MetricResult) String() string {
 		`DryRun:` + fmt.Sprintf("%v", this.DryRun) + `,`,
 		`Metadata:` + mapStringForMetadata + `,`,
 		`ConsecutiveSuccess:` + fmt.Sprintf("%v", this.ConsecutiveSuccess) + `,`,
+		`Advisory:` + fmt.Sprintf("%v", this.Advisory) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14892,6 +19518,36 @@ func (this *OAuth2Config) String() string {
 	}, "")
 	return s
 }
+func (this *OTLPMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]WebMetricHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "WebMetricHeader", "WebMetricHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	s := strings.Join([]string{`&OTLPMetric{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`MetricName:` + fmt.Sprintf("%v", this.MetricName) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Lookback:` + fmt.Sprintf("%v", this.Lookback) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ObjectRef) String() string {
 	if this == nil {
 		return "nil"
@@ -15012,6 +19668,155 @@ func (this *PrometheusRangeQueryArgs) String() string {
 	}, "")
 	return s
 }
+func (this *ReleaseTrain) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseTrain{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ReleaseTrainSpec", "ReleaseTrainSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ReleaseTrainStatus", "ReleaseTrainStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]AnalysisTemplateRef{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateRef", "AnalysisTemplateRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForArgs := "[]Argument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "Argument", "Argument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&ReleaseTrainAnalysis{`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainImage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseTrainImage{`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`Image:` + fmt.Sprintf("%v", this.Image) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ReleaseTrain{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ReleaseTrain", "ReleaseTrain", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ReleaseTrainList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainRolloutStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseTrainRolloutStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`PreviousRevision:` + fmt.Sprintf("%v", this.PreviousRevision) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForImages := "[]ReleaseTrainImage{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ReleaseTrainImage", "ReleaseTrainImage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForWaves := "[]ReleaseTrainWave{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "ReleaseTrainWave", "ReleaseTrainWave", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&ReleaseTrainSpec{`,
+		`Images:` + repeatedStringForImages + `,`,
+		`TemplatePatch:` + valueToStringGenerated(this.TemplatePatch) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`RollbackOnFailure:` + fmt.Sprintf("%v", this.RollbackOnFailure) + `,`,
+		`Abort:` + fmt.Sprintf("%v", this.Abort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]ReleaseTrainWaveStatus{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "ReleaseTrainWaveStatus", "ReleaseTrainWaveStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&ReleaseTrainStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`CurrentWave:` + fmt.Sprintf("%v", this.CurrentWave) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainWave) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleaseTrainWave{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Rollouts:` + fmt.Sprintf("%v", this.Rollouts) + `,`,
+		`MaxConcurrent:` + valueToStringGenerated(this.MaxConcurrent) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "ReleaseTrainAnalysis", "ReleaseTrainAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleaseTrainWaveStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRollouts := "[]ReleaseTrainRolloutStatus{"
+	for _, f := range this.Rollouts {
+		repeatedStringForRollouts += strings.Replace(strings.Replace(f.String(), "ReleaseTrainRolloutStatus", "ReleaseTrainRolloutStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRollouts += "}"
+	s := strings.Join([]string{`&ReleaseTrainWaveStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Rollouts:` + repeatedStringForRollouts + `,`,
+		`AnalysisRun:` + fmt.Sprintf("%v", this.AnalysisRun) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ReplicaProgressThreshold) String() string {
 	if this == nil {
 		return "nil"
@@ -15111,6 +19916,35 @@ func (this *RolloutAnalysisRunStatus) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutCluster) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutCluster{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutClusterStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutClusterStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`StableRS:` + fmt.Sprintf("%v", this.StableRS) + `,`,
+		`CanaryRS:` + fmt.Sprintf("%v", this.CanaryRS) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`UpdatedReplicas:` + fmt.Sprintf("%v", this.UpdatedReplicas) + `,`,
+		`AvailableReplicas:` + fmt.Sprintf("%v", this.AvailableReplicas) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutCondition) String() string {
 	if this == nil {
 		return "nil"
@@ -15126,6 +19960,19 @@ func (this *RolloutCondition) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutDependency) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutDependency{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`RevisionAnnotation:` + fmt.Sprintf("%v", this.RevisionAnnotation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutDurationStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -15231,10 +20078,72 @@ func (this *RolloutPause) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutSchedule{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutScheduleSpec", "RolloutScheduleSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutScheduleList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]RolloutSchedule{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "RolloutSchedule", "RolloutSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutScheduleList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutScheduleRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutScheduleRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutScheduleSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWindows := "[]DeploymentWindow{"
+	for _, f := range this.Windows {
+		repeatedStringForWindows += strings.Replace(strings.Replace(f.String(), "DeploymentWindow", "DeploymentWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWindows += "}"
+	s := strings.Join([]string{`&RolloutScheduleSpec{`,
+		`Windows:` + repeatedStringForWindows + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForDependsOn := "[]RolloutDependency{"
+	for _, f := range this.DependsOn {
+		repeatedStringForDependsOn += strings.Replace(strings.Replace(f.String(), "RolloutDependency", "RolloutDependency", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDependsOn += "}"
+	repeatedStringForClusters := "[]RolloutCluster{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(strings.Replace(f.String(), "RolloutCluster", "RolloutCluster", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForClusters += "}"
 	s := strings.Join([]string{`&RolloutSpec{`,
 		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
@@ -15249,6 +20158,8 @@ func (this *RolloutSpec) String() string {
 		`Analysis:` + strings.Replace(this.Analysis.String(), "AnalysisRunStrategy", "AnalysisRunStrategy", 1) + `,`,
 		`ProgressDeadlineAbort:` + fmt.Sprintf("%v", this.ProgressDeadlineAbort) + `,`,
 		`RollbackWindow:` + strings.Replace(this.RollbackWindow.String(), "RollbackWindowSpec", "RollbackWindowSpec", 1) + `,`,
+		`DependsOn:` + repeatedStringForDependsOn + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15272,6 +20183,11 @@ func (this *RolloutStatus) String() string {
 		repeatedStringForALBs += strings.Replace(strings.Replace(f.String(), "ALBStatus", "ALBStatus", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForALBs += "}"
+	repeatedStringForClusters := "[]RolloutClusterStatus{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(strings.Replace(f.String(), "RolloutClusterStatus", "RolloutClusterStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForClusters += "}"
 	s := strings.Join([]string{`&RolloutStatus{`,
 		`Abort:` + fmt.Sprintf("%v", this.Abort) + `,`,
 		`PauseConditions:` + repeatedStringForPauseConditions + `,`,
@@ -15300,6 +20216,9 @@ func (this *RolloutStatus) String() string {
 		`ALB:` + strings.Replace(this.ALB.String(), "ALBStatus", "ALBStatus", 1) + `,`,
 		`ALBs:` + repeatedStringForALBs + `,`,
 		`Duration:` + strings.Replace(this.Duration.String(), "RolloutDurationStatus", "RolloutDurationStatus", 1) + `,`,
+		`NextDeploymentWindow:` + strings.Replace(fmt.Sprintf("%v", this.NextDeploymentWindow), "Time", "v1.Time", 1) + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
+		`DaemonSet:` + strings.Replace(strings.Replace(this.DaemonSet.String(), "DaemonSetStatus", "DaemonSetStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15311,6 +20230,8 @@ func (this *RolloutStrategy) String() string {
 	s := strings.Join([]string{`&RolloutStrategy{`,
 		`BlueGreen:` + strings.Replace(this.BlueGreen.String(), "BlueGreenStrategy", "BlueGreenStrategy", 1) + `,`,
 		`Canary:` + strings.Replace(this.Canary.String(), "CanaryStrategy", "CanaryStrategy", 1) + `,`,
+		`DeploymentWindows:` + strings.Replace(this.DeploymentWindows.String(), "DeploymentWindows", "DeploymentWindows", 1) + `,`,
+		`DaemonSet:` + strings.Replace(this.DaemonSet.String(), "DaemonSetStrategy", "DaemonSetStrategy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15346,6 +20267,7 @@ func (this *RolloutTrafficRouting) String() string {
 		`Apisix:` + strings.Replace(this.Apisix.String(), "ApisixTrafficRouting", "ApisixTrafficRouting", 1) + `,`,
 		`Plugins:` + mapStringForPlugins + `,`,
 		`MaxTrafficWeight:` + valueToStringGenerated(this.MaxTrafficWeight) + `,`,
+		`GatewayAPI:` + strings.Replace(this.GatewayAPI.String(), "GatewayAPITrafficRouting", "GatewayAPITrafficRouting", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15382,6 +20304,8 @@ func (this *RunSummary) String() string {
 		`Failed:` + fmt.Sprintf("%v", this.Failed) + `,`,
 		`Inconclusive:` + fmt.Sprintf("%v", this.Inconclusive) + `,`,
 		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`SuccessfulWeight:` + fmt.Sprintf("%v", this.SuccessfulWeight) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -15397,6 +20321,20 @@ func (this *SMITrafficRouting) String() string {
 	}, "")
 	return s
 }
+func (this *SQLMetric) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SQLMetric{`,
+		`Driver:` + fmt.Sprintf("%v", this.Driver) + `,`,
+		`DSN:` + fmt.Sprintf("%v", this.DSN) + `,`,
+		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`Parameters:` + fmt.Sprintf("%v", this.Parameters) + `,`,
+		`TimeoutSeconds:` + fmt.Sprintf("%v", this.TimeoutSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ScopeDetail) String() string {
 	if this == nil {
 		return "nil"
@@ -15445,6 +20383,17 @@ func (this *SetCanaryScale) String() string {
 	}, "")
 	return s
 }
+func (this *SetClusterWeight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetClusterWeight{`,
+		`Clusters:` + fmt.Sprintf("%v", this.Clusters) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SetHeaderRoute) String() string {
 	if this == nil {
 		return "nil"
@@ -15478,6 +20427,20 @@ func (this *SetMirrorRoute) String() string {
 	}, "")
 	return s
 }
+func (this *SetWeightRamp) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetWeightRamp{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Increment:` + fmt.Sprintf("%v", this.Increment) + `,`,
+		`Interval:` + fmt.Sprintf("%v", this.Interval) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "WeightRampAnalysis", "WeightRampAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Sigv4Config) String() string {
 	if this == nil {
 		return "nil"
@@ -15720,6 +20683,49 @@ func (this *WeightDestination) String() string {
 	}, "")
 	return s
 }
+func (this *WeightRampAnalysis) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WeightRampAnalysis{`,
+		`MetricName:` + fmt.Sprintf("%v", this.MetricName) + `,`,
+		`MinIncrement:` + fmt.Sprintf("%v", this.MinIncrement) + `,`,
+		`MaxIncrement:` + fmt.Sprintf("%v", this.MaxIncrement) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WeightRampIncrement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WeightRampIncrement{`,
+		`Increment:` + fmt.Sprintf("%v", this.Increment) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`MeasurementValue:` + fmt.Sprintf("%v", this.MeasurementValue) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WeightRampStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForIncrements := "[]WeightRampIncrement{"
+	for _, f := range this.Increments {
+		repeatedStringForIncrements += strings.Replace(strings.Replace(f.String(), "WeightRampIncrement", "WeightRampIncrement", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForIncrements += "}"
+	s := strings.Join([]string{`&WeightRampStatus{`,
+		`StepIndex:` + fmt.Sprintf("%v", this.StepIndex) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
+		`LastIncrementTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastIncrementTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Increments:` + repeatedStringForIncrements + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -17165,6 +22171,62 @@ func (m *AnalysisRunSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompositeConditions == nil {
+				m.CompositeConditions = &CompositeConditions{}
+			}
+			if err := m.CompositeConditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessWeightThreshold", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessWeightThreshold = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17454,6 +22516,42 @@ func (m *AnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvisorySummary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdvisorySummary == nil {
+				m.AdvisorySummary = &RunSummary{}
+			}
+			if err := m.AdvisorySummary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18100,6 +23198,62 @@ func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompositeConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompositeConditions == nil {
+				m.CompositeConditions = &CompositeConditions{}
+			}
+			if err := m.CompositeConditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessWeightThreshold", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessWeightThreshold = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20453,79 +25607,115 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CanaryStep) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SetWeight = &v
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WeightRamp == nil {
+				m.WeightRamp = &WeightRampStatus{}
+			}
+			if err := m.WeightRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetWeight = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20775,6 +25965,78 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeightRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetWeightRamp == nil {
+				m.SetWeightRamp = &SetWeightRamp{}
+			}
+			if err := m.SetWeightRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetClusterWeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetClusterWeight == nil {
+				m.SetClusterWeight = &SetClusterWeight{}
+			}
+			if err := m.SetClusterWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22401,7 +27663,7 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
+func (m *CompositeConditions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22424,15 +27686,15 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: CompositeConditions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CompositeConditions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22460,11 +27722,11 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22492,11 +27754,167 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonSetCohort) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonSetCohort: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonSetCohort: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeSelector == nil {
+				m.NodeSelector = &v1.LabelSelector{}
+			}
+			if err := m.NodeSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Percentage = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonSetStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonSetStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonSetStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22523,109 +27941,18 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]string)
+			if m.CurrentStepAnalysisRunStatus == nil {
+				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22635,29 +27962,33 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Formula = string(dAtA[iNdEx:postIndex])
+			if m.CurrentBackgroundAnalysisRunStatus == nil {
+				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var stringLen uint64
+			m.Nodes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22667,29 +27998,16 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nodes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ApiVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedNodes", wireType)
 			}
-			var stringLen uint64
+			m.UpdatedNodes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22699,27 +28017,64 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpdatedNodes |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Aggregator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DaemonSetStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DaemonSetStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DaemonSetStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cohort", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22746,15 +28101,18 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Cohort == nil {
+				m.Cohort = &DaemonSetCohort{}
+			}
+			if err := m.Cohort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22764,79 +28122,33 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestTimeout = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DryRun) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pause == nil {
+				m.Pause = &RolloutPause{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22846,23 +28158,27 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22885,7 +28201,7 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Experiment) Unmarshal(dAtA []byte) error {
+func (m *DaemonSetStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22908,15 +28224,15 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+			return fmt.Errorf("proto: DaemonSetStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DaemonSetStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22943,13 +28259,14 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, DaemonSetStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22976,13 +28293,16 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysisBackground{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23009,7 +28329,10 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
+			}
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23034,7 +28357,7 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23057,15 +28380,15 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23093,11 +28416,11 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23125,13 +28448,13 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23141,27 +28464,122 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			if m.Queries == nil {
+				m.Queries = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23189,61 +28607,11 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Formula = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23271,11 +28639,11 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ApiVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23303,13 +28671,13 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			m.Aggregator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23319,28 +28687,7 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ClusterScope = &b
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -23355,16 +28702,15 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, Argument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimeout", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23374,12 +28720,24 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiredForCompletion = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestTimeout = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23401,7 +28759,7 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
+func (m *DeploymentWindow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23424,15 +28782,15 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentWindow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentWindow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23460,11 +28818,11 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
+			m.Kind = DeploymentWindowKind(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23492,13 +28850,13 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RRule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23508,61 +28866,27 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RRule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23590,11 +28914,11 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23622,7 +28946,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23645,7 +28969,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentList) Unmarshal(dAtA []byte) error {
+func (m *DeploymentWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23668,15 +28992,15 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentWindows: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentWindows: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23703,13 +29027,14 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, DeploymentWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleRefs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23736,8 +29061,8 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Experiment{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ScheduleRefs = append(m.ScheduleRefs, RolloutScheduleRef{})
+			if err := m.ScheduleRefs[len(m.ScheduleRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23762,7 +29087,7 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
+func (m *DryRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23785,17 +29110,17 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23805,29 +29130,77 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, TemplateSpec{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MetricName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElasticsearchMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElasticsearchMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElasticsearchMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23855,13 +29228,13 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23871,17 +29244,29 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ProgressDeadlineSeconds = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23891,17 +29276,29 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Terminate = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23911,31 +29308,29 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampField", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23945,15 +29340,27 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ScaleDownDelaySeconds = &v
-		case 7:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimestampField = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23980,16 +29387,16 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			m.TimeoutSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23999,31 +29406,16 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24033,25 +29425,12 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Insecure = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24073,7 +29452,7 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
+func (m *Experiment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24096,113 +29475,15 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
-			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24229,16 +29510,13 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AvailableAt == nil {
-				m.AvailableAt = &v1.Time{}
-			}
-			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24265,14 +29543,13 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ExperimentCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24299,8 +29576,7 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
-			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24325,7 +29601,7 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FieldRef) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24348,15 +29624,15 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24384,61 +29660,43 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24466,11 +29724,11 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24498,7 +29756,7 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24521,7 +29779,7 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24544,15 +29802,15 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HeaderRoutingMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeaderRoutingMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24580,11 +29838,64 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeaderName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ClusterScope = &b
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24611,13 +29922,31 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeaderValue == nil {
-				m.HeaderValue = &StringMatch{}
-			}
-			if err := m.HeaderValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24639,7 +29968,7 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
+func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24662,15 +29991,15 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfluxdbMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfluxdbMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24698,11 +30027,11 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24730,63 +30059,13 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24796,29 +30075,30 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24828,27 +30108,28 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24876,11 +30157,11 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalSubsetNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24908,7 +30189,7 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalSubsetNames = append(m.AdditionalSubsetNames, string(dAtA[iNdEx:postIndex]))
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24931,7 +30212,7 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24954,15 +30235,15 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24989,16 +30270,13 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VirtualService == nil {
-				m.VirtualService = &IstioVirtualService{}
-			}
-			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25025,52 +30303,16 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationRule == nil {
-				m.DestinationRule = &IstioDestinationRule{}
-			}
-			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Experiment{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
-			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -25087,7 +30329,7 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
+func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25110,17 +30352,17 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25130,27 +30372,29 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, TemplateSpec{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25178,11 +30422,51 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminate = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25209,14 +30493,34 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
-			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ScaleDownDelaySeconds = &v
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25243,64 +30547,14 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TCPRoutes = append(m.TCPRoutes, TCPRoute{})
-			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25327,13 +30581,14 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25360,7 +30615,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25385,7 +30640,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25408,15 +30663,15 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25444,11 +30699,11 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25476,13 +30731,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25492,29 +30747,31 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
+			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25524,29 +30781,33 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
+			if m.AvailableAt == nil {
+				m.AvailableAt = &v1.Time{}
+			}
+			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25556,29 +30817,31 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			m.Conditions = append(m.Conditions, ExperimentCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25588,29 +30851,81 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25620,30 +30935,79 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GatewayAPIRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GatewayAPIRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GatewayAPIRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25653,46 +31017,24 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, KayentaScope{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Lookback = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25714,7 +31056,7 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaScope) Unmarshal(dAtA []byte) error {
+func (m *GatewayAPITrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25737,17 +31079,17 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
+			return fmt.Errorf("proto: GatewayAPITrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GatewayAPITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25757,27 +31099,29 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.HTTPRoutes = append(m.HTTPRoutes, GatewayAPIRoute{})
+			if err := m.HTTPRoutes[len(m.HTTPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPCRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25804,13 +31148,14 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GRPCRoutes = append(m.GRPCRoutes, GatewayAPIRoute{})
+			if err := m.GRPCRoutes[len(m.GRPCRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25837,7 +31182,8 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TCPRoutes = append(m.TCPRoutes, GatewayAPIRoute{})
+			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25862,7 +31208,7 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
+func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25885,17 +31231,17 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Pass = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25905,16 +31251,29 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Pass |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			m.Marginal = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25924,11 +31283,24 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Marginal |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25950,7 +31322,7 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
+func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25973,15 +31345,15 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeaderRoutingMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeaderRoutingMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26009,19 +31381,55 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.HeaderName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeaderValue == nil {
+				m.HeaderValue = &StringMatch{}
+			}
+			if err := m.HeaderValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -26032,7 +31440,7 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Measurement) Unmarshal(dAtA []byte) error {
+func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26055,15 +31463,15 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfluxdbMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfluxdbMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26091,11 +31499,11 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Profile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26123,49 +31531,63 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26175,31 +31597,27 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26227,13 +31645,13 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26243,124 +31661,29 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Metadata[mapkey] = mapvalue
+			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalSubsetNames", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26370,27 +31693,23 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResumeAt == nil {
-				m.ResumeAt = &v1.Time{}
-			}
-			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AdditionalSubsetNames = append(m.AdditionalSubsetNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26413,7 +31732,7 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
+func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26436,17 +31755,17 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MeasurementRetention: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MeasurementRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26456,29 +31775,33 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			if m.VirtualService == nil {
+				m.VirtualService = &IstioVirtualService{}
+			}
+			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
 			}
-			m.Limit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26488,11 +31811,62 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DestinationRule == nil {
+				m.DestinationRule = &IstioDestinationRule{}
+			}
+			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
+			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26514,7 +31888,7 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metric) Unmarshal(dAtA []byte) error {
+func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26537,10 +31911,10 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -26577,7 +31951,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26605,13 +31979,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26621,27 +31995,29 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
+			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
+			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26668,50 +32044,66 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
-			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TCPRoutes = append(m.TCPRoutes, TCPRoute{})
+			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26721,27 +32113,28 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26768,52 +32161,95 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailureLimit == nil {
-				m.FailureLimit = &intstr.IntOrString{}
-			}
-			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JudgeComparison) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JudgeComparison: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JudgeComparison: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InconclusiveLimit == nil {
-				m.InconclusiveLimit = &intstr.IntOrString{}
-			}
-			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26840,16 +32276,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsecutiveErrorLimit == nil {
-				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
-			}
-			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Baseline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26876,15 +32309,15 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Baseline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccessLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26894,27 +32327,23 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsecutiveSuccessLimit == nil {
-				m.ConsecutiveSuccessLimit = &intstr.IntOrString{}
-			}
-			if err := m.ConsecutiveSuccessLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Direction = JudgeDirection(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26937,7 +32366,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricProvider) Unmarshal(dAtA []byte) error {
+func (m *JudgeMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26960,15 +32389,15 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: JudgeMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JudgeMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comparisons", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26995,16 +32424,14 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prometheus == nil {
-				m.Prometheus = &PrometheusMetric{}
-			}
-			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Comparisons = append(m.Comparisons, JudgeComparison{})
+			if err := m.Comparisons[len(m.Comparisons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27031,18 +32458,15 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kayenta == nil {
-				m.Kayenta = &KayentaMetric{}
-			}
-			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfidenceLevel", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27052,67 +32476,65 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.ConfidenceLevel = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Web == nil {
-				m.Web = &WebMetric{}
-			}
-			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JudgeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datadog == nil {
-				m.Datadog = &DatadogMetric{}
-			}
-			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JudgeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JudgeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27139,16 +32561,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wavefront == nil {
-				m.Wavefront = &WavefrontMetric{}
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusMetric{}
 			}
-			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27175,16 +32597,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRelic == nil {
-				m.NewRelic = &NewRelicMetric{}
+			if m.Web == nil {
+				m.Web = &WebMetric{}
 			}
-			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27211,16 +32633,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &JobMetric{}
+			if m.Datadog == nil {
+				m.Datadog = &DatadogMetric{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27247,16 +32669,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CloudWatch == nil {
-				m.CloudWatch = &CloudWatchMetric{}
+			if m.Wavefront == nil {
+				m.Wavefront = &WavefrontMetric{}
 			}
-			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27283,16 +32705,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Graphite == nil {
-				m.Graphite = &GraphiteMetric{}
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicMetric{}
 			}
-			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27319,16 +32741,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Influxdb == nil {
-				m.Influxdb = &InfluxdbMetric{}
+			if m.CloudWatch == nil {
+				m.CloudWatch = &CloudWatchMetric{}
 			}
-			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27355,16 +32777,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SkyWalking == nil {
-				m.SkyWalking = &SkyWalkingMetric{}
+			if m.Graphite == nil {
+				m.Graphite = &GraphiteMetric{}
 			}
-			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27391,104 +32813,48 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = make(map[string]encoding_json.RawMessage)
+			if m.Influxdb == nil {
+				m.Influxdb = &InfluxdbMetric{}
 			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SkyWalking == nil {
+				m.SkyWalking = &SkyWalkingMetric{}
+			}
+			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27511,7 +32877,7 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricResult) Unmarshal(dAtA []byte) error {
+func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27534,15 +32900,15 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27570,11 +32936,11 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27602,13 +32968,13 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27618,29 +32984,27 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Measurements = append(m.Measurements, Measurement{})
-			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27668,13 +33032,13 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27684,16 +33048,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
 			}
-			m.Successful = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27703,16 +33080,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			m.Failed = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27722,16 +33112,64 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, KayentaScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
 			}
-			m.Inconclusive = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27741,16 +33179,4969 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lookback = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KayentaScope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+			}
+			m.Pass = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pass |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+			}
+			m.Marginal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Marginal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubernetesMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubernetesMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LokiMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LokiMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LokiMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RangeQuery == nil {
+				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			}
+			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Measurement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeAt == nil {
+				m.ResumeAt = &v1.Time{}
+			}
+			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MeasurementRetention: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MeasurementRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
+			}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailureLimit == nil {
+				m.FailureLimit = &intstr.IntOrString{}
+			}
+			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InconclusiveLimit == nil {
+				m.InconclusiveLimit = &intstr.IntOrString{}
+			}
+			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsecutiveErrorLimit == nil {
+				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
+			}
+			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccessLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsecutiveSuccessLimit == nil {
+				m.ConsecutiveSuccessLimit = &intstr.IntOrString{}
+			}
+			if err := m.ConsecutiveSuccessLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advisory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Advisory = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weight = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusMetric{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kayenta == nil {
+				m.Kayenta = &KayentaMetric{}
+			}
+			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Web == nil {
+				m.Web = &WebMetric{}
+			}
+			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datadog == nil {
+				m.Datadog = &DatadogMetric{}
+			}
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wavefront == nil {
+				m.Wavefront = &WavefrontMetric{}
+			}
+			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicMetric{}
+			}
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &JobMetric{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CloudWatch == nil {
+				m.CloudWatch = &CloudWatchMetric{}
+			}
+			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Graphite == nil {
+				m.Graphite = &GraphiteMetric{}
+			}
+			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Influxdb == nil {
+				m.Influxdb = &InfluxdbMetric{}
+			}
+			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SkyWalking == nil {
+				m.SkyWalking = &SkyWalkingMetric{}
+			}
+			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plugin == nil {
+				m.Plugin = make(map[string]encoding_json.RawMessage)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Judge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Judge == nil {
+				m.Judge = &JudgeMetric{}
+			}
+			if err := m.Judge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OTLP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OTLP == nil {
+				m.OTLP = &OTLPMetric{}
+			}
+			if err := m.OTLP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elasticsearch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Elasticsearch == nil {
+				m.Elasticsearch = &ElasticsearchMetric{}
+			}
+			if err := m.Elasticsearch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loki", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Loki == nil {
+				m.Loki = &LokiMetric{}
+			}
+			if err := m.Loki.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SQL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SQL == nil {
+				m.SQL = &SQLMetric{}
+			}
+			if err := m.SQL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &KubernetesMetric{}
+			}
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Measurements = append(m.Measurements, Measurement{})
+			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			}
+			m.Inconclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inconclusive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+			}
+			m.ConsecutiveError = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveError |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccess", wireType)
+			}
+			m.ConsecutiveSuccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveSuccess |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Advisory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Advisory = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdditionalIngressAnnotations == nil {
+				m.AdditionalIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AdditionalIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanaryIngressAnnotations == nil {
+				m.CanaryIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CanaryIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OTLPMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OTLPMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OTLPMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lookback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lookback = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScaleDown = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PingService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PongService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
 			}
-			m.Error = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27760,16 +38151,30 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			m.ConsecutiveError = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27779,14 +38184,15 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsecutiveError |= int32(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			m.Timeout = &v
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -27803,10 +38209,10 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.DryRun = bool(v != 0)
-		case 12:
+			m.Insecure = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27833,109 +38239,16 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccess", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
 			}
-			m.ConsecutiveSuccess = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27945,11 +38258,28 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConsecutiveSuccess |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RangeQuery == nil {
+				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			}
+			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27971,7 +38301,7 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27994,15 +38324,15 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusRangeQueryArgs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusRangeQueryArgs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28030,11 +38360,11 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28062,13 +38392,13 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28078,12 +38408,24 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Timeout = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28105,7 +38447,7 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrain) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28128,17 +38470,17 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrain: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrain: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28148,29 +38490,30 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28180,27 +38523,28 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28227,109 +38571,65 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalIngressAnnotations == nil {
-				m.AdditionalIngressAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.AdditionalIngressAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseTrainAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseTrainAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseTrainAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28339,27 +38639,29 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
+			m.Templates = append(m.Templates, AnalysisTemplateRef{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28386,103 +38688,10 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryIngressAnnotations == nil {
-				m.CanaryIngressAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CanaryIngressAnnotations[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28505,7 +38714,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrainImage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28528,15 +38737,15 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrainImage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrainImage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28564,11 +38773,11 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			m.Container = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28596,13 +38805,63 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			m.Image = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseTrainList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseTrainList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseTrainList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28612,29 +38871,30 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28644,23 +38904,25 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, ReleaseTrain{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28683,7 +38945,7 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrainRolloutStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28706,15 +38968,15 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrainRolloutStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrainRolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28742,11 +39004,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28774,11 +39036,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Phase = ReleaseTrainPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28806,11 +39068,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28838,7 +39100,7 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScaleDown = string(dAtA[iNdEx:postIndex])
+			m.PreviousRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28861,7 +39123,7 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PauseCondition) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrainSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28884,17 +39146,17 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrainSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrainSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28904,29 +39166,31 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, ReleaseTrainImage{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplatePatch", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28936,80 +39200,31 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TemplatePatch = append(m.TemplatePatch[:0], dAtA[iNdEx:postIndex]...)
+			if m.TemplatePatch == nil {
+				m.TemplatePatch = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29019,29 +39234,31 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PingService = string(dAtA[iNdEx:postIndex])
+			m.Waves = append(m.Waves, ReleaseTrainWave{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackOnFailure", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29051,24 +39268,32 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.RollbackOnFailure = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PongService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Abort = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29090,7 +39315,7 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginStep) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrainStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29113,15 +39338,15 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrainStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrainStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29149,13 +39374,13 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Phase = ReleaseTrainPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29165,81 +39390,29 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
-			if m.Config == nil {
-				m.Config = []byte{}
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWave", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29249,122 +39422,27 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.CurrentWave = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29391,159 +39469,52 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Waves = append(m.Waves, ReleaseTrainWaveStatus{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29553,11 +39524,28 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29579,7 +39567,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrainWave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29602,15 +39590,15 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrainWave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrainWave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29638,11 +39626,11 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollouts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29670,86 +39658,13 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Rollouts = append(m.Rollouts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Timeout = &v
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrent", wireType)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29759,29 +39674,15 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Headers = append(m.Headers, WebMetricHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.MaxConcurrent = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29808,10 +39709,10 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RangeQuery == nil {
-				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			if m.Analysis == nil {
+				m.Analysis = &ReleaseTrainAnalysis{}
 			}
-			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29836,7 +39737,7 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
+func (m *ReleaseTrainWaveStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29859,15 +39760,15 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusRangeQueryArgs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseTrainWaveStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusRangeQueryArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseTrainWaveStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29895,11 +39796,11 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29927,11 +39828,11 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			m.Phase = ReleaseTrainPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29959,7 +39860,73 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Step = DurationString(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollouts = append(m.Rollouts, ReleaseTrainRolloutStatus{})
+			if err := m.Rollouts[len(m.Rollouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30215,25 +40182,242 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, AnalysisTemplateRef{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30260,13 +40444,14 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30293,13 +40478,14 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30326,7 +40512,10 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AnalysisRunMetadata == nil {
+				m.AnalysisRunMetadata = &AnalysisRunMetadata{}
+			}
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30351,7 +40540,7 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30374,15 +40563,15 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30409,16 +40598,15 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, AnalysisTemplateRef{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30428,31 +40616,67 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.StartingStep = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30462,31 +40686,29 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30496,31 +40718,29 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30530,27 +40750,23 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AnalysisRunMetadata == nil {
-				m.AnalysisRunMetadata = &AnalysisRunMetadata{}
-			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30573,7 +40789,7 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+func (m *RolloutCluster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30596,17 +40812,17 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutCluster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutCluster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30616,28 +40832,59 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -30654,7 +40901,7 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.StartingStep = &v
+			m.Replicas = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30676,7 +40923,7 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *RolloutClusterStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30699,10 +40946,10 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutClusterStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutClusterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -30738,8 +40985,27 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30767,9 +41033,98 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.StableRS = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryRS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryRS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			}
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+			}
+			m.AvailableReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -31066,6 +41421,184 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RolloutDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionAnnotation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevisionAnnotation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RolloutDurationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31985,50 +42518,453 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Rollout{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &intstr.IntOrString{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutScheduleList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutScheduleList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutScheduleList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, RolloutSchedule{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutScheduleRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutScheduleRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutScheduleRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32038,25 +42974,23 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Rollout{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32079,7 +43013,7 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutPause) Unmarshal(dAtA []byte) error {
+func (m *RolloutScheduleSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32102,15 +43036,15 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutScheduleSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutScheduleSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32137,10 +43071,8 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &intstr.IntOrString{}
-			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Windows = append(m.Windows, DeploymentWindow{})
+			if err := m.Windows[len(m.Windows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -32559,6 +43491,74 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, RolloutDependency{})
+			if err := m.DependsOn[len(m.DependsOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, RolloutCluster{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32929,13 +43929,164 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservedGeneration = string(dAtA[iNdEx:postIndex])
+			m.ObservedGeneration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, RolloutCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HPAReplicas", wireType)
+			}
+			m.HPAReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HPAReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32945,29 +44096,27 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, RolloutCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StableRS = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32994,48 +44143,18 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.RestartedAt == nil {
+				m.RestartedAt = &v1.Time{}
 			}
-			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RestartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 21:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HPAReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFull", wireType)
 			}
-			m.HPAReplicas = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33045,14 +44164,15 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HPAReplicas |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			m.PromoteFull = bool(v != 0)
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33080,11 +44200,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33112,13 +44232,13 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableRS = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33128,53 +44248,29 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RestartedAt == nil {
-				m.RestartedAt = &v1.Time{}
-			}
-			if err := m.RestartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFull", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.PromoteFull = bool(v != 0)
-		case 22:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33184,29 +44280,33 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			if m.ALB == nil {
+				m.ALB = &ALBStatus{}
+			}
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 23:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ALBs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33216,29 +44316,31 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.ALBs = append(m.ALBs, ALBStatus{})
+			if err := m.ALBs[len(m.ALBs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 24:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33248,27 +44350,31 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
+			if m.Duration == nil {
+				m.Duration = &RolloutDurationStatus{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 25:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextDeploymentWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33295,16 +44401,16 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ALB == nil {
-				m.ALB = &ALBStatus{}
+			if m.NextDeploymentWindow == nil {
+				m.NextDeploymentWindow = &v1.Time{}
 			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NextDeploymentWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALBs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33331,14 +44437,14 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ALBs = append(m.ALBs, ALBStatus{})
-			if err := m.ALBs[len(m.ALBs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Clusters = append(m.Clusters, RolloutClusterStatus{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DaemonSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33365,10 +44471,7 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &RolloutDurationStatus{}
-			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DaemonSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -33487,10 +44590,82 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Canary == nil {
-				m.Canary = &CanaryStrategy{}
+			if m.Canary == nil {
+				m.Canary = &CanaryStrategy{}
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeploymentWindows == nil {
+				m.DeploymentWindows = &DeploymentWindows{}
+			}
+			if err := m.DeploymentWindows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaemonSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DaemonSet == nil {
+				m.DaemonSet = &DaemonSetStrategy{}
 			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DaemonSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34014,6 +45189,42 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MaxTrafficWeight = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GatewayAPI", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GatewayAPI == nil {
+				m.GatewayAPI = &GatewayAPITrafficRouting{}
+			}
+			if err := m.GatewayAPI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34410,6 +45621,44 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulWeight", wireType)
+			}
+			m.SuccessfulWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessfulWeight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34545,6 +45794,203 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SQLMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SQLMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SQLMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Driver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Driver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DSN", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DSN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parameters = append(m.Parameters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35068,6 +46514,107 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetClusterWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetClusterWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetClusterWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35320,6 +46867,181 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetWeightRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetWeightRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetWeightRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			m.Increment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Increment |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &WeightRampAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37562,7 +49284,439 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WavefrontMetric) Unmarshal(dAtA []byte) error {
+func (m *WavefrontMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WavefrontMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WavefrontMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = WebMetricMethod(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+			}
+			m.TimeoutSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSONBody = append(m.JSONBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.JSONBody == nil {
+				m.JSONBody = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37585,15 +49739,15 @@ func (m *WavefrontMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WavefrontMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: WebMetricHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WavefrontMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WebMetricHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37621,11 +49775,11 @@ func (m *WavefrontMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37653,7 +49807,7 @@ func (m *WavefrontMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37676,7 +49830,7 @@ func (m *WavefrontMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebMetric) Unmarshal(dAtA []byte) error {
+func (m *WeightDestination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37699,17 +49853,17 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightDestination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightDestination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37719,27 +49873,14 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = WebMetricMethod(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37767,45 +49908,11 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Headers = append(m.Headers, WebMetricHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37833,30 +49940,61 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Body = string(dAtA[iNdEx:postIndex])
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.TimeoutSeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TimeoutSeconds |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WeightRampAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WeightRampAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WeightRampAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -37884,33 +50022,13 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSONPath = string(dAtA[iNdEx:postIndex])
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Insecure = bool(v != 0)
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIncrement", wireType)
 			}
-			var byteLen int
+			m.MinIncrement = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37920,31 +50038,16 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MinIncrement |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JSONBody = append(m.JSONBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.JSONBody == nil {
-				m.JSONBody = []byte{}
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncrement", wireType)
 			}
-			var msglen int
+			m.MaxIncrement = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37954,25 +50057,11 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxIncrement |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37994,7 +50083,7 @@ func (m *WebMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
+func (m *WeightRampIncrement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38017,15 +50106,53 @@ func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebMetricHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightRampIncrement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebMetricHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightRampIncrement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			m.Increment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Increment |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38053,13 +50180,13 @@ func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.MeasurementValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38069,23 +50196,24 @@ func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -38108,7 +50236,7 @@ func (m *WebMetricHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WeightDestination) Unmarshal(dAtA []byte) error {
+func (m *WeightRampStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38131,13 +50259,32 @@ func (m *WeightDestination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WeightDestination: wiretype end group for non-group")
+			return fmt.Errorf("proto: WeightRampStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WeightDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WeightRampStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepIndex", wireType)
+			}
+			m.StepIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
@@ -38156,11 +50303,11 @@ func (m *WeightDestination) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIncrementTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38170,29 +50317,30 @@ func (m *WeightDestination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			if err := m.LastIncrementTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Increments", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -38202,23 +50350,25 @@ func (m *WeightDestination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			m.Increments = append(m.Increments, WeightRampIncrement{})
+			if err := m.Increments[len(m.Increments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
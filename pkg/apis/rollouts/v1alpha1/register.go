@@ -18,6 +18,8 @@ var (
 	AnalysisTemplateGVR        = SchemeGroupVersion.WithResource("analysistemplates")
 	ClusterAnalysisTemplateGVR = SchemeGroupVersion.WithResource("clusteranalysistemplates")
 	ExperimentGVR              = SchemeGroupVersion.WithResource("experiments")
+	RolloutScheduleGVR         = SchemeGroupVersion.WithResource("rolloutschedules")
+	ReleaseTrainGVR            = SchemeGroupVersion.WithResource("releasetrains")
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -48,6 +50,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAnalysisTemplateList{},
 		&AnalysisRun{},
 		&AnalysisRunList{},
+		&RolloutSchedule{},
+		&RolloutScheduleList{},
+		&ReleaseTrain{},
+		&ReleaseTrainList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,164 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReleaseTrain pushes an image or pod template change through an ordered list of Rollouts in waves
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=releasetrains,shortName=rt
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",description="ReleaseTrain status"
+// +kubebuilder:printcolumn:name="Wave",type="string",JSONPath=".status.currentWave",description="Wave the ReleaseTrain is currently releasing"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type ReleaseTrain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   ReleaseTrainSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status ReleaseTrainStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// ReleaseTrainList is a list of ReleaseTrain resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ReleaseTrainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []ReleaseTrain `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ReleaseTrainSpec is the spec for a ReleaseTrain resource
+type ReleaseTrainSpec struct {
+	// Images sets the image of the named containers in the pod template of each Rollout
+	// +optional
+	Images []ReleaseTrainImage `json:"images,omitempty" protobuf:"bytes,1,rep,name=images"`
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// TemplatePatch is a JSON merge patch which is applied to the pod template of each Rollout
+	// +optional
+	TemplatePatch json.RawMessage `json:"templatePatch,omitempty" protobuf:"bytes,2,opt,name=templatePatch"`
+	// Waves are the groups of Rollouts which are released one after the other
+	Waves []ReleaseTrainWave `json:"waves" protobuf:"bytes,3,rep,name=waves"`
+	// RollbackOnFailure undoes the change on every Rollout released by the train when a wave fails
+	// +optional
+	RollbackOnFailure bool `json:"rollbackOnFailure,omitempty" protobuf:"varint,4,opt,name=rollbackOnFailure"`
+	// Abort stops the train. Waves which have not started are not released.
+	// +optional
+	Abort bool `json:"abort,omitempty" protobuf:"varint,5,opt,name=abort"`
+}
+
+// ReleaseTrainImage sets the image of a container
+type ReleaseTrainImage struct {
+	// Container is the name of the container or init container
+	Container string `json:"container" protobuf:"bytes,1,opt,name=container"`
+	// Image to set on the container
+	Image string `json:"image" protobuf:"bytes,2,opt,name=image"`
+}
+
+// ReleaseTrainWave is a group of Rollouts which are released together
+type ReleaseTrainWave struct {
+	// Name of the wave
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Rollouts are the names of the Rollouts in the namespace of the ReleaseTrain which are released in this wave
+	Rollouts []string `json:"rollouts" protobuf:"bytes,2,rep,name=rollouts"`
+	// MaxConcurrent is the maximum number of Rollouts of the wave which are updating at the same time.
+	// Defaults to all the Rollouts of the wave.
+	// +optional
+	MaxConcurrent *int32 `json:"maxConcurrent,omitempty" protobuf:"varint,3,opt,name=maxConcurrent"`
+	// Analysis gates the wave once all of its Rollouts are healthy. The next wave only starts after the
+	// analysis is successful.
+	// +optional
+	Analysis *ReleaseTrainAnalysis `json:"analysis,omitempty" protobuf:"bytes,4,opt,name=analysis"`
+}
+
+// ReleaseTrainAnalysis references the AnalysisTemplates of an analysis run after a wave
+type ReleaseTrainAnalysis struct {
+	// Templates reference to a list of analysis templates to combine for an AnalysisRun
+	// +patchMergeKey=templateName
+	// +patchStrategy=merge
+	Templates []AnalysisTemplateRef `json:"templates" patchStrategy:"merge" patchMergeKey:"templateName" protobuf:"bytes,1,rep,name=templates"`
+	// Args are the arguments that will be added to the AnalysisRun
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Args []Argument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=args"`
+}
+
+// ReleaseTrainPhase is the phase of a ReleaseTrain, of one of its waves or of one of its Rollouts
+type ReleaseTrainPhase string
+
+const (
+	ReleaseTrainPhasePending    ReleaseTrainPhase = "Pending"
+	ReleaseTrainPhaseRunning    ReleaseTrainPhase = "Running"
+	ReleaseTrainPhaseSuccessful ReleaseTrainPhase = "Successful"
+	ReleaseTrainPhaseFailed     ReleaseTrainPhase = "Failed"
+	ReleaseTrainPhaseRolledBack ReleaseTrainPhase = "RolledBack"
+	// ReleaseTrainPhaseRollbackFailed indicates that some Rollouts could not be rolled back since the ReplicaSet of
+	// their previous revision no longer exists
+	ReleaseTrainPhaseRollbackFailed ReleaseTrainPhase = "RollbackFailed"
+)
+
+// Completed returns whether or not the phase is a terminal phase
+func (p ReleaseTrainPhase) Completed() bool {
+	switch p {
+	case ReleaseTrainPhaseSuccessful, ReleaseTrainPhaseFailed, ReleaseTrainPhaseRolledBack, ReleaseTrainPhaseRollbackFailed:
+		return true
+	}
+	return false
+}
+
+// ReleaseTrainStatus is the status for a ReleaseTrain resource
+type ReleaseTrainStatus struct {
+	// Phase is the status of the ReleaseTrain
+	Phase ReleaseTrainPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ReleaseTrainPhase"`
+	// Message explains the phase of the ReleaseTrain
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// CurrentWave is the name of the wave which is being released
+	// +optional
+	CurrentWave string `json:"currentWave,omitempty" protobuf:"bytes,3,opt,name=currentWave"`
+	// Waves is the status of each wave
+	// +optional
+	Waves []ReleaseTrainWaveStatus `json:"waves,omitempty" protobuf:"bytes,4,rep,name=waves"`
+	// StartedAt is the time the ReleaseTrain started releasing its first wave
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,5,opt,name=startedAt"`
+	// FinishedAt is the time the ReleaseTrain completed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,6,opt,name=finishedAt"`
+}
+
+// ReleaseTrainWaveStatus is the status of a wave of a ReleaseTrain
+type ReleaseTrainWaveStatus struct {
+	// Name of the wave
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase of the wave
+	Phase ReleaseTrainPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=ReleaseTrainPhase"`
+	// Message explains the phase of the wave
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Rollouts is the status of each Rollout of the wave
+	Rollouts []ReleaseTrainRolloutStatus `json:"rollouts,omitempty" protobuf:"bytes,4,rep,name=rollouts"`
+	// AnalysisRun is the name of the AnalysisRun gating the wave
+	// +optional
+	AnalysisRun string `json:"analysisRun,omitempty" protobuf:"bytes,5,opt,name=analysisRun"`
+}
+
+// ReleaseTrainRolloutStatus is the status of a Rollout released by a ReleaseTrain
+type ReleaseTrainRolloutStatus struct {
+	// Name of the Rollout
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Phase of the release of the Rollout
+	Phase ReleaseTrainPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=ReleaseTrainPhase"`
+	// Message explains the phase of the release of the Rollout
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// PreviousRevision is the revision of the Rollout before it was updated by the ReleaseTrain. It is empty if the
+	// Rollout already had the change.
+	// +optional
+	PreviousRevision string `json:"previousRevision,omitempty" protobuf:"bytes,4,opt,name=previousRevision"`
+}
@@ -0,0 +1,77 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RolloutSchedule is a cluster wide calendar of deployment windows which can be referenced by Rollouts
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rolloutschedules,scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type RolloutSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutScheduleSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutScheduleList is a list of RolloutSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RolloutScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RolloutSchedule `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutScheduleSpec is the specification for a RolloutSchedule resource
+type RolloutScheduleSpec struct {
+	// Windows is the list of allowed and blocked deployment windows of the schedule
+	Windows []DeploymentWindow `json:"windows" protobuf:"bytes,1,rep,name=windows"`
+}
+
+// DeploymentWindowKind is the kind of a deployment window
+type DeploymentWindowKind string
+
+const (
+	// DeploymentWindowKindAllow allows new revisions to progress while the window is open
+	DeploymentWindowKindAllow DeploymentWindowKind = "Allow"
+	// DeploymentWindowKindBlock holds new revisions while the window is open
+	DeploymentWindowKindBlock DeploymentWindowKind = "Block"
+)
+
+// DeploymentWindow is a recurring time window during which new revisions are either allowed or blocked
+type DeploymentWindow struct {
+	// Kind is either Allow or Block
+	Kind DeploymentWindowKind `json:"kind" protobuf:"bytes,1,opt,name=kind,casttype=DeploymentWindowKind"`
+	// Schedule is a standard cron expression (e.g. "0 9 * * 1-5") at which the window opens.
+	// Either schedule or rrule must be specified
+	// +optional
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
+	// RRule is an RFC 5545 recurrence rule (e.g. "DTSTART:20240101T090000\nRRULE:FREQ=WEEKLY;BYDAY=MO")
+	// at which the window opens. Either schedule or rrule must be specified
+	// +optional
+	RRule string `json:"rrule,omitempty" protobuf:"bytes,3,opt,name=rrule"`
+	// Duration is how long the window stays open after it opens (e.g. 8h)
+	Duration DurationString `json:"duration" protobuf:"bytes,4,opt,name=duration,casttype=DurationString"`
+	// TimeZone is the IANA time zone in which the schedule is evaluated. Defaults to UTC
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,5,opt,name=timeZone"`
+}
+
+// DeploymentWindows restricts the times at which new revisions of a Rollout are allowed to progress
+type DeploymentWindows struct {
+	// Windows is a list of inline deployment windows
+	// +optional
+	Windows []DeploymentWindow `json:"windows,omitempty" protobuf:"bytes,1,rep,name=windows"`
+	// ScheduleRefs references RolloutSchedules whose windows are added to the inline windows
+	// +optional
+	ScheduleRefs []RolloutScheduleRef `json:"scheduleRefs,omitempty" protobuf:"bytes,2,rep,name=scheduleRefs"`
+}
+
+// RolloutScheduleRef references a RolloutSchedule
+type RolloutScheduleRef struct {
+	// Name of the RolloutSchedule
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
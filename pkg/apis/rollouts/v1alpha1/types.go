@@ -83,6 +83,45 @@ type RolloutSpec struct {
 	RestartAt *metav1.Time `json:"restartAt,omitempty" protobuf:"bytes,9,opt,name=restartAt"`
 	// Analysis configuration for the analysis runs to retain
 	Analysis *AnalysisRunStrategy `json:"analysis,omitempty" protobuf:"bytes,11,opt,name=analysis"`
+	// DependsOn lists Rollouts which must reach a phase before this Rollout starts updating to a new revision
+	// +optional
+	DependsOn []RolloutDependency `json:"dependsOn,omitempty" protobuf:"bytes,14,rep,name=dependsOn"`
+	// Clusters lists remote clusters in which the Rollout manages ReplicaSets in addition to the local cluster.
+	// Only supported with the canary strategy, where setClusterWeight steps control the weight per cluster.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	Clusters []RolloutCluster `json:"clusters,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,15,rep,name=clusters"`
+}
+
+// RolloutCluster defines a remote cluster in which the Rollout manages ReplicaSets
+type RolloutCluster struct {
+	// Name of the secret in the controller namespace holding the kubeconfig of the cluster
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace in the remote cluster in which the ReplicaSets are created. Defaults to the namespace of the Rollout.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Replicas is the number of desired pods in the remote cluster. Defaults to the replicas of the Rollout.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty" protobuf:"varint,3,opt,name=replicas"`
+}
+
+// RolloutDependency references a Rollout which must reach a phase before a new revision is started
+type RolloutDependency struct {
+	// Name of the Rollout
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace of the Rollout. Defaults to the namespace of the dependent Rollout.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Phase the Rollout is required to be in. Defaults to Healthy.
+	// +optional
+	Phase RolloutPhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=RolloutPhase"`
+	// RevisionAnnotation is the key of an annotation which the Rollout is required to have with the same value as
+	// the dependent Rollout. This allows waiting for a specific release of the Rollout.
+	// +optional
+	RevisionAnnotation string `json:"revisionAnnotation,omitempty" protobuf:"bytes,4,opt,name=revisionAnnotation"`
 }
 
 func (s *RolloutSpec) SetResolvedSelector(selector *metav1.LabelSelector) {
@@ -159,6 +198,9 @@ const (
 	// LabelKeyControllerInstanceID is the label the controller uses for the rollout, experiment, analysis segregation
 	// between controllers. Controllers will only operate on objects with the same instanceID as the controller.
 	LabelKeyControllerInstanceID = "argo-rollouts.argoproj.io/controller-instance-id"
+	// AnalysisRunRerunOfAnnotationKey is the annotation of an AnalysisRun re-run by the kubectl plugin, whose value is
+	// the name of the completed AnalysisRun it re-runs
+	AnalysisRunRerunOfAnnotationKey = "argo-rollouts.argoproj.io/rerun-of"
 )
 
 // RolloutStrategy defines strategy to apply during next rollout
@@ -167,6 +209,12 @@ type RolloutStrategy struct {
 	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty" protobuf:"bytes,1,opt,name=blueGreen"`
 	// +optional
 	Canary *CanaryStrategy `json:"canary,omitempty" protobuf:"bytes,2,opt,name=canary"`
+	// DeploymentWindows restricts the times at which new revisions are allowed to progress
+	// +optional
+	DeploymentWindows *DeploymentWindows `json:"deploymentWindows,omitempty" protobuf:"bytes,3,opt,name=deploymentWindows"`
+	// DaemonSet runs the pods of the rollout with a DaemonSet, and updates them node cohort by node cohort
+	// +optional
+	DaemonSet *DaemonSetStrategy `json:"daemonSet,omitempty" protobuf:"bytes,4,opt,name=daemonSet"`
 }
 
 // BlueGreenStrategy defines parameters for Blue Green deployment
@@ -341,6 +389,45 @@ type CanaryStrategy struct {
 	ReplicaProgressThreshold *ReplicaProgressThreshold `json:"replicaProgressThreshold,omitempty" protobuf:"bytes,17,opt,name=replicaProgressThreshold"`
 }
 
+// DaemonSetStrategy defines parameters for a DaemonSet update by node cohorts
+type DaemonSetStrategy struct {
+	// Steps define the order in which the node cohorts are updated
+	// +optional
+	Steps []DaemonSetStep `json:"steps,omitempty" protobuf:"bytes,1,rep,name=steps"`
+	// Analysis runs a separate analysisRun while all the steps execute. This is intended to be a continuous validation
+	// of the updated nodes
+	// +optional
+	Analysis *RolloutAnalysisBackground `json:"analysis,omitempty" protobuf:"bytes,2,opt,name=analysis"`
+	// MaxUnavailable is the maximum number of pods which may be unavailable while their nodes are updated.
+	// Value can be an absolute number (ex: 5) or a percentage of the nodes running the DaemonSet (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up. Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"bytes,3,opt,name=maxUnavailable"`
+}
+
+// DaemonSetStep defines a step of a DaemonSet update. Only one of its fields may be set
+type DaemonSetStep struct {
+	// Cohort updates the pods of a cohort of nodes to the new revision, in addition to the nodes of the previous cohorts
+	// +optional
+	Cohort *DaemonSetCohort `json:"cohort,omitempty" protobuf:"bytes,1,opt,name=cohort"`
+	// Pause freezes the rollout until it is resumed or the duration elapses
+	// +optional
+	Pause *RolloutPause `json:"pause,omitempty" protobuf:"bytes,2,opt,name=pause"`
+	// Analysis defines the AnalysisRun that will run for a step
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty" protobuf:"bytes,3,opt,name=analysis"`
+}
+
+// DaemonSetCohort selects a cohort of nodes, either by label or by percentage. Only one of its fields may be set
+type DaemonSetCohort struct {
+	// NodeSelector selects the nodes of the cohort by their labels
+	// +optional
+	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty" protobuf:"bytes,1,opt,name=nodeSelector"`
+	// Percentage is the percentage of the nodes running the DaemonSet, ordered by name, which are in the cohort
+	// +optional
+	Percentage *int32 `json:"percentage,omitempty" protobuf:"varint,2,opt,name=percentage"`
+}
+
 // PingPongSpec holds the ping and pong service name.
 type PingPongSpec struct {
 	// name of the ping service
@@ -412,6 +499,8 @@ type RolloutTrafficRouting struct {
 
 	// MaxTrafficWeight The total weight of traffic. If unspecified, it defaults to 100
 	MaxTrafficWeight *int32 `json:"maxTrafficWeight,omitempty" protobuf:"varint,11,opt,name=maxTrafficWeight"`
+	// GatewayAPI holds specific configuration to use the Kubernetes Gateway API to route traffic
+	GatewayAPI *GatewayAPITrafficRouting `json:"gatewayAPI,omitempty" protobuf:"bytes,12,opt,name=gatewayAPI"`
 }
 
 type MangedRoutes struct {
@@ -440,6 +529,22 @@ type ApisixRoute struct {
 	Rules []string `json:"rules,omitempty" protobuf:"bytes,2,rep,name=rules"`
 }
 
+// GatewayAPITrafficRouting defines the configuration required to use the Kubernetes Gateway API as traffic router
+type GatewayAPITrafficRouting struct {
+	// HTTPRoutes refer to the HTTPRoutes used to route traffic to the service
+	HTTPRoutes []GatewayAPIRoute `json:"httpRoutes,omitempty" protobuf:"bytes,1,rep,name=httpRoutes"`
+	// GRPCRoutes refer to the GRPCRoutes used to route traffic to the service
+	GRPCRoutes []GatewayAPIRoute `json:"grpcRoutes,omitempty" protobuf:"bytes,2,rep,name=grpcRoutes"`
+	// TCPRoutes refer to the TCPRoutes used to route traffic to the service
+	TCPRoutes []GatewayAPIRoute `json:"tcpRoutes,omitempty" protobuf:"bytes,3,rep,name=tcpRoutes"`
+}
+
+// GatewayAPIRoute holds information on a Gateway API route the rollout needs to modify
+type GatewayAPIRoute struct {
+	// Name refer to the name of the route in the namespace of the rollout
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+}
+
 // AmbassadorTrafficRouting defines the configuration required to use Ambassador as traffic
 // router
 type AmbassadorTrafficRouting struct {
@@ -677,6 +782,49 @@ type CanaryStep struct {
 	SetMirrorRoute *SetMirrorRoute `json:"setMirrorRoute,omitempty" protobuf:"bytes,8,opt,name=setMirrorRoute"`
 	// Plugin defines a plugin to execute for a step
 	Plugin *PluginStep `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
+	// SetWeightRamp raises the canary weight progressively on a fixed interval
+	// +optional
+	SetWeightRamp *SetWeightRamp `json:"setWeightRamp,omitempty" protobuf:"bytes,10,opt,name=setWeightRamp"`
+	// SetClusterWeight sets the canary weight of the ReplicaSets in remote clusters
+	// +optional
+	SetClusterWeight *SetClusterWeight `json:"setClusterWeight,omitempty" protobuf:"bytes,11,opt,name=setClusterWeight"`
+}
+
+// SetClusterWeight defines a step which sets the canary weight in a set of remote clusters
+type SetClusterWeight struct {
+	// Clusters is the list of names of remote clusters, as listed in spec.clusters, the weight is applied to
+	Clusters []string `json:"clusters" protobuf:"bytes,1,rep,name=clusters"`
+	// Weight is the percentage of the replicas of each cluster which run the canary version
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+}
+
+// SetWeightRamp defines a step which raises the canary weight from From to To by Increment every Interval
+type SetWeightRamp struct {
+	// From is the weight the ramp starts at
+	From int32 `json:"from" protobuf:"varint,1,opt,name=from"`
+	// To is the weight the ramp ends at
+	To int32 `json:"to" protobuf:"varint,2,opt,name=to"`
+	// Increment is the weight added to the canary at every interval. Required unless analysis is set
+	// +optional
+	Increment int32 `json:"increment,omitempty" protobuf:"varint,3,opt,name=increment"`
+	// Interval is the time to wait between two increments (e.g. 30s, 2m)
+	Interval DurationString `json:"interval" protobuf:"bytes,4,opt,name=interval,casttype=DurationString"`
+	// Analysis decides the size of every increment from a measurement of the background analysis,
+	// instead of using a fixed increment
+	// +optional
+	Analysis *WeightRampAnalysis `json:"analysis,omitempty" protobuf:"bytes,5,opt,name=analysis"`
+}
+
+// WeightRampAnalysis defines how the increments of a setWeightRamp step are derived from a background analysis metric
+type WeightRampAnalysis struct {
+	// MetricName is the name of the background analysis metric whose latest successful measurement decides the
+	// increment. The measurement value is expected to be between 0 and 1, where 0 results in MinIncrement and 1 in
+	// MaxIncrement.
+	MetricName string `json:"metricName" protobuf:"bytes,1,opt,name=metricName"`
+	// MinIncrement is the smallest weight added to the canary at every interval
+	MinIncrement int32 `json:"minIncrement" protobuf:"varint,2,opt,name=minIncrement"`
+	// MaxIncrement is the largest weight added to the canary at every interval
+	MaxIncrement int32 `json:"maxIncrement" protobuf:"varint,3,opt,name=maxIncrement"`
 }
 
 type PluginStep struct {
@@ -904,6 +1052,12 @@ const (
 	PauseReasonCanaryPauseStep PauseReason = "CanaryPauseStep"
 	// PauseReasonBlueGreenPause pause rollout before promoting rollout
 	PauseReasonBlueGreenPause PauseReason = "BlueGreenPause"
+	// PauseReasonDaemonSetPauseStep pause rollout for DaemonSet pause step
+	PauseReasonDaemonSetPauseStep PauseReason = "DaemonSetPauseStep"
+	// PauseReasonDeploymentWindow holds a new revision until the next allowed deployment window
+	PauseReasonDeploymentWindow PauseReason = "DeploymentWindow"
+	// PauseReasonWaitingForDependencies holds a new revision until the Rollouts it depends on reach their required phase
+	PauseReasonWaitingForDependencies PauseReason = "WaitingForDependencies"
 )
 
 // PauseCondition the reason for a pause and when it started
@@ -1014,6 +1168,45 @@ type RolloutStatus struct {
 	// Duration tracks timing information for the current rollout attempt
 	// +optional
 	Duration *RolloutDurationStatus `json:"duration,omitempty" protobuf:"bytes,27,opt,name=duration"`
+	// NextDeploymentWindow is the time at which the next allowed deployment window opens. It is only set while the
+	// rollout is held outside of its deployment windows
+	// +optional
+	NextDeploymentWindow *metav1.Time `json:"nextDeploymentWindow,omitempty" protobuf:"bytes,28,opt,name=nextDeploymentWindow"`
+	// Clusters reports the state of the ReplicaSets in every remote cluster listed in spec.clusters
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Clusters []RolloutClusterStatus `json:"clusters,omitempty" protobuf:"bytes,29,rep,name=clusters"`
+	// DaemonSet describes the state of the DaemonSet strategy
+	// +optional
+	DaemonSet DaemonSetStatus `json:"daemonSet,omitempty" protobuf:"bytes,30,opt,name=daemonSet"`
+}
+
+// RolloutClusterStatus is the state of the ReplicaSets of a Rollout in a remote cluster
+type RolloutClusterStatus struct {
+	// Name of the remote cluster
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Weight is the canary weight currently applied in the cluster
+	// +optional
+	Weight int32 `json:"weight,omitempty" protobuf:"varint,2,opt,name=weight"`
+	// StableRS is the name of the stable ReplicaSet in the cluster
+	// +optional
+	StableRS string `json:"stableRS,omitempty" protobuf:"bytes,3,opt,name=stableRS"`
+	// CanaryRS is the name of the canary ReplicaSet in the cluster
+	// +optional
+	CanaryRS string `json:"canaryRS,omitempty" protobuf:"bytes,4,opt,name=canaryRS"`
+	// Replicas is the total number of pods managed by the Rollout in the cluster
+	// +optional
+	Replicas int32 `json:"replicas,omitempty" protobuf:"varint,5,opt,name=replicas"`
+	// UpdatedReplicas is the number of canary pods in the cluster
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty" protobuf:"varint,6,opt,name=updatedReplicas"`
+	// AvailableReplicas is the number of available pods managed by the Rollout in the cluster
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty" protobuf:"varint,7,opt,name=availableReplicas"`
+	// Message explains why the cluster could not be reconciled
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,8,opt,name=message"`
 }
 
 // RolloutDurationStatus tracks timing for a rollout attempt
@@ -1170,6 +1363,47 @@ type CanaryStatus struct {
 	StablePingPong PingPongType `json:"stablePingPong,omitempty" protobuf:"bytes,5,opt,name=stablePingPong"`
 	// StepPluginStatuses holds the status of the step plugins executed
 	StepPluginStatuses []StepPluginStatus `json:"stepPluginStatuses,omitempty" protobuf:"bytes,6,rep,name=stepPluginStatuses"`
+	// WeightRamp records the position of the setWeightRamp step in progress
+	WeightRamp *WeightRampStatus `json:"weightRamp,omitempty" protobuf:"bytes,7,opt,name=weightRamp"`
+}
+
+// DaemonSetStatus status fields that only pertain to the DaemonSet rollout
+type DaemonSetStatus struct {
+	// CurrentStepAnalysisRunStatus indicates the status of the current step analysis run
+	CurrentStepAnalysisRunStatus *RolloutAnalysisRunStatus `json:"currentStepAnalysisRunStatus,omitempty" protobuf:"bytes,1,opt,name=currentStepAnalysisRunStatus"`
+	// CurrentBackgroundAnalysisRunStatus indicates the status of the current background analysis run
+	CurrentBackgroundAnalysisRunStatus *RolloutAnalysisRunStatus `json:"currentBackgroundAnalysisRunStatus,omitempty" protobuf:"bytes,2,opt,name=currentBackgroundAnalysisRunStatus"`
+	// Nodes is the number of nodes running a pod of the DaemonSet
+	Nodes int32 `json:"nodes,omitempty" protobuf:"varint,3,opt,name=nodes"`
+	// UpdatedNodes is the number of nodes selected by the cohorts up to the current step, which are updated to the new
+	// revision
+	UpdatedNodes int32 `json:"updatedNodes,omitempty" protobuf:"varint,4,opt,name=updatedNodes"`
+}
+
+// WeightRampStatus records the position of a setWeightRamp step, so that the ramp resumes where it was after an
+// abort or a controller restart
+type WeightRampStatus struct {
+	// StepIndex is the index of the setWeightRamp step
+	StepIndex int32 `json:"stepIndex" protobuf:"varint,1,opt,name=stepIndex"`
+	// Weight is the current weight of the ramp
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+	// LastIncrementTime is the time the weight was last raised
+	LastIncrementTime metav1.Time `json:"lastIncrementTime" protobuf:"bytes,3,opt,name=lastIncrementTime"`
+	// Increments records the increments chosen from the analysis of the ramp
+	// +optional
+	Increments []WeightRampIncrement `json:"increments,omitempty" protobuf:"bytes,4,rep,name=increments"`
+}
+
+// WeightRampIncrement records an increment of a setWeightRamp step chosen from a measurement
+type WeightRampIncrement struct {
+	// Increment is the weight which was added to the canary
+	Increment int32 `json:"increment" protobuf:"varint,1,opt,name=increment"`
+	// Weight is the weight of the canary after the increment
+	Weight int32 `json:"weight" protobuf:"varint,2,opt,name=weight"`
+	// MeasurementValue is the value of the measurement the increment was chosen from
+	MeasurementValue string `json:"measurementValue" protobuf:"bytes,3,opt,name=measurementValue"`
+	// Time is the time of the increment
+	Time metav1.Time `json:"time" protobuf:"bytes,4,opt,name=time"`
 }
 
 type PingPongType string
@@ -1322,6 +1556,12 @@ const (
 	// RolloutHealthy means that rollout is in a completed state and is healthy. Which means that all the pods have been updated
 	// and are passing their health checks and are ready to serve traffic.
 	RolloutHealthy RolloutConditionType = "Healthy"
+	// TrafficWeightsMismatch means that the traffic routers of a rollout using multiple traffic routers have not
+	// all taken the desired weight. The message reports the weight of each traffic router.
+	TrafficWeightsMismatch RolloutConditionType = "TrafficWeightsMismatch"
+	// WaitingForDependencies means that the rollout is holding a new revision until the Rollouts listed in
+	// spec.dependsOn reach their required phase. The message lists the unmet dependencies.
+	WaitingForDependencies RolloutConditionType = "WaitingForDependencies"
 )
 
 // RolloutCondition describes the state of a rollout at a certain point.
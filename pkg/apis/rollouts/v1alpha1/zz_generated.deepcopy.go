@@ -239,6 +239,16 @@ func (in *AnalysisRunSpec) DeepCopyInto(out *AnalysisRunSpec) {
 		*out = new(TTLStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CompositeConditions != nil {
+		in, out := &in.CompositeConditions, &out.CompositeConditions
+		*out = new(CompositeConditions)
+		**out = **in
+	}
+	if in.SuccessWeightThreshold != nil {
+		in, out := &in.SuccessWeightThreshold, &out.SuccessWeightThreshold
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -276,6 +286,11 @@ func (in *AnalysisRunStatus) DeepCopyInto(out *AnalysisRunStatus) {
 		in, out := &in.CompletedAt, &out.CompletedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.AdvisorySummary != nil {
+		in, out := &in.AdvisorySummary, &out.AdvisorySummary
+		*out = new(RunSummary)
+		**out = **in
+	}
 	return
 }
 
@@ -430,6 +445,16 @@ func (in *AnalysisTemplateSpec) DeepCopyInto(out *AnalysisTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CompositeConditions != nil {
+		in, out := &in.CompositeConditions, &out.CompositeConditions
+		*out = new(CompositeConditions)
+		**out = **in
+	}
+	if in.SuccessWeightThreshold != nil {
+		in, out := &in.SuccessWeightThreshold, &out.SuccessWeightThreshold
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -825,6 +850,11 @@ func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WeightRamp != nil {
+		in, out := &in.WeightRamp, &out.WeightRamp
+		*out = new(WeightRampStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -881,6 +911,16 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(PluginStep)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SetWeightRamp != nil {
+		in, out := &in.SetWeightRamp, &out.SetWeightRamp
+		*out = new(SetWeightRamp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SetClusterWeight != nil {
+		in, out := &in.SetClusterWeight, &out.SetClusterWeight
+		*out = new(SetClusterWeight)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1166,6 +1206,138 @@ func (in *ClusterAnalysisTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeConditions) DeepCopyInto(out *CompositeConditions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeConditions.
+func (in *CompositeConditions) DeepCopy() *CompositeConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetCohort) DeepCopyInto(out *DaemonSetCohort) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetCohort.
+func (in *DaemonSetCohort) DeepCopy() *DaemonSetCohort {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetCohort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetStatus) DeepCopyInto(out *DaemonSetStatus) {
+	*out = *in
+	if in.CurrentStepAnalysisRunStatus != nil {
+		in, out := &in.CurrentStepAnalysisRunStatus, &out.CurrentStepAnalysisRunStatus
+		*out = new(RolloutAnalysisRunStatus)
+		**out = **in
+	}
+	if in.CurrentBackgroundAnalysisRunStatus != nil {
+		in, out := &in.CurrentBackgroundAnalysisRunStatus, &out.CurrentBackgroundAnalysisRunStatus
+		*out = new(RolloutAnalysisRunStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetStatus.
+func (in *DaemonSetStatus) DeepCopy() *DaemonSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetStep) DeepCopyInto(out *DaemonSetStep) {
+	*out = *in
+	if in.Cohort != nil {
+		in, out := &in.Cohort, &out.Cohort
+		*out = new(DaemonSetCohort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(RolloutPause)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetStep.
+func (in *DaemonSetStep) DeepCopy() *DaemonSetStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetStrategy) DeepCopyInto(out *DaemonSetStrategy) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DaemonSetStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysisBackground)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetStrategy.
+func (in *DaemonSetStrategy) DeepCopy() *DaemonSetStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
 	*out = *in
@@ -1190,6 +1362,48 @@ func (in *DatadogMetric) DeepCopy() *DatadogMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentWindow) DeepCopyInto(out *DeploymentWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentWindow.
+func (in *DeploymentWindow) DeepCopy() *DeploymentWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentWindows) DeepCopyInto(out *DeploymentWindows) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]DeploymentWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScheduleRefs != nil {
+		in, out := &in.ScheduleRefs, &out.ScheduleRefs
+		*out = make([]RolloutScheduleRef, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentWindows.
+func (in *DeploymentWindows) DeepCopy() *DeploymentWindows {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentWindows)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DryRun) DeepCopyInto(out *DryRun) {
 	*out = *in
@@ -1206,6 +1420,27 @@ func (in *DryRun) DeepCopy() *DryRun {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchMetric) DeepCopyInto(out *ElasticsearchMetric) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchMetric.
+func (in *ElasticsearchMetric) DeepCopy() *ElasticsearchMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Experiment) DeepCopyInto(out *Experiment) {
 	*out = *in
@@ -1435,6 +1670,53 @@ func (in *FieldRef) DeepCopy() *FieldRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPIRoute) DeepCopyInto(out *GatewayAPIRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPIRoute.
+func (in *GatewayAPIRoute) DeepCopy() *GatewayAPIRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPIRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayAPITrafficRouting) DeepCopyInto(out *GatewayAPITrafficRouting) {
+	*out = *in
+	if in.HTTPRoutes != nil {
+		in, out := &in.HTTPRoutes, &out.HTTPRoutes
+		*out = make([]GatewayAPIRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCRoutes != nil {
+		in, out := &in.GRPCRoutes, &out.GRPCRoutes
+		*out = make([]GatewayAPIRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.TCPRoutes != nil {
+		in, out := &in.TCPRoutes, &out.TCPRoutes
+		*out = make([]GatewayAPIRoute, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayAPITrafficRouting.
+func (in *GatewayAPITrafficRouting) DeepCopy() *GatewayAPITrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayAPITrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GraphiteMetric) DeepCopyInto(out *GraphiteMetric) {
 	*out = *in
@@ -1594,79 +1876,235 @@ func (in *JobMetric) DeepCopy() *JobMetric {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KayentaMetric) DeepCopyInto(out *KayentaMetric) {
+func (in *JudgeComparison) DeepCopyInto(out *JudgeComparison) {
 	*out = *in
-	out.Threshold = in.Threshold
-	if in.Scopes != nil {
-		in, out := &in.Scopes, &out.Scopes
-		*out = make([]KayentaScope, len(*in))
-		copy(*out, *in)
-	}
+	in.Canary.DeepCopyInto(&out.Canary)
+	in.Baseline.DeepCopyInto(&out.Baseline)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KayentaMetric.
-func (in *KayentaMetric) DeepCopy() *KayentaMetric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JudgeComparison.
+func (in *JudgeComparison) DeepCopy() *JudgeComparison {
 	if in == nil {
 		return nil
 	}
-	out := new(KayentaMetric)
+	out := new(JudgeComparison)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KayentaScope) DeepCopyInto(out *KayentaScope) {
+func (in *JudgeMetric) DeepCopyInto(out *JudgeMetric) {
 	*out = *in
-	out.ControlScope = in.ControlScope
-	out.ExperimentScope = in.ExperimentScope
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KayentaScope.
-func (in *KayentaScope) DeepCopy() *KayentaScope {
-	if in == nil {
-		return nil
+	if in.Comparisons != nil {
+		in, out := &in.Comparisons, &out.Comparisons
+		*out = make([]JudgeComparison, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	out := new(KayentaScope)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KayentaThreshold) DeepCopyInto(out *KayentaThreshold) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KayentaThreshold.
-func (in *KayentaThreshold) DeepCopy() *KayentaThreshold {
-	if in == nil {
-		return nil
+	out.Threshold = in.Threshold
+	if in.ConfidenceLevel != nil {
+		in, out := &in.ConfidenceLevel, &out.ConfidenceLevel
+		*out = new(int32)
+		**out = **in
 	}
-	out := new(KayentaThreshold)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MangedRoutes) DeepCopyInto(out *MangedRoutes) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MangedRoutes.
-func (in *MangedRoutes) DeepCopy() *MangedRoutes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JudgeMetric.
+func (in *JudgeMetric) DeepCopy() *JudgeMetric {
 	if in == nil {
 		return nil
 	}
-	out := new(MangedRoutes)
+	out := new(JudgeMetric)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Measurement) DeepCopyInto(out *Measurement) {
+func (in *JudgeQuery) DeepCopyInto(out *JudgeQuery) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(PrometheusMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Web != nil {
+		in, out := &in.Web, &out.Web
+		*out = new(WebMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wavefront != nil {
+		in, out := &in.Wavefront, &out.Wavefront
+		*out = new(WavefrontMetric)
+		**out = **in
+	}
+	if in.NewRelic != nil {
+		in, out := &in.NewRelic, &out.NewRelic
+		*out = new(NewRelicMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CloudWatch != nil {
+		in, out := &in.CloudWatch, &out.CloudWatch
+		*out = new(CloudWatchMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Graphite != nil {
+		in, out := &in.Graphite, &out.Graphite
+		*out = new(GraphiteMetric)
+		**out = **in
+	}
+	if in.Influxdb != nil {
+		in, out := &in.Influxdb, &out.Influxdb
+		*out = new(InfluxdbMetric)
+		**out = **in
+	}
+	if in.SkyWalking != nil {
+		in, out := &in.SkyWalking, &out.SkyWalking
+		*out = new(SkyWalkingMetric)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JudgeQuery.
+func (in *JudgeQuery) DeepCopy() *JudgeQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(JudgeQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KayentaMetric) DeepCopyInto(out *KayentaMetric) {
+	*out = *in
+	out.Threshold = in.Threshold
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]KayentaScope, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KayentaMetric.
+func (in *KayentaMetric) DeepCopy() *KayentaMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(KayentaMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KayentaScope) DeepCopyInto(out *KayentaScope) {
+	*out = *in
+	out.ControlScope = in.ControlScope
+	out.ExperimentScope = in.ExperimentScope
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KayentaScope.
+func (in *KayentaScope) DeepCopy() *KayentaScope {
+	if in == nil {
+		return nil
+	}
+	out := new(KayentaScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KayentaThreshold) DeepCopyInto(out *KayentaThreshold) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KayentaThreshold.
+func (in *KayentaThreshold) DeepCopy() *KayentaThreshold {
+	if in == nil {
+		return nil
+	}
+	out := new(KayentaThreshold)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubernetesMetric) DeepCopyInto(out *KubernetesMetric) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubernetesMetric.
+func (in *KubernetesMetric) DeepCopy() *KubernetesMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(KubernetesMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LokiMetric) DeepCopyInto(out *LokiMetric) {
+	*out = *in
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.RangeQuery != nil {
+		in, out := &in.RangeQuery, &out.RangeQuery
+		*out = new(PrometheusRangeQueryArgs)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LokiMetric.
+func (in *LokiMetric) DeepCopy() *LokiMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(LokiMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MangedRoutes) DeepCopyInto(out *MangedRoutes) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MangedRoutes.
+func (in *MangedRoutes) DeepCopy() *MangedRoutes {
+	if in == nil {
+		return nil
+	}
+	out := new(MangedRoutes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Measurement) DeepCopyInto(out *Measurement) {
 	*out = *in
 	if in.StartedAt != nil {
 		in, out := &in.StartedAt, &out.StartedAt
@@ -1745,6 +2183,11 @@ func (in *Metric) DeepCopyInto(out *Metric) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -1816,6 +2259,36 @@ func (in *MetricProvider) DeepCopyInto(out *MetricProvider) {
 		*out = new(SkyWalkingMetric)
 		**out = **in
 	}
+	if in.Judge != nil {
+		in, out := &in.Judge, &out.Judge
+		*out = new(JudgeMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OTLP != nil {
+		in, out := &in.OTLP, &out.OTLP
+		*out = new(OTLPMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Elasticsearch != nil {
+		in, out := &in.Elasticsearch, &out.Elasticsearch
+		*out = new(ElasticsearchMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(LokiMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQL != nil {
+		in, out := &in.SQL, &out.SQL
+		*out = new(SQLMetric)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kubernetes != nil {
+		in, out := &in.Kubernetes, &out.Kubernetes
+		*out = new(KubernetesMetric)
+		**out = **in
+	}
 	if in.Plugin != nil {
 		in, out := &in.Plugin, &out.Plugin
 		*out = make(map[string]json.RawMessage, len(*in))
@@ -1951,6 +2424,34 @@ func (in *OAuth2Config) DeepCopy() *OAuth2Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPMetric) DeepCopyInto(out *OTLPMetric) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPMetric.
+func (in *OTLPMetric) DeepCopy() *OTLPMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectRef) DeepCopyInto(out *ObjectRef) {
 	*out = *in
@@ -2057,60 +2558,299 @@ func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopyInto(out *Pre
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredDuringSchedulingIgnoredDuringExecution.
-func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopy() *PreferredDuringSchedulingIgnoredDuringExecution {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferredDuringSchedulingIgnoredDuringExecution.
+func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopy() *PreferredDuringSchedulingIgnoredDuringExecution {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferredDuringSchedulingIgnoredDuringExecution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
+	*out = *in
+	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]WebMetricHeader, len(*in))
+		copy(*out, *in)
+	}
+	if in.RangeQuery != nil {
+		in, out := &in.RangeQuery, &out.RangeQuery
+		*out = new(PrometheusRangeQueryArgs)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMetric.
+func (in *PrometheusMetric) DeepCopy() *PrometheusMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRangeQueryArgs) DeepCopyInto(out *PrometheusRangeQueryArgs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRangeQueryArgs.
+func (in *PrometheusRangeQueryArgs) DeepCopy() *PrometheusRangeQueryArgs {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRangeQueryArgs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrain) DeepCopyInto(out *ReleaseTrain) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrain.
+func (in *ReleaseTrain) DeepCopy() *ReleaseTrain {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseTrain) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainAnalysis) DeepCopyInto(out *ReleaseTrainAnalysis) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]AnalysisTemplateRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]Argument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainAnalysis.
+func (in *ReleaseTrainAnalysis) DeepCopy() *ReleaseTrainAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainImage) DeepCopyInto(out *ReleaseTrainImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainImage.
+func (in *ReleaseTrainImage) DeepCopy() *ReleaseTrainImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainList) DeepCopyInto(out *ReleaseTrainList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReleaseTrain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainList.
+func (in *ReleaseTrainList) DeepCopy() *ReleaseTrainList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReleaseTrainList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainRolloutStatus) DeepCopyInto(out *ReleaseTrainRolloutStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainRolloutStatus.
+func (in *ReleaseTrainRolloutStatus) DeepCopy() *ReleaseTrainRolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainRolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainSpec) DeepCopyInto(out *ReleaseTrainSpec) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ReleaseTrainImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.TemplatePatch != nil {
+		in, out := &in.TemplatePatch, &out.TemplatePatch
+		*out = make(json.RawMessage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]ReleaseTrainWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainSpec.
+func (in *ReleaseTrainSpec) DeepCopy() *ReleaseTrainSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReleaseTrainSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReleaseTrainStatus) DeepCopyInto(out *ReleaseTrainStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]ReleaseTrainWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainStatus.
+func (in *ReleaseTrainStatus) DeepCopy() *ReleaseTrainStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PreferredDuringSchedulingIgnoredDuringExecution)
+	out := new(ReleaseTrainStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
+func (in *ReleaseTrainWave) DeepCopyInto(out *ReleaseTrainWave) {
 	*out = *in
-	in.Authentication.DeepCopyInto(&out.Authentication)
-	if in.Timeout != nil {
-		in, out := &in.Timeout, &out.Timeout
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Headers != nil {
-		in, out := &in.Headers, &out.Headers
-		*out = make([]WebMetricHeader, len(*in))
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.RangeQuery != nil {
-		in, out := &in.RangeQuery, &out.RangeQuery
-		*out = new(PrometheusRangeQueryArgs)
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int32)
 		**out = **in
 	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ReleaseTrainAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusMetric.
-func (in *PrometheusMetric) DeepCopy() *PrometheusMetric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainWave.
+func (in *ReleaseTrainWave) DeepCopy() *ReleaseTrainWave {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusMetric)
+	out := new(ReleaseTrainWave)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusRangeQueryArgs) DeepCopyInto(out *PrometheusRangeQueryArgs) {
+func (in *ReleaseTrainWaveStatus) DeepCopyInto(out *ReleaseTrainWaveStatus) {
 	*out = *in
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]ReleaseTrainRolloutStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRangeQueryArgs.
-func (in *PrometheusRangeQueryArgs) DeepCopy() *PrometheusRangeQueryArgs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReleaseTrainWaveStatus.
+func (in *ReleaseTrainWaveStatus) DeepCopy() *ReleaseTrainWaveStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusRangeQueryArgs)
+	out := new(ReleaseTrainWaveStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2274,6 +3014,43 @@ func (in *RolloutAnalysisRunStatus) DeepCopy() *RolloutAnalysisRunStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutCluster) DeepCopyInto(out *RolloutCluster) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutCluster.
+func (in *RolloutCluster) DeepCopy() *RolloutCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutClusterStatus) DeepCopyInto(out *RolloutClusterStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutClusterStatus.
+func (in *RolloutClusterStatus) DeepCopy() *RolloutClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutCondition) DeepCopyInto(out *RolloutCondition) {
 	*out = *in
@@ -2292,6 +3069,22 @@ func (in *RolloutCondition) DeepCopy() *RolloutCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutDependency) DeepCopyInto(out *RolloutDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutDependency.
+func (in *RolloutDependency) DeepCopy() *RolloutDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutDurationStatus) DeepCopyInto(out *RolloutDurationStatus) {
 	*out = *in
@@ -2490,6 +3283,103 @@ func (in *RolloutPause) DeepCopy() *RolloutPause {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutSchedule) DeepCopyInto(out *RolloutSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutSchedule.
+func (in *RolloutSchedule) DeepCopy() *RolloutSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutScheduleList) DeepCopyInto(out *RolloutScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutScheduleList.
+func (in *RolloutScheduleList) DeepCopy() *RolloutScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutScheduleRef) DeepCopyInto(out *RolloutScheduleRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutScheduleRef.
+func (in *RolloutScheduleRef) DeepCopy() *RolloutScheduleRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutScheduleRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutScheduleSpec) DeepCopyInto(out *RolloutScheduleSpec) {
+	*out = *in
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]DeploymentWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutScheduleSpec.
+func (in *RolloutScheduleSpec) DeepCopy() *RolloutScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 	*out = *in
@@ -2534,6 +3424,18 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		*out = new(AnalysisRunStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]RolloutDependency, len(*in))
+		copy(*out, *in)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]RolloutCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2599,6 +3501,16 @@ func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 		*out = new(RolloutDurationStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NextDeploymentWindow != nil {
+		in, out := &in.NextDeploymentWindow, &out.NextDeploymentWindow
+		*out = (*in).DeepCopy()
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]RolloutClusterStatus, len(*in))
+		copy(*out, *in)
+	}
+	in.DaemonSet.DeepCopyInto(&out.DaemonSet)
 	return
 }
 
@@ -2625,6 +3537,16 @@ func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 		*out = new(CanaryStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeploymentWindows != nil {
+		in, out := &in.DeploymentWindows, &out.DeploymentWindows
+		*out = new(DeploymentWindows)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DaemonSet != nil {
+		in, out := &in.DaemonSet, &out.DaemonSet
+		*out = new(DaemonSetStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2706,6 +3628,11 @@ func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.GatewayAPI != nil {
+		in, out := &in.GatewayAPI, &out.GatewayAPI
+		*out = new(GatewayAPITrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2784,6 +3711,27 @@ func (in *SMITrafficRouting) DeepCopy() *SMITrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLMetric) DeepCopyInto(out *SQLMetric) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLMetric.
+func (in *SQLMetric) DeepCopy() *SQLMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScopeDetail) DeepCopyInto(out *ScopeDetail) {
 	*out = *in
@@ -2858,6 +3806,27 @@ func (in *SetCanaryScale) DeepCopy() *SetCanaryScale {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetClusterWeight) DeepCopyInto(out *SetClusterWeight) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetClusterWeight.
+func (in *SetClusterWeight) DeepCopy() *SetClusterWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(SetClusterWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SetHeaderRoute) DeepCopyInto(out *SetHeaderRoute) {
 	*out = *in
@@ -2909,6 +3878,27 @@ func (in *SetMirrorRoute) DeepCopy() *SetMirrorRoute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetWeightRamp) DeepCopyInto(out *SetWeightRamp) {
+	*out = *in
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(WeightRampAnalysis)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetWeightRamp.
+func (in *SetWeightRamp) DeepCopy() *SetWeightRamp {
+	if in == nil {
+		return nil
+	}
+	out := new(SetWeightRamp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Sigv4Config) DeepCopyInto(out *Sigv4Config) {
 	*out = *in
@@ -3291,3 +4281,60 @@ func (in *WeightDestination) DeepCopy() *WeightDestination {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightRampAnalysis) DeepCopyInto(out *WeightRampAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightRampAnalysis.
+func (in *WeightRampAnalysis) DeepCopy() *WeightRampAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightRampAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightRampIncrement) DeepCopyInto(out *WeightRampIncrement) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightRampIncrement.
+func (in *WeightRampIncrement) DeepCopy() *WeightRampIncrement {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightRampIncrement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightRampStatus) DeepCopyInto(out *WeightRampStatus) {
+	*out = *in
+	in.LastIncrementTime.DeepCopyInto(&out.LastIncrementTime)
+	if in.Increments != nil {
+		in, out := &in.Increments, &out.Increments
+		*out = make([]WeightRampIncrement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightRampStatus.
+func (in *WeightRampStatus) DeepCopy() *WeightRampStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightRampStatus)
+	in.DeepCopyInto(out)
+	return out
+}
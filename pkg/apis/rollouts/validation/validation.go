@@ -20,6 +20,8 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/deploymentwindow"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 )
 
@@ -35,9 +37,9 @@ const (
 	// InvalidSetCanaryScaleTrafficPolicy indicates that TrafficRouting, required for SetCanaryScale, is missing
 	InvalidSetCanaryScaleTrafficPolicy = "SetCanaryScale requires TrafficRouting to be set"
 	// InvalidSetHeaderRouteTrafficPolicy indicates that TrafficRouting required for SetHeaderRoute is missing
-	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix"
+	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix and GatewayAPI"
 	// InvalidSetMirrorRouteTrafficPolicy indicates that TrafficRouting, required for SetMirrorRoute, is missing
-	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio and Plugins"
+	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio and GatewayAPI and Plugins"
 	// InvalidStringMatchMultipleValuePolicy indicates that SetCanaryScale, has multiple values set
 	InvalidStringMatchMultipleValuePolicy = "StringMatch match value must have exactly one of the following: exact, regex, prefix"
 	// InvalidStringMatchMissedValuePolicy indicates that SetCanaryScale, has multiple values set
@@ -48,8 +50,47 @@ const (
 	InvalidDurationMessage = "Duration needs to be greater than 0"
 	// InvalidMaxSurgeMaxUnavailable indicates both maxSurge and MaxUnavailable can not be set to zero
 	InvalidMaxSurgeMaxUnavailable = "MaxSurge and MaxUnavailable both can not be zero"
-	// InvalidStepMessage indicates that a step must have either experiment, setWeight, setCanaryScale, plugin or pause
-	InvalidStepMessage = "Step must have one of the following set: experiment, setWeight, setCanaryScale, plugin or pause"
+	// InvalidStepMessage indicates that a step must have either experiment, setWeight, setWeightRamp, setClusterWeight, setCanaryScale, plugin or pause
+	InvalidStepMessage = "Step must have one of the following set: experiment, setWeight, setWeightRamp, setClusterWeight, setCanaryScale, plugin or pause"
+	// InvalidSetWeightRampMessage indicates the setWeightRamp weights need to be ascending and between 0 and max weight
+	InvalidSetWeightRampMessage = "SetWeightRamp from and to need to be between 0 and %d, and from must not be greater than to"
+	// InvalidSetWeightRampIncrementMessage indicates the setWeightRamp increment needs to be greater than 0
+	InvalidSetWeightRampIncrementMessage = "SetWeightRamp increment needs to be greater than 0"
+	// InvalidSetWeightRampAnalysisIncrementMessage indicates the setWeightRamp analysis increments need to be ascending and greater than 0
+	InvalidSetWeightRampAnalysisIncrementMessage = "SetWeightRamp analysis minIncrement needs to be greater than 0 and not greater than maxIncrement"
+	// InvalidSetWeightRampAnalysisMessage indicates that a setWeightRamp analysis requires a background analysis
+	InvalidSetWeightRampAnalysisMessage = "SetWeightRamp analysis requires a background analysis to be set"
+	// InvalidSetWeightRampIntervalMessage indicates the setWeightRamp interval needs to be a valid duration greater than 0
+	InvalidSetWeightRampIntervalMessage = "SetWeightRamp interval needs to be a duration greater than 0"
+	// InvalidDeploymentWindowsMessage indicates that deploymentWindows need at least one window or schedule reference
+	InvalidDeploymentWindowsMessage = "DeploymentWindows requires at least one window or scheduleRef"
+	// InvalidDependsOnPhaseMessage indicates that a dependency requires an unknown phase
+	InvalidDependsOnPhaseMessage = "Phase must be one of Healthy, Progressing, Paused or Degraded"
+	// InvalidDependsOnSelfMessage indicates that a rollout can not depend on itself
+	InvalidDependsOnSelfMessage = "Rollout can not depend on itself"
+	// InvalidDependsOnNamespaceMessage indicates that a dependency is in a namespace the controller does not watch
+	InvalidDependsOnNamespaceMessage = "Namespace must be the namespace of the rollout when the controller is namespaced (%s)"
+	// InvalidScheduleRefsNamespacedMessage indicates that a rollout references cluster-scoped RolloutSchedules which a
+	// namespaced controller does not watch
+	InvalidScheduleRefsNamespacedMessage = "RolloutSchedules cannot be referenced when the controller is namespaced (%s), use inline windows instead"
+	// InvalidClustersStrategyMessage indicates that remote clusters are only supported with the canary strategy
+	InvalidClustersStrategyMessage = "Clusters are only supported with the canary strategy"
+	// InvalidSetClusterWeightMessage indicates the setClusterWeight weight needs to be between 0 and 100
+	InvalidSetClusterWeightMessage = "SetClusterWeight weight needs to be between 0 and 100"
+	// InvalidSetClusterWeightClusterMessage indicates that a setClusterWeight step references a cluster missing from spec.clusters
+	InvalidSetClusterWeightClusterMessage = "Cluster %s is not listed in spec.clusters"
+	// InvalidStatefulSetStrategyMessage indicates that StatefulSet workload references are only supported with the canary strategy
+	InvalidStatefulSetStrategyMessage = "StatefulSet workload references are only supported with the canary strategy"
+	// InvalidStatefulSetFieldMessage indicates that a field is not supported with a StatefulSet workload reference
+	InvalidStatefulSetFieldMessage = "%s is not supported with a StatefulSet workload reference"
+	// InvalidDaemonSetStepMessage indicates that a DaemonSet step must have exactly one of cohort, pause or analysis
+	InvalidDaemonSetStepMessage = "Step must have exactly one of the following set: cohort, pause or analysis"
+	// InvalidDaemonSetCohortMessage indicates that a cohort must have exactly one of nodeSelector or percentage
+	InvalidDaemonSetCohortMessage = "Cohort must have exactly one of the following set: nodeSelector or percentage"
+	// InvalidDaemonSetCohortPercentageMessage indicates that the percentage of a cohort needs to be between 0 and 100
+	InvalidDaemonSetCohortPercentageMessage = "Cohort percentage needs to be between 0 and 100"
+	// InvalidDaemonSetWorkloadRefMessage indicates that the DaemonSet strategy does not support workload references
+	InvalidDaemonSetWorkloadRefMessage = "WorkloadRef is not supported with the daemonSet strategy"
 	// InvalidStrategyMessage indicates that multiple strategies can not be listed
 	InvalidStrategyMessage = "Multiple Strategies can not be listed"
 	// DuplicatedServicesBlueGreenMessage the message to indicate that the rollout uses the same service for the active and preview services
@@ -86,6 +127,8 @@ const (
 	// InvalideStepRouteNameNotFoundInManagedRoutes A step has been configured that requires managedRoutes and the route name
 	// is missing from managedRoutes
 	InvalideStepRouteNameNotFoundInManagedRoutes = "Steps define a route that does not exist in spec.strategy.canary.trafficRouting.managedRoutes"
+	// MissingGatewayAPIRoutesMessage indicates that the Gateway API traffic router does not reference any route
+	MissingGatewayAPIRoutesMessage = "GatewayAPI traffic routing requires at least one of httpRoutes, grpcRoutes or tcpRoutes"
 )
 
 // allowAllPodValidationOptions allows all pod options to be true for the purposes of rollout pod
@@ -176,10 +219,125 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 	}
 
 	allErrs = append(allErrs, ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))...)
+	allErrs = append(allErrs, ValidateDependsOn(rollout, fldPath.Child("dependsOn"))...)
+	allErrs = append(allErrs, ValidateClusters(rollout, fldPath.Child("clusters"))...)
+	allErrs = append(allErrs, ValidateStatefulSetWorkload(rollout, fldPath)...)
 
 	return allErrs
 }
 
+// ValidateStatefulSetWorkload checks that a rollout which references a StatefulSet only uses the features which can
+// be implemented by driving the partition of the StatefulSet
+func ValidateStatefulSetWorkload(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !rolloututil.IsStatefulSetWorkload(rollout) {
+		return allErrs
+	}
+	unsupported := func(path *field.Path, value any, name string) {
+		allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf(InvalidStatefulSetFieldMessage, name)))
+	}
+	if scaleDown := rollout.Spec.WorkloadRef.ScaleDown; scaleDown != "" && scaleDown != v1alpha1.ScaleDownNever {
+		unsupported(fldPath.Child("workloadRef", "scaleDown"), scaleDown, "scaleDown")
+	}
+	if len(rollout.Spec.Clusters) > 0 {
+		unsupported(fldPath.Child("clusters"), len(rollout.Spec.Clusters), "clusters")
+	}
+	canary := rollout.Spec.Strategy.Canary
+	if canary == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy"), rollout.Spec.WorkloadRef.Kind, InvalidStatefulSetStrategyMessage))
+		return allErrs
+	}
+	canaryFldPath := fldPath.Child("strategy", "canary")
+	if canary.TrafficRouting != nil {
+		unsupported(canaryFldPath.Child("trafficRouting"), "", "trafficRouting")
+	}
+	if canary.CanaryService != "" {
+		unsupported(canaryFldPath.Child("canaryService"), canary.CanaryService, "canaryService")
+	}
+	if canary.StableService != "" {
+		unsupported(canaryFldPath.Child("stableService"), canary.StableService, "stableService")
+	}
+	for i, step := range canary.Steps {
+		stepFldPath := canaryFldPath.Child("steps").Index(i)
+		switch {
+		case step.Experiment != nil:
+			unsupported(stepFldPath.Child("experiment"), "", "experiment")
+		case step.SetWeightRamp != nil:
+			unsupported(stepFldPath.Child("setWeightRamp"), "", "setWeightRamp")
+		case step.SetClusterWeight != nil:
+			unsupported(stepFldPath.Child("setClusterWeight"), "", "setClusterWeight")
+		case step.Plugin != nil:
+			unsupported(stepFldPath.Child("plugin"), step.Plugin.Name, "plugin")
+		}
+	}
+	return allErrs
+}
+
+func ValidateClusters(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(rollout.Spec.Clusters) == 0 {
+		return allErrs
+	}
+	if rollout.Spec.Strategy.Canary == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, len(rollout.Spec.Clusters), InvalidClustersStrategyMessage))
+	}
+	names := map[string]bool{}
+	for i, cluster := range rollout.Spec.Clusters {
+		clusterFldPath := fldPath.Index(i)
+		if cluster.Name == "" {
+			allErrs = append(allErrs, field.Required(clusterFldPath.Child("name"), fmt.Sprintf(MissingFieldMessage, "name")))
+		} else if names[cluster.Name] {
+			allErrs = append(allErrs, field.Duplicate(clusterFldPath.Child("name"), cluster.Name))
+		}
+		names[cluster.Name] = true
+		if cluster.Replicas != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cluster.Replicas), clusterFldPath.Child("replicas"))...)
+		}
+	}
+	return allErrs
+}
+
+func ValidateDependsOn(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, dep := range rollout.Spec.DependsOn {
+		depFldPath := fldPath.Index(i)
+		if dep.Name == "" {
+			allErrs = append(allErrs, field.Required(depFldPath.Child("name"), fmt.Sprintf(MissingFieldMessage, "name")))
+		} else if dep.Name == rollout.Name && (dep.Namespace == "" || dep.Namespace == rollout.Namespace) {
+			allErrs = append(allErrs, field.Invalid(depFldPath.Child("name"), dep.Name, InvalidDependsOnSelfMessage))
+		}
+		switch dep.Phase {
+		case "", v1alpha1.RolloutPhaseHealthy, v1alpha1.RolloutPhaseProgressing, v1alpha1.RolloutPhasePaused, v1alpha1.RolloutPhaseDegraded:
+		default:
+			allErrs = append(allErrs, field.Invalid(depFldPath.Child("phase"), dep.Phase, InvalidDependsOnPhaseMessage))
+		}
+	}
+	return allErrs
+}
+
+// ValidateDependsOnNamespace validates that the dependencies of the rollout can be resolved by a controller which
+// only watches the given namespace
+func ValidateDependsOnNamespace(rollout *v1alpha1.Rollout, namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, dep := range rollout.Spec.DependsOn {
+		if dep.Namespace != "" && dep.Namespace != namespace {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("namespace"), dep.Namespace, fmt.Sprintf(InvalidDependsOnNamespaceMessage, namespace)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateScheduleRefsNamespace validates that the rollout does not reference RolloutSchedules, which are cluster-scoped
+// and thus not watched by a controller which only watches the given namespace
+func ValidateScheduleRefsNamespace(rollout *v1alpha1.Rollout, namespace string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	windows := rollout.Spec.Strategy.DeploymentWindows
+	if windows != nil && len(windows.ScheduleRefs) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf(InvalidScheduleRefsNamespacedMessage, namespace)))
+	}
+	return allErrs
+}
+
 // removeSecurityContextPrivileged removes the privileged value on containers for the purposes of
 // validation. This is necessary because the k8s ValidateSecurityContext library which we reuse,
 // calls k8s.io/kubernetes/pkg/capabilities.Get(), which determines the security capabilities at a
@@ -200,16 +358,40 @@ func removeSecurityContextPrivileged(template *core.PodTemplateSpec) {
 func ValidateRolloutStrategy(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
 	strategy := rollout.Spec.Strategy
 	allErrs := field.ErrorList{}
-	if strategy.BlueGreen == nil && strategy.Canary == nil {
-		message := fmt.Sprintf(MissingFieldMessage, ".spec.strategy.canary or .spec.strategy.blueGreen")
+	strategies := countSet(strategy.BlueGreen != nil, strategy.Canary != nil, strategy.DaemonSet != nil)
+	if strategies == 0 {
+		message := fmt.Sprintf(MissingFieldMessage, ".spec.strategy.canary, .spec.strategy.blueGreen or .spec.strategy.daemonSet")
 		allErrs = append(allErrs, field.Required(fldPath.Child("strategy"), message))
-	} else if strategy.BlueGreen != nil && strategy.Canary != nil {
-		errVal := fmt.Sprintf("blueGreen: %t canary: %t", rollout.Spec.Strategy.BlueGreen != nil, rollout.Spec.Strategy.Canary != nil)
+	} else if strategies > 1 {
+		errVal := fmt.Sprintf("blueGreen: %t canary: %t daemonSet: %t", strategy.BlueGreen != nil, strategy.Canary != nil, strategy.DaemonSet != nil)
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy"), errVal, InvalidStrategyMessage))
 	} else if strategy.BlueGreen != nil {
 		allErrs = append(allErrs, ValidateRolloutStrategyBlueGreen(rollout, fldPath)...)
 	} else if strategy.Canary != nil {
 		allErrs = append(allErrs, ValidateRolloutStrategyCanary(rollout, fldPath)...)
+	} else if strategy.DaemonSet != nil {
+		allErrs = append(allErrs, ValidateRolloutStrategyDaemonSet(rollout, fldPath.Child("daemonSet"))...)
+	}
+	if strategy.DeploymentWindows != nil {
+		allErrs = append(allErrs, ValidateDeploymentWindows(strategy.DeploymentWindows, fldPath.Child("strategy", "deploymentWindows"))...)
+	}
+	return allErrs
+}
+
+func ValidateDeploymentWindows(deploymentWindows *v1alpha1.DeploymentWindows, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(deploymentWindows.Windows) == 0 && len(deploymentWindows.ScheduleRefs) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, InvalidDeploymentWindowsMessage))
+	}
+	for i, window := range deploymentWindows.Windows {
+		if _, err := deploymentwindow.Parse(window); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("windows").Index(i), window, err.Error()))
+		}
+	}
+	for i, ref := range deploymentWindows.ScheduleRefs {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("scheduleRefs").Index(i).Child("name"), fmt.Sprintf(MissingFieldMessage, "name")))
+		}
 	}
 	return allErrs
 }
@@ -228,6 +410,48 @@ func ValidateRolloutStrategyBlueGreen(rollout *v1alpha1.Rollout, fldPath *field.
 	return allErrs
 }
 
+// ValidateRolloutStrategyDaemonSet checks the steps of the DaemonSet strategy, which manages a DaemonSet owned by the
+// rollout rather than a referenced workload
+func ValidateRolloutStrategyDaemonSet(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	daemonSet := rollout.Spec.Strategy.DaemonSet
+	allErrs := field.ErrorList{}
+	if rollout.Spec.WorkloadRef != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "workloadRef"), rollout.Spec.WorkloadRef.Name, InvalidDaemonSetWorkloadRefMessage))
+	}
+	for i, step := range daemonSet.Steps {
+		stepFldPath := fldPath.Child("steps").Index(i)
+		if countSet(step.Cohort != nil, step.Pause != nil, step.Analysis != nil) != 1 {
+			errVal := fmt.Sprintf("step.Cohort: %t step.Pause: %t step.Analysis: %t", step.Cohort != nil, step.Pause != nil, step.Analysis != nil)
+			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidDaemonSetStepMessage))
+		}
+		if cohort := step.Cohort; cohort != nil {
+			if countSet(cohort.NodeSelector != nil, cohort.Percentage != nil) != 1 {
+				errVal := fmt.Sprintf("cohort.NodeSelector: %t cohort.Percentage: %t", cohort.NodeSelector != nil, cohort.Percentage != nil)
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("cohort"), errVal, InvalidDaemonSetCohortMessage))
+			}
+			if cohort.Percentage != nil && (*cohort.Percentage < 0 || *cohort.Percentage > 100) {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("cohort", "percentage"), *cohort.Percentage, InvalidDaemonSetCohortPercentageMessage))
+			}
+			if cohort.NodeSelector != nil {
+				if _, err := metav1.LabelSelectorAsSelector(cohort.NodeSelector); err != nil {
+					allErrs = append(allErrs, field.Invalid(stepFldPath.Child("cohort", "nodeSelector"), cohort.NodeSelector, err.Error()))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+func countSet(fields ...bool) int {
+	count := 0
+	for _, set := range fields {
+		if set {
+			count++
+		}
+	}
+	return count
+}
+
 func InvalidWorkloadRef(rollout *v1alpha1.Rollout, err error) error {
 	fldPath := field.NewPath("spec")
 	return field.Invalid(fldPath.Child("workloadRef"), rollout.Spec.WorkloadRef, err.Error())
@@ -250,7 +474,8 @@ func requireCanaryStableServices(rollout *v1alpha1.Rollout) bool {
 		canary.TrafficRouting.Ambassador != nil,
 		canary.TrafficRouting.Nginx != nil,
 		canary.TrafficRouting.AppMesh != nil,
-		canary.TrafficRouting.Traefik != nil:
+		canary.TrafficRouting.Traefik != nil,
+		canary.TrafficRouting.GatewayAPI != nil:
 		return true
 	default:
 		return false
@@ -307,15 +532,20 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting").Child("maxTrafficWeight"), canary.TrafficRouting.MaxTrafficWeight, InvalidCanaryMaxWeightOnlySupportInNginxAndPlugins))
 			}
 		}
+		if gatewayAPI := canary.TrafficRouting.GatewayAPI; gatewayAPI != nil {
+			if len(gatewayAPI.HTTPRoutes) == 0 && len(gatewayAPI.GRPCRoutes) == 0 && len(gatewayAPI.TCPRoutes) == 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting").Child("gatewayAPI"), gatewayAPI, MissingGatewayAPIRoutesMessage))
+			}
+		}
 	}
 
 	for i, step := range canary.Steps {
 		stepFldPath := fldPath.Child("steps").Index(i)
 		allErrs = append(allErrs, hasMultipleStepsType(step, stepFldPath)...)
-		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil &&
+		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.SetWeightRamp == nil && step.SetClusterWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil &&
 			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil {
-			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t",
-				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil)
+			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.SetWeightRamp: %t step.SetClusterWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t",
+				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.SetWeightRamp == nil, step.SetClusterWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil)
 			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidStepMessage))
 		}
 
@@ -324,16 +554,54 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		if step.SetWeight != nil && (*step.SetWeight < 0 || *step.SetWeight > maxTrafficWeight) {
 			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setWeight"), *canary.Steps[i].SetWeight, fmt.Sprintf(InvalidSetWeightMessage, maxTrafficWeight)))
 		}
+		if ramp := step.SetWeightRamp; ramp != nil {
+			rampFldPath := stepFldPath.Child("setWeightRamp")
+			if ramp.From < 0 || ramp.To > maxTrafficWeight || ramp.From > ramp.To {
+				allErrs = append(allErrs, field.Invalid(rampFldPath, *ramp, fmt.Sprintf(InvalidSetWeightRampMessage, maxTrafficWeight)))
+			}
+			if ramp.Analysis != nil {
+				analysisFldPath := rampFldPath.Child("analysis")
+				if ramp.Analysis.MetricName == "" {
+					allErrs = append(allErrs, field.Required(analysisFldPath.Child("metricName"), fmt.Sprintf(MissingFieldMessage, "metricName")))
+				}
+				if ramp.Analysis.MinIncrement <= 0 || ramp.Analysis.MinIncrement > ramp.Analysis.MaxIncrement {
+					allErrs = append(allErrs, field.Invalid(analysisFldPath, *ramp.Analysis, InvalidSetWeightRampAnalysisIncrementMessage))
+				}
+				if canary.Analysis == nil || len(canary.Analysis.Templates) == 0 {
+					allErrs = append(allErrs, field.Invalid(analysisFldPath, *ramp.Analysis, InvalidSetWeightRampAnalysisMessage))
+				}
+			} else if ramp.Increment <= 0 {
+				allErrs = append(allErrs, field.Invalid(rampFldPath.Child("increment"), ramp.Increment, InvalidSetWeightRampIncrementMessage))
+			}
+			if interval, err := ramp.Interval.Duration(); err != nil || interval <= 0 {
+				allErrs = append(allErrs, field.Invalid(rampFldPath.Child("interval"), ramp.Interval, InvalidSetWeightRampIntervalMessage))
+			}
+		}
+		if clusterWeight := step.SetClusterWeight; clusterWeight != nil {
+			clusterWeightFldPath := stepFldPath.Child("setClusterWeight")
+			if clusterWeight.Weight < 0 || clusterWeight.Weight > 100 {
+				allErrs = append(allErrs, field.Invalid(clusterWeightFldPath.Child("weight"), clusterWeight.Weight, InvalidSetClusterWeightMessage))
+			}
+			if len(clusterWeight.Clusters) == 0 {
+				allErrs = append(allErrs, field.Required(clusterWeightFldPath.Child("clusters"), fmt.Sprintf(MissingFieldMessage, "clusters")))
+			}
+			for j, name := range clusterWeight.Clusters {
+				if !hasCluster(rollout, name) {
+					allErrs = append(allErrs, field.Invalid(clusterWeightFldPath.Child("clusters").Index(j), name, fmt.Sprintf(InvalidSetClusterWeightClusterMessage, name)))
+				}
+			}
+		}
 		if step.Pause != nil && step.Pause.DurationSeconds() < 0 {
 			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("pause").Child("duration"), step.Pause.DurationSeconds(), InvalidDurationMessage))
 		}
-		if step.SetCanaryScale != nil && canary.TrafficRouting == nil {
+		// a StatefulSet applies the canary scale to its partition instead of to the traffic routing
+		if step.SetCanaryScale != nil && canary.TrafficRouting == nil && !rolloututil.IsStatefulSetWorkload(rollout) {
 			allErrs = append(allErrs, field.Required(fldPath.Child("trafficRouting"), InvalidSetCanaryScaleTrafficPolicy))
 		}
 
 		if step.SetHeaderRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && len(trafficRouting.Plugins) == 0) {
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && trafficRouting.GatewayAPI == nil && len(trafficRouting.Plugins) == 0) {
 				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setHeaderRoute"), step.SetHeaderRoute, InvalidSetHeaderRouteTrafficPolicy))
 			} else if step.SetHeaderRoute.Match != nil && len(step.SetHeaderRoute.Match) > 0 {
 				for j, match := range step.SetHeaderRoute.Match {
@@ -350,7 +618,7 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetMirrorRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || (trafficRouting.Istio == nil && len(trafficRouting.Plugins) == 0) {
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.GatewayAPI == nil && len(trafficRouting.Plugins) == 0) {
 				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute, InvalidSetMirrorRouteTrafficPolicy))
 			}
 			if step.SetMirrorRoute.Match != nil && len(step.SetMirrorRoute.Match) > 0 {
@@ -489,6 +757,8 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	allErrs := field.ErrorList{}
 	oneOf := make([]bool, 0, 3)
 	oneOf = append(oneOf, s.SetWeight != nil)
+	oneOf = append(oneOf, s.SetWeightRamp != nil)
+	oneOf = append(oneOf, s.SetClusterWeight != nil)
 	oneOf = append(oneOf, s.Pause != nil)
 	oneOf = append(oneOf, s.Experiment != nil)
 	oneOf = append(oneOf, s.Analysis != nil)
@@ -496,7 +766,7 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	for i := range oneOf {
 		if oneOf[i] {
 			if hasMultipleStepTypes {
-				errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t", s.Experiment != nil, s.Pause != nil, s.SetWeight != nil, s.Analysis != nil)
+				errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.SetWeightRamp: %t step.SetClusterWeight: %t step.Analysis: %t", s.Experiment != nil, s.Pause != nil, s.SetWeight != nil, s.SetWeightRamp != nil, s.SetClusterWeight != nil, s.Analysis != nil)
 				allErrs = append(allErrs, field.Invalid(fldPath, errVal, InvalidStepMessage))
 				break
 			}
@@ -506,6 +776,15 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+func hasCluster(rollout *v1alpha1.Rollout, name string) bool {
+	for _, cluster := range rollout.Spec.Clusters {
+		if cluster.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func hasALBInvalidValues(match *v1alpha1.StringMatch, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if match == nil {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -98,7 +99,7 @@ func TestValidateRolloutStrategy(t *testing.T) {
 	}
 
 	allErrs := ValidateRolloutStrategy(&rollout, field.NewPath(""))
-	message := fmt.Sprintf(MissingFieldMessage, ".spec.strategy.canary or .spec.strategy.blueGreen")
+	message := fmt.Sprintf(MissingFieldMessage, ".spec.strategy.canary, .spec.strategy.blueGreen or .spec.strategy.daemonSet")
 	assert.Equal(t, message, allErrs[0].Detail)
 
 	rollout.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{}
@@ -129,6 +130,43 @@ func TestValidateRolloutStrategyBlueGreen(t *testing.T) {
 	assert.Equal(t, ScaleDownLimitLargerThanRevisionLimit, allErrs[1].Detail)
 }
 
+func TestValidateRolloutStrategyDaemonSet(t *testing.T) {
+	rollout := v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{
+					Steps: []v1alpha1.DaemonSetStep{
+						{Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](25)}},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{Cohort: &v1alpha1.DaemonSetCohort{NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "b"}}}},
+						{Analysis: &v1alpha1.RolloutAnalysis{}},
+					},
+				},
+			},
+		},
+	}
+	allErrs := ValidateRolloutStrategy(&rollout, field.NewPath("spec", "strategy"))
+	assert.Empty(t, allErrs)
+
+	rollout.Spec.Strategy.DaemonSet.Steps = []v1alpha1.DaemonSetStep{
+		{},
+		{Cohort: &v1alpha1.DaemonSetCohort{}, Pause: &v1alpha1.RolloutPause{}},
+		{Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](120)}},
+	}
+	rollout.Spec.WorkloadRef = &v1alpha1.ObjectRef{Kind: "DaemonSet", Name: "agent"}
+	allErrs = ValidateRolloutStrategy(&rollout, field.NewPath("spec", "strategy"))
+	require.Len(t, allErrs, 5)
+	assert.Equal(t, InvalidDaemonSetWorkloadRefMessage, allErrs[0].Detail)
+	assert.Equal(t, InvalidDaemonSetStepMessage, allErrs[1].Detail)
+	assert.Equal(t, InvalidDaemonSetStepMessage, allErrs[2].Detail)
+	assert.Equal(t, InvalidDaemonSetCohortMessage, allErrs[3].Detail)
+	assert.Equal(t, "spec.strategy.daemonSet.steps[2].cohort.percentage", allErrs[4].Field)
+
+	rollout.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{}
+	allErrs = ValidateRolloutStrategy(&rollout, field.NewPath("spec", "strategy"))
+	assert.Equal(t, InvalidStrategyMessage, allErrs[0].Detail)
+}
+
 func TestValidateRolloutStrategyCanaryMissingServiceNames(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -580,6 +618,142 @@ func TestValidateRolloutStrategyCanarySetHeaderRoutePlugins(t *testing.T) {
 	})
 }
 
+func TestValidateRolloutStrategyCanarySetWeightRamp(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		Steps: []v1alpha1.CanaryStep{{
+			SetWeightRamp: &v1alpha1.SetWeightRamp{From: 10, To: 50, Increment: 5, Interval: "2m"},
+		}},
+	}
+
+	t.Run("valid ramp", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("ramp combined with setWeight", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeight = ptr.To[int32](10)
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStepMessage, allErrs[0].Detail)
+	})
+
+	t.Run("descending weights", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.From = 60
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, fmt.Sprintf(InvalidSetWeightRampMessage, 100), allErrs[0].Detail)
+	})
+
+	t.Run("weight above max", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.To = 101
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, fmt.Sprintf(InvalidSetWeightRampMessage, 100), allErrs[0].Detail)
+	})
+
+	t.Run("zero increment", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.Increment = 0
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetWeightRampIncrementMessage, allErrs[0].Detail)
+	})
+
+	t.Run("valid analysis ramp", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.Analysis = &v1alpha1.RolloutAnalysisBackground{
+			RolloutAnalysis: v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "headroom"}}},
+		}
+		validRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.Increment = 0
+		validRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.Analysis = &v1alpha1.WeightRampAnalysis{MetricName: "headroom", MinIncrement: 2, MaxIncrement: 10}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("analysis ramp without background analysis", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.Analysis = &v1alpha1.WeightRampAnalysis{MetricName: "headroom", MinIncrement: 2, MaxIncrement: 10}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetWeightRampAnalysisMessage, allErrs[0].Detail)
+	})
+
+	t.Run("analysis ramp with invalid increments", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Analysis = &v1alpha1.RolloutAnalysisBackground{
+			RolloutAnalysis: v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "headroom"}}},
+		}
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.Analysis = &v1alpha1.WeightRampAnalysis{MetricName: "headroom", MinIncrement: 10, MaxIncrement: 2}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetWeightRampAnalysisIncrementMessage, allErrs[0].Detail)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		for _, interval := range []v1alpha1.DurationString{"", "0s", "two minutes"} {
+			invalidRo := ro.DeepCopy()
+			invalidRo.Spec.Strategy.Canary.Steps[0].SetWeightRamp.Interval = interval
+			allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+			assert.Len(t, allErrs, 1)
+			assert.Equal(t, InvalidSetWeightRampIntervalMessage, allErrs[0].Detail)
+		}
+	})
+}
+
+func TestValidateRolloutStrategyCanaryGatewayAPI(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			GatewayAPI: &v1alpha1.GatewayAPITrafficRouting{
+				HTTPRoutes: []v1alpha1.GatewayAPIRoute{{Name: "http-route"}},
+			},
+			ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}, {Name: "mirror"}},
+		},
+	}
+
+	t.Run("using SetHeaderRoute and SetMirrorRoute steps with the Gateway API", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name: "header",
+				Match: []v1alpha1.HeaderRoutingMatch{{
+					HeaderName:  "agent",
+					HeaderValue: &v1alpha1.StringMatch{Prefix: "chrome"},
+				}},
+			},
+		}, {
+			SetMirrorRoute: &v1alpha1.SetMirrorRoute{
+				Name:  "mirror",
+				Match: []v1alpha1.RouteMatch{{Path: &v1alpha1.StringMatch{Prefix: "/"}}},
+			},
+		}}
+		allErrs := ValidateRolloutStrategyCanary(validRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("missing routes", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.HTTPRoutes = nil
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, MissingGatewayAPIRoutesMessage, allErrs[0].Detail)
+	})
+
+	t.Run("missing canary service", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.CanaryService = ""
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidTrafficRoutingMessage, allErrs[0].Detail)
+	})
+}
+
 func TestValidateRolloutStrategyCanarySetMirrorRoute(t *testing.T) {
 	ro := &v1alpha1.Rollout{}
 	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
@@ -1179,3 +1353,256 @@ func TestCanaryExperimentStepWithWeight(t *testing.T) {
 		assert.Equal(t, 0, len(allErrs))
 	})
 }
+
+func TestValidateDeploymentWindows(t *testing.T) {
+	fldPath := field.NewPath("spec", "strategy", "deploymentWindows")
+
+	t.Run("valid windows", func(t *testing.T) {
+		allErrs := ValidateDeploymentWindows(&v1alpha1.DeploymentWindows{
+			Windows: []v1alpha1.DeploymentWindow{{
+				Kind:     v1alpha1.DeploymentWindowKindAllow,
+				Schedule: "0 9 * * 1-5",
+				Duration: "8h",
+				TimeZone: "Europe/Berlin",
+			}},
+			ScheduleRefs: []v1alpha1.RolloutScheduleRef{{Name: "holidays"}},
+		}, fldPath)
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("no windows", func(t *testing.T) {
+		allErrs := ValidateDeploymentWindows(&v1alpha1.DeploymentWindows{}, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidDeploymentWindowsMessage, allErrs[0].Detail)
+	})
+
+	t.Run("invalid window", func(t *testing.T) {
+		allErrs := ValidateDeploymentWindows(&v1alpha1.DeploymentWindows{
+			Windows: []v1alpha1.DeploymentWindow{{
+				Kind:     v1alpha1.DeploymentWindowKindBlock,
+				Schedule: "0 9 * * 1-5",
+				Duration: "8h",
+				TimeZone: "Nowhere/Special",
+			}},
+		}, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.deploymentWindows.windows[0]", allErrs[0].Field)
+		assert.Contains(t, allErrs[0].Detail, "invalid time zone 'Nowhere/Special'")
+	})
+
+	t.Run("missing schedule name", func(t *testing.T) {
+		allErrs := ValidateDeploymentWindows(&v1alpha1.DeploymentWindows{
+			ScheduleRefs: []v1alpha1.RolloutScheduleRef{{}},
+		}, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.deploymentWindows.scheduleRefs[0].name", allErrs[0].Field)
+	})
+
+	t.Run("namespaced controller", func(t *testing.T) {
+		ro := &v1alpha1.Rollout{}
+		allErrs := ValidateScheduleRefsNamespace(ro, "default", fldPath.Child("scheduleRefs"))
+		assert.Empty(t, allErrs)
+
+		ro.Spec.Strategy.DeploymentWindows = &v1alpha1.DeploymentWindows{
+			ScheduleRefs: []v1alpha1.RolloutScheduleRef{{Name: "holidays"}},
+		}
+		allErrs = ValidateScheduleRefsNamespace(ro, "default", fldPath.Child("scheduleRefs"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.deploymentWindows.scheduleRefs", allErrs[0].Field)
+		assert.Equal(t, "RolloutSchedules cannot be referenced when the controller is namespaced (default), use inline windows instead", allErrs[0].Detail)
+	})
+}
+
+func TestValidateDependsOn(t *testing.T) {
+	fldPath := field.NewPath("spec", "dependsOn")
+	newRollout := func(deps ...v1alpha1.RolloutDependency) *v1alpha1.Rollout {
+		return &v1alpha1.Rollout{
+			ObjectMeta: metav1.ObjectMeta{Name: "frontend", Namespace: "default"},
+			Spec:       v1alpha1.RolloutSpec{DependsOn: deps},
+		}
+	}
+
+	t.Run("valid dependencies", func(t *testing.T) {
+		allErrs := ValidateDependsOn(newRollout(
+			v1alpha1.RolloutDependency{Name: "backend-api"},
+			v1alpha1.RolloutDependency{Name: "frontend", Namespace: "other", Phase: v1alpha1.RolloutPhasePaused, RevisionAnnotation: "example.com/release"},
+		), fldPath)
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		allErrs := ValidateDependsOn(newRollout(v1alpha1.RolloutDependency{}), fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.dependsOn[0].name", allErrs[0].Field)
+	})
+
+	t.Run("depends on itself", func(t *testing.T) {
+		allErrs := ValidateDependsOn(newRollout(v1alpha1.RolloutDependency{Name: "frontend", Namespace: "default"}), fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidDependsOnSelfMessage, allErrs[0].Detail)
+	})
+
+	t.Run("invalid phase", func(t *testing.T) {
+		allErrs := ValidateDependsOn(newRollout(v1alpha1.RolloutDependency{Name: "backend-api", Phase: "Done"}), fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.dependsOn[0].phase", allErrs[0].Field)
+		assert.Equal(t, InvalidDependsOnPhaseMessage, allErrs[0].Detail)
+	})
+
+	t.Run("namespaced controller", func(t *testing.T) {
+		ro := newRollout(
+			v1alpha1.RolloutDependency{Name: "backend-api"},
+			v1alpha1.RolloutDependency{Name: "database", Namespace: "default"},
+			v1alpha1.RolloutDependency{Name: "payments", Namespace: "payments"},
+		)
+		allErrs := ValidateDependsOnNamespace(ro, "default", fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.dependsOn[2].namespace", allErrs[0].Field)
+		assert.Equal(t, "Namespace must be the namespace of the rollout when the controller is namespaced (default)", allErrs[0].Detail)
+	})
+}
+
+func TestValidateClusters(t *testing.T) {
+	fldPath := field.NewPath("spec", "clusters")
+	newRollout := func(clusters ...v1alpha1.RolloutCluster) *v1alpha1.Rollout {
+		ro := &v1alpha1.Rollout{Spec: v1alpha1.RolloutSpec{Clusters: clusters}}
+		ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{}
+		return ro
+	}
+
+	t.Run("valid clusters", func(t *testing.T) {
+		allErrs := ValidateClusters(newRollout(
+			v1alpha1.RolloutCluster{Name: "staging"},
+			v1alpha1.RolloutCluster{Name: "prod-eu", Namespace: "shop", Replicas: ptr.To[int32](3)},
+		), fldPath)
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("blue-green strategy", func(t *testing.T) {
+		ro := newRollout(v1alpha1.RolloutCluster{Name: "staging"})
+		ro.Spec.Strategy.Canary = nil
+		ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{}
+		allErrs := ValidateClusters(ro, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidClustersStrategyMessage, allErrs[0].Detail)
+	})
+
+	t.Run("missing and duplicated names", func(t *testing.T) {
+		allErrs := ValidateClusters(newRollout(
+			v1alpha1.RolloutCluster{},
+			v1alpha1.RolloutCluster{Name: "staging"},
+			v1alpha1.RolloutCluster{Name: "staging"},
+		), fldPath)
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.clusters[0].name", allErrs[0].Field)
+		assert.Equal(t, field.ErrorTypeDuplicate, allErrs[1].Type)
+		assert.Equal(t, "spec.clusters[2].name", allErrs[1].Field)
+	})
+
+	t.Run("negative replicas", func(t *testing.T) {
+		allErrs := ValidateClusters(newRollout(v1alpha1.RolloutCluster{Name: "staging", Replicas: ptr.To[int32](-1)}), fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.clusters[0].replicas", allErrs[0].Field)
+	})
+}
+
+func TestValidateStatefulSetWorkload(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	newRollout := func(steps ...v1alpha1.CanaryStep) *v1alpha1.Rollout {
+		ro := &v1alpha1.Rollout{Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "guestbook"},
+		}}
+		ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{Steps: steps}
+		return ro
+	}
+
+	t.Run("valid steps", func(t *testing.T) {
+		allErrs := ValidateStatefulSetWorkload(newRollout(
+			v1alpha1.CanaryStep{SetWeight: ptr.To[int32](20)},
+			v1alpha1.CanaryStep{SetCanaryScale: &v1alpha1.SetCanaryScale{Replicas: ptr.To[int32](1)}},
+			v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}},
+			v1alpha1.CanaryStep{Analysis: &v1alpha1.RolloutAnalysis{}},
+		), fldPath)
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("deployment workload", func(t *testing.T) {
+		ro := newRollout(v1alpha1.CanaryStep{Experiment: &v1alpha1.RolloutExperimentStep{}})
+		ro.Spec.WorkloadRef.Kind = "Deployment"
+		assert.Empty(t, ValidateStatefulSetWorkload(ro, fldPath))
+	})
+
+	t.Run("blue-green strategy", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary = nil
+		ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{}
+		allErrs := ValidateStatefulSetWorkload(ro, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStatefulSetStrategyMessage, allErrs[0].Detail)
+	})
+
+	t.Run("unsupported fields and steps", func(t *testing.T) {
+		ro := newRollout(
+			v1alpha1.CanaryStep{SetWeight: ptr.To[int32](20)},
+			v1alpha1.CanaryStep{Experiment: &v1alpha1.RolloutExperimentStep{}},
+		)
+		ro.Spec.WorkloadRef.ScaleDown = v1alpha1.ScaleDownOnSuccess
+		ro.Spec.Strategy.Canary.CanaryService = "canary"
+		ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{}
+		allErrs := ValidateStatefulSetWorkload(ro, fldPath)
+		assert.Len(t, allErrs, 4)
+		assert.Equal(t, "spec.workloadRef.scaleDown", allErrs[0].Field)
+		assert.Equal(t, "spec.strategy.canary.trafficRouting", allErrs[1].Field)
+		assert.Equal(t, "spec.strategy.canary.canaryService", allErrs[2].Field)
+		assert.Equal(t, "spec.strategy.canary.steps[1].experiment", allErrs[3].Field)
+		assert.Equal(t, fmt.Sprintf(InvalidStatefulSetFieldMessage, "experiment"), allErrs[3].Detail)
+	})
+}
+
+func TestValidateRolloutStrategyCanarySetClusterWeight(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Clusters = []v1alpha1.RolloutCluster{{Name: "staging"}, {Name: "prod-eu"}}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		Steps: []v1alpha1.CanaryStep{{
+			SetClusterWeight: &v1alpha1.SetClusterWeight{Clusters: []string{"staging"}, Weight: 50},
+		}},
+	}
+
+	t.Run("valid step", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
+	t.Run("combined with setWeight", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeight = ptr.To[int32](10)
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath("spec", "strategy", "canary"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStepMessage, allErrs[0].Detail)
+	})
+
+	t.Run("weight above 100", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetClusterWeight.Weight = 101
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath("spec", "strategy", "canary"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidSetClusterWeightMessage, allErrs[0].Detail)
+	})
+
+	t.Run("unknown cluster", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetClusterWeight.Clusters = []string{"staging", "prod-us"}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath("spec", "strategy", "canary"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.canary.steps[0].setClusterWeight.clusters[1]", allErrs[0].Field)
+		assert.Equal(t, fmt.Sprintf(InvalidSetClusterWeightClusterMessage, "prod-us"), allErrs[0].Detail)
+	})
+
+	t.Run("no clusters", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetClusterWeight.Clusters = nil
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath("spec", "strategy", "canary"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.canary.steps[0].setClusterWeight.clusters", allErrs[0].Field)
+	})
+}
@@ -44,10 +44,18 @@ func (c *FakeArgoprojV1alpha1) Experiments(namespace string) v1alpha1.Experiment
 	return newFakeExperiments(c, namespace)
 }
 
+func (c *FakeArgoprojV1alpha1) ReleaseTrains(namespace string) v1alpha1.ReleaseTrainInterface {
+	return newFakeReleaseTrains(c, namespace)
+}
+
 func (c *FakeArgoprojV1alpha1) Rollouts(namespace string) v1alpha1.RolloutInterface {
 	return newFakeRollouts(c, namespace)
 }
 
+func (c *FakeArgoprojV1alpha1) RolloutSchedules() v1alpha1.RolloutScheduleInterface {
+	return newFakeRolloutSchedules(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeArgoprojV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,52 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeRolloutSchedules implements RolloutScheduleInterface
+type fakeRolloutSchedules struct {
+	*gentype.FakeClientWithList[*v1alpha1.RolloutSchedule, *v1alpha1.RolloutScheduleList]
+	Fake *FakeArgoprojV1alpha1
+}
+
+func newFakeRolloutSchedules(fake *FakeArgoprojV1alpha1) rolloutsv1alpha1.RolloutScheduleInterface {
+	return &fakeRolloutSchedules{
+		gentype.NewFakeClientWithList[*v1alpha1.RolloutSchedule, *v1alpha1.RolloutScheduleList](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("rolloutschedules"),
+			v1alpha1.SchemeGroupVersion.WithKind("RolloutSchedule"),
+			func() *v1alpha1.RolloutSchedule { return &v1alpha1.RolloutSchedule{} },
+			func() *v1alpha1.RolloutScheduleList { return &v1alpha1.RolloutScheduleList{} },
+			func(dst, src *v1alpha1.RolloutScheduleList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.RolloutScheduleList) []*v1alpha1.RolloutSchedule {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.RolloutScheduleList, items []*v1alpha1.RolloutSchedule) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
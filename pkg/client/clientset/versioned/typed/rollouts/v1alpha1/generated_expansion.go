@@ -26,4 +26,8 @@ type ClusterAnalysisTemplateExpansion interface{}
 
 type ExperimentExpansion interface{}
 
+type ReleaseTrainExpansion interface{}
+
 type RolloutExpansion interface{}
+
+type RolloutScheduleExpansion interface{}
@@ -32,7 +32,9 @@ type ArgoprojV1alpha1Interface interface {
 	AnalysisTemplatesGetter
 	ClusterAnalysisTemplatesGetter
 	ExperimentsGetter
+	ReleaseTrainsGetter
 	RolloutsGetter
+	RolloutSchedulesGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -56,10 +58,18 @@ func (c *ArgoprojV1alpha1Client) Experiments(namespace string) ExperimentInterfa
 	return newExperiments(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) ReleaseTrains(namespace string) ReleaseTrainInterface {
+	return newReleaseTrains(c, namespace)
+}
+
 func (c *ArgoprojV1alpha1Client) Rollouts(namespace string) RolloutInterface {
 	return newRollouts(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) RolloutSchedules() RolloutScheduleInterface {
+	return newRolloutSchedules(c)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
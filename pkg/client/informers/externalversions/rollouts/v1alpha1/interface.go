@@ -32,8 +32,12 @@ type Interface interface {
 	ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer
 	// Experiments returns a ExperimentInformer.
 	Experiments() ExperimentInformer
+	// ReleaseTrains returns a ReleaseTrainInformer.
+	ReleaseTrains() ReleaseTrainInformer
 	// Rollouts returns a RolloutInformer.
 	Rollouts() RolloutInformer
+	// RolloutSchedules returns a RolloutScheduleInformer.
+	RolloutSchedules() RolloutScheduleInformer
 }
 
 type version struct {
@@ -67,7 +71,17 @@ func (v *version) Experiments() ExperimentInformer {
 	return &experimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ReleaseTrains returns a ReleaseTrainInformer.
+func (v *version) ReleaseTrains() ReleaseTrainInformer {
+	return &releaseTrainInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Rollouts returns a RolloutInformer.
 func (v *version) Rollouts() RolloutInformer {
 	return &rolloutInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// RolloutSchedules returns a RolloutScheduleInformer.
+func (v *version) RolloutSchedules() RolloutScheduleInformer {
+	return &rolloutScheduleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -46,6 +46,14 @@ type ExperimentListerExpansion interface{}
 // ExperimentNamespaceLister.
 type ExperimentNamespaceListerExpansion interface{}
 
+// ReleaseTrainListerExpansion allows custom methods to be added to
+// ReleaseTrainLister.
+type ReleaseTrainListerExpansion interface{}
+
+// ReleaseTrainNamespaceListerExpansion allows custom methods to be added to
+// ReleaseTrainNamespaceLister.
+type ReleaseTrainNamespaceListerExpansion interface{}
+
 // RolloutListerExpansion allows custom methods to be added to
 // RolloutLister.
 type RolloutListerExpansion interface{}
@@ -53,3 +61,7 @@ type RolloutListerExpansion interface{}
 // RolloutNamespaceListerExpansion allows custom methods to be added to
 // RolloutNamespaceLister.
 type RolloutNamespaceListerExpansion interface{}
+
+// RolloutScheduleListerExpansion allows custom methods to be added to
+// RolloutScheduleLister.
+type RolloutScheduleListerExpansion interface{}
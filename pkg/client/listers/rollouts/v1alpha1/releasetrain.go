@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	rolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ReleaseTrainLister helps list ReleaseTrains.
+// All objects returned here must be treated as read-only.
+type ReleaseTrainLister interface {
+	// List lists all ReleaseTrains in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rolloutsv1alpha1.ReleaseTrain, err error)
+	// ReleaseTrains returns an object that can list and get ReleaseTrains.
+	ReleaseTrains(namespace string) ReleaseTrainNamespaceLister
+	ReleaseTrainListerExpansion
+}
+
+// releaseTrainLister implements the ReleaseTrainLister interface.
+type releaseTrainLister struct {
+	listers.ResourceIndexer[*rolloutsv1alpha1.ReleaseTrain]
+}
+
+// NewReleaseTrainLister returns a new ReleaseTrainLister.
+func NewReleaseTrainLister(indexer cache.Indexer) ReleaseTrainLister {
+	return &releaseTrainLister{listers.New[*rolloutsv1alpha1.ReleaseTrain](indexer, rolloutsv1alpha1.Resource("releasetrain"))}
+}
+
+// ReleaseTrains returns an object that can list and get ReleaseTrains.
+func (s *releaseTrainLister) ReleaseTrains(namespace string) ReleaseTrainNamespaceLister {
+	return releaseTrainNamespaceLister{listers.NewNamespaced[*rolloutsv1alpha1.ReleaseTrain](s.ResourceIndexer, namespace)}
+}
+
+// ReleaseTrainNamespaceLister helps list and get ReleaseTrains.
+// All objects returned here must be treated as read-only.
+type ReleaseTrainNamespaceLister interface {
+	// List lists all ReleaseTrains in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rolloutsv1alpha1.ReleaseTrain, err error)
+	// Get retrieves the ReleaseTrain from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*rolloutsv1alpha1.ReleaseTrain, error)
+	ReleaseTrainNamespaceListerExpansion
+}
+
+// releaseTrainNamespaceLister implements the ReleaseTrainNamespaceLister
+// interface.
+type releaseTrainNamespaceLister struct {
+	listers.ResourceIndexer[*rolloutsv1alpha1.ReleaseTrain]
+}
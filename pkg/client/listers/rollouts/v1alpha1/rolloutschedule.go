@@ -0,0 +1,48 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	rolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// RolloutScheduleLister helps list RolloutSchedules.
+// All objects returned here must be treated as read-only.
+type RolloutScheduleLister interface {
+	// List lists all RolloutSchedules in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*rolloutsv1alpha1.RolloutSchedule, err error)
+	// Get retrieves the RolloutSchedule from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*rolloutsv1alpha1.RolloutSchedule, error)
+	RolloutScheduleListerExpansion
+}
+
+// rolloutScheduleLister implements the RolloutScheduleLister interface.
+type rolloutScheduleLister struct {
+	listers.ResourceIndexer[*rolloutsv1alpha1.RolloutSchedule]
+}
+
+// NewRolloutScheduleLister returns a new RolloutScheduleLister.
+func NewRolloutScheduleLister(indexer cache.Indexer) RolloutScheduleLister {
+	return &rolloutScheduleLister{listers.New[*rolloutsv1alpha1.RolloutSchedule](indexer, rolloutsv1alpha1.Resource("rolloutschedule"))}
+}
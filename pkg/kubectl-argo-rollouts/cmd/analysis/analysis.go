@@ -0,0 +1,172 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+const (
+	analysisExample = `
+	# Get the metrics and measurements of an AnalysisRun
+	%[1]s analysis get guestbook-877894d5b-4-1
+
+	# List the AnalysisRuns of a rollout
+	%[1]s analysis list --rollout guestbook
+
+	# Re-run a completed AnalysisRun
+	%[1]s analysis rerun guestbook-877894d5b-4-1`
+
+	terminateExample = `
+	# Terminate an AnalysisRun
+	%[1]s analysis terminate guestbook-877894d5b-4-1`
+
+	rerunExample = `
+	# Re-run a completed AnalysisRun
+	%[1]s analysis rerun guestbook-877894d5b-4-1
+
+	# Re-run a completed AnalysisRun with a given name
+	%[1]s analysis rerun guestbook-877894d5b-4-1 --name guestbook-smoke-test`
+)
+
+// NewCmdAnalysis returns a new instance of an `rollouts analysis` command
+func NewCmdAnalysis(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:          "analysis <get|list|watch|terminate|rerun>",
+		Short:        "Inspect and manage AnalysisRuns",
+		Long:         "This command consists of multiple subcommands which can be used to inspect the metrics and measurements of AnalysisRuns, and to terminate or re-run them.",
+		Example:      o.Example(analysisExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			return o.UsageErr(c)
+		},
+	}
+	cmd.AddCommand(NewCmdAnalysisGet(o))
+	cmd.AddCommand(NewCmdAnalysisList(o))
+	cmd.AddCommand(NewCmdAnalysisWatch(o))
+	cmd.AddCommand(NewCmdAnalysisTerminate(o))
+	cmd.AddCommand(NewCmdAnalysisRerun(o))
+	return cmd
+}
+
+// NewCmdAnalysisTerminate returns a new instance of an `rollouts analysis terminate` command
+func NewCmdAnalysisTerminate(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:          "terminate ANALYSISRUN_NAME",
+		Short:        "Terminate an AnalysisRun",
+		Long:         "This command terminates an AnalysisRun.",
+		Example:      o.Example(terminateExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return o.UsageErr(c)
+			}
+			analysisRunIf := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(o.Namespace())
+			for _, name := range args {
+				if err := analysisutil.TerminateRun(analysisRunIf, name); err != nil {
+					return err
+				}
+				fmt.Fprintf(o.Out, "analysisRun '%s' terminated\n", name)
+			}
+			return nil
+		},
+		ValidArgsFunction: completionutil.AnalysisRunNameCompletionFunc(o),
+	}
+	return cmd
+}
+
+// NewCmdAnalysisRerun returns a new instance of an `rollouts analysis rerun` command
+func NewCmdAnalysisRerun(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var name, generateName string
+	var cmd = &cobra.Command{
+		Use:   "rerun ANALYSISRUN_NAME",
+		Short: "Re-run a completed AnalysisRun",
+		Long: "This command creates a new AnalysisRun with the metrics and args of a completed AnalysisRun. The new run " +
+			"is not owned by the rollout or experiment of the completed run, and does not affect them. The labels and " +
+			"annotations the controller sets on the completed run are not copied, and the new run is annotated with the " +
+			"name of the completed run.",
+		Example:      o.Example(rerunExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			analysisRunIf := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(o.Namespace())
+			run, err := analysisRunIf.Get(c.Context(), args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			newRun, err := rerunAnalysisRun(run, name, generateName)
+			if err != nil {
+				return err
+			}
+			created, err := analysisRunIf.Create(c.Context(), newRun, metav1.CreateOptions{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(o.Out, "%s.%s/%s created\n", rollouts.AnalysisRunSingular, rollouts.Group, created.Name)
+			return nil
+		},
+		ValidArgsFunction: completionutil.AnalysisRunNameCompletionFunc(o),
+	}
+	cmd.Flags().StringVar(&name, "name", "", "Use the specified name for the new run")
+	cmd.Flags().StringVar(&generateName, "generate-name", "", "Use the specified generateName for the new run (default is the name of the completed run followed by a dash)")
+	return cmd
+}
+
+// rerunControllerLabels are the labels of an AnalysisRun set by the rollout controller, which do not apply to a re-run
+var rerunControllerLabels = []string{
+	v1alpha1.RolloutTypeLabel,
+	v1alpha1.RolloutCanaryStepIndexLabel,
+	v1alpha1.DefaultRolloutUniqueLabelKey,
+}
+
+// rerunAnalysisRun returns a new AnalysisRun with the metrics and args of the completed run. The labels and
+// annotations set by the controller are dropped, and the new run is annotated with the name of the completed run.
+func rerunAnalysisRun(run *v1alpha1.AnalysisRun, name, generateName string) (*v1alpha1.AnalysisRun, error) {
+	if !run.Status.Phase.Completed() {
+		return nil, fmt.Errorf("analysisRun '%s' has not completed", run.Name)
+	}
+	if name == "" && generateName == "" {
+		generateName = run.Name + "-"
+	}
+	labels := map[string]string{}
+	for k, v := range run.Labels {
+		labels[k] = v
+	}
+	for _, k := range rerunControllerLabels {
+		delete(labels, k)
+	}
+	runAnnotations := map[string]string{}
+	for k, v := range run.Annotations {
+		runAnnotations[k] = v
+	}
+	delete(runAnnotations, annotations.RevisionAnnotation)
+	runAnnotations[v1alpha1.AnalysisRunRerunOfAnnotationKey] = run.Name
+	newRun := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:         name,
+			GenerateName: generateName,
+			Namespace:    run.Namespace,
+			Labels:       labels,
+			Annotations:  runAnnotations,
+		},
+		Spec: *run.Spec.DeepCopy(),
+	}
+	newRun.Spec.Terminate = false
+	return newRun, nil
+}
+
+// getAnalysisRun returns the AnalysisRun of the current namespace
+func getAnalysisRun(ctx context.Context, o *options.ArgoRolloutsOptions, name string) (*v1alpha1.AnalysisRun, error) {
+	return o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(o.Namespace()).Get(ctx, name, metav1.GetOptions{})
+}
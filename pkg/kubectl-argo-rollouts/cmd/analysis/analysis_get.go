@@ -0,0 +1,283 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+)
+
+const (
+	getExample = `
+	# Get the metrics and measurements of an AnalysisRun
+	%[1]s analysis get guestbook-877894d5b-4-1`
+
+	watchExample = `
+	# Watch an AnalysisRun until it completes
+	%[1]s analysis watch guestbook-877894d5b-4-1
+
+	# Watch an AnalysisRun, fail if it takes more than 5 minutes
+	%[1]s analysis watch guestbook-877894d5b-4-1 --timeout-seconds 300`
+
+	tableFormat = "%-20s%v\n"
+
+	// sparklineLevels are the characters of the sparklines of the measurement values, from the lowest to the highest
+	sparklineLevels = "_.-~^"
+)
+
+// NewCmdAnalysisGet returns a new instance of an `rollouts analysis get` command
+func NewCmdAnalysisGet(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var cmd = &cobra.Command{
+		Use:   "get ANALYSISRUN_NAME",
+		Short: "Get the metrics and measurements of an AnalysisRun",
+		Long: "This command prints the status of an AnalysisRun, a table of its metrics with a sparkline of their " +
+			"measurement values, and the measurements of each metric over time.",
+		Example:      o.Example(getExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			run, err := getAnalysisRun(c.Context(), o, args[0])
+			if err != nil {
+				return err
+			}
+			printAnalysisRun(o.Out, run)
+			return nil
+		},
+		ValidArgsFunction: completionutil.AnalysisRunNameCompletionFunc(o),
+	}
+	return cmd
+}
+
+// NewCmdAnalysisWatch returns a new instance of an `rollouts analysis watch` command
+func NewCmdAnalysisWatch(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var timeoutSeconds int
+	var cmd = &cobra.Command{
+		Use:          "watch ANALYSISRUN_NAME",
+		Short:        "Watch an AnalysisRun until it completes",
+		Long:         "This command prints the metrics and measurements of an AnalysisRun each time they change, until the run completes.",
+		Example:      o.Example(watchExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			ctx := c.Context()
+			if timeoutSeconds > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Duration(timeoutSeconds)*time.Second)
+				defer cancel()
+			}
+			return watchAnalysisRun(ctx, o, args[0])
+		},
+		ValidArgsFunction: completionutil.AnalysisRunNameCompletionFunc(o),
+	}
+	cmd.Flags().IntVar(&timeoutSeconds, "timeout-seconds", 0, "Timeout after specified seconds")
+	return cmd
+}
+
+// watchAnalysisRun prints the AnalysisRun each time it changes, until it completes
+func watchAnalysisRun(ctx context.Context, o *options.ArgoRolloutsOptions, name string) error {
+	analysisRunIf := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(o.Namespace())
+	run, err := analysisRunIf.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	clearScreen(o.Out)
+	printAnalysisRun(o.Out, run)
+	if run.Status.Phase.Completed() {
+		return nil
+	}
+	watcher, err := analysisRunIf.Watch(ctx, metav1.ListOptions{
+		FieldSelector:   "metadata.name=" + name,
+		ResourceVersion: run.ResourceVersion,
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for analysisRun '%s' to complete", name)
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return fmt.Errorf("watch of analysisRun '%s' closed", name)
+			}
+			if event.Type == watch.Deleted {
+				return fmt.Errorf("analysisRun '%s' was deleted", name)
+			}
+			run, ok := event.Object.(*v1alpha1.AnalysisRun)
+			if !ok || run.Name != name {
+				continue
+			}
+			clearScreen(o.Out)
+			printAnalysisRun(o.Out, run)
+			if run.Status.Phase.Completed() {
+				return nil
+			}
+		}
+	}
+}
+
+func clearScreen(out io.Writer) {
+	fmt.Fprint(out, "\033[H\033[2J")
+	fmt.Fprint(out, "\033[0;0H")
+}
+
+func printAnalysisRun(out io.Writer, run *v1alpha1.AnalysisRun) {
+	arInfo := info.NewAnalysisRunInfo(run)
+	fmt.Fprintf(out, tableFormat, "Name:", arInfo.ObjectMeta.Name)
+	fmt.Fprintf(out, tableFormat, "Namespace:", arInfo.ObjectMeta.Namespace)
+	fmt.Fprintf(out, tableFormat, "Status:", strings.TrimSpace(arInfo.Icon+" "+arInfo.Status))
+	if run.Status.Message != "" {
+		fmt.Fprintf(out, tableFormat, "Message:", run.Status.Message)
+	}
+	if arInfo.Revision > 0 {
+		fmt.Fprintf(out, tableFormat, "Revision:", arInfo.Revision)
+	}
+	if run.Status.StartedAt != nil {
+		fmt.Fprintf(out, tableFormat, "Started:", run.Status.StartedAt.UTC().Format(time.RFC3339))
+	}
+	for i, arg := range run.Spec.Args {
+		label := ""
+		if i == 0 {
+			label = "Args:"
+		}
+		fmt.Fprintf(out, tableFormat, label, formatArg(arg))
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "METRIC\tSTATUS\tSUCCESSFUL\tFAILED\tINCONCLUSIVE\tERROR\tVALUES\tLAST\n")
+	for _, metric := range run.Spec.Metrics {
+		result := metricResult(run, metric.Name)
+		phase := v1alpha1.AnalysisPhasePending
+		var successful, failed, inconclusive, errors int32
+		if result != nil {
+			phase = result.Phase
+			successful, failed, inconclusive, errors = result.Successful, result.Failed, result.Inconclusive, result.Error
+		}
+		values := measurementValues(arInfo, metric.Name)
+		last := "-"
+		if len(values) > 0 {
+			last = values[len(values)-1]
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n", metric.Name, phase, successful, failed, inconclusive, errors, sparkline(values), last)
+	}
+	_ = w.Flush()
+
+	for _, metric := range run.Spec.Metrics {
+		fmt.Fprintf(out, "\n%s:\n", metric.Name)
+		if result := metricResult(run, metric.Name); result != nil && result.Message != "" {
+			fmt.Fprintf(out, "  Message: %s\n", result.Message)
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "  TIME\tSTATUS\tVALUE\n")
+		for _, measurement := range arInfo.NonJobInfo {
+			if measurement.MetricName == metric.Name {
+				fmt.Fprintf(w, "  %s\t%s\t%s\n", formatTime(measurement.StartedAt), measurement.Status, measurement.Value)
+			}
+		}
+		for _, job := range arInfo.Jobs {
+			if job.MetricName == metric.Name {
+				fmt.Fprintf(w, "  %s\t%s\tjob/%s\n", formatTime(job.StartedAt), job.Status, job.ObjectMeta.Name)
+			}
+		}
+		_ = w.Flush()
+	}
+}
+
+func metricResult(run *v1alpha1.AnalysisRun, name string) *v1alpha1.MetricResult {
+	for i := range run.Status.MetricResults {
+		if run.Status.MetricResults[i].Name == name {
+			return &run.Status.MetricResults[i]
+		}
+	}
+	return nil
+}
+
+// measurementValues returns the values of the measurements of the metric, from the oldest to the newest
+func measurementValues(arInfo *rollout.AnalysisRunInfo, metricName string) []string {
+	var values []string
+	for _, measurement := range arInfo.NonJobInfo {
+		if measurement.MetricName == metricName && measurement.Value != "" {
+			values = append(values, measurement.Value)
+		}
+	}
+	return values
+}
+
+// sparkline returns an ASCII sparkline of the numeric values. Values which are not numbers, such as the results of a
+// job, are left out.
+func sparkline(values []string) string {
+	var numbers []float64
+	for _, value := range values {
+		if number, ok := parseValue(value); ok {
+			numbers = append(numbers, number)
+		}
+	}
+	if len(numbers) == 0 {
+		return "-"
+	}
+	minValue, maxValue := numbers[0], numbers[0]
+	for _, number := range numbers {
+		minValue = math.Min(minValue, number)
+		maxValue = math.Max(maxValue, number)
+	}
+	var sb strings.Builder
+	for _, number := range numbers {
+		level := len(sparklineLevels) / 2
+		if maxValue > minValue {
+			level = int(math.Round((number - minValue) / (maxValue - minValue) * float64(len(sparklineLevels)-1)))
+		}
+		sb.WriteByte(sparklineLevels[level])
+	}
+	return sb.String()
+}
+
+// parseValue parses the value of a measurement, which is a number, or a vector of numbers such as `[0.99]` of which
+// the first number is used
+func parseValue(value string) (float64, bool) {
+	value = strings.Trim(strings.TrimSpace(value), "[]")
+	value, _, _ = strings.Cut(value, ",")
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, false
+	}
+	return number, true
+}
+
+func formatArg(arg v1alpha1.Argument) string {
+	switch {
+	case arg.Value != nil:
+		return fmt.Sprintf("%s=%s", arg.Name, *arg.Value)
+	case arg.ValueFrom != nil && arg.ValueFrom.SecretKeyRef != nil:
+		return fmt.Sprintf("%s=<secret %s/%s>", arg.Name, arg.ValueFrom.SecretKeyRef.Name, arg.ValueFrom.SecretKeyRef.Key)
+	case arg.ValueFrom != nil && arg.ValueFrom.FieldRef != nil:
+		return fmt.Sprintf("%s=<field %s>", arg.Name, arg.ValueFrom.FieldRef.FieldPath)
+	}
+	return arg.Name
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,99 @@
+package analysis
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	listExample = `
+	# List AnalysisRuns
+	%[1]s analysis list
+
+	# List the AnalysisRuns of a rollout
+	%[1]s analysis list --rollout guestbook
+
+	# List AnalysisRuns from all namespaces
+	%[1]s analysis list --all-namespaces`
+
+	listHeaderFmtString = "NAME\tSTATUS\tREVISION\tSUCCESSFUL\tFAILED\tINCONCLUSIVE\tERROR\tAGE\n"
+	listColumnFmtString = "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%s\n"
+)
+
+// NewCmdAnalysisList returns a new instance of an `rollouts analysis list` command
+func NewCmdAnalysisList(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var allNamespaces bool
+	var rolloutName string
+	var cmd = &cobra.Command{
+		Use:          "list",
+		Short:        "List AnalysisRuns",
+		Long:         "This command lists the AnalysisRuns of a namespace (uses current namespace context if namespace not specified), with the number of measurements of each outcome.",
+		Example:      o.Example(listExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return o.UsageErr(c)
+			}
+			namespace := o.Namespace()
+			if allNamespaces {
+				namespace = metav1.NamespaceAll
+			}
+			runList, err := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(namespace).List(c.Context(), metav1.ListOptions{})
+			if err != nil {
+				return err
+			}
+			var runs []*v1alpha1.AnalysisRun
+			for i := range runList.Items {
+				if rolloutName == "" || ownedByRollout(&runList.Items[i], rolloutName) {
+					runs = append(runs, &runList.Items[i])
+				}
+			}
+			if len(runs) == 0 {
+				fmt.Fprintln(o.ErrOut, "No resources found.")
+				return nil
+			}
+			w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+			headerStr := listHeaderFmtString
+			fmtStr := listColumnFmtString
+			if allNamespaces {
+				headerStr = "NAMESPACE\t" + headerStr
+				fmtStr = "%s\t" + fmtStr
+			}
+			fmt.Fprint(w, headerStr)
+			for _, run := range runs {
+				arInfo := info.NewAnalysisRunInfo(run)
+				revision := "-"
+				if arInfo.Revision > 0 {
+					revision = fmt.Sprint(arInfo.Revision)
+				}
+				age := duration.HumanDuration(timeutil.MetaNow().Sub(run.CreationTimestamp.Time))
+				var cols []any
+				if allNamespaces {
+					cols = append(cols, run.Namespace)
+				}
+				cols = append(cols, run.Name, run.Status.Phase, revision, arInfo.Successful, arInfo.Failed, arInfo.Inconclusive, arInfo.Error, age)
+				fmt.Fprintf(w, fmtStr, cols...)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "Include all namespaces")
+	cmd.Flags().StringVar(&rolloutName, "rollout", "", "Only list the AnalysisRuns of the rollout")
+	return cmd
+}
+
+// ownedByRollout returns whether the rollout with the name is the controller of the AnalysisRun
+func ownedByRollout(run *v1alpha1.AnalysisRun, name string) bool {
+	ownerRef := metav1.GetControllerOf(run)
+	return ownerRef != nil && ownerRef.Kind == rollouts.RolloutKind && ownerRef.Name == name
+}
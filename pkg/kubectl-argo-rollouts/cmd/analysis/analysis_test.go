@@ -0,0 +1,254 @@
+package analysis
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	kubetesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/metricproviders/job"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	fakeroclient "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	fakeoptions "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+var started = metav1.NewTime(time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC))
+
+func measurement(phase v1alpha1.AnalysisPhase, value string, minutes int) v1alpha1.Measurement {
+	startedAt := metav1.NewTime(started.Add(time.Duration(minutes) * time.Minute))
+	return v1alpha1.Measurement{Phase: phase, Value: value, StartedAt: &startedAt}
+}
+
+func newAnalysisRun(phase v1alpha1.AnalysisPhase) *v1alpha1.AnalysisRun {
+	jobStartedAt := metav1.NewTime(started.Time)
+	return &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "guestbook-6dff4ccb5c-2-1",
+			Namespace:   "test",
+			Labels:      map[string]string{v1alpha1.RolloutTypeLabel: v1alpha1.RolloutTypeStepLabel},
+			Annotations: map[string]string{annotations.RevisionAnnotation: "2"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "argoproj.io/v1alpha1",
+				Kind:       "Rollout",
+				Name:       "guestbook",
+				UID:        "guestbook-uid",
+				Controller: ptr.To(true),
+			}},
+		},
+		Spec: v1alpha1.AnalysisRunSpec{
+			Args: []v1alpha1.Argument{
+				{Name: "service-name", Value: ptr.To("guestbook-canary")},
+				{Name: "api-token", ValueFrom: &v1alpha1.ValueFrom{SecretKeyRef: &v1alpha1.SecretKeyRef{Name: "token", Key: "value"}}},
+			},
+			Metrics: []v1alpha1.Metric{
+				{Name: "success-rate", Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{Query: "up"}}},
+				{Name: "smoke", Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}}},
+			},
+			Terminate: true,
+		},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase:     phase,
+			Message:   "Metric \"success-rate\" assessed Failed due to failed (1) > failureLimit (0)",
+			StartedAt: &started,
+			MetricResults: []v1alpha1.MetricResult{
+				{
+					Name:       "success-rate",
+					Phase:      v1alpha1.AnalysisPhaseFailed,
+					Successful: 2,
+					Failed:     1,
+					Measurements: []v1alpha1.Measurement{
+						measurement(v1alpha1.AnalysisPhaseSuccessful, "[0.99]", 0),
+						measurement(v1alpha1.AnalysisPhaseSuccessful, "[0.95]", 1),
+						measurement(v1alpha1.AnalysisPhaseFailed, "[0.79]", 2),
+					},
+				},
+				{
+					Name:       "smoke",
+					Phase:      v1alpha1.AnalysisPhaseSuccessful,
+					Successful: 1,
+					Measurements: []v1alpha1.Measurement{{
+						Phase:     v1alpha1.AnalysisPhaseSuccessful,
+						StartedAt: &jobStartedAt,
+						Metadata:  map[string]string{job.JobNameKey: "guestbook-smoke"},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func newOptions(objs ...runtime.Object) (*options.ArgoRolloutsOptions, func()) {
+	tf, o := fakeoptions.NewFakeArgoRolloutsOptions(objs...)
+	o.RESTClientGetter = tf.WithNamespace("test")
+	return o, tf.Cleanup
+}
+
+func runAnalysis(o *options.ArgoRolloutsOptions, args ...string) (string, error) {
+	cmd := NewCmdAnalysis(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return o.Out.(*bytes.Buffer).String(), err
+}
+
+func TestAnalysisGet(t *testing.T) {
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseFailed))
+	defer cleanup()
+	stdout, err := runAnalysis(o, "get", "guestbook-6dff4ccb5c-2-1")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Name:               guestbook-6dff4ccb5c-2-1\n")
+	assert.Contains(t, stdout, "Status:             ✖ Failed\n")
+	assert.Contains(t, stdout, "Message:            Metric \"success-rate\" assessed Failed due to failed (1) > failureLimit (0)\n")
+	assert.Contains(t, stdout, "Revision:           2\n")
+	assert.Contains(t, stdout, "Started:            2026-10-01T12:00:00Z\n")
+	assert.Contains(t, stdout, "Args:               service-name=guestbook-canary\n                    api-token=<secret token/value>\n")
+	assert.Contains(t, stdout, "METRIC        STATUS      SUCCESSFUL  FAILED  INCONCLUSIVE  ERROR  VALUES  LAST\n"+
+		"success-rate  Failed      2           1       0             0      ^~_     [0.79]\n"+
+		"smoke         Successful  1           0       0             0      -       -\n")
+	assert.Contains(t, stdout, "success-rate:\n"+
+		"  TIME                  STATUS      VALUE\n"+
+		"  2026-10-01T12:00:00Z  Successful  [0.99]\n"+
+		"  2026-10-01T12:01:00Z  Successful  [0.95]\n"+
+		"  2026-10-01T12:02:00Z  Failed      [0.79]\n")
+	assert.Contains(t, stdout, "smoke:\n"+
+		"  TIME                  STATUS      VALUE\n"+
+		"  2026-10-01T12:00:00Z  Successful  job/guestbook-smoke\n")
+}
+
+func TestAnalysisGetNotFound(t *testing.T) {
+	o, cleanup := newOptions()
+	defer cleanup()
+	_, err := runAnalysis(o, "get", "guestbook-6dff4ccb5c-2-1")
+	assert.EqualError(t, err, "analysisruns.argoproj.io \"guestbook-6dff4ccb5c-2-1\" not found")
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "_-^", sparkline([]string{"1", "2", "3"}))
+	assert.Equal(t, "---", sparkline([]string{"0.5", "[0.5]", "[0.5, 0.7]"}))
+	assert.Equal(t, "_^", sparkline([]string{"1", "true", "[]", "2"}))
+	assert.Equal(t, "-", sparkline([]string{"true"}))
+	assert.Equal(t, "-", sparkline(nil))
+}
+
+func TestAnalysisList(t *testing.T) {
+	other := newAnalysisRun(v1alpha1.AnalysisPhaseRunning)
+	other.Name = "canary-demo-1"
+	other.Annotations = nil
+	other.OwnerReferences[0].Name = "canary-demo"
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseFailed), other)
+	defer cleanup()
+	stdout, err := runAnalysis(o, "list")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "NAME                      STATUS   REVISION  SUCCESSFUL  FAILED  INCONCLUSIVE  ERROR  AGE\n")
+	assert.Contains(t, stdout, "canary-demo-1             Running  -         3           1       0             0")
+	assert.Contains(t, stdout, "guestbook-6dff4ccb5c-2-1  Failed   2         3           1       0             0")
+
+	o, cleanup = newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseFailed), other)
+	defer cleanup()
+	stdout, err = runAnalysis(o, "list", "--rollout", "canary-demo")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "canary-demo-1")
+	assert.NotContains(t, stdout, "guestbook-6dff4ccb5c-2-1")
+}
+
+func TestAnalysisListNoResources(t *testing.T) {
+	o, cleanup := newOptions()
+	defer cleanup()
+	stdout, err := runAnalysis(o, "list")
+	require.NoError(t, err)
+	assert.Empty(t, stdout)
+	assert.Equal(t, "No resources found.\n", o.ErrOut.(*bytes.Buffer).String())
+}
+
+func TestAnalysisWatchCompleted(t *testing.T) {
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseFailed))
+	defer cleanup()
+	stdout, err := runAnalysis(o, "watch", "guestbook-6dff4ccb5c-2-1")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Status:             ✖ Failed\n")
+}
+
+func TestAnalysisWatch(t *testing.T) {
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseRunning))
+	defer cleanup()
+	watcher := watch.NewFakeWithChanSize(2, false)
+	watcher.Modify(newAnalysisRun(v1alpha1.AnalysisPhaseRunning))
+	watcher.Modify(newAnalysisRun(v1alpha1.AnalysisPhaseSuccessful))
+	o.RolloutsClient.(*fakeroclient.Clientset).PrependWatchReactor("analysisruns", kubetesting.DefaultWatchReactor(watcher, nil))
+	stdout, err := runAnalysis(o, "watch", "guestbook-6dff4ccb5c-2-1")
+	require.NoError(t, err)
+	assert.Contains(t, stdout, "Status:             ◌ Running\n")
+	assert.Contains(t, stdout, "Status:             ✔ Successful\n")
+}
+
+func TestAnalysisWatchTimeout(t *testing.T) {
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseRunning))
+	defer cleanup()
+	_, err := runAnalysis(o, "watch", "guestbook-6dff4ccb5c-2-1", "--timeout-seconds", "1")
+	assert.EqualError(t, err, "timed out waiting for analysisRun 'guestbook-6dff4ccb5c-2-1' to complete")
+}
+
+func TestAnalysisTerminate(t *testing.T) {
+	run := newAnalysisRun(v1alpha1.AnalysisPhaseRunning)
+	run.Spec.Terminate = false
+	o, cleanup := newOptions(run)
+	defer cleanup()
+	stdout, err := runAnalysis(o, "terminate", "guestbook-6dff4ccb5c-2-1")
+	require.NoError(t, err)
+	assert.Equal(t, "analysisRun 'guestbook-6dff4ccb5c-2-1' terminated\n", stdout)
+	terminated, err := o.RolloutsClient.ArgoprojV1alpha1().AnalysisRuns("test").Get(context.Background(), "guestbook-6dff4ccb5c-2-1", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.True(t, terminated.Spec.Terminate)
+}
+
+func TestAnalysisRerun(t *testing.T) {
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseFailed))
+	defer cleanup()
+	stdout, err := runAnalysis(o, "rerun", "guestbook-6dff4ccb5c-2-1", "--name", "guestbook-rerun")
+	require.NoError(t, err)
+	assert.Equal(t, "analysisrun.argoproj.io/guestbook-rerun created\n", stdout)
+	rerun, err := o.RolloutsClient.ArgoprojV1alpha1().AnalysisRuns("test").Get(context.Background(), "guestbook-rerun", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, rerun.OwnerReferences)
+	assert.False(t, rerun.Spec.Terminate)
+	assert.Equal(t, newAnalysisRun(v1alpha1.AnalysisPhaseFailed).Spec.Args, rerun.Spec.Args)
+	assert.Equal(t, v1alpha1.AnalysisPhase(""), rerun.Status.Phase)
+}
+
+func TestRerunAnalysisRunDropsControllerMetadata(t *testing.T) {
+	run := newAnalysisRun(v1alpha1.AnalysisPhaseSuccessful)
+	run.Labels[v1alpha1.RolloutCanaryStepIndexLabel] = "2"
+	run.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = "6dff4ccb5c"
+	run.Labels["app"] = "guestbook"
+	run.Annotations["team"] = "web"
+	newRun, err := rerunAnalysisRun(run, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "guestbook"}, newRun.Labels)
+	assert.Equal(t, map[string]string{"team": "web", v1alpha1.AnalysisRunRerunOfAnnotationKey: "guestbook-6dff4ccb5c-2-1"}, newRun.Annotations)
+	// the completed run is not modified
+	assert.Equal(t, "2", run.Annotations[annotations.RevisionAnnotation])
+}
+
+func TestAnalysisRerunNotCompleted(t *testing.T) {
+	o, cleanup := newOptions(newAnalysisRun(v1alpha1.AnalysisPhaseRunning))
+	defer cleanup()
+	_, err := runAnalysis(o, "rerun", "guestbook-6dff4ccb5c-2-1")
+	assert.EqualError(t, err, "analysisRun 'guestbook-6dff4ccb5c-2-1' has not completed")
+}
+
+func TestRerunAnalysisRunGenerateName(t *testing.T) {
+	newRun, err := rerunAnalysisRun(newAnalysisRun(v1alpha1.AnalysisPhaseSuccessful), "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "", newRun.Name)
+	assert.Equal(t, "guestbook-6dff4ccb5c-2-1-", newRun.GenerateName)
+}
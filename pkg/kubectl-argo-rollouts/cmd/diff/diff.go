@@ -0,0 +1,480 @@
+package diff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/spf13/cobra"
+	goyaml "go.yaml.in/yaml/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+const (
+	diffExample = `
+	# Preview what applying a rollout manifest does to the live rollout
+	%[1]s diff -f my-rollout.yaml
+
+	# Preview the rollouts of a kustomization
+	kustomize build overlays/production | %[1]s diff -f -`
+
+	tableFormat = "%-20s%v\n"
+)
+
+// Change describes how the controller reconciles the live rollout once the manifest is applied
+type Change string
+
+const (
+	// ChangeInitialDeploy is the first revision of a rollout, which is promoted without progressing through the steps
+	ChangeInitialDeploy Change = "InitialDeploy"
+	// ChangeNone keeps the current revision of the rollout
+	ChangeNone Change = "None"
+	// ChangeNewRevision creates a new revision, which progresses through the steps
+	ChangeNewRevision Change = "NewRevision"
+	// ChangeExistingRevision updates the rollout to a revision newer than the stable revision, which progresses
+	// through the steps
+	ChangeExistingRevision Change = "ExistingRevision"
+	// ChangeRollbackToStable rolls back to the stable revision, without progressing through the steps
+	ChangeRollbackToStable Change = "RollbackToStable"
+	// ChangeFastRollback rolls back to a revision within the rollback window, without progressing through the steps
+	ChangeFastRollback Change = "FastRollback"
+	// ChangeRollback rolls back to a revision outside the rollback window, which progresses through the steps
+	ChangeRollback Change = "Rollback"
+)
+
+// DiffOptions holds the options of the `rollouts diff` command
+type DiffOptions struct {
+	File string
+
+	options.ArgoRolloutsOptions
+}
+
+// RolloutDiff describes what applying a rollout manifest does to the live rollout
+type RolloutDiff struct {
+	Name      string
+	Namespace string
+	Strategy  string
+	// CurrentPodHash is the pod template hash of the current revision of the live rollout
+	CurrentPodHash string
+	// DesiredPodHash is the pod template hash of the revision of the manifest
+	DesiredPodHash string
+	Change         Change
+	// Revision is the revision the rollout is updated to
+	Revision int64
+	// StepsChanged is whether the steps change, which restarts the steps of the current revision
+	StepsChanged             bool
+	Steps                    []string
+	AnalysisTemplates        []string
+	AnalysisTemplatesChanged bool
+	TrafficRouters           []string
+	TrafficRoutersChanged    bool
+}
+
+// NewCmdDiff returns a new instance of a `rollouts diff` command
+func NewCmdDiff(o *options.ArgoRolloutsOptions) *cobra.Command {
+	diffOptions := DiffOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:   "diff",
+		Short: "Preview what a Rollout manifest change will do",
+		Long: "This command compares the Rollouts of a manifest with the live Rollouts. It reports whether the pod template " +
+			"changes and a new revision starts, or whether the change is a rollback, and which steps, analysis templates " +
+			"and traffic routers apply.",
+		Example:      o.Example(diffExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if diffOptions.File == "" || len(args) != 0 {
+				return o.UsageErr(c)
+			}
+			desiredRollouts, err := diffOptions.readRollouts(diffOptions.File)
+			if err != nil {
+				return err
+			}
+			if len(desiredRollouts) == 0 {
+				return fmt.Errorf("no Rollout found in %s", diffOptions.File)
+			}
+			for i, desired := range desiredRollouts {
+				diff, err := diffOptions.DiffRollout(desired)
+				if err != nil {
+					return err
+				}
+				if i > 0 {
+					fmt.Fprintln(o.Out)
+				}
+				printDiff(o.Out, diff)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&diffOptions.File, "filename", "f", "", "File containing the Rollout manifest, or - to read it from stdin")
+	return cmd
+}
+
+// readRollouts returns the Rollouts of the manifest
+func (o *DiffOptions) readRollouts(path string) ([]*v1alpha1.Rollout, error) {
+	var fileBytes []byte
+	var err error
+	if path == "-" {
+		fileBytes, err = io.ReadAll(o.In)
+	} else {
+		fileBytes, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var ros []*v1alpha1.Rollout
+	decoder := goyaml.NewDecoder(bytes.NewReader(fileBytes))
+	for {
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		if value == nil {
+			continue
+		}
+		valueBytes, err := goyaml.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(valueBytes, &typeMeta); err != nil {
+			return nil, err
+		}
+		if typeMeta.GroupVersionKind().Group != rollouts.Group || typeMeta.Kind != rollouts.RolloutKind {
+			continue
+		}
+		var ro v1alpha1.Rollout
+		if err := yaml.UnmarshalStrict(valueBytes, &ro); err != nil {
+			return nil, err
+		}
+		ros = append(ros, &ro)
+	}
+	return ros, nil
+}
+
+// DiffRollout compares the desired rollout with the live rollout and its ReplicaSets
+func (o *DiffOptions) DiffRollout(desired *v1alpha1.Rollout) (*RolloutDiff, error) {
+	ctx := context.TODO()
+	if desired.Spec.WorkloadRef != nil {
+		return nil, fmt.Errorf("rollout '%s' references a workload, which diff does not support", desired.Name)
+	}
+	namespace := desired.Namespace
+	if namespace == "" {
+		namespace = o.Namespace()
+	}
+	live, err := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		live = nil
+	}
+	var allRSs []*appsv1.ReplicaSet
+	if live != nil {
+		allRSs, err = o.getReplicaSets(ctx, live)
+		if err != nil {
+			return nil, err
+		}
+	}
+	diff := diffRollout(live, desired, allRSs)
+	diff.Namespace = namespace
+	return diff, nil
+}
+
+// getReplicaSets returns the ReplicaSets owned by the rollout
+func (o *DiffOptions) getReplicaSets(ctx context.Context, ro *v1alpha1.Rollout) ([]*appsv1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(ro.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	rsList, err := o.KubeClientset().AppsV1().ReplicaSets(ro.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	var allRSs []*appsv1.ReplicaSet
+	for i := range rsList.Items {
+		if metav1.IsControlledBy(&rsList.Items[i], ro) {
+			allRSs = append(allRSs, &rsList.Items[i])
+		}
+	}
+	return allRSs, nil
+}
+
+// diffRollout compares the desired rollout with the live rollout, using the ReplicaSet lookup and rollback logic
+// of the controller. The live rollout is nil when it does not exist yet.
+func diffRollout(live, desired *v1alpha1.Rollout, allRSs []*appsv1.ReplicaSet) *RolloutDiff {
+	diff := &RolloutDiff{
+		Name:              desired.Name,
+		Strategy:          strategy(desired),
+		Steps:             steps(desired),
+		AnalysisTemplates: analysisTemplates(desired),
+		TrafficRouters:    trafficRouters(desired),
+	}
+	if live == nil {
+		diff.DesiredPodHash = hash.ComputePodTemplateHash(&desired.Spec.Template, nil)
+		diff.Change = ChangeInitialDeploy
+		diff.Revision = 1
+		return diff
+	}
+
+	// the desired rollout has the status of the live rollout, as it would once the manifest is applied
+	ro := live.DeepCopy()
+	ro.Spec = *desired.Spec.DeepCopy()
+	newRS := replicasetutil.FindNewReplicaSet(ro, allRSs)
+	olderRSs := replicasetutil.FindOldReplicaSets(ro, allRSs, newRS)
+	stableRS := replicasetutil.GetStableRS(ro, newRS, olderRSs)
+
+	diff.CurrentPodHash = live.Status.CurrentPodHash
+	diff.DesiredPodHash = hash.ComputePodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+	if newRS != nil {
+		diff.DesiredPodHash = replicasetutil.GetPodTemplateHash(newRS)
+	}
+	diff.AnalysisTemplatesChanged = !reflect.DeepEqual(diff.AnalysisTemplates, analysisTemplates(live))
+	diff.TrafficRoutersChanged = !reflect.DeepEqual(diff.TrafficRouters, trafficRouters(live))
+	rollbackWithinWindow, _ := replicasetutil.IsRollbackWithinWindow(ro, newRS, stableRS, allRSs)
+
+	switch {
+	case stableRS == nil && diff.DesiredPodHash != diff.CurrentPodHash:
+		diff.Change = ChangeInitialDeploy
+		diff.Revision = replicasetutil.MaxRevision(allRSs) + 1
+	case diff.DesiredPodHash == diff.CurrentPodHash:
+		diff.Change = ChangeNone
+		diff.Revision = revision(newRS)
+		diff.StepsChanged = conditions.ComputeStepHash(ro) != live.Status.CurrentStepHash
+	case newRS == nil:
+		diff.Change = ChangeNewRevision
+		diff.Revision = replicasetutil.MaxRevision(allRSs) + 1
+	case replicasetutil.GetPodTemplateHash(newRS) == ro.Status.StableRS:
+		diff.Change = ChangeRollbackToStable
+		diff.Revision = revision(newRS)
+	case rollbackWithinWindow:
+		diff.Change = ChangeFastRollback
+		diff.Revision = revision(newRS)
+	case replicasetutil.IsRollback(ro, newRS, stableRS):
+		diff.Change = ChangeRollback
+		diff.Revision = revision(newRS)
+	default:
+		diff.Change = ChangeExistingRevision
+		diff.Revision = revision(newRS)
+	}
+	return diff
+}
+
+func revision(rs *appsv1.ReplicaSet) int64 {
+	if rs == nil {
+		return 0
+	}
+	v, err := replicasetutil.Revision(rs)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func strategy(ro *v1alpha1.Rollout) string {
+	switch {
+	case ro.Spec.Strategy.BlueGreen != nil:
+		return "BlueGreen"
+	case ro.Spec.Strategy.DaemonSet != nil:
+		return "DaemonSet"
+	}
+	return "Canary"
+}
+
+// steps returns the steps of the rollout as JSON
+func steps(ro *v1alpha1.Rollout) []string {
+	var values []any
+	switch {
+	case ro.Spec.Strategy.Canary != nil:
+		for _, step := range ro.Spec.Strategy.Canary.Steps {
+			values = append(values, step)
+		}
+	case ro.Spec.Strategy.DaemonSet != nil:
+		for _, step := range ro.Spec.Strategy.DaemonSet.Steps {
+			values = append(values, step)
+		}
+	}
+	var steps []string
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			data = []byte(fmt.Sprintf("%v", value))
+		}
+		steps = append(steps, string(data))
+	}
+	return steps
+}
+
+// analysisTemplates returns the analysis templates referenced by the rollout, along with how they are used
+func analysisTemplates(ro *v1alpha1.Rollout) []string {
+	var templates []string
+	add := func(analysis *v1alpha1.RolloutAnalysis, templateType validation.AnalysisTemplateType, step int) {
+		if analysis == nil {
+			return
+		}
+		for _, ref := range analysis.Templates {
+			templates = append(templates, formatTemplate(ref.TemplateName, ref.IsClusterScope(), templateType, step))
+		}
+	}
+	switch {
+	case ro.Spec.Strategy.BlueGreen != nil:
+		add(ro.Spec.Strategy.BlueGreen.PrePromotionAnalysis, validation.PrePromotionAnalysis, -1)
+		add(ro.Spec.Strategy.BlueGreen.PostPromotionAnalysis, validation.PostPromotionAnalysis, -1)
+	case ro.Spec.Strategy.Canary != nil:
+		canary := ro.Spec.Strategy.Canary
+		if canary.Analysis != nil {
+			add(&canary.Analysis.RolloutAnalysis, validation.BackgroundAnalysis, -1)
+		}
+		for i, step := range canary.Steps {
+			add(step.Analysis, validation.InlineAnalysis, i)
+			if step.Experiment != nil {
+				for _, ref := range step.Experiment.Analyses {
+					templates = append(templates, formatTemplate(ref.TemplateName, ref.IsClusterScope(), "ExperimentAnalysis", i))
+				}
+			}
+		}
+	case ro.Spec.Strategy.DaemonSet != nil:
+		daemonSet := ro.Spec.Strategy.DaemonSet
+		if daemonSet.Analysis != nil {
+			add(&daemonSet.Analysis.RolloutAnalysis, validation.BackgroundAnalysis, -1)
+		}
+		for i, step := range daemonSet.Steps {
+			add(step.Analysis, validation.InlineAnalysis, i)
+		}
+	}
+	return templates
+}
+
+func formatTemplate(name string, clusterScope bool, templateType validation.AnalysisTemplateType, step int) string {
+	kind := "AnalysisTemplate"
+	if clusterScope {
+		kind = "ClusterAnalysisTemplate"
+	}
+	if step >= 0 {
+		return fmt.Sprintf("%s/%s (%s, step %d)", kind, name, templateType, step)
+	}
+	return fmt.Sprintf("%s/%s (%s)", kind, name, templateType)
+}
+
+// trafficRouters returns the traffic routers of a canary rollout
+func trafficRouters(ro *v1alpha1.Rollout) []string {
+	if ro.Spec.Strategy.Canary == nil || ro.Spec.Strategy.Canary.TrafficRouting == nil {
+		return nil
+	}
+	trafficRouting := ro.Spec.Strategy.Canary.TrafficRouting
+	var routers []string
+	if trafficRouting.ALB != nil {
+		routers = append(routers, "ALB")
+	}
+	if trafficRouting.Ambassador != nil {
+		routers = append(routers, "Ambassador")
+	}
+	if trafficRouting.Apisix != nil {
+		routers = append(routers, "Apisix")
+	}
+	if trafficRouting.AppMesh != nil {
+		routers = append(routers, "AppMesh")
+	}
+	if trafficRouting.GatewayAPI != nil {
+		routers = append(routers, "GatewayAPI")
+	}
+	if trafficRouting.Istio != nil {
+		routers = append(routers, "Istio")
+	}
+	if trafficRouting.Nginx != nil {
+		routers = append(routers, "Nginx")
+	}
+	if trafficRouting.SMI != nil {
+		routers = append(routers, "SMI")
+	}
+	if trafficRouting.Traefik != nil {
+		routers = append(routers, "Traefik")
+	}
+	var plugins []string
+	for name := range trafficRouting.Plugins {
+		plugins = append(plugins, "Plugin "+name)
+	}
+	sort.Strings(plugins)
+	return append(routers, plugins...)
+}
+
+// Message describes the change for the reader of the diff
+func (d *RolloutDiff) Message() string {
+	switch d.Change {
+	case ChangeInitialDeploy:
+		return fmt.Sprintf("Initial deploy of revision %d: promoted without progressing through the steps", d.Revision)
+	case ChangeNone:
+		if d.StepsChanged {
+			return fmt.Sprintf("Pod template unchanged: revision %d restarts from the first step", d.Revision)
+		}
+		return fmt.Sprintf("Pod template unchanged: revision %d continues", d.Revision)
+	case ChangeNewRevision:
+		return fmt.Sprintf("New revision %d: progressive rollout", d.Revision)
+	case ChangeExistingRevision:
+		return fmt.Sprintf("Update to revision %d: progressive rollout", d.Revision)
+	case ChangeRollbackToStable:
+		return fmt.Sprintf("Rollback to stable revision %d: promoted without progressing through the steps", d.Revision)
+	case ChangeFastRollback:
+		return fmt.Sprintf("Rollback to revision %d within the rollback window: promoted without progressing through the steps", d.Revision)
+	case ChangeRollback:
+		return fmt.Sprintf("Rollback to revision %d outside the rollback window: progressive rollout", d.Revision)
+	}
+	return string(d.Change)
+}
+
+func printDiff(w io.Writer, d *RolloutDiff) {
+	changed := func(label string, changed bool) string {
+		if changed {
+			return label + " (changed)"
+		}
+		return label
+	}
+	fmt.Fprintf(w, tableFormat, "Name:", d.Name)
+	fmt.Fprintf(w, tableFormat, "Namespace:", d.Namespace)
+	fmt.Fprintf(w, tableFormat, "Strategy:", d.Strategy)
+	podHash := d.DesiredPodHash
+	if d.CurrentPodHash != "" && d.CurrentPodHash != d.DesiredPodHash {
+		podHash = fmt.Sprintf("%s -> %s", d.CurrentPodHash, d.DesiredPodHash)
+	}
+	fmt.Fprintf(w, tableFormat, "Pod Template Hash:", podHash)
+	fmt.Fprintf(w, tableFormat, "Result:", d.Message())
+	if len(d.Steps) > 0 {
+		fmt.Fprintln(w, changed("Steps:", d.StepsChanged))
+		for i, step := range d.Steps {
+			fmt.Fprintf(w, "  %d: %s\n", i, step)
+		}
+	}
+	if len(d.AnalysisTemplates) > 0 || d.AnalysisTemplatesChanged {
+		fmt.Fprintln(w, changed("Analysis Templates:", d.AnalysisTemplatesChanged))
+		for _, template := range d.AnalysisTemplates {
+			fmt.Fprintf(w, "  %s\n", template)
+		}
+	}
+	if len(d.TrafficRouters) > 0 || d.TrafficRoutersChanged {
+		fmt.Fprintln(w, changed("Traffic Routers:", d.TrafficRoutersChanged))
+		for _, router := range d.TrafficRouters {
+			fmt.Fprintf(w, "  %s\n", router)
+		}
+	}
+}
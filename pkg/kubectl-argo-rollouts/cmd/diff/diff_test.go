@@ -0,0 +1,232 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+func newTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "guestbook"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "guestbook", Image: image}},
+		},
+	}
+}
+
+func newRollout(image string) *v1alpha1.Rollout {
+	ro := &v1alpha1.Rollout{
+		TypeMeta: metav1.TypeMeta{Kind: "Rollout", APIVersion: "argoproj.io/v1alpha1"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "test",
+			UID:       types.UID("guestbook-uid"),
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Template: newTemplate(image),
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: ptr.To[int32](20)},
+						{Pause: &v1alpha1.RolloutPause{}},
+					},
+				},
+			},
+		},
+	}
+	return ro
+}
+
+func newReplicaSet(ro *v1alpha1.Rollout, image string, revision int, age time.Duration) *appsv1.ReplicaSet {
+	template := newTemplate(image)
+	podHash := hash.ComputePodTemplateHash(&template, nil)
+	template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = podHash
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              ro.Name + "-" + podHash,
+			UID:               types.UID(podHash),
+			Namespace:         ro.Namespace,
+			Labels:            map[string]string{"app": "guestbook", v1alpha1.DefaultRolloutUniqueLabelKey: podHash},
+			Annotations:       map[string]string{annotations.RevisionAnnotation: strconv.Itoa(revision)},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age).Truncate(time.Second)),
+			OwnerReferences:   []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}},
+			Template: template,
+		},
+	}
+}
+
+// newLiveObjects returns a promoted rollout running v3, with the ReplicaSets of the revisions v1, v2 and v3
+func newLiveObjects() []runtime.Object {
+	live := newRollout("guestbook:v3")
+	v1 := newReplicaSet(live, "guestbook:v1", 1, 3*time.Hour)
+	v2 := newReplicaSet(live, "guestbook:v2", 2, 2*time.Hour)
+	v3 := newReplicaSet(live, "guestbook:v3", 3, time.Hour)
+	podHash := v3.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	live.Status.StableRS = podHash
+	live.Status.CurrentPodHash = podHash
+	live.Status.CurrentStepHash = conditions.ComputeStepHash(live)
+	return []runtime.Object{live, v1, v2, v3}
+}
+
+func writeManifest(t *testing.T, ro *v1alpha1.Rollout) string {
+	data, err := yaml.Marshal(ro)
+	assert.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "rollout.yaml")
+	assert.NoError(t, os.WriteFile(path, data, 0644))
+	return path
+}
+
+func runDiff(t *testing.T, objs []runtime.Object, desired *v1alpha1.Rollout) (string, string, error) {
+	tf, o := options.NewFakeArgoRolloutsOptions(objs...)
+	o.RESTClientGetter = tf.WithNamespace("test")
+	defer tf.Cleanup()
+	cmd := NewCmdDiff(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", writeManifest(t, desired)})
+	err := cmd.Execute()
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	return stdout, stderr, err
+}
+
+func TestDiffNewRevision(t *testing.T) {
+	stdout, _, err := runDiff(t, newLiveObjects(), newRollout("guestbook:v4"))
+	assert.NoError(t, err)
+	desired := newTemplate("guestbook:v4")
+	live := newTemplate("guestbook:v3")
+	assert.Contains(t, stdout, "Pod Template Hash:  "+hash.ComputePodTemplateHash(&live, nil)+" -> "+hash.ComputePodTemplateHash(&desired, nil))
+	assert.Contains(t, stdout, "Result:             New revision 4: progressive rollout")
+	assert.Contains(t, stdout, "Steps:\n  0: {\"setWeight\":20}\n  1: {\"pause\":{}}\n")
+}
+
+func TestDiffUnchanged(t *testing.T) {
+	stdout, _, err := runDiff(t, newLiveObjects(), newRollout("guestbook:v3"))
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Result:             Pod template unchanged: revision 3 continues")
+	assert.NotContains(t, stdout, "(changed)")
+}
+
+func TestDiffStepsChanged(t *testing.T) {
+	desired := newRollout("guestbook:v3")
+	desired.Spec.Strategy.Canary.Steps[0].SetWeight = ptr.To[int32](50)
+	stdout, _, err := runDiff(t, newLiveObjects(), desired)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Pod template unchanged: revision 3 restarts from the first step")
+	assert.Contains(t, stdout, "Steps: (changed)\n  0: {\"setWeight\":50}\n")
+}
+
+func TestDiffRollbackToStable(t *testing.T) {
+	objs := newLiveObjects()
+	live := objs[0].(*v1alpha1.Rollout)
+	// v4 is progressing, and v3 is stable
+	v4 := newReplicaSet(live, "guestbook:v4", 4, time.Minute)
+	live.Spec.Template = newTemplate("guestbook:v4")
+	live.Status.CurrentPodHash = v4.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	objs = append(objs, v4)
+
+	stdout, _, err := runDiff(t, objs, newRollout("guestbook:v3"))
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Rollback to stable revision 3: promoted without progressing through the steps")
+}
+
+func TestDiffRollback(t *testing.T) {
+	stdout, _, err := runDiff(t, newLiveObjects(), newRollout("guestbook:v1"))
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Rollback to revision 1 outside the rollback window: progressive rollout")
+
+	desired := newRollout("guestbook:v1")
+	desired.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 2}
+	stdout, _, err = runDiff(t, newLiveObjects(), desired)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Rollback to revision 1 within the rollback window: promoted without progressing through the steps")
+
+	desired.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+	stdout, _, err = runDiff(t, newLiveObjects(), desired)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Rollback to revision 1 outside the rollback window")
+}
+
+func TestDiffInitialDeploy(t *testing.T) {
+	stdout, _, err := runDiff(t, nil, newRollout("guestbook:v1"))
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Namespace:          test\n")
+	assert.Contains(t, stdout, "Result:             Initial deploy of revision 1: promoted without progressing through the steps")
+}
+
+func TestDiffAnalysisAndTrafficRouters(t *testing.T) {
+	desired := newRollout("guestbook:v3")
+	desired.Spec.Strategy.Canary.Analysis = &v1alpha1.RolloutAnalysisBackground{
+		RolloutAnalysis: v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate", ClusterScope: ptr.To(true)}}},
+	}
+	desired.Spec.Strategy.Canary.Steps = append(desired.Spec.Strategy.Canary.Steps, v1alpha1.CanaryStep{
+		Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke"}}},
+	})
+	desired.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx:   &v1alpha1.NginxTrafficRouting{StableIngress: "guestbook"},
+		Plugins: map[string]json.RawMessage{"argoproj-labs/gatewayAPI": json.RawMessage(`{}`)},
+	}
+	stdout, _, err := runDiff(t, newLiveObjects(), desired)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Analysis Templates: (changed)\n  ClusterAnalysisTemplate/success-rate (BackgroundAnalysis)\n  AnalysisTemplate/smoke (InlineAnalysis, step 2)\n")
+	assert.Contains(t, stdout, "Traffic Routers: (changed)\n  Nginx\n  Plugin argoproj-labs/gatewayAPI\n")
+}
+
+func TestDiffWorkloadRef(t *testing.T) {
+	desired := newRollout("")
+	desired.Spec.Template = corev1.PodTemplateSpec{}
+	desired.Spec.WorkloadRef = &v1alpha1.ObjectRef{Kind: "Deployment", Name: "guestbook", APIVersion: "apps/v1"}
+	_, _, err := runDiff(t, nil, desired)
+	assert.EqualError(t, err, "rollout 'guestbook' references a workload, which diff does not support")
+}
+
+func TestDiffNoRollout(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	o.RESTClientGetter = tf.WithNamespace("test")
+	defer tf.Cleanup()
+	path := filepath.Join(t.TempDir(), "service.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("apiVersion: v1\nkind: Service\nmetadata:\n  name: guestbook\n"), 0644))
+	cmd := NewCmdDiff(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", path})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "no Rollout found in "+path)
+}
+
+func TestDiffStdin(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newLiveObjects()...)
+	o.RESTClientGetter = tf.WithNamespace("test")
+	defer tf.Cleanup()
+	data, err := yaml.Marshal(newRollout("guestbook:v4"))
+	assert.NoError(t, err)
+	o.In = bytes.NewReader(append([]byte("---\n"), data...))
+	cmd := NewCmdDiff(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "-"})
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, o.Out.(*bytes.Buffer).String(), "New revision 4: progressive rollout")
+}
@@ -77,7 +77,10 @@ const (
 	%[1]s get rollout guestbook -w
   
 	# Get an experiment
-	%[1]s get experiment my-experiment`
+	%[1]s get experiment my-experiment
+
+	# Get a rollout as JSON
+	%[1]s get rollout guestbook -o json`
 
 	getUsage = `This command consists of multiple subcommands which can be used to get extended information about a rollout or experiment.`
 
@@ -100,6 +103,7 @@ type GetOptions struct {
 	Watch          bool
 	NoColor        bool
 	TimeoutSeconds int
+	Output         string
 
 	options.ArgoRolloutsOptions
 }
@@ -126,7 +130,7 @@ const (
 )
 
 func (o *GetOptions) PrintHeader(w io.Writer) {
-	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", "NAME", "KIND", "STATUS", "AGE", "INFO")
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s%s\n", "NAME", "KIND", "STATUS", "AGE", "INFO", o.wideColumn("DETAILS"))
 }
 
 // Clear clears the terminal for updates for live watching of objects
@@ -23,7 +23,10 @@ const (
 	%[1]s get experiment my-experiment
 	
 	# Watch experiment progress
-	%[1]s get experiment my-experiment -w`
+	%[1]s get experiment my-experiment -w
+
+	# Get an experiment as JSON
+	%[1]s get experiment my-experiment -o json`
 )
 
 // NewCmdGetExperiment returns a new instance of an `rollouts get experiment` command
@@ -43,6 +46,9 @@ func NewCmdGetExperiment(o *options.ArgoRolloutsOptions) *cobra.Command {
 			if len(args) != 1 {
 				return o.UsageErr(c)
 			}
+			if err := getOptions.validateOutput(); err != nil {
+				return err
+			}
 			name := args[0]
 			controller := viewcontroller.NewExperimentViewController(o.Namespace(), name, getOptions.KubeClientset(), getOptions.RolloutsClientset())
 			ctx := context.Background()
@@ -52,6 +58,9 @@ func NewCmdGetExperiment(o *options.ArgoRolloutsOptions) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if getOptions.structuredOutput() {
+				return getOptions.PrintObject(expInfo)
+			}
 			if !getOptions.Watch {
 				getOptions.PrintExperiment(expInfo)
 			} else {
@@ -69,6 +78,7 @@ func NewCmdGetExperiment(o *options.ArgoRolloutsOptions) *cobra.Command {
 	}
 	cmd.Flags().BoolVarP(&getOptions.Watch, "watch", "w", false, "Watch live updates to the rollout")
 	cmd.Flags().BoolVar(&getOptions.NoColor, "no-color", false, "Do not colorize output")
+	cmd.Flags().StringVarP(&getOptions.Output, "output", "o", "", outputUsage)
 	return cmd
 }
 
@@ -126,7 +136,7 @@ func (o *GetOptions) PrintExperimentInfo(w io.Writer, expInfo rollout.Experiment
 	infoCols := []string{}
 	total := len(expInfo.ReplicaSets) + len(expInfo.AnalysisRuns)
 	curr := 0
-	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", prefix, IconExperiment, name, "Experiment", o.colorize(expInfo.Icon), expInfo.Status, info.Age(*expInfo.ObjectMeta), strings.Join(infoCols, ","))
+	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v%s\n", prefix, IconExperiment, name, "Experiment", o.colorize(expInfo.Icon), expInfo.Status, info.Age(*expInfo.ObjectMeta), strings.Join(infoCols, ","), o.wideColumn(""))
 
 	for _, rsInfo := range expInfo.ReplicaSets {
 		childPrefix, childSubpfx := getPrefixes(curr == total-1, subpfx)
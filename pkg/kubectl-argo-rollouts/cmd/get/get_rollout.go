@@ -28,7 +28,13 @@ const (
   	%[1]s get rollout guestbook -w
 
 	# Watch the rollout, fail if it takes more than 60 seconds
-	%[1]s get rollout guestbook -w --timeout-seconds 60`
+	%[1]s get rollout guestbook -w --timeout-seconds 60
+
+	# Get a rollout as YAML
+	%[1]s get rollout guestbook -o yaml
+
+	# Get the step and weights of a rollout
+	%[1]s get rollout guestbook -o custom-columns=NAME:.objectMeta.name,STEP:.step,SETWEIGHT:.setWeight,ACTUALWEIGHT:.actualWeight`
 )
 
 // NewCmdGetRollout returns a new instance of an `rollouts get rollout` command
@@ -48,6 +54,9 @@ func NewCmdGetRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
 			if len(args) != 1 {
 				return o.UsageErr(c)
 			}
+			if err := getOptions.validateOutput(); err != nil {
+				return err
+			}
 			name := args[0]
 			controller := viewcontroller.NewRolloutViewController(o.Namespace(), name, getOptions.KubeClientset(), getOptions.RolloutsClientset())
 			ctx, cancel := context.WithCancel(context.Background())
@@ -59,6 +68,9 @@ func NewCmdGetRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if getOptions.structuredOutput() {
+				return getOptions.PrintObject(ri)
+			}
 			if !getOptions.Watch {
 				getOptions.PrintRollout(ri)
 			} else {
@@ -91,6 +103,7 @@ func NewCmdGetRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
 	cmd.Flags().BoolVarP(&getOptions.Watch, "watch", "w", false, "Watch live updates to the rollout")
 	cmd.Flags().BoolVar(&getOptions.NoColor, "no-color", false, "Do not colorize output")
 	cmd.Flags().IntVar(&getOptions.TimeoutSeconds, "timeout-seconds", 0, "Timeout after specified seconds")
+	cmd.Flags().StringVarP(&getOptions.Output, "output", "o", "", outputUsage)
 	return cmd
 }
 
@@ -171,7 +184,7 @@ func (o *GetOptions) PrintRollout(roInfo *rollout.RolloutInfo) {
 func (o *GetOptions) PrintRolloutTree(roInfo *rollout.RolloutInfo) {
 	w := ansiterm.NewTabWriter(o.Out, 0, 0, 2, ' ', 0)
 	o.PrintHeader(w)
-	fmt.Fprintf(w, "%s %s\t%s\t%s %s\t%s\t%v\n", IconRollout, roInfo.ObjectMeta.Name, "Rollout", o.colorize(roInfo.Icon), roInfo.Status, info.Age(*roInfo.ObjectMeta), "")
+	fmt.Fprintf(w, "%s %s\t%s\t%s %s\t%s\t%v%s\n", IconRollout, roInfo.ObjectMeta.Name, "Rollout", o.colorize(roInfo.Icon), roInfo.Status, info.Age(*roInfo.ObjectMeta), "", o.wideColumn(rolloutDetails(roInfo)))
 	revisions := info.Revisions(roInfo)
 	for i, rev := range revisions {
 		isLast := i == len(revisions)-1
@@ -183,7 +196,7 @@ func (o *GetOptions) PrintRolloutTree(roInfo *rollout.RolloutInfo) {
 
 func (o *GetOptions) PrintRevision(w io.Writer, roInfo *rollout.RolloutInfo, revision int, prefix string, subpfx string) {
 	name := fmt.Sprintf("revision:%d", revision)
-	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", prefix, IconRevision, name, "", "", "", "", "")
+	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v%s\n", prefix, IconRevision, name, "", "", "", "", "", o.wideColumn(""))
 	replicaSets := info.ReplicaSetsByRevision(roInfo, revision)
 	experiments := info.ExperimentsByRevision(roInfo, revision)
 	analysisRuns := info.AnalysisRunsByRevision(roInfo, revision)
@@ -236,7 +249,7 @@ func (o *GetOptions) PrintReplicaSetInfo(w io.Writer, rsInfo rollout.ReplicaSetI
 		infoCols = append(infoCols, fmt.Sprintf("delay:%s", info.ScaleDownDelay(rsInfo)))
 	}
 
-	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", prefix, IconReplicaSet, name, "ReplicaSet", o.colorize(rsInfo.Icon), rsInfo.Status, info.Age(*rsInfo.ObjectMeta), strings.Join(infoCols, ","))
+	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v%s\n", prefix, IconReplicaSet, name, "ReplicaSet", o.colorize(rsInfo.Icon), rsInfo.Status, info.Age(*rsInfo.ObjectMeta), strings.Join(infoCols, ","), o.wideColumn(replicaSetDetails(rsInfo)))
 	for i, podInfo := range rsInfo.Pods {
 		isLast := i == len(rsInfo.Pods)-1
 		podPrefix, _ := getPrefixes(isLast, subpfx)
@@ -244,7 +257,7 @@ func (o *GetOptions) PrintReplicaSetInfo(w io.Writer, rsInfo rollout.ReplicaSetI
 		if podInfo.Restarts > 0 {
 			podInfoCol = append(podInfoCol, fmt.Sprintf("restarts:%d", podInfo.Restarts))
 		}
-		fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", podPrefix, IconPod, podInfo.ObjectMeta.Name, "Pod", o.colorize(podInfo.Icon), podInfo.Status, info.Age(*podInfo.ObjectMeta), strings.Join(podInfoCol, ","))
+		fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v%s\n", podPrefix, IconPod, podInfo.ObjectMeta.Name, "Pod", o.colorize(podInfo.Icon), podInfo.Status, info.Age(*podInfo.ObjectMeta), strings.Join(podInfoCol, ","), o.wideColumn(""))
 	}
 }
 
@@ -263,7 +276,7 @@ func (o *GetOptions) PrintAnalysisRunInfo(w io.Writer, arInfo rollout.AnalysisRu
 	if arInfo.Error > 0 {
 		infoCols = append(infoCols, fmt.Sprintf("%s %d", o.colorize(info.IconWarning), arInfo.Error))
 	}
-	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", prefix, IconAnalysis, name, "AnalysisRun", o.colorize(arInfo.Icon), arInfo.Status, info.Age(*arInfo.ObjectMeta), strings.Join(infoCols, ","))
+	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v%s\n", prefix, IconAnalysis, name, "AnalysisRun", o.colorize(arInfo.Icon), arInfo.Status, info.Age(*arInfo.ObjectMeta), strings.Join(infoCols, ","), o.wideColumn(analysisRunDetails(arInfo)))
 	for i, jobInfo := range arInfo.Jobs {
 		isLast := i == len(arInfo.Jobs)-1
 		jobPrefix, jobChildPrefix := getPrefixes(isLast, subpfx)
@@ -273,5 +286,39 @@ func (o *GetOptions) PrintAnalysisRunInfo(w io.Writer, arInfo rollout.AnalysisRu
 
 func (o *GetOptions) PrintJob(w io.Writer, jobInfo rollout.JobInfo, prefix string, subpfx string) {
 	name := o.colorizeStatus(jobInfo.ObjectMeta.Name, jobInfo.Status)
-	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v\n", prefix, IconJob, name, "Job", o.colorize(jobInfo.Icon), jobInfo.Status, info.Age(*jobInfo.ObjectMeta), "")
+	details := ""
+	if jobInfo.MetricName != "" {
+		details = fmt.Sprintf("metric:%s", jobInfo.MetricName)
+	}
+	fmt.Fprintf(w, "%s%s %s\t%s\t%s %s\t%s\t%v%s\n", prefix, IconJob, name, "Job", o.colorize(jobInfo.Icon), jobInfo.Status, info.Age(*jobInfo.ObjectMeta), "", o.wideColumn(details))
+}
+
+// rolloutDetails returns the details of the wide output of a rollout, which are the step and weights of a canary
+func rolloutDetails(roInfo *rollout.RolloutInfo) string {
+	if roInfo.Strategy != "Canary" {
+		return ""
+	}
+	return fmt.Sprintf("step:%s,setWeight:%s,actualWeight:%s", roInfo.Step, roInfo.SetWeight, roInfo.ActualWeight)
+}
+
+// replicaSetDetails returns the details of the wide output of a ReplicaSet, which are its available replicas and
+// images
+func replicaSetDetails(rsInfo rollout.ReplicaSetInfo) string {
+	details := []string{fmt.Sprintf("available:%d/%d", rsInfo.Available, rsInfo.Replicas)}
+	for _, image := range rsInfo.Images {
+		details = append(details, fmt.Sprintf("image:%s", image))
+	}
+	return strings.Join(details, ",")
+}
+
+// analysisRunDetails returns the details of the wide output of an AnalysisRun, which are the phases of its metrics
+func analysisRunDetails(arInfo rollout.AnalysisRunInfo) string {
+	if arInfo.SpecAndStatus == nil || arInfo.SpecAndStatus.Status == nil {
+		return ""
+	}
+	var details []string
+	for _, result := range arInfo.SpecAndStatus.Status.MetricResults {
+		details = append(details, fmt.Sprintf("%s:%s", result.Name, result.Phase))
+	}
+	return strings.Join(details, ",")
 }
@@ -2,6 +2,7 @@ package get
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -9,7 +10,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
 
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info/testdata"
 	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
@@ -561,3 +564,169 @@ NAME                                                     KIND         STATUS
 `, "\n")
 	assertStdout(t, expectedOut, o.IOStreams)
 }
+
+func TestGetRolloutWide(t *testing.T) {
+	rolloutObjs := testdata.NewExperimentAnalysisRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color", "-o", "wide"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+Name:            rollout-experiment-analysis
+Namespace:       jesse-test
+Status:          ✖ Degraded
+Message:         ProgressDeadlineExceeded: ReplicaSet "rollout-experiment-analysis-6f646bf7b7" has timed out progressing.
+Strategy:        Canary
+  Step:          1/2
+  SetWeight:     25
+  ActualWeight:  25
+Images:          argoproj/rollouts-demo:blue (stable)
+                 argoproj/rollouts-demo:yellow (canary)
+Replicas:
+  Desired:       4
+  Current:       4
+  Updated:       1
+  Ready:         4
+  Available:     4
+
+NAME                                                                           KIND         STATUS          AGE  INFO             DETAILS
+⟳ rollout-experiment-analysis                                                  Rollout      ✖ Degraded      7d                    step:1/2,setWeight:25,actualWeight:25
+├──# revision:2
+│  ├──⧉ rollout-experiment-analysis-6f646bf7b7                                 ReplicaSet   ✔ Healthy       7d   canary           available:1/1,image:argoproj/rollouts-demo:yellow
+│  │  └──□ rollout-experiment-analysis-6f646bf7b7-wn5w8                        Pod          ✔ Running       7d   ready:1/1
+│  ├──Σ rollout-experiment-analysis-6f646bf7b7-1-vcv27                         Experiment   ◌ Running       7d
+│  │  ├──⧉ rollout-experiment-analysis-6f646bf7b7-1-vcv27-baseline-7d768b8b5f  ReplicaSet   ✔ Healthy       7d                    available:1/1,image:argoproj/rollouts-demo:blue
+│  │  │  └──□ rollout-experiment-analysis-6f646bf7b7-1-vcv27-baseline-7dczdst  Pod          ✔ Running       7d   ready:1/1
+│  │  └──⧉ rollout-experiment-analysis-6f646bf7b7-1-vcv27-canary-7699dcf5d     ReplicaSet   ✔ Healthy       7d                    available:1/1,image:argoproj/rollouts-demo:yellow
+│  │     └──□ rollout-experiment-analysis-6f646bf7b7-1-vcv27-canary-7699vgr24  Pod          ✔ Running       7d   ready:1/1
+│  └──α rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr               AnalysisRun  ? Inconclusive  7d   ✔ 4,✖ 4,? 1,⚠ 1  random-fail:Inconclusive
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rzl6lt     Job          ✖ Failed        7d                    metric:random-fail
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-r8lqpd     Job          ✔ Successful    7d                    metric:random-fail
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rjjsgg     Job          ✔ Successful    7d                    metric:random-fail
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rrnfj5     Job          ✖ Failed        7d                    metric:random-fail
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rx5kqk     Job          ✖ Failed        7d                    metric:random-fail
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rp894b     Job          ✔ Successful    7d                    metric:random-fail
+│     ├──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rmngtj     Job          ✖ Failed        7d                    metric:random-fail
+│     └──⊞ rollout-experiment-analysis-random-fail-6f646bf7b7-skqcr-rsxm69     Job          ✔ Successful    7d                    metric:random-fail
+└──# revision:1
+   └──⧉ rollout-experiment-analysis-f6db98dff                                  ReplicaSet   ✔ Healthy       7d   stable           available:3/3,image:argoproj/rollouts-demo:blue
+      ├──□ rollout-experiment-analysis-f6db98dff-8dmnz                         Pod          ✔ Running       7d   ready:1/1
+      ├──□ rollout-experiment-analysis-f6db98dff-bb6v6                         Pod          ✔ Running       7d   ready:1/1
+      └──□ rollout-experiment-analysis-f6db98dff-bq55x                         Pod          ✔ Running       7d   ready:1/1
+`, "\n")
+	assertStdout(t, expectedOut, o.IOStreams)
+}
+
+func TestGetRolloutJSON(t *testing.T) {
+	rolloutObjs := testdata.NewExperimentAnalysisRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "-o", "json"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Empty(t, o.ErrOut.(*bytes.Buffer).String())
+
+	var roInfo rollout.RolloutInfo
+	assert.NoError(t, json.Unmarshal(o.Out.(*bytes.Buffer).Bytes(), &roInfo))
+	assert.Equal(t, "rollout-experiment-analysis", roInfo.ObjectMeta.Name)
+	assert.Equal(t, "1/2", roInfo.Step)
+	assert.Equal(t, "25", roInfo.SetWeight)
+	assert.Equal(t, "25", roInfo.ActualWeight)
+	assert.Len(t, roInfo.ReplicaSets, 2)
+	assert.Len(t, roInfo.ReplicaSets[0].Pods, 1)
+	assert.Len(t, roInfo.Experiments, 1)
+	assert.Len(t, roInfo.AnalysisRuns, 1)
+	assert.Equal(t, "Inconclusive", roInfo.AnalysisRuns[0].Status)
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, roInfo.AnalysisRuns[0].SpecAndStatus.Status.MetricResults[0].Phase)
+}
+
+func TestGetRolloutYAML(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "-o", "yaml"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	assert.Empty(t, o.ErrOut.(*bytes.Buffer).String())
+
+	var roInfo rollout.RolloutInfo
+	assert.NoError(t, yaml.Unmarshal(o.Out.(*bytes.Buffer).Bytes(), &roInfo))
+	assert.Equal(t, "canary-demo", roInfo.ObjectMeta.Name)
+	assert.Equal(t, "Degraded", roInfo.Status)
+	assert.Equal(t, "0/8", roInfo.Step)
+	assert.Equal(t, "20", roInfo.SetWeight)
+	assert.Len(t, roInfo.ReplicaSets, 3)
+	assert.Len(t, roInfo.ReplicaSets[1].Pods, 5)
+}
+
+func TestGetRolloutCustomColumns(t *testing.T) {
+	rolloutObjs := testdata.NewExperimentAnalysisRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "-o", "custom-columns=NAME:.objectMeta.name,STEP:.step,WEIGHT:{.actualWeight},REPLICASETS:.replicaSets[*].objectMeta.name,ANALYSIS:.analysisRuns[*].status,PAUSED:.paused"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+NAME                         STEP  WEIGHT  REPLICASETS                                                                   ANALYSIS      PAUSED
+rollout-experiment-analysis  1/2   25      rollout-experiment-analysis-6f646bf7b7,rollout-experiment-analysis-f6db98dff  Inconclusive  <none>
+`, "\n")
+	assertStdout(t, expectedOut, o.IOStreams)
+}
+
+func TestGetExperimentJSON(t *testing.T) {
+	rolloutObjs := testdata.NewExperimentAnalysisRollout()
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetExperiment(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Experiments[0].Name, "-o", "json"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	var expInfo rollout.ExperimentInfo
+	assert.NoError(t, json.Unmarshal(o.Out.(*bytes.Buffer).Bytes(), &expInfo))
+	assert.Equal(t, "rollout-experiment-analysis-6f646bf7b7-1-vcv27", expInfo.ObjectMeta.Name)
+	assert.Equal(t, "Running", expInfo.Status)
+	assert.Len(t, expInfo.ReplicaSets, 2)
+}
+
+func TestGetRolloutInvalidOutput(t *testing.T) {
+	for _, test := range []struct {
+		args          []string
+		expectedError string
+	}{
+		{[]string{"-o", "xml"}, "unknown output format 'xml'. Output format. One of: json|yaml|wide|custom-columns=HEADER:JSONPATH,..."},
+		{[]string{"-o", "json", "-w"}, "--watch is not supported with the 'json' output format"},
+		{[]string{"-o", "custom-columns="}, "custom-columns format specified but no custom columns given"},
+		{[]string{"-o", "custom-columns=NAME"}, "unexpected custom-columns spec: NAME, expected <header>:<json-path-expr>"},
+	} {
+		tf, o := options.NewFakeArgoRolloutsOptions()
+		cmd := NewCmdGetRollout(o)
+		cmd.PersistentPreRunE = o.PersistentPreRunE
+		cmd.SetArgs(append([]string{"guestbook"}, test.args...))
+		err := cmd.Execute()
+		assert.EqualError(t, err, test.expectedError)
+		tf.Cleanup()
+	}
+}
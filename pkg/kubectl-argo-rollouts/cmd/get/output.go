@@ -0,0 +1,172 @@
+package get
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/juju/ansiterm"
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// OutputJSON prints the object as JSON
+	OutputJSON = "json"
+	// OutputYAML prints the object as YAML
+	OutputYAML = "yaml"
+	// OutputWide prints the tree view with additional details
+	OutputWide = "wide"
+	// OutputCustomColumnsPrefix prefixes the spec of the custom columns, e.g. custom-columns=NAME:.objectMeta.name
+	OutputCustomColumnsPrefix = "custom-columns="
+
+	outputUsage = "Output format. One of: json|yaml|wide|custom-columns=HEADER:JSONPATH,..."
+	// noneValue is printed for the custom columns without a value
+	noneValue = "<none>"
+)
+
+// validateOutput returns an error when the output format is not supported
+func (o *GetOptions) validateOutput() error {
+	switch {
+	case o.Output == "", o.Output == OutputWide:
+		return nil
+	case o.Output == OutputJSON, o.Output == OutputYAML:
+	case strings.HasPrefix(o.Output, OutputCustomColumnsPrefix):
+		if _, err := parseCustomColumns(strings.TrimPrefix(o.Output, OutputCustomColumnsPrefix)); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown output format '%s'. %s", o.Output, outputUsage)
+	}
+	if o.Watch {
+		return fmt.Errorf("--watch is not supported with the '%s' output format", o.Output)
+	}
+	return nil
+}
+
+// structuredOutput returns whether the object is printed in a structured output format instead of the tree view
+func (o *GetOptions) structuredOutput() bool {
+	return o.Output == OutputJSON || o.Output == OutputYAML || strings.HasPrefix(o.Output, OutputCustomColumnsPrefix)
+}
+
+// wide returns whether the tree view is printed with additional details
+func (o *GetOptions) wide() bool {
+	return o.Output == OutputWide
+}
+
+// wideColumn returns the details column of a row of the tree view, which is only printed in the wide output format
+func (o *GetOptions) wideColumn(details string) string {
+	if !o.wide() {
+		return ""
+	}
+	return "\t" + details
+}
+
+// PrintObject prints the info model of a rollout or experiment in the structured output format
+func (o *GetOptions) PrintObject(obj any) error {
+	switch o.Output {
+	case OutputJSON:
+		data, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(o.Out, string(data))
+		return err
+	case OutputYAML:
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		_, err = o.Out.Write(data)
+		return err
+	}
+	columns, err := parseCustomColumns(strings.TrimPrefix(o.Output, OutputCustomColumnsPrefix))
+	if err != nil {
+		return err
+	}
+	return printCustomColumns(o.Out, columns, obj)
+}
+
+type customColumn struct {
+	header string
+	parser *jsonpath.JSONPath
+}
+
+// parseCustomColumns parses the spec of the custom columns, a comma separated list of HEADER:JSONPATH, where the
+// JSONPath may omit the surrounding braces
+func parseCustomColumns(spec string) ([]customColumn, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	var columns []customColumn
+	for _, part := range strings.Split(spec, ",") {
+		header, path, ok := strings.Cut(part, ":")
+		if !ok || header == "" || path == "" {
+			return nil, fmt.Errorf("unexpected custom-columns spec: %s, expected <header>:<json-path-expr>", part)
+		}
+		if !strings.HasPrefix(path, "{") {
+			path = "{" + path + "}"
+		}
+		parser := jsonpath.New(header).AllowMissingKeys(true)
+		if err := parser.Parse(path); err != nil {
+			return nil, fmt.Errorf("invalid custom-columns JSONPath '%s': %w", path, err)
+		}
+		columns = append(columns, customColumn{header: header, parser: parser})
+	}
+	return columns, nil
+}
+
+// printCustomColumns prints a table of the custom columns of the object. The values found by the JSONPath of a
+// column are comma separated.
+func printCustomColumns(out io.Writer, columns []customColumn, obj any) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var object any
+	if err := decoder.Decode(&object); err != nil {
+		return err
+	}
+
+	headers := make([]string, len(columns))
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		headers[i] = column.header
+		results, err := column.parser.FindResults(object)
+		if err != nil {
+			return err
+		}
+		var found []string
+		for _, result := range results {
+			for _, value := range result {
+				found = append(found, formatColumnValue(value.Interface()))
+			}
+		}
+		values[i] = noneValue
+		if len(found) > 0 {
+			values[i] = strings.Join(found, ",")
+		}
+	}
+	w := ansiterm.NewTabWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+	fmt.Fprintln(w, strings.Join(values, "\t"))
+	return w.Flush()
+}
+
+// formatColumnValue formats a value found by the JSONPath of a custom column, printing objects and arrays as JSON
+func formatColumnValue(value any) string {
+	switch value.(type) {
+	case map[string]any, []any:
+		data, err := json.Marshal(value)
+		if err == nil {
+			return string(data)
+		}
+	case nil:
+		return noneValue
+	}
+	return fmt.Sprintf("%v", value)
+}
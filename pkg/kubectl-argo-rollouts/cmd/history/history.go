@@ -0,0 +1,287 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+const (
+	historyExample = `
+	# List the revisions of a rollout
+	%[1]s history guestbook
+
+	# Show the details of revision 3
+	%[1]s history guestbook --revision 3
+
+	# Show how the pod template of revision 3 differs from revision 2
+	%[1]s history guestbook --revision 3 --diff 2`
+
+	tableFormat = "%-20s%v\n"
+)
+
+// Outcome is how the update of a rollout to a revision ended
+type Outcome string
+
+const (
+	// OutcomePromoted is a revision which became the stable revision of the rollout
+	OutcomePromoted Outcome = "Promoted"
+	// OutcomeAborted is a revision whose update was aborted, or whose analysis failed
+	OutcomeAborted Outcome = "Aborted"
+	// OutcomeDegraded is the current revision of a degraded rollout
+	OutcomeDegraded Outcome = "Degraded"
+	// OutcomeProgressing is the current revision of a rollout which is still progressing
+	OutcomeProgressing Outcome = "Progressing"
+	// OutcomePaused is the current revision of a paused rollout
+	OutcomePaused Outcome = "Paused"
+	// OutcomeReplaced is an older revision which was replaced by a newer revision. The controller keeps no record of
+	// whether it was promoted before.
+	OutcomeReplaced Outcome = "Replaced"
+)
+
+// HistoryOptions holds the options of the `rollouts history` command
+type HistoryOptions struct {
+	Revision int64
+	Diff     int64
+
+	options.ArgoRolloutsOptions
+}
+
+// RevisionHistory describes a revision of a rollout
+type RevisionHistory struct {
+	Revision        int64
+	ReplicaSet      string
+	PodTemplateHash string
+	Images          []string
+	Created         metav1.Time
+	Outcome         Outcome
+	// Message explains the outcome
+	Message      string
+	AnalysisRuns []*rollout.AnalysisRunInfo
+	// Template is the pod template of the revision, without the pod template hash label
+	Template corev1.PodTemplateSpec
+}
+
+// NewCmdHistory returns a new instance of a `rollouts history` command
+func NewCmdHistory(o *options.ArgoRolloutsOptions) *cobra.Command {
+	historyOptions := HistoryOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:   "history ROLLOUT_NAME",
+		Short: "List the revisions of a rollout",
+		Long: "This command lists the ReplicaSet revisions of a rollout, with their pod template hash, images, creation " +
+			"time and outcome. The outcome of a revision is derived from the status of the rollout and the analysis runs " +
+			"of the revision. Revision numbers are those accepted by the undo command.",
+		Example:      o.Example(historyExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 || (historyOptions.Diff != 0 && historyOptions.Revision == 0) {
+				return o.UsageErr(c)
+			}
+			revisions, err := historyOptions.History(c.Context(), args[0])
+			if err != nil {
+				return err
+			}
+			if historyOptions.Revision == 0 {
+				printHistory(o.Out, revisions)
+				return nil
+			}
+			revision, err := findRevision(revisions, historyOptions.Revision)
+			if err != nil {
+				return err
+			}
+			if historyOptions.Diff == 0 {
+				return printRevision(o.Out, revision)
+			}
+			other, err := findRevision(revisions, historyOptions.Diff)
+			if err != nil {
+				return err
+			}
+			return printTemplateDiff(o.Out, other, revision)
+		},
+		ValidArgsFunction: completionutil.RolloutNameCompletionFunc(o),
+	}
+	cmd.Flags().Int64Var(&historyOptions.Revision, "revision", 0, "Show the details of the revision")
+	cmd.Flags().Int64Var(&historyOptions.Diff, "diff", 0, "Show the diff of the pod template of --revision against this revision")
+	return cmd
+}
+
+// History returns the revisions of the rollout, from the newest to the oldest
+func (o *HistoryOptions) History(ctx context.Context, name string) ([]*RevisionHistory, error) {
+	namespace := o.Namespace()
+	ro, err := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	rsList, err := o.KubeClientset().AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var allRSs []*appsv1.ReplicaSet
+	for i := range rsList.Items {
+		allRSs = append(allRSs, &rsList.Items[i])
+	}
+	arList, err := o.RolloutsClientset().ArgoprojV1alpha1().AnalysisRuns(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var allARs []*v1alpha1.AnalysisRun
+	for i := range arList.Items {
+		allARs = append(allARs, &arList.Items[i])
+	}
+	return rolloutHistory(ro, allRSs, allARs), nil
+}
+
+// rolloutHistory returns the revisions of the ReplicaSets of the rollout, from the newest to the oldest
+func rolloutHistory(ro *v1alpha1.Rollout, allRSs []*appsv1.ReplicaSet, allARs []*v1alpha1.AnalysisRun) []*RevisionHistory {
+	roInfo := info.NewRolloutInfo(ro, allRSs, nil, nil, allARs, nil)
+	rsByName := map[string]*appsv1.ReplicaSet{}
+	for _, rs := range allRSs {
+		rsByName[rs.Name] = rs
+	}
+	var revisions []*RevisionHistory
+	for _, rsInfo := range roInfo.ReplicaSets {
+		rs := rsByName[rsInfo.ObjectMeta.Name]
+		template := *rs.Spec.Template.DeepCopy()
+		delete(template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+		revision := &RevisionHistory{
+			Revision:        rsInfo.Revision,
+			ReplicaSet:      rsInfo.ObjectMeta.Name,
+			PodTemplateHash: replicasetutil.GetPodTemplateHash(rs),
+			Images:          rsInfo.Images,
+			Created:         rsInfo.ObjectMeta.CreationTimestamp,
+			AnalysisRuns:    info.AnalysisRunsByRevision(roInfo, int(rsInfo.Revision)),
+			Template:        template,
+		}
+		revision.Outcome, revision.Message = revisionOutcome(ro, revision)
+		revisions = append(revisions, revision)
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+	return revisions
+}
+
+// revisionOutcome returns the outcome of the revision, from the status of the rollout and the analysis runs of the
+// revision
+func revisionOutcome(ro *v1alpha1.Rollout, revision *RevisionHistory) (Outcome, string) {
+	current := revision.PodTemplateHash == ro.Status.CurrentPodHash
+	stable := revision.PodTemplateHash == ro.Status.StableRS
+	if current && ro.Status.Abort {
+		return OutcomeAborted, ro.Status.Message
+	}
+	if stable {
+		return OutcomePromoted, ""
+	}
+	if current {
+		phase, message := rolloututil.GetRolloutPhase(ro)
+		switch phase {
+		case v1alpha1.RolloutPhaseDegraded:
+			return OutcomeDegraded, message
+		case v1alpha1.RolloutPhasePaused:
+			return OutcomePaused, message
+		default:
+			return OutcomeProgressing, message
+		}
+	}
+	for _, run := range revision.AnalysisRuns {
+		if phase := v1alpha1.AnalysisPhase(run.Status); phase == v1alpha1.AnalysisPhaseFailed || phase == v1alpha1.AnalysisPhaseError {
+			return OutcomeAborted, fmt.Sprintf("AnalysisRun %s %s", run.ObjectMeta.Name, phase)
+		}
+	}
+	return OutcomeReplaced, ""
+}
+
+func findRevision(revisions []*RevisionHistory, revision int64) (*RevisionHistory, error) {
+	for _, r := range revisions {
+		if r.Revision == revision {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("revision %d not found", revision)
+}
+
+func printHistory(out io.Writer, revisions []*RevisionHistory) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "REVISION\tPOD-TEMPLATE-HASH\tIMAGES\tCREATED\tOUTCOME\n")
+	for _, r := range revisions {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", r.Revision, r.PodTemplateHash, strings.Join(r.Images, ","), r.Created.UTC().Format(time.RFC3339), r.Outcome)
+	}
+	_ = w.Flush()
+}
+
+func printRevision(out io.Writer, r *RevisionHistory) error {
+	fmt.Fprintf(out, tableFormat, "Revision:", r.Revision)
+	fmt.Fprintf(out, tableFormat, "ReplicaSet:", r.ReplicaSet)
+	fmt.Fprintf(out, tableFormat, "Pod Template Hash:", r.PodTemplateHash)
+	fmt.Fprintf(out, tableFormat, "Images:", strings.Join(r.Images, ","))
+	fmt.Fprintf(out, tableFormat, "Created:", r.Created.UTC().Format(time.RFC3339))
+	outcome := string(r.Outcome)
+	if r.Message != "" {
+		outcome += ": " + r.Message
+	}
+	fmt.Fprintf(out, tableFormat, "Outcome:", outcome)
+	if len(r.AnalysisRuns) > 0 {
+		fmt.Fprintln(out, "Analysis Runs:")
+		for _, run := range r.AnalysisRuns {
+			fmt.Fprintf(out, "  %s  %s\n", run.ObjectMeta.Name, run.Status)
+		}
+	}
+	template, err := yaml.Marshal(r.Template)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "Pod Template:")
+	for _, line := range strings.Split(strings.TrimRight(string(template), "\n"), "\n") {
+		fmt.Fprintf(out, "  %s\n", line)
+	}
+	return nil
+}
+
+// printTemplateDiff prints the unified diff of the pod templates of the revisions
+func printTemplateDiff(out io.Writer, from, to *RevisionHistory) error {
+	fromTemplate, err := yaml.Marshal(from.Template)
+	if err != nil {
+		return err
+	}
+	toTemplate, err := yaml.Marshal(to.Template)
+	if err != nil {
+		return err
+	}
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(fromTemplate)),
+		B:        difflib.SplitLines(string(toTemplate)),
+		FromFile: fmt.Sprintf("revision %d", from.Revision),
+		ToFile:   fmt.Sprintf("revision %d", to.Revision),
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		fmt.Fprintf(out, "The pod templates of revisions %d and %d are identical\n", from.Revision, to.Revision)
+		return nil
+	}
+	fmt.Fprint(out, diff)
+	return nil
+}
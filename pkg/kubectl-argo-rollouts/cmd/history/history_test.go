@@ -0,0 +1,176 @@
+package history
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+var created = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func newTemplate(image string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "guestbook"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "guestbook", Image: image}},
+		},
+	}
+}
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "test",
+			UID:       types.UID("guestbook-uid"),
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{},
+			},
+		},
+	}
+}
+
+func newReplicaSet(ro *v1alpha1.Rollout, image string, revision int) *appsv1.ReplicaSet {
+	template := newTemplate(image)
+	podHash := hash.ComputePodTemplateHash(&template, nil)
+	template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = podHash
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              ro.Name + "-" + podHash,
+			UID:               types.UID(podHash),
+			Namespace:         ro.Namespace,
+			Labels:            map[string]string{"app": "guestbook", v1alpha1.DefaultRolloutUniqueLabelKey: podHash},
+			Annotations:       map[string]string{annotations.RevisionAnnotation: strconv.Itoa(revision)},
+			CreationTimestamp: metav1.NewTime(created.Add(time.Duration(revision) * time.Hour)),
+			OwnerReferences:   []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}},
+			Template: template,
+		},
+	}
+}
+
+func newAnalysisRun(ro *v1alpha1.Rollout, rs *appsv1.ReplicaSet, phase v1alpha1.AnalysisPhase) *v1alpha1.AnalysisRun {
+	return &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            rs.Name + "-" + rs.Annotations[annotations.RevisionAnnotation],
+			Namespace:       ro.Namespace,
+			Annotations:     map[string]string{annotations.RevisionAnnotation: rs.Annotations[annotations.RevisionAnnotation]},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+		},
+		Status: v1alpha1.AnalysisRunStatus{Phase: phase},
+	}
+}
+
+// newObjects returns a rollout whose revision 1 was replaced, revision 2 was aborted by a failed analysis, revision 3
+// is stable and revision 4 is progressing
+func newObjects() []runtime.Object {
+	ro := newRollout()
+	ro.Spec.Template = newTemplate("guestbook:v4")
+	v1 := newReplicaSet(ro, "guestbook:v1", 1)
+	v2 := newReplicaSet(ro, "guestbook:v2", 2)
+	v3 := newReplicaSet(ro, "guestbook:v3", 3)
+	v4 := newReplicaSet(ro, "guestbook:v4", 4)
+	ro.Status.StableRS = v3.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	ro.Status.CurrentPodHash = v4.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	ro.Status.CurrentStepIndex = ptr.To[int32](0)
+	return []runtime.Object{ro, v1, v2, v3, v4, newAnalysisRun(ro, v2, v1alpha1.AnalysisPhaseFailed), newAnalysisRun(ro, v3, v1alpha1.AnalysisPhaseSuccessful)}
+}
+
+func runHistory(objs []runtime.Object, args ...string) (string, error) {
+	tf, o := options.NewFakeArgoRolloutsOptions(objs...)
+	o.RESTClientGetter = tf.WithNamespace("test")
+	defer tf.Cleanup()
+	cmd := NewCmdHistory(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return o.Out.(*bytes.Buffer).String(), err
+}
+
+func hashOf(image string) string {
+	template := newTemplate(image)
+	return hash.ComputePodTemplateHash(&template, nil)
+}
+
+func TestHistory(t *testing.T) {
+	stdout, err := runHistory(newObjects(), "guestbook")
+	assert.NoError(t, err)
+	expected := "REVISION  POD-TEMPLATE-HASH  IMAGES        CREATED               OUTCOME\n" +
+		"4         6dff4ccb5c         guestbook:v4  2026-10-01T16:00:00Z  Progressing\n" +
+		"3         9789945c6          guestbook:v3  2026-10-01T15:00:00Z  Promoted\n" +
+		"2         8458755875         guestbook:v2  2026-10-01T14:00:00Z  Aborted\n" +
+		"1         6c5b96bb9d         guestbook:v1  2026-10-01T13:00:00Z  Replaced\n"
+	assert.Equal(t, expected, stdout)
+}
+
+func TestHistoryAborted(t *testing.T) {
+	objs := newObjects()
+	ro := objs[0].(*v1alpha1.Rollout)
+	ro.Status.Abort = true
+	ro.Status.Message = "RolloutAborted: Rollout aborted update to revision 4"
+	stdout, err := runHistory(objs, "guestbook", "--revision", "4")
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Outcome:            Aborted: RolloutAborted: Rollout aborted update to revision 4\n")
+}
+
+func TestHistoryRevision(t *testing.T) {
+	stdout, err := runHistory(newObjects(), "guestbook", "--revision", "2")
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Revision:           2\n")
+	assert.Contains(t, stdout, "ReplicaSet:         guestbook-"+hashOf("guestbook:v2")+"\n")
+	assert.Contains(t, stdout, "Images:             guestbook:v2\n")
+	assert.Contains(t, stdout, "Created:            2026-10-01T14:00:00Z\n")
+	assert.Contains(t, stdout, "Outcome:            Aborted: AnalysisRun guestbook-"+hashOf("guestbook:v2")+"-2 Failed\n")
+	assert.Contains(t, stdout, "Analysis Runs:\n  guestbook-"+hashOf("guestbook:v2")+"-2  Failed\n")
+	assert.Contains(t, stdout, "Pod Template:\n  metadata:\n")
+	assert.NotContains(t, stdout, v1alpha1.DefaultRolloutUniqueLabelKey)
+}
+
+func TestHistoryDiff(t *testing.T) {
+	stdout, err := runHistory(newObjects(), "guestbook", "--revision", "4", "--diff", "3")
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "--- revision 3\n+++ revision 4\n")
+	assert.Contains(t, stdout, "-  - image: guestbook:v3\n+  - image: guestbook:v4\n")
+
+	stdout, err = runHistory(newObjects(), "guestbook", "--revision", "4", "--diff", "4")
+	assert.NoError(t, err)
+	assert.Equal(t, "The pod templates of revisions 4 and 4 are identical\n", stdout)
+}
+
+func TestHistoryRevisionNotFound(t *testing.T) {
+	_, err := runHistory(newObjects(), "guestbook", "--revision", "5")
+	assert.EqualError(t, err, "revision 5 not found")
+}
+
+func TestHistoryUsage(t *testing.T) {
+	_, err := runHistory(newObjects())
+	assert.Error(t, err)
+
+	_, err = runHistory(newObjects(), "guestbook", "--diff", "3")
+	assert.Error(t, err)
+}
+
+func TestHistoryRolloutNotFound(t *testing.T) {
+	_, err := runHistory(nil, "guestbook")
+	assert.EqualError(t, err, "rollouts.argoproj.io \"guestbook\" not found")
+}
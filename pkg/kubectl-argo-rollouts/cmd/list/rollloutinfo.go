@@ -63,6 +63,11 @@ func newRolloutInfo(ro v1alpha1.Rollout) rolloutInfo {
 		// }
 	} else if ro.Spec.Strategy.BlueGreen != nil {
 		ri.strategy = "BlueGreen"
+	} else if ro.Spec.Strategy.DaemonSet != nil {
+		ri.strategy = "DaemonSet"
+		if ro.Status.CurrentStepIndex != nil && len(ro.Spec.Strategy.DaemonSet.Steps) > 0 {
+			ri.step = fmt.Sprintf("%d/%d", *ro.Status.CurrentStepIndex, len(ro.Spec.Strategy.DaemonSet.Steps))
+		}
 	}
 	phase, _ := rolloututil.GetRolloutPhase(&ro)
 	ri.status = string(phase)
@@ -103,7 +103,7 @@ func PromoteRollout(rolloutIf clientset.RolloutInterface, name string, skipCurre
 		if ro.Spec.Strategy.BlueGreen != nil {
 			return nil, fmt.Errorf(skipFlagsWithBlueGreenError)
 		}
-		if ro.Spec.Strategy.Canary != nil && len(ro.Spec.Strategy.Canary.Steps) == 0 {
+		if _, stepCount := currentStep(ro); stepCount == 0 {
 			return nil, fmt.Errorf(skipFlagWithNoStepCanaryError)
 		}
 	}
@@ -136,18 +136,24 @@ func PromoteRollout(rolloutIf clientset.RolloutInterface, name string, skipCurre
 }
 
 func isInconclusive(rollout *v1alpha1.Rollout) bool {
-	return rollout.Spec.Strategy.Canary != nil && rollout.Status.Canary.CurrentStepAnalysisRunStatus != nil && rollout.Status.Canary.CurrentStepAnalysisRunStatus.Status == v1alpha1.AnalysisPhaseInconclusive
+	stepAnalysisRunStatus := rollout.Status.Canary.CurrentStepAnalysisRunStatus
+	if rollout.Spec.Strategy.DaemonSet != nil {
+		stepAnalysisRunStatus = rollout.Status.DaemonSet.CurrentStepAnalysisRunStatus
+	} else if rollout.Spec.Strategy.Canary == nil {
+		return false
+	}
+	return stepAnalysisRunStatus != nil && stepAnalysisRunStatus.Status == v1alpha1.AnalysisPhaseInconclusive
 }
 
 func getPatches(rollout *v1alpha1.Rollout, skipCurrentStep, skipAllStep, full bool) ([]byte, []byte, []byte) {
 	var specPatch, statusPatch, unifiedPatch []byte
 	switch {
 	case skipCurrentStep:
-		_, index := replicasetutil.GetCurrentCanaryStep(rollout)
-		// At this point, the controller knows that the rollout is a canary with steps and GetCurrentCanaryStep returns 0 if
+		index, stepCount := currentStep(rollout)
+		// At this point, the controller knows that the rollout has steps and currentStep returns 0 if
 		// the index is not set in the rollout
 		if index != nil {
-			if *index < int32(len(rollout.Spec.Strategy.Canary.Steps)) {
+			if *index < stepCount {
 				*index++
 			}
 			statusPatch = []byte(fmt.Sprintf(setCurrentStepIndex, *index))
@@ -155,7 +161,8 @@ func getPatches(rollout *v1alpha1.Rollout, skipCurrentStep, skipAllStep, full bo
 		}
 
 	case skipAllStep:
-		statusPatch = []byte(fmt.Sprintf(setCurrentStepIndex, len(rollout.Spec.Strategy.Canary.Steps)))
+		_, stepCount := currentStep(rollout)
+		statusPatch = []byte(fmt.Sprintf(setCurrentStepIndex, stepCount))
 		unifiedPatch = statusPatch
 	case full:
 		if rollout.Spec.Paused {
@@ -174,25 +181,25 @@ func getPatches(rollout *v1alpha1.Rollout, skipCurrentStep, skipAllStep, full bo
 		// so that rollout can proceed to next step
 		// without such patch, rollout will be stuck in inconclusive state in case if next step is pause step
 		if isInconclusive(rollout) && len(rollout.Status.PauseConditions) > 0 && rollout.Status.ControllerPause {
-			_, index := replicasetutil.GetCurrentCanaryStep(rollout)
+			index, stepCount := currentStep(rollout)
 			if index != nil {
-				if *index < int32(len(rollout.Spec.Strategy.Canary.Steps)) {
+				if *index < stepCount {
 					*index++
 				}
 				statusPatch = []byte(fmt.Sprintf(clearPauseConditionsAndControllerPausePatch, *index))
 			}
 		} else if len(rollout.Status.PauseConditions) > 0 {
 			statusPatch = []byte(clearPauseConditionsPatch)
-		} else if rollout.Spec.Strategy.Canary != nil {
+		} else if rollout.Spec.Strategy.Canary != nil || rollout.Spec.Strategy.DaemonSet != nil {
 			// we only want to clear pause conditions, or increment step index (never both)
 			// this else block covers the case of promoting a rollout when it is in the middle of
 			// running analysis/experiment
 			// TODO: we currently do not handle promotion of two analysis steps in a row properly
-			_, index := replicasetutil.GetCurrentCanaryStep(rollout)
-			// At this point, the controller knows that the rollout is a canary with steps and GetCurrentCanaryStep returns 0 if
+			index, stepCount := currentStep(rollout)
+			// At this point, the controller knows that the rollout has steps and currentStep returns 0 if
 			// the index is not set in the rollout
 			if index != nil {
-				if *index < int32(len(rollout.Spec.Strategy.Canary.Steps)) {
+				if *index < stepCount {
 					*index++
 				}
 				statusPatch = []byte(fmt.Sprintf(clearPauseConditionsPatchWithStep, *index))
@@ -202,3 +209,16 @@ func getPatches(rollout *v1alpha1.Rollout, skipCurrentStep, skipAllStep, full bo
 	}
 	return specPatch, statusPatch, unifiedPatch
 }
+
+// currentStep returns the index of the current step and the number of steps of a canary or DaemonSet rollout
+func currentStep(rollout *v1alpha1.Rollout) (*int32, int32) {
+	if rollout.Spec.Strategy.DaemonSet != nil {
+		_, index := replicasetutil.GetCurrentDaemonSetStep(rollout)
+		return index, int32(len(rollout.Spec.Strategy.DaemonSet.Steps))
+	}
+	if rollout.Spec.Strategy.Canary != nil {
+		_, index := replicasetutil.GetCurrentCanaryStep(rollout)
+		return index, int32(len(rollout.Spec.Strategy.Canary.Steps))
+	}
+	return nil, 0
+}
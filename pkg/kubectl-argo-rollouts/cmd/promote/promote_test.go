@@ -219,6 +219,53 @@ func TestPromoteCmdSuccesFirstStepWithSkipFirstStep(t *testing.T) {
 	assert.Empty(t, stderr)
 }
 
+func TestPromoteCmdSuccessDaemonSetSkipCurrentStep(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "node-agent",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{
+					Steps: []v1alpha1.DaemonSetStep{
+						{Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](10)}},
+						{Pause: &v1alpha1.RolloutPause{}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentStepIndex: ptr.To[int32](1),
+		},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro)
+	defer tf.Cleanup()
+	fakeClient := o.RolloutsClient.(*fakeroclient.Clientset)
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			patchRo := v1alpha1.Rollout{}
+			err := json.Unmarshal(patchAction.GetPatch(), &patchRo)
+			if err != nil {
+				panic(err)
+			}
+			ro.Status.CurrentStepIndex = patchRo.Status.CurrentStepIndex
+		}
+		return true, &ro, nil
+	})
+
+	cmd := NewCmdPromote(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"node-agent", "-c"})
+
+	err := cmd.Execute()
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), *ro.Status.CurrentStepIndex)
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, stdout, "rollout 'node-agent' promoted\n")
+}
+
 func TestPromoteCmdSuccesFirstStep(t *testing.T) {
 	ro := v1alpha1.Rollout{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,215 @@
+package simulate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	goyaml "go.yaml.in/yaml/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/rollout/simulator"
+)
+
+const (
+	simulateExample = `
+	# Simulate the update of a rollout to the pod template of the manifest
+	%[1]s simulate -f my-rollout.yaml
+
+	# Simulate the analysis of step 2 failing
+	%[1]s simulate -f my-rollout.yaml --analysis-result 2=fail
+
+	# Simulate an inconclusive pre-promotion analysis of a blue-green rollout
+	%[1]s simulate -f my-rollout.yaml --analysis-result prePromotion=inconclusive`
+)
+
+var analysisResults = map[string]v1alpha1.AnalysisPhase{
+	"pass":         v1alpha1.AnalysisPhaseSuccessful,
+	"fail":         v1alpha1.AnalysisPhaseFailed,
+	"inconclusive": v1alpha1.AnalysisPhaseInconclusive,
+}
+
+// SimulateOptions holds the options of the `rollouts simulate` command
+type SimulateOptions struct {
+	File           string
+	AnalysisResult []string
+	Timeout        time.Duration
+	ControllerLogs bool
+
+	options.ArgoRolloutsOptions
+}
+
+// NewCmdSimulate returns a new instance of a `rollouts simulate` command
+func NewCmdSimulate(o *options.ArgoRolloutsOptions) *cobra.Command {
+	simulateOptions := SimulateOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:   "simulate",
+		Short: "Simulate the update of a Rollout with virtual time",
+		Long: "This command runs the reconciliation of the Rollouts of a manifest against fake clients with virtual time, " +
+			"from a healthy revision to the pod template of the manifest, and prints the timeline of the ReplicaSet " +
+			"scales, traffic weights, steps, pauses and analysis. Analysis runs and experiments succeed once their " +
+			"measurements complete, unless a result is set for their step. Indefinite pauses are promoted. " +
+			"The analysis templates and services the Rollouts reference are read from the manifest.",
+		Example:      o.Example(simulateExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if simulateOptions.File == "" || len(args) != 0 {
+				return o.UsageErr(c)
+			}
+			results, err := parseAnalysisResults(simulateOptions.AnalysisResult)
+			if err != nil {
+				return err
+			}
+			ros, objs, err := simulateOptions.readObjects(simulateOptions.File)
+			if err != nil {
+				return err
+			}
+			if len(ros) == 0 {
+				return fmt.Errorf("no Rollout found in %s", simulateOptions.File)
+			}
+
+			// the controller logs with the standard logger
+			logger := log.StandardLogger()
+			prevOut := logger.Out
+			defer logger.SetOutput(prevOut)
+			if simulateOptions.ControllerLogs {
+				logger.SetOutput(o.ErrOut)
+			} else {
+				logger.SetOutput(io.Discard)
+			}
+
+			for i, ro := range ros {
+				simulation, err := simulator.Simulate(context.TODO(), ro, simulator.Options{
+					Objects:         objs,
+					AnalysisResults: results,
+					Timeout:         simulateOptions.Timeout,
+				})
+				if err != nil {
+					return err
+				}
+				if i > 0 {
+					fmt.Fprintln(o.Out)
+				}
+				printSimulation(o.Out, ro.Name, simulation)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&simulateOptions.File, "filename", "f", "", "File containing the Rollout manifest, or - to read it from stdin")
+	cmd.Flags().StringArrayVar(&simulateOptions.AnalysisResult, "analysis-result", []string{}, "Result of the analysis of a step, as STEP=pass|fail|inconclusive, where STEP is a step index, prePromotion or postPromotion")
+	cmd.Flags().DurationVar(&simulateOptions.Timeout, "timeout", simulator.DefaultTimeout, "Virtual time after which the simulation stops")
+	cmd.Flags().BoolVar(&simulateOptions.ControllerLogs, "controller-logs", false, "Print the logs of the controller to stderr")
+	return cmd
+}
+
+// parseAnalysisResults parses the STEP=RESULT values of the --analysis-result flag
+func parseAnalysisResults(values []string) (map[string]v1alpha1.AnalysisPhase, error) {
+	results := map[string]v1alpha1.AnalysisPhase{}
+	for _, value := range values {
+		key, result, ok := strings.Cut(value, "=")
+		phase, known := analysisResults[strings.ToLower(result)]
+		if !ok || !known {
+			return nil, fmt.Errorf("invalid analysis result '%s': expected STEP=pass|fail|inconclusive", value)
+		}
+		if key != simulator.PrePromotionKey && key != simulator.PostPromotionKey {
+			if index, err := strconv.Atoi(key); err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid analysis result '%s': STEP is a step index, %s or %s", value, simulator.PrePromotionKey, simulator.PostPromotionKey)
+			}
+		}
+		results[key] = phase
+	}
+	return results, nil
+}
+
+// readObjects returns the Rollouts of the manifest, and the analysis templates and services they can reference
+func (o *SimulateOptions) readObjects(path string) ([]*v1alpha1.Rollout, []runtime.Object, error) {
+	var fileBytes []byte
+	var err error
+	if path == "-" {
+		fileBytes, err = io.ReadAll(o.In)
+	} else {
+		fileBytes, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ros []*v1alpha1.Rollout
+	var objs []runtime.Object
+	decoder := goyaml.NewDecoder(bytes.NewReader(fileBytes))
+	for {
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			if err != io.EOF {
+				return nil, nil, err
+			}
+			break
+		}
+		if value == nil {
+			continue
+		}
+		valueBytes, err := goyaml.Marshal(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		var typeMeta metav1.TypeMeta
+		if err := yaml.Unmarshal(valueBytes, &typeMeta); err != nil {
+			return nil, nil, err
+		}
+		gvk := typeMeta.GroupVersionKind()
+		var obj runtime.Object
+		switch {
+		case gvk.Group == rollouts.Group && gvk.Kind == rollouts.RolloutKind:
+			ro := &v1alpha1.Rollout{}
+			if err := yaml.UnmarshalStrict(valueBytes, ro); err != nil {
+				return nil, nil, err
+			}
+			ros = append(ros, ro)
+			continue
+		case gvk.Group == rollouts.Group && gvk.Kind == rollouts.AnalysisTemplateKind:
+			obj = &v1alpha1.AnalysisTemplate{}
+		case gvk.Group == rollouts.Group && gvk.Kind == rollouts.ClusterAnalysisTemplateKind:
+			obj = &v1alpha1.ClusterAnalysisTemplate{}
+		case gvk.Group == "" && gvk.Kind == "Service":
+			obj = &corev1.Service{}
+		default:
+			continue
+		}
+		if err := yaml.UnmarshalStrict(valueBytes, obj); err != nil {
+			return nil, nil, err
+		}
+		objs = append(objs, obj)
+	}
+	return ros, objs, nil
+}
+
+func printSimulation(out io.Writer, name string, simulation *simulator.Simulation) {
+	fmt.Fprintf(out, "Rollout: %s\n\n", name)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "TIME\tEVENT\n")
+	for _, event := range simulation.Events {
+		fmt.Fprintf(w, "%s\t%s\n", event.Time, event.Message)
+	}
+	_ = w.Flush()
+	result := string(simulation.Phase)
+	if simulation.Message != "" {
+		result += ": " + simulation.Message
+	}
+	fmt.Fprintf(out, "\nResult: %s after %s\n", result, simulation.Duration)
+}
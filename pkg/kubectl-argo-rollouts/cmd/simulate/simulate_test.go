@@ -0,0 +1,125 @@
+package simulate
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+const canaryManifest = `
+apiVersion: argoproj.io/v1alpha1
+kind: Rollout
+metadata:
+  name: guestbook
+spec:
+  replicas: 5
+  selector:
+    matchLabels:
+      app: guestbook
+  template:
+    metadata:
+      labels:
+        app: guestbook
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v2
+  strategy:
+    canary:
+      canaryService: guestbook-canary
+      stableService: guestbook-stable
+      trafficRouting:
+        nginx:
+          stableIngress: guestbook
+      steps:
+      - setWeight: 20
+      - analysis:
+          templates:
+          - templateName: smoke
+      - setWeight: 50
+      - pause: {}
+---
+apiVersion: argoproj.io/v1alpha1
+kind: AnalysisTemplate
+metadata:
+  name: smoke
+spec:
+  metrics:
+  - name: success-rate
+    count: 3
+    interval: 1m
+    provider:
+      prometheus:
+        address: http://prometheus:9090
+        query: up
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: guestbook-canary
+spec:
+  selector:
+    app: guestbook
+`
+
+func runSimulate(t *testing.T, manifest string, args ...string) (string, error) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	path := filepath.Join(t.TempDir(), "rollout.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(manifest), 0644))
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs(append([]string{"-f", path}, args...))
+	err := cmd.Execute()
+	return o.Out.(*bytes.Buffer).String(), err
+}
+
+func TestSimulate(t *testing.T) {
+	stdout, err := runSimulate(t, canaryManifest)
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Rollout: guestbook\n\nTIME   EVENT\n0s     Rollout updated to the pod template of the manifest\n")
+	assert.Contains(t, stdout, "Traffic weight: canary 20%, stable 80%")
+	assert.Contains(t, stdout, "2m0s   Traffic weight: canary 50%, stable 50%")
+	assert.Contains(t, stdout, "2m0s   Promoted")
+	assert.Contains(t, stdout, "2m30s  ReplicaSet guestbook-75fdf597bd (revision 1) scaled from 5 to 0\n\nResult: Healthy after 2m30s\n")
+}
+
+func TestSimulateAnalysisResult(t *testing.T) {
+	stdout, err := runSimulate(t, canaryManifest, "--analysis-result", "1=fail")
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Failed")
+	assert.Contains(t, stdout, "Result: Degraded: RolloutAborted: Rollout aborted update to revision 2")
+
+	stdout, err = runSimulate(t, canaryManifest, "--analysis-result", "1=inconclusive")
+	assert.NoError(t, err)
+	assert.Contains(t, stdout, "Result: Paused: InconclusiveAnalysisRun after 2m0s\n")
+}
+
+func TestSimulateInvalidAnalysisResult(t *testing.T) {
+	_, err := runSimulate(t, canaryManifest, "--analysis-result", "1=maybe")
+	assert.EqualError(t, err, "invalid analysis result '1=maybe': expected STEP=pass|fail|inconclusive")
+
+	_, err = runSimulate(t, canaryManifest, "--analysis-result", "last=fail")
+	assert.EqualError(t, err, "invalid analysis result 'last=fail': STEP is a step index, prePromotion or postPromotion")
+}
+
+func TestSimulateNoRollout(t *testing.T) {
+	_, err := runSimulate(t, "apiVersion: v1\nkind: Service\nmetadata:\n  name: guestbook\n")
+	assert.ErrorContains(t, err, "no Rollout found in ")
+}
+
+func TestSimulateStdin(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	o.In = bytes.NewReader([]byte(canaryManifest))
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "-"})
+	assert.NoError(t, cmd.Execute())
+	assert.Contains(t, o.Out.(*bytes.Buffer).String(), "Result: Healthy")
+}
@@ -78,6 +78,11 @@ func NewRolloutInfo(
 		}
 	} else if ro.Spec.Strategy.BlueGreen != nil {
 		roInfo.Strategy = "BlueGreen"
+	} else if ro.Spec.Strategy.DaemonSet != nil {
+		roInfo.Strategy = "DaemonSet"
+		if ro.Status.CurrentStepIndex != nil && len(ro.Spec.Strategy.DaemonSet.Steps) > 0 {
+			roInfo.Step = fmt.Sprintf("%d/%d", *ro.Status.CurrentStepIndex, len(ro.Spec.Strategy.DaemonSet.Steps))
+		}
 	}
 	phase, message := rolloututil.GetRolloutPhase(ro)
 	roInfo.Status = string(phase)
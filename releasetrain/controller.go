@@ -0,0 +1,281 @@
+package releasetrain
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	appsinformers "k8s.io/client-go/informers/apps/v1"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	kubectlutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	register "github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	"github.com/argoproj/argo-rollouts/utils/diff"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+// rolloutIndexName is the index by which ReleaseTrains are cached against the Rollouts they release
+const rolloutIndexName = "byRollout"
+
+// Controller is the controller implementation for ReleaseTrain resources
+type Controller struct {
+	// argoProjClientset is a clientset for our own API group
+	argoProjClientset clientset.Interface
+
+	releaseTrainLister            listers.ReleaseTrainLister
+	releaseTrainIndexer           cache.Indexer
+	rolloutsLister                listers.RolloutLister
+	replicaSetLister              appslisters.ReplicaSetLister
+	analysisTemplateLister        listers.AnalysisTemplateLister
+	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
+	analysisRunLister             listers.AnalysisRunLister
+
+	releaseTrainSynced cache.InformerSynced
+
+	metricsServer *metrics.MetricsServer
+
+	// used for unit testing
+	enqueueReleaseTrain func(obj any)
+
+	// releaseTrainWorkqueue is a rate limited work queue of the ReleaseTrains to reconcile
+	releaseTrainWorkqueue workqueue.RateLimitingInterface
+	// recorder is an event recorder for recording Event resources to the
+	// Kubernetes API.
+	recorder record.EventRecorder
+}
+
+// ControllerConfig describes the data required to instantiate a new release train controller
+type ControllerConfig struct {
+	ArgoProjClientset               clientset.Interface
+	ReleaseTrainInformer            informers.ReleaseTrainInformer
+	RolloutsInformer                informers.RolloutInformer
+	ReplicaSetInformer              appsinformers.ReplicaSetInformer
+	AnalysisRunInformer             informers.AnalysisRunInformer
+	AnalysisTemplateInformer        informers.AnalysisTemplateInformer
+	ClusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer
+	ReleaseTrainWorkQueue           workqueue.RateLimitingInterface
+	MetricsServer                   *metrics.MetricsServer
+	Recorder                        record.EventRecorder
+}
+
+// NewController returns a new release train controller
+func NewController(cfg ControllerConfig) *Controller {
+	controller := &Controller{
+		argoProjClientset:             cfg.ArgoProjClientset,
+		releaseTrainLister:            cfg.ReleaseTrainInformer.Lister(),
+		releaseTrainIndexer:           cfg.ReleaseTrainInformer.Informer().GetIndexer(),
+		rolloutsLister:                cfg.RolloutsInformer.Lister(),
+		replicaSetLister:              cfg.ReplicaSetInformer.Lister(),
+		analysisTemplateLister:        cfg.AnalysisTemplateInformer.Lister(),
+		clusterAnalysisTemplateLister: cfg.ClusterAnalysisTemplateInformer.Lister(),
+		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
+		releaseTrainSynced:            cfg.ReleaseTrainInformer.Informer().HasSynced,
+		metricsServer:                 cfg.MetricsServer,
+		releaseTrainWorkqueue:         cfg.ReleaseTrainWorkQueue,
+		recorder:                      cfg.Recorder,
+	}
+
+	controller.enqueueReleaseTrain = func(obj any) {
+		controllerutil.Enqueue(obj, cfg.ReleaseTrainWorkQueue)
+	}
+
+	kubectlutil.CheckErr(cfg.ReleaseTrainInformer.Informer().AddIndexers(cache.Indexers{
+		rolloutIndexName: func(obj any) ([]string, error) {
+			if train := unstructuredutil.ObjectToReleaseTrain(obj); train != nil {
+				return rolloutKeys(train), nil
+			}
+			return []string{}, nil
+		},
+	}))
+
+	log.Info("Setting up release train event handlers")
+	cfg.ReleaseTrainInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueReleaseTrain,
+		UpdateFunc: func(old, new any) {
+			controller.enqueueReleaseTrain(new)
+		},
+		DeleteFunc: func(obj any) {
+			controller.enqueueReleaseTrain(obj)
+			if train := unstructuredutil.ObjectToReleaseTrain(obj); train != nil {
+				logutil.WithReleaseTrain(train).Info("release train deleted")
+				controller.metricsServer.Remove(train.Namespace, train.Name, logutil.ReleaseTrainKey)
+			}
+		},
+	})
+
+	cfg.RolloutsInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueTrainsOfRollout,
+		UpdateFunc: func(old, new any) {
+			controller.enqueueTrainsOfRollout(new)
+		},
+		DeleteFunc: controller.enqueueTrainsOfRollout,
+	})
+
+	cfg.AnalysisRunInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueIfCompleted,
+		UpdateFunc: func(oldObj, newObj any) {
+			controller.enqueueIfCompleted(newObj)
+		},
+		DeleteFunc: controller.enqueueIfCompleted,
+	})
+	return controller
+}
+
+// Run starts the controller threads
+func (c *Controller) Run(ctx context.Context, threadiness int) error {
+	log.Info("Starting ReleaseTrain workers")
+	wg := sync.WaitGroup{}
+	for i := 0; i < threadiness; i++ {
+		wg.Add(1)
+		go wait.Until(func() {
+			controllerutil.RunWorker(ctx, c.releaseTrainWorkqueue, logutil.ReleaseTrainKey, c.syncHandler, c.metricsServer)
+			log.Debug("ReleaseTrain worker has stopped")
+			wg.Done()
+		}, time.Second, ctx.Done())
+	}
+	log.Info("Started ReleaseTrain workers")
+	<-ctx.Done()
+	wg.Wait()
+	log.Info("All release train workers have stopped")
+
+	return nil
+}
+
+func (c *Controller) syncHandler(ctx context.Context, key string) error {
+	startTime := timeutil.Now()
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	logCtx := log.WithField(logutil.ReleaseTrainKey, name).WithField(logutil.NamespaceKey, namespace)
+	logCtx.Infof("Started syncing ReleaseTrain at (%v)", startTime)
+	train, err := c.releaseTrainLister.ReleaseTrains(namespace).Get(name)
+	if k8serrors.IsNotFound(err) {
+		logCtx.Info("ReleaseTrain has been deleted")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		duration := time.Since(startTime)
+		c.metricsServer.IncReleaseTrainReconcile(train, duration)
+		logCtx.WithField("time_ms", duration.Seconds()*1e3).Info("Reconciliation completed")
+	}()
+
+	if train.DeletionTimestamp != nil {
+		logCtx.Info("No reconciliation as release train marked for deletion")
+		return nil
+	}
+
+	trainCtx := &trainContext{
+		train:                         train.DeepCopy(),
+		log:                           logCtx,
+		argoProjClientset:             c.argoProjClientset,
+		rolloutsLister:                c.rolloutsLister,
+		replicaSetLister:              c.replicaSetLister,
+		analysisTemplateLister:        c.analysisTemplateLister,
+		clusterAnalysisTemplateLister: c.clusterAnalysisTemplateLister,
+		analysisRunLister:             c.analysisRunLister,
+		recorder:                      c.recorder,
+	}
+	newStatus := trainCtx.reconcile()
+	return c.persistReleaseTrainStatus(train, newStatus)
+}
+
+func (c *Controller) persistReleaseTrainStatus(orig *v1alpha1.ReleaseTrain, newStatus *v1alpha1.ReleaseTrainStatus) error {
+	ctx := context.TODO()
+	logCtx := logutil.WithReleaseTrain(orig)
+	patch, modified, err := diff.CreateTwoWayMergePatch(
+		&v1alpha1.ReleaseTrain{
+			Status: orig.Status,
+		},
+		&v1alpha1.ReleaseTrain{
+			Status: *newStatus,
+		}, v1alpha1.ReleaseTrain{})
+	if err != nil {
+		logCtx.Errorf("Error constructing release train status patch: %v", err)
+		return err
+	}
+	if !modified {
+		logCtx.Info("No status changes. Skipping patch")
+		return nil
+	}
+	logCtx.Debugf("ReleaseTrain Patch: %s", patch)
+	patched, err := c.argoProjClientset.ArgoprojV1alpha1().ReleaseTrains(orig.Namespace).Patch(ctx, orig.Name, patchtypes.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		logCtx.Warningf("Error updating release train: %v", err)
+		return err
+	}
+	logCtx.Info("Patch status successfully")
+	c.recordEvent(patched, orig.Status, newStatus)
+	return nil
+}
+
+func (c *Controller) recordEvent(train *v1alpha1.ReleaseTrain, prevStatus v1alpha1.ReleaseTrainStatus, newStatus *v1alpha1.ReleaseTrainStatus) {
+	if prevStatus.Phase != newStatus.Phase {
+		eventType := corev1.EventTypeNormal
+		switch newStatus.Phase {
+		case v1alpha1.ReleaseTrainPhaseFailed, v1alpha1.ReleaseTrainPhaseRolledBack, v1alpha1.ReleaseTrainPhaseRollbackFailed:
+			eventType = corev1.EventTypeWarning
+		}
+		c.recorder.Eventf(train, record.EventOptions{EventType: eventType, EventReason: "ReleaseTrain" + string(newStatus.Phase)}, "ReleaseTrain transitioned from %s -> %s", prevStatus.Phase, newStatus.Phase)
+	}
+}
+
+// enqueueTrainsOfRollout enqueues the ReleaseTrains which release the given rollout
+func (c *Controller) enqueueTrainsOfRollout(obj any) {
+	ro := unstructuredutil.ObjectToRollout(obj)
+	if ro == nil {
+		return
+	}
+	trains, err := c.releaseTrainIndexer.ByIndex(rolloutIndexName, fmt.Sprintf("%s/%s", ro.Namespace, ro.Name))
+	if err != nil {
+		logutil.WithRollout(ro).Errorf("Failed to look up release trains: %v", err)
+		return
+	}
+	for _, train := range trains {
+		c.enqueueReleaseTrain(train)
+	}
+}
+
+// enqueueIfCompleted conditionally enqueues the AnalysisRun's ReleaseTrain if the run is complete
+func (c *Controller) enqueueIfCompleted(obj any) {
+	run := unstructuredutil.ObjectToAnalysisRun(obj)
+	if run == nil {
+		return
+	}
+	if run.Status.Phase.Completed() {
+		controllerutil.EnqueueParentObject(run, register.ReleaseTrainKind, c.enqueueReleaseTrain)
+	}
+}
+
+// rolloutKeys returns the namespace/name keys of the Rollouts released by the given train
+func rolloutKeys(train *v1alpha1.ReleaseTrain) []string {
+	var keys []string
+	for _, wave := range train.Spec.Waves {
+		for _, name := range wave.Rollouts {
+			keys = append(keys, fmt.Sprintf("%s/%s", train.Namespace, name))
+		}
+	}
+	return keys
+}
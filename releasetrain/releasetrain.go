@@ -0,0 +1,553 @@
+package releasetrain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+var controllerKind = v1alpha1.SchemeGroupVersion.WithKind("ReleaseTrain")
+
+type trainContext struct {
+	// params
+	train                         *v1alpha1.ReleaseTrain
+	log                           *log.Entry
+	argoProjClientset             clientset.Interface
+	rolloutsLister                listers.RolloutLister
+	replicaSetLister              appslisters.ReplicaSetLister
+	analysisTemplateLister        listers.AnalysisTemplateLister
+	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
+	analysisRunLister             listers.AnalysisRunLister
+	recorder                      record.EventRecorder
+
+	// calculated values during reconciliation
+	newStatus *v1alpha1.ReleaseTrainStatus
+}
+
+// reconcile releases the waves of the train one after the other and returns the new status of the train
+func (tc *trainContext) reconcile() *v1alpha1.ReleaseTrainStatus {
+	tc.newStatus = tc.train.Status.DeepCopy()
+	if tc.newStatus.Phase.Completed() {
+		return tc.newStatus
+	}
+	if err := validateSpec(tc.train.Spec); err != nil {
+		tc.log.Warnf("Spec submitted is invalid: %v", err)
+		tc.newStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		tc.newStatus.Message = fmt.Sprintf("Invalid spec: %v", err)
+		now := timeutil.MetaNow()
+		tc.newStatus.FinishedAt = &now
+		return tc.newStatus
+	}
+	if len(tc.newStatus.Waves) == 0 {
+		tc.initializeStatus()
+	} else if len(tc.newStatus.Waves) != len(tc.train.Spec.Waves) {
+		tc.fail("Waves were modified after the train started")
+		return tc.newStatus
+	}
+
+	if tc.train.Spec.Abort {
+		tc.terminateAnalysis()
+		tc.fail("ReleaseTrain aborted")
+		return tc.newStatus
+	}
+
+	for i, wave := range tc.train.Spec.Waves {
+		waveStatus := &tc.newStatus.Waves[i]
+		if waveStatus.Phase == v1alpha1.ReleaseTrainPhaseSuccessful {
+			continue
+		}
+		tc.newStatus.CurrentWave = wave.Name
+		if waveStatus.Phase != v1alpha1.ReleaseTrainPhaseFailed {
+			tc.reconcileWave(wave, waveStatus)
+		}
+		switch waveStatus.Phase {
+		case v1alpha1.ReleaseTrainPhaseFailed:
+			tc.fail(fmt.Sprintf("Wave '%s' failed: %s", wave.Name, waveStatus.Message))
+		case v1alpha1.ReleaseTrainPhaseSuccessful:
+			continue
+		default:
+			tc.newStatus.Message = fmt.Sprintf("Releasing wave '%s'", wave.Name)
+		}
+		return tc.newStatus
+	}
+
+	tc.log.Info("All waves released")
+	tc.newStatus.Phase = v1alpha1.ReleaseTrainPhaseSuccessful
+	tc.newStatus.Message = ""
+	now := timeutil.MetaNow()
+	tc.newStatus.FinishedAt = &now
+	return tc.newStatus
+}
+
+func (tc *trainContext) initializeStatus() {
+	for _, wave := range tc.train.Spec.Waves {
+		waveStatus := v1alpha1.ReleaseTrainWaveStatus{
+			Name:  wave.Name,
+			Phase: v1alpha1.ReleaseTrainPhasePending,
+		}
+		for _, name := range wave.Rollouts {
+			waveStatus.Rollouts = append(waveStatus.Rollouts, v1alpha1.ReleaseTrainRolloutStatus{
+				Name:  name,
+				Phase: v1alpha1.ReleaseTrainPhasePending,
+			})
+		}
+		tc.newStatus.Waves = append(tc.newStatus.Waves, waveStatus)
+	}
+	tc.newStatus.Phase = v1alpha1.ReleaseTrainPhaseRunning
+	now := timeutil.MetaNow()
+	tc.newStatus.StartedAt = &now
+}
+
+// reconcileWave updates the Rollouts of the wave, at most maxConcurrent at a time, and runs the analysis of the wave
+// once all of them are healthy
+func (tc *trainContext) reconcileWave(wave v1alpha1.ReleaseTrainWave, waveStatus *v1alpha1.ReleaseTrainWaveStatus) {
+	waveStatus.Phase = v1alpha1.ReleaseTrainPhaseRunning
+	running := 0
+	for i := range waveStatus.Rollouts {
+		roStatus := &waveStatus.Rollouts[i]
+		if roStatus.Phase == v1alpha1.ReleaseTrainPhaseRunning {
+			tc.assessRollout(roStatus)
+		}
+		switch roStatus.Phase {
+		case v1alpha1.ReleaseTrainPhaseRunning:
+			running++
+		case v1alpha1.ReleaseTrainPhaseFailed:
+			waveStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+			waveStatus.Message = fmt.Sprintf("Rollout '%s' failed: %s", roStatus.Name, roStatus.Message)
+			return
+		}
+	}
+
+	maxConcurrent := len(waveStatus.Rollouts)
+	if wave.MaxConcurrent != nil {
+		maxConcurrent = int(*wave.MaxConcurrent)
+	}
+	pending := 0
+	for i := range waveStatus.Rollouts {
+		roStatus := &waveStatus.Rollouts[i]
+		if roStatus.Phase != v1alpha1.ReleaseTrainPhasePending {
+			continue
+		}
+		if running >= maxConcurrent {
+			pending++
+			continue
+		}
+		tc.startRollout(roStatus)
+		switch roStatus.Phase {
+		case v1alpha1.ReleaseTrainPhaseRunning:
+			running++
+		case v1alpha1.ReleaseTrainPhasePending:
+			// a rollout whose previous revision was recorded is updated on the next reconciliation
+			if roStatus.PreviousRevision != "" {
+				running++
+			} else {
+				pending++
+			}
+		case v1alpha1.ReleaseTrainPhaseFailed:
+			waveStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+			waveStatus.Message = fmt.Sprintf("Rollout '%s' failed: %s", roStatus.Name, roStatus.Message)
+			return
+		default:
+			pending++
+		}
+	}
+	if running > 0 || pending > 0 {
+		waveStatus.Message = fmt.Sprintf("%d of %d Rollouts released", len(waveStatus.Rollouts)-running-pending, len(waveStatus.Rollouts))
+		return
+	}
+
+	if wave.Analysis == nil {
+		waveStatus.Phase = v1alpha1.ReleaseTrainPhaseSuccessful
+		waveStatus.Message = ""
+		return
+	}
+	tc.reconcileAnalysis(wave, waveStatus)
+}
+
+// startRollout applies the change of the train to the pod template of the rollout
+func (tc *trainContext) startRollout(roStatus *v1alpha1.ReleaseTrainRolloutStatus) {
+	ro, err := tc.rolloutsLister.Rollouts(tc.train.Namespace).Get(roStatus.Name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+			roStatus.Message = "Rollout not found"
+			return
+		}
+		roStatus.Message = err.Error()
+		return
+	}
+	if ro.Spec.WorkloadRef != nil {
+		roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		roStatus.Message = "Rollouts which reference a workload are not supported"
+		return
+	}
+	template, err := tc.applyChange(ro.Spec.Template)
+	if err != nil {
+		roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		roStatus.Message = err.Error()
+		return
+	}
+	if !apiequality.Semantic.DeepEqual(template, ro.Spec.Template) {
+		// the previous revision is persisted in the status of the train before the rollout is updated, so that the
+		// rollout can always be rolled back to it
+		revision := ro.Annotations[annotations.RevisionAnnotation]
+		if roStatus.PreviousRevision != revision {
+			roStatus.PreviousRevision = revision
+			roStatus.Message = fmt.Sprintf("Recorded revision %s, updating Rollout", revision)
+			return
+		}
+		if err := tc.updateTemplate(ro, template); err != nil {
+			roStatus.Message = fmt.Sprintf("Failed to update Rollout: %v", err)
+			return
+		}
+		tc.log.Infof("Updated Rollout '%s' from revision %s", ro.Name, revision)
+	} else {
+		roStatus.PreviousRevision = ""
+	}
+	roStatus.Phase = v1alpha1.ReleaseTrainPhaseRunning
+	roStatus.Message = ""
+}
+
+// assessRollout checks whether a rollout which was started by the train completed its update
+func (tc *trainContext) assessRollout(roStatus *v1alpha1.ReleaseTrainRolloutStatus) {
+	ro, err := tc.rolloutsLister.Rollouts(tc.train.Namespace).Get(roStatus.Name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+			roStatus.Message = "Rollout not found"
+			return
+		}
+		roStatus.Message = err.Error()
+		return
+	}
+	template, err := tc.applyChange(ro.Spec.Template)
+	if err != nil {
+		roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		roStatus.Message = err.Error()
+		return
+	}
+	if !apiequality.Semantic.DeepEqual(template, ro.Spec.Template) {
+		// the change was reverted, or the update is not in the cache yet
+		if err := tc.updateTemplate(ro, template); err != nil {
+			roStatus.Message = fmt.Sprintf("Failed to update Rollout: %v", err)
+			return
+		}
+		roStatus.Message = "waiting for rollout spec update to be observed"
+		return
+	}
+	if ro.Status.Abort {
+		roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		roStatus.Message = "Rollout was aborted"
+		return
+	}
+	phase, message := rolloututil.GetRolloutPhase(ro)
+	switch phase {
+	case v1alpha1.RolloutPhaseHealthy:
+		roStatus.Phase = v1alpha1.ReleaseTrainPhaseSuccessful
+		roStatus.Message = ""
+	case v1alpha1.RolloutPhaseDegraded:
+		roStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		roStatus.Message = message
+	default:
+		roStatus.Message = message
+	}
+}
+
+// applyChange returns a copy of the pod template with the images and template patch of the train applied
+func (tc *trainContext) applyChange(template corev1.PodTemplateSpec) (corev1.PodTemplateSpec, error) {
+	newTemplate := template.DeepCopy()
+	for _, image := range tc.train.Spec.Images {
+		found := false
+		for i := range newTemplate.Spec.InitContainers {
+			if newTemplate.Spec.InitContainers[i].Name == image.Container {
+				newTemplate.Spec.InitContainers[i].Image = image.Image
+				found = true
+			}
+		}
+		for i := range newTemplate.Spec.Containers {
+			if newTemplate.Spec.Containers[i].Name == image.Container {
+				newTemplate.Spec.Containers[i].Image = image.Image
+				found = true
+			}
+		}
+		if !found {
+			return template, fmt.Errorf("container '%s' not found", image.Container)
+		}
+	}
+	if len(tc.train.Spec.TemplatePatch) == 0 {
+		return *newTemplate, nil
+	}
+	templateBytes, err := json.Marshal(newTemplate)
+	if err != nil {
+		return template, err
+	}
+	patchedBytes, err := jsonpatch.MergePatch(templateBytes, tc.train.Spec.TemplatePatch)
+	if err != nil {
+		return template, fmt.Errorf("failed to apply template patch: %w", err)
+	}
+	var patched corev1.PodTemplateSpec
+	if err := json.Unmarshal(patchedBytes, &patched); err != nil {
+		return template, fmt.Errorf("failed to apply template patch: %w", err)
+	}
+	return patched, nil
+}
+
+func (tc *trainContext) updateTemplate(ro *v1alpha1.Rollout, template corev1.PodTemplateSpec) error {
+	newRollout := ro.DeepCopy()
+	newRollout.Spec.Template = template
+	_, err := tc.argoProjClientset.ArgoprojV1alpha1().Rollouts(ro.Namespace).Update(context.TODO(), newRollout, metav1.UpdateOptions{})
+	return err
+}
+
+// reconcileAnalysis creates the AnalysisRun which gates the wave and assesses its result
+func (tc *trainContext) reconcileAnalysis(wave v1alpha1.ReleaseTrainWave, waveStatus *v1alpha1.ReleaseTrainWaveStatus) {
+	if waveStatus.AnalysisRun == "" {
+		run, err := tc.createAnalysisRun(wave)
+		if err != nil {
+			tc.log.Warnf("Failed to create AnalysisRun for wave '%s': %v", wave.Name, err)
+			waveStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+			waveStatus.Message = fmt.Sprintf("Failed to create AnalysisRun: %v", err)
+			return
+		}
+		tc.log.Infof("Created AnalysisRun '%s' for wave '%s'", run.Name, wave.Name)
+		waveStatus.AnalysisRun = run.Name
+		waveStatus.Message = fmt.Sprintf("Running AnalysisRun '%s'", run.Name)
+		return
+	}
+	run, err := tc.analysisRunLister.AnalysisRuns(tc.train.Namespace).Get(waveStatus.AnalysisRun)
+	if err != nil {
+		waveStatus.Message = fmt.Sprintf("Waiting for AnalysisRun '%s': %v", waveStatus.AnalysisRun, err)
+		return
+	}
+	switch run.Status.Phase {
+	case v1alpha1.AnalysisPhaseSuccessful:
+		waveStatus.Phase = v1alpha1.ReleaseTrainPhaseSuccessful
+		waveStatus.Message = ""
+	case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseInconclusive:
+		waveStatus.Phase = v1alpha1.ReleaseTrainPhaseFailed
+		waveStatus.Message = fmt.Sprintf("AnalysisRun '%s' completed with phase %s", run.Name, run.Status.Phase)
+		if run.Status.Message != "" {
+			waveStatus.Message = fmt.Sprintf("%s: %s", waveStatus.Message, run.Status.Message)
+		}
+	default:
+		waveStatus.Message = fmt.Sprintf("Running AnalysisRun '%s'", run.Name)
+	}
+}
+
+func (tc *trainContext) createAnalysisRun(wave v1alpha1.ReleaseTrainWave) (*v1alpha1.AnalysisRun, error) {
+	templates, clusterTemplates, err := tc.getAnalysisTemplatesFromRefs(wave.Analysis.Templates)
+	if err != nil {
+		return nil, err
+	}
+	runLabels := map[string]string{}
+	if instanceID := analysisutil.GetInstanceID(tc.train); instanceID != "" {
+		runLabels[v1alpha1.LabelKeyControllerInstanceID] = instanceID
+	}
+	name := fmt.Sprintf("%s-%s", tc.train.Name, wave.Name)
+	run, err := analysisutil.NewAnalysisRunFromTemplates(templates, clusterTemplates, wave.Analysis.Args, nil, nil, runLabels, map[string]string{}, name, "", tc.train.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(tc.train, controllerKind)}
+	analysisRunIf := tc.argoProjClientset.ArgoprojV1alpha1().AnalysisRuns(tc.train.Namespace)
+	return analysisutil.CreateWithCollisionCounter(tc.log, analysisRunIf, *run)
+}
+
+func (tc *trainContext) getAnalysisTemplatesFromRefs(templateRefs []v1alpha1.AnalysisTemplateRef) ([]*v1alpha1.AnalysisTemplate, []*v1alpha1.ClusterAnalysisTemplate, error) {
+	templates := make([]*v1alpha1.AnalysisTemplate, 0)
+	clusterTemplates := make([]*v1alpha1.ClusterAnalysisTemplate, 0)
+	for _, templateRef := range templateRefs {
+		var nested []v1alpha1.AnalysisTemplateRef
+		if templateRef.IsClusterScope() {
+			template, err := tc.clusterAnalysisTemplateLister.Get(templateRef.TemplateName)
+			if err != nil {
+				return nil, nil, err
+			}
+			clusterTemplates = append(clusterTemplates, template)
+			nested = template.Spec.Templates
+		} else {
+			template, err := tc.analysisTemplateLister.AnalysisTemplates(tc.train.Namespace).Get(templateRef.TemplateName)
+			if err != nil {
+				return nil, nil, err
+			}
+			templates = append(templates, template)
+			nested = template.Spec.Templates
+		}
+		if len(nested) > 0 {
+			innerTemplates, innerClusterTemplates, err := tc.getAnalysisTemplatesFromRefs(nested)
+			if err != nil {
+				return nil, nil, err
+			}
+			templates = append(templates, innerTemplates...)
+			clusterTemplates = append(clusterTemplates, innerClusterTemplates...)
+		}
+	}
+	uniqueTemplates, uniqueClusterTemplates := analysisutil.FilterUniqueTemplates(templates, clusterTemplates)
+	return uniqueTemplates, uniqueClusterTemplates, nil
+}
+
+// terminateAnalysis terminates the AnalysisRun of the current wave when the train is aborted
+func (tc *trainContext) terminateAnalysis() {
+	for _, waveStatus := range tc.newStatus.Waves {
+		if waveStatus.Phase != v1alpha1.ReleaseTrainPhaseRunning || waveStatus.AnalysisRun == "" {
+			continue
+		}
+		run, err := tc.analysisRunLister.AnalysisRuns(tc.train.Namespace).Get(waveStatus.AnalysisRun)
+		if err != nil || run.Status.Phase.Completed() || run.Spec.Terminate {
+			continue
+		}
+		analysisRunIf := tc.argoProjClientset.ArgoprojV1alpha1().AnalysisRuns(tc.train.Namespace)
+		if err := analysisutil.TerminateRun(analysisRunIf, run.Name); err != nil {
+			tc.log.Warnf("Failed to terminate AnalysisRun '%s': %v", run.Name, err)
+		}
+	}
+}
+
+// fail stops the train. When spec.rollbackOnFailure is set, the train only completes once every Rollout it updated
+// was restored to its previous revision, or could not be restored because that revision no longer exists.
+func (tc *trainContext) fail(message string) {
+	tc.log.Warn(message)
+	tc.newStatus.Message = message
+	phase := v1alpha1.ReleaseTrainPhaseFailed
+	if tc.train.Spec.RollbackOnFailure {
+		pending, failed := tc.rollback()
+		if pending > 0 {
+			tc.newStatus.Message = fmt.Sprintf("%s, rolling back %d Rollouts", message, pending)
+			return
+		}
+		phase = v1alpha1.ReleaseTrainPhaseRolledBack
+		if failed > 0 {
+			phase = v1alpha1.ReleaseTrainPhaseRollbackFailed
+			tc.newStatus.Message = fmt.Sprintf("%s, failed to roll back %d Rollouts", message, failed)
+		}
+	}
+	tc.newStatus.Phase = phase
+	now := timeutil.MetaNow()
+	tc.newStatus.FinishedAt = &now
+}
+
+// rollback restores the previous revision of every Rollout updated by the train. It returns the number of Rollouts
+// which could not be rolled back yet, and the number of Rollouts which cannot be rolled back because the ReplicaSet
+// of their previous revision no longer exists.
+func (tc *trainContext) rollback() (int, int) {
+	pending, failed := 0, 0
+	for i := range tc.newStatus.Waves {
+		for j := range tc.newStatus.Waves[i].Rollouts {
+			roStatus := &tc.newStatus.Waves[i].Rollouts[j]
+			switch {
+			case roStatus.PreviousRevision == "" || roStatus.Phase == v1alpha1.ReleaseTrainPhaseRolledBack:
+				continue
+			case roStatus.Phase == v1alpha1.ReleaseTrainPhaseRollbackFailed:
+				failed++
+				continue
+			}
+			err := tc.rollbackRollout(roStatus)
+			if errors.Is(err, errPreviousRevisionNotFound) {
+				tc.recorder.Eventf(tc.train, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: "RolloutRollbackFailed"}, "Failed to roll back Rollout '%s' to revision %s: %v", roStatus.Name, roStatus.PreviousRevision, err)
+				roStatus.Phase = v1alpha1.ReleaseTrainPhaseRollbackFailed
+				roStatus.Message = fmt.Sprintf("Failed to roll back to revision %s: %v", roStatus.PreviousRevision, err)
+				failed++
+				continue
+			}
+			if err != nil {
+				tc.log.Warnf("Failed to roll back Rollout '%s': %v", roStatus.Name, err)
+				roStatus.Message = fmt.Sprintf("Failed to roll back to revision %s: %v", roStatus.PreviousRevision, err)
+				pending++
+				continue
+			}
+			tc.recorder.Eventf(tc.train, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: "RolloutRolledBack"}, "Rolled back Rollout '%s' to revision %s", roStatus.Name, roStatus.PreviousRevision)
+			roStatus.Phase = v1alpha1.ReleaseTrainPhaseRolledBack
+			roStatus.Message = fmt.Sprintf("Rolled back to revision %s", roStatus.PreviousRevision)
+		}
+	}
+	return pending, failed
+}
+
+// errPreviousRevisionNotFound is returned when the ReplicaSet of the previous revision of a rollout was deleted, for
+// instance by the revision history limit of the rollout
+var errPreviousRevisionNotFound = errors.New("ReplicaSet of the revision not found")
+
+// rollbackRollout restores the pod template of the ReplicaSet of the previous revision of the rollout
+func (tc *trainContext) rollbackRollout(roStatus *v1alpha1.ReleaseTrainRolloutStatus) error {
+	ro, err := tc.rolloutsLister.Rollouts(tc.train.Namespace).Get(roStatus.Name)
+	if err != nil {
+		return err
+	}
+	rsList, err := tc.replicaSetLister.ReplicaSets(ro.Namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, rs := range rsList {
+		if !metav1.IsControlledBy(rs, ro) || rs.Annotations[annotations.RevisionAnnotation] != roStatus.PreviousRevision {
+			continue
+		}
+		template := rs.Spec.Template.DeepCopy()
+		delete(template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+		if apiequality.Semantic.DeepEqual(*template, ro.Spec.Template) {
+			return nil
+		}
+		return tc.updateTemplate(ro, *template)
+	}
+	return errPreviousRevisionNotFound
+}
+
+// validateSpec returns an error when the spec of a ReleaseTrain cannot be released
+func validateSpec(spec v1alpha1.ReleaseTrainSpec) error {
+	if len(spec.Images) == 0 && len(spec.TemplatePatch) == 0 {
+		return fmt.Errorf("images or templatePatch must be specified")
+	}
+	for _, image := range spec.Images {
+		if image.Container == "" || image.Image == "" {
+			return fmt.Errorf("images must specify a container and an image")
+		}
+	}
+	if len(spec.Waves) == 0 {
+		return fmt.Errorf("at least one wave must be specified")
+	}
+	waveNames := map[string]bool{}
+	rollouts := map[string]bool{}
+	for _, wave := range spec.Waves {
+		if wave.Name == "" {
+			return fmt.Errorf("waves must be named")
+		}
+		if waveNames[wave.Name] {
+			return fmt.Errorf("wave '%s' is specified more than once", wave.Name)
+		}
+		waveNames[wave.Name] = true
+		if len(wave.Rollouts) == 0 {
+			return fmt.Errorf("wave '%s' has no rollouts", wave.Name)
+		}
+		if wave.MaxConcurrent != nil && *wave.MaxConcurrent < 1 {
+			return fmt.Errorf("wave '%s' maxConcurrent must be at least 1", wave.Name)
+		}
+		if wave.Analysis != nil && len(wave.Analysis.Templates) == 0 {
+			return fmt.Errorf("wave '%s' analysis has no templates", wave.Name)
+		}
+		for _, name := range wave.Rollouts {
+			if rollouts[name] {
+				return fmt.Errorf("rollout '%s' is released more than once", name)
+			}
+			rollouts[name] = true
+		}
+	}
+	return nil
+}
@@ -0,0 +1,411 @@
+package releasetrain
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+func newTrainRollout(name, image string, phase v1alpha1.RolloutPhase) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   metav1.NamespaceDefault,
+			UID:         types.UID(name),
+			Annotations: map[string]string{annotations.RevisionAnnotation: "1"},
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": name}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "app", Image: image}},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			Phase: phase,
+		},
+	}
+}
+
+func newReleaseTrain(waves ...v1alpha1.ReleaseTrainWave) *v1alpha1.ReleaseTrain {
+	return &v1alpha1.ReleaseTrain{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "release",
+			Namespace: metav1.NamespaceDefault,
+			UID:       types.UID("release"),
+		},
+		Spec: v1alpha1.ReleaseTrainSpec{
+			Images: []v1alpha1.ReleaseTrainImage{{Container: "app", Image: "app:v2"}},
+			Waves:  waves,
+		},
+	}
+}
+
+// runTrain reconciles the train once against the given objects and returns the fake clientset
+func runTrain(t *testing.T, train *v1alpha1.ReleaseTrain, objects ...runtime.Object) *fake.Clientset {
+	t.Helper()
+	objects = append(objects, train)
+	var argoObjects, kubeObjects []runtime.Object
+	for _, obj := range objects {
+		if _, ok := obj.(*appsv1.ReplicaSet); ok {
+			kubeObjects = append(kubeObjects, obj)
+		} else {
+			argoObjects = append(argoObjects, obj)
+		}
+	}
+	client := fake.NewSimpleClientset(argoObjects...)
+	kubeclient := k8sfake.NewSimpleClientset(kubeObjects...)
+	i := informers.NewSharedInformerFactory(client, 0)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+
+	c := NewController(ControllerConfig{
+		ArgoProjClientset:               client,
+		ReleaseTrainInformer:            i.Argoproj().V1alpha1().ReleaseTrains(),
+		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
+		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		ReleaseTrainWorkQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ReleaseTrains"),
+		MetricsServer: metrics.NewMetricsServer(metrics.ServerConfig{
+			K8SRequestProvider: &metrics.K8sRequestsCountProvider{},
+		}),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	for _, obj := range objects {
+		var err error
+		switch o := obj.(type) {
+		case *v1alpha1.ReleaseTrain:
+			err = i.Argoproj().V1alpha1().ReleaseTrains().Informer().GetIndexer().Add(o)
+		case *v1alpha1.Rollout:
+			err = i.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer().Add(o)
+		case *v1alpha1.AnalysisTemplate:
+			err = i.Argoproj().V1alpha1().AnalysisTemplates().Informer().GetIndexer().Add(o)
+		case *v1alpha1.AnalysisRun:
+			err = i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer().Add(o)
+		case *appsv1.ReplicaSet:
+			err = k8sI.Apps().V1().ReplicaSets().Informer().GetIndexer().Add(o)
+		}
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.syncHandler(context.Background(), "default/release"))
+	return client
+}
+
+func getTrain(t *testing.T, client *fake.Clientset) *v1alpha1.ReleaseTrain {
+	t.Helper()
+	train, err := client.ArgoprojV1alpha1().ReleaseTrains(metav1.NamespaceDefault).Get(context.Background(), "release", metav1.GetOptions{})
+	require.NoError(t, err)
+	return train
+}
+
+func getImage(t *testing.T, client *fake.Clientset, name string) string {
+	t.Helper()
+	ro, err := client.ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+	require.NoError(t, err)
+	return ro.Spec.Template.Spec.Containers[0].Image
+}
+
+func TestReleaseTrainStartsFirstWave(t *testing.T) {
+	train := newReleaseTrain(
+		v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a", "b"}, MaxConcurrent: ptr.To[int32](1)},
+		v1alpha1.ReleaseTrainWave{Name: "rest", Rollouts: []string{"c"}},
+	)
+	rollouts := []runtime.Object{
+		newTrainRollout("a", "app:v1", v1alpha1.RolloutPhaseHealthy),
+		newTrainRollout("b", "app:v1", v1alpha1.RolloutPhaseHealthy),
+		newTrainRollout("c", "app:v1", v1alpha1.RolloutPhaseHealthy),
+	}
+	client := runTrain(t, train, rollouts...)
+
+	// the previous revision is recorded before the rollout is updated
+	assert.Equal(t, "app:v1", getImage(t, client, "a"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainRolloutStatus{Name: "a", Phase: v1alpha1.ReleaseTrainPhasePending, Message: "Recorded revision 1, updating Rollout", PreviousRevision: "1"}, status.Waves[0].Rollouts[0])
+	assert.Equal(t, v1alpha1.ReleaseTrainRolloutStatus{Name: "b", Phase: v1alpha1.ReleaseTrainPhasePending}, status.Waves[0].Rollouts[1])
+
+	train.Status = status
+	client = runTrain(t, train, rollouts...)
+
+	assert.Equal(t, "app:v2", getImage(t, client, "a"))
+	assert.Equal(t, "app:v1", getImage(t, client, "b"))
+	assert.Equal(t, "app:v1", getImage(t, client, "c"))
+	status = getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRunning, status.Phase)
+	assert.Equal(t, "canary", status.CurrentWave)
+	assert.NotNil(t, status.StartedAt)
+	require.Len(t, status.Waves, 2)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRunning, status.Waves[0].Phase)
+	assert.Equal(t, "0 of 2 Rollouts released", status.Waves[0].Message)
+	assert.Equal(t, v1alpha1.ReleaseTrainRolloutStatus{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}, status.Waves[0].Rollouts[0])
+	assert.Equal(t, v1alpha1.ReleaseTrainPhasePending, status.Waves[0].Rollouts[1].Phase)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhasePending, status.Waves[1].Phase)
+}
+
+func TestReleaseTrainMovesToNextWave(t *testing.T) {
+	train := newReleaseTrain(
+		v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}},
+		v1alpha1.ReleaseTrainWave{Name: "rest", Rollouts: []string{"b"}},
+	)
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:     "canary",
+			Phase:    v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}},
+		}, {
+			Name:     "rest",
+			Phase:    v1alpha1.ReleaseTrainPhasePending,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "b", Phase: v1alpha1.ReleaseTrainPhasePending}},
+		}},
+	}
+	client := runTrain(t, train,
+		newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseHealthy),
+		newTrainRollout("b", "app:v1", v1alpha1.RolloutPhaseHealthy),
+	)
+
+	assert.Equal(t, "app:v1", getImage(t, client, "b"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, "rest", status.CurrentWave)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseSuccessful, status.Waves[0].Phase)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseSuccessful, status.Waves[0].Rollouts[0].Phase)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhasePending, status.Waves[1].Rollouts[0].Phase)
+	assert.Equal(t, "1", status.Waves[1].Rollouts[0].PreviousRevision)
+}
+
+func TestReleaseTrainWaitsForRollout(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}})
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:     "canary",
+			Phase:    v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}},
+		}},
+	}
+	ro := newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseProgressing)
+	ro.Status.Message = "more replicas need to be updated"
+	client := runTrain(t, train, ro)
+
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRunning, status.Phase)
+	assert.Equal(t, "Releasing wave 'canary'", status.Message)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRunning, status.Waves[0].Rollouts[0].Phase)
+	assert.Equal(t, "more replicas need to be updated", status.Waves[0].Rollouts[0].Message)
+}
+
+func TestReleaseTrainSuccessful(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}})
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:     "canary",
+			Phase:    v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}},
+		}},
+	}
+	client := runTrain(t, train, newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseHealthy))
+
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseSuccessful, status.Phase)
+	assert.NotNil(t, status.FinishedAt)
+}
+
+func TestReleaseTrainCreatesAnalysisRun(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{
+		Name:     "canary",
+		Rollouts: []string{"a"},
+		Analysis: &v1alpha1.ReleaseTrainAnalysis{
+			Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke-test"}},
+		},
+	})
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:     "canary",
+			Phase:    v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}},
+		}},
+	}
+	template := &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "smoke-test", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:     "smoke",
+				Provider: v1alpha1.MetricProvider{Job: &v1alpha1.JobMetric{}},
+			}},
+		},
+	}
+	client := runTrain(t, train, newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseHealthy), template)
+
+	run, err := client.ArgoprojV1alpha1().AnalysisRuns(metav1.NamespaceDefault).Get(context.Background(), "release-canary", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, run.OwnerReferences, 1)
+	assert.Equal(t, "ReleaseTrain", run.OwnerReferences[0].Kind)
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRunning, status.Waves[0].Phase)
+	assert.Equal(t, "release-canary", status.Waves[0].AnalysisRun)
+}
+
+func TestReleaseTrainFailedAnalysisRollsBack(t *testing.T) {
+	train := newReleaseTrain(
+		v1alpha1.ReleaseTrainWave{
+			Name:     "canary",
+			Rollouts: []string{"a"},
+			Analysis: &v1alpha1.ReleaseTrainAnalysis{
+				Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke-test"}},
+			},
+		},
+		v1alpha1.ReleaseTrainWave{Name: "rest", Rollouts: []string{"b"}},
+	)
+	train.Spec.RollbackOnFailure = true
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:        "canary",
+			Phase:       v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts:    []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseSuccessful, PreviousRevision: "1"}},
+			AnalysisRun: "release-canary",
+		}, {
+			Name:     "rest",
+			Phase:    v1alpha1.ReleaseTrainPhasePending,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "b", Phase: v1alpha1.ReleaseTrainPhasePending}},
+		}},
+	}
+	ro := newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseHealthy)
+	ro.Annotations[annotations.RevisionAnnotation] = "2"
+	previous := newTrainRollout("a", "app:v1", v1alpha1.RolloutPhaseHealthy)
+	previous.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = "abc123"
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "a-abc123",
+			Namespace:       metav1.NamespaceDefault,
+			Annotations:     map[string]string{annotations.RevisionAnnotation: "1"},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{Template: previous.Spec.Template},
+	}
+	run := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "release-canary", Namespace: metav1.NamespaceDefault},
+		Status:     v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseFailed, Message: "metric \"smoke\" assessed Failed"},
+	}
+	client := runTrain(t, train, ro, newTrainRollout("b", "app:v1", v1alpha1.RolloutPhaseHealthy), rs, run)
+
+	assert.Equal(t, "app:v1", getImage(t, client, "a"))
+	restored, err := client.ArgoprojV1alpha1().Rollouts(metav1.NamespaceDefault).Get(context.Background(), "a", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotContains(t, restored.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	assert.Equal(t, "app:v1", getImage(t, client, "b"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRolledBack, status.Phase)
+	assert.Equal(t, "Wave 'canary' failed: AnalysisRun 'release-canary' completed with phase Failed: metric \"smoke\" assessed Failed", status.Message)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRolledBack, status.Waves[0].Rollouts[0].Phase)
+	assert.Equal(t, "Rolled back to revision 1", status.Waves[0].Rollouts[0].Message)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhasePending, status.Waves[1].Phase)
+}
+
+func TestReleaseTrainRollbackFailsWithoutPreviousReplicaSet(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}})
+	train.Spec.RollbackOnFailure = true
+	train.Spec.Abort = true
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:     "canary",
+			Phase:    v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}},
+		}},
+	}
+	// the ReplicaSet of revision 1 was garbage collected
+	ro := newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseHealthy)
+	ro.Annotations[annotations.RevisionAnnotation] = "2"
+	client := runTrain(t, train, ro)
+
+	assert.Equal(t, "app:v2", getImage(t, client, "a"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRollbackFailed, status.Phase)
+	assert.Equal(t, "ReleaseTrain aborted, failed to roll back 1 Rollouts", status.Message)
+	assert.NotNil(t, status.FinishedAt)
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseRollbackFailed, status.Waves[0].Rollouts[0].Phase)
+	assert.Equal(t, "Failed to roll back to revision 1: ReplicaSet of the revision not found", status.Waves[0].Rollouts[0].Message)
+}
+
+func TestReleaseTrainDegradedRolloutFails(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}})
+	train.Status = v1alpha1.ReleaseTrainStatus{
+		Phase: v1alpha1.ReleaseTrainPhaseRunning,
+		Waves: []v1alpha1.ReleaseTrainWaveStatus{{
+			Name:     "canary",
+			Phase:    v1alpha1.ReleaseTrainPhaseRunning,
+			Rollouts: []v1alpha1.ReleaseTrainRolloutStatus{{Name: "a", Phase: v1alpha1.ReleaseTrainPhaseRunning, PreviousRevision: "1"}},
+		}},
+	}
+	ro := newTrainRollout("a", "app:v2", v1alpha1.RolloutPhaseDegraded)
+	ro.Status.Message = "ProgressDeadlineExceeded"
+	client := runTrain(t, train, ro)
+
+	assert.Equal(t, "app:v2", getImage(t, client, "a"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseFailed, status.Phase)
+	assert.Equal(t, "Wave 'canary' failed: Rollout 'a' failed: ProgressDeadlineExceeded", status.Message)
+}
+
+func TestReleaseTrainAbort(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}})
+	train.Spec.Abort = true
+	client := runTrain(t, train, newTrainRollout("a", "app:v1", v1alpha1.RolloutPhaseHealthy))
+
+	assert.Equal(t, "app:v1", getImage(t, client, "a"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseFailed, status.Phase)
+	assert.Equal(t, "ReleaseTrain aborted", status.Message)
+}
+
+func TestReleaseTrainInvalidSpec(t *testing.T) {
+	train := newReleaseTrain(
+		v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}},
+		v1alpha1.ReleaseTrainWave{Name: "rest", Rollouts: []string{"a"}},
+	)
+	client := runTrain(t, train, newTrainRollout("a", "app:v1", v1alpha1.RolloutPhaseHealthy))
+
+	assert.Equal(t, "app:v1", getImage(t, client, "a"))
+	status := getTrain(t, client).Status
+	assert.Equal(t, v1alpha1.ReleaseTrainPhaseFailed, status.Phase)
+	assert.Equal(t, "Invalid spec: rollout 'a' is released more than once", status.Message)
+}
+
+func TestApplyChange(t *testing.T) {
+	train := newReleaseTrain(v1alpha1.ReleaseTrainWave{Name: "canary", Rollouts: []string{"a"}})
+	train.Spec.TemplatePatch = json.RawMessage(`{"metadata":{"annotations":{"example.com/release":"2024.2"}}}`)
+	tc := &trainContext{train: train}
+
+	template, err := tc.applyChange(newTrainRollout("a", "app:v1", v1alpha1.RolloutPhaseHealthy).Spec.Template)
+	require.NoError(t, err)
+	assert.Equal(t, "app:v2", template.Spec.Containers[0].Image)
+	assert.Equal(t, "2024.2", template.Annotations["example.com/release"])
+	assert.Equal(t, "a", template.Labels["app"])
+
+	train.Spec.Images[0].Container = "sidecar"
+	_, err = tc.applyChange(newTrainRollout("a", "app:v1", v1alpha1.RolloutPhaseHealthy).Spec.Template)
+	assert.EqualError(t, err, "container 'sidecar' not found")
+}
@@ -3,6 +3,7 @@ package rollout
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -54,6 +55,8 @@ func (c *Controller) getAnalysisRunsForRollout(rollout *v1alpha1.Rollout) ([]*v1
 		rollout.Status.Canary.CurrentStepAnalysisRunStatus,
 		rollout.Status.BlueGreen.PrePromotionAnalysisRunStatus,
 		rollout.Status.BlueGreen.PostPromotionAnalysisRunStatus,
+		rollout.Status.DaemonSet.CurrentBackgroundAnalysisRunStatus,
+		rollout.Status.DaemonSet.CurrentStepAnalysisRunStatus,
 	}
 	for _, arStatus := range arStatuses {
 		if arStatus == nil || seen[arStatus.Name] {
@@ -114,6 +117,18 @@ func (c *rolloutContext) reconcileAnalysisRuns() error {
 		}
 		c.setPauseOrAbortForBlueGreen(postPromotionAr, true)
 		newCurrentAnalysisRuns.BlueGreenPostPromotion = postPromotionAr
+	} else if c.rollout.Spec.Strategy.DaemonSet != nil {
+		stepAnalysisRun, err := c.reconcileDaemonSetStepAnalysisRun()
+		if err != nil {
+			return err
+		}
+		newCurrentAnalysisRuns.DaemonSetStep = stepAnalysisRun
+
+		backgroundAnalysisRun, err := c.reconcileDaemonSetBackgroundAnalysisRun()
+		if err != nil {
+			return err
+		}
+		newCurrentAnalysisRuns.DaemonSetBackground = backgroundAnalysisRun
 	}
 	c.SetCurrentAnalysisRuns(newCurrentAnalysisRuns)
 
@@ -187,6 +202,7 @@ func needsNewAnalysisRun(currentAr *v1alpha1.AnalysisRun, rollout *v1alpha1.Roll
 	// because of termination when the AnalysisRun is still in-flight.
 	if rollout.Status.ControllerPause &&
 		getPauseCondition(rollout, v1alpha1.PauseReasonCanaryPauseStep) == nil &&
+		getPauseCondition(rollout, v1alpha1.PauseReasonDaemonSetPauseStep) == nil &&
 		getPauseCondition(rollout, v1alpha1.PauseReasonBlueGreenPause) == nil {
 
 		return currentAr.Status.Phase == v1alpha1.AnalysisPhaseInconclusive
@@ -239,6 +255,18 @@ func (c *rolloutContext) reconcileAnalysisRunStatusChanges(currARs analysisutil.
 		currARs.CanaryBackground,
 		v1alpha1.RolloutTypeBackgroundRunLabel,
 	)
+
+	c.emitAnalysisRunStatusChanges(
+		c.rollout.Status.DaemonSet.CurrentStepAnalysisRunStatus,
+		currARs.DaemonSetStep,
+		v1alpha1.RolloutTypeStepLabel,
+	)
+
+	c.emitAnalysisRunStatusChanges(
+		c.rollout.Status.DaemonSet.CurrentBackgroundAnalysisRunStatus,
+		currARs.DaemonSetBackground,
+		v1alpha1.RolloutTypeBackgroundRunLabel,
+	)
 }
 
 func (c *rolloutContext) reconcilePrePromotionAnalysisRun() (*v1alpha1.AnalysisRun, error) {
@@ -348,14 +376,22 @@ func (c *rolloutContext) reconcilePostPromotionAnalysisRun() (*v1alpha1.Analysis
 }
 
 func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun, error) {
-	currentAr := c.currentArs.CanaryBackground
-	if c.rollout.Spec.Strategy.Canary.Analysis == nil || len(c.rollout.Spec.Strategy.Canary.Analysis.Templates) == 0 {
+	return c.reconcileBackgroundAnalysis(c.rollout.Spec.Strategy.Canary.Analysis, replicasetutil.BeforeStartingStep(c.rollout), c.currentArs.CanaryBackground)
+}
+
+func (c *rolloutContext) reconcileDaemonSetBackgroundAnalysisRun() (*v1alpha1.AnalysisRun, error) {
+	return c.reconcileBackgroundAnalysis(c.rollout.Spec.Strategy.DaemonSet.Analysis, replicasetutil.DaemonSetBeforeStartingStep(c.rollout), c.currentArs.DaemonSetBackground)
+}
+
+// reconcileBackgroundAnalysis reconciles the background AnalysisRun of a step-based strategy
+func (c *rolloutContext) reconcileBackgroundAnalysis(analysis *v1alpha1.RolloutAnalysisBackground, beforeStartingStep bool, currentAr *v1alpha1.AnalysisRun) (*v1alpha1.AnalysisRun, error) {
+	if analysis == nil || len(analysis.Templates) == 0 {
 		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
 		return nil, err
 	}
 
 	// Do not create a background run if the rollout is completely rolled out, just created, before the starting step
-	if rolloututil.IsFullyPromoted(c.rollout) || c.rollout.Status.StableRS == "" || c.rollout.Status.CurrentPodHash == "" || replicasetutil.BeforeStartingStep(c.rollout) {
+	if rolloututil.IsFullyPromoted(c.rollout) || c.rollout.Status.StableRS == "" || c.rollout.Status.CurrentPodHash == "" || beforeStartingStep {
 		return nil, nil
 	}
 
@@ -367,7 +403,7 @@ func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun
 		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
 		instanceID := analysisutil.GetInstanceID(c.rollout)
 		backgroundLabels := analysisutil.BackgroundLabels(podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(&c.rollout.Spec.Strategy.Canary.Analysis.RolloutAnalysis, "", backgroundLabels)
+		currentAr, err := c.createAnalysisRun(&analysis.RolloutAnalysis, "", backgroundLabels)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Info("Created background AnalysisRun")
 		}
@@ -386,6 +422,47 @@ func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun
 	return currentAr, nil
 }
 
+// weightRampMeasurement returns the latest successful measurement of the background analysis metric which gates a
+// setWeightRamp step. Only measurements which finished after the last increment are considered, so that every
+// increment is decided from the behavior of the canary at its current weight.
+func (c *rolloutContext) weightRampMeasurement(rampAnalysis *v1alpha1.WeightRampAnalysis, since metav1.Time) *v1alpha1.Measurement {
+	currentAr := c.currentArs.CanaryBackground
+	if currentAr == nil {
+		return nil
+	}
+	result := analysisutil.GetResult(currentAr, rampAnalysis.MetricName)
+	if result == nil {
+		return nil
+	}
+	for i := len(result.Measurements) - 1; i >= 0; i-- {
+		measurement := result.Measurements[i]
+		if measurement.FinishedAt == nil {
+			continue
+		}
+		if measurement.FinishedAt.Before(&since) {
+			return nil
+		}
+		if measurement.Phase == v1alpha1.AnalysisPhaseSuccessful {
+			return &measurement
+		}
+	}
+	return nil
+}
+
+// weightRampIncrementFromValue scales a measurement value between 0 and 1 to an increment between the minimum and
+// maximum increment of the ramp. Values outside of the range are clamped. A vector result, such as the one returned
+// by Prometheus, uses its first element.
+func weightRampIncrementFromValue(value string, rampAnalysis *v1alpha1.WeightRampAnalysis) (int32, error) {
+	first, _, _ := strings.Cut(strings.Trim(value, "[]"), ",")
+	f, err := strconv.ParseFloat(strings.TrimSpace(first), 64)
+	if err != nil || math.IsNaN(f) {
+		return 0, fmt.Errorf("measurement value '%s' of metric '%s' is not a number", value, rampAnalysis.MetricName)
+	}
+	f = math.Min(math.Max(f, 0), 1)
+	spread := float64(rampAnalysis.MaxIncrement - rampAnalysis.MinIncrement)
+	return rampAnalysis.MinIncrement + int32(math.Round(f*spread)), nil
+}
+
 func (c *rolloutContext) createAnalysisRun(rolloutAnalysis *v1alpha1.RolloutAnalysis, infix string, labels map[string]string) (*v1alpha1.AnalysisRun, error) {
 	args, err := analysisutil.BuildArgumentsForRolloutAnalysisRun(rolloutAnalysis.Args, c.stableRS, c.newRS, c.rollout)
 	if err != nil {
@@ -406,16 +483,33 @@ func (c *rolloutContext) createAnalysisRun(rolloutAnalysis *v1alpha1.RolloutAnal
 
 func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun, error) {
 	step, index := replicasetutil.GetCurrentCanaryStep(c.rollout)
-	currentAr := c.currentArs.CanaryStep
+	var analysis *v1alpha1.RolloutAnalysis
+	if step != nil {
+		analysis = step.Analysis
+	}
+	return c.reconcileStepAnalysis(analysis, index, c.currentArs.CanaryStep)
+}
+
+func (c *rolloutContext) reconcileDaemonSetStepAnalysisRun() (*v1alpha1.AnalysisRun, error) {
+	step, index := replicasetutil.GetCurrentDaemonSetStep(c.rollout)
+	var analysis *v1alpha1.RolloutAnalysis
+	if step != nil {
+		analysis = step.Analysis
+	}
+	return c.reconcileStepAnalysis(analysis, index, c.currentArs.DaemonSetStep)
+}
 
+// reconcileStepAnalysis reconciles the AnalysisRun of the analysis of the current step of a step-based strategy. The
+// analysis is nil if the current step is not an analysis step.
+func (c *rolloutContext) reconcileStepAnalysis(analysis *v1alpha1.RolloutAnalysis, index *int32, currentAr *v1alpha1.AnalysisRun) (*v1alpha1.AnalysisRun, error) {
 	if len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Status.Abort {
 		return currentAr, nil
 	}
 
 	// for promotion cases
-	analysisRunFromPreviousStep := step != nil && step.Analysis != nil && currentAr != nil && currentAr.GetLabels()[v1alpha1.RolloutCanaryStepIndexLabel] != strconv.Itoa(int(*index))
+	analysisRunFromPreviousStep := analysis != nil && index != nil && currentAr != nil && currentAr.GetLabels()[v1alpha1.RolloutCanaryStepIndexLabel] != strconv.Itoa(int(*index))
 
-	if step == nil || step.Analysis == nil || len(step.Analysis.Templates) == 0 || index == nil || analysisRunFromPreviousStep {
+	if analysis == nil || len(analysis.Templates) == 0 || index == nil || analysisRunFromPreviousStep {
 		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
 		return nil, err
 	}
@@ -424,7 +518,7 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
 		instanceID := analysisutil.GetInstanceID(c.rollout)
 		stepLabels := analysisutil.StepLabels(*index, podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(step.Analysis, strconv.Itoa(int(*index)), stepLabels)
+		currentAr, err := c.createAnalysisRun(analysis, strconv.Itoa(int(*index)), stepLabels)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Infof("Created AnalysisRun for step '%d'", *index)
 		}
@@ -3224,3 +3224,115 @@ func TestSkipPostPromotionAnalysisRun(t *testing.T) {
 		assert.False(t, result, "Should not skip when currentAr is not nil, even if ReplicaSet becomes unsaturated")
 	})
 }
+
+func TestWeightRampIncrementFromValue(t *testing.T) {
+	rampAnalysis := &v1alpha1.WeightRampAnalysis{MetricName: "headroom", MinIncrement: 5, MaxIncrement: 25}
+	tests := []struct {
+		value     string
+		increment int32
+	}{
+		{"0", 5},
+		{"0.5", 15},
+		{"1", 25},
+		{"[0.25]", 10},
+		{"[0.75,0.1]", 20},
+		{"-3", 5},
+		{"1.7", 25},
+	}
+	for _, test := range tests {
+		increment, err := weightRampIncrementFromValue(test.value, rampAnalysis)
+		assert.NoError(t, err)
+		assert.Equal(t, test.increment, increment, test.value)
+	}
+
+	_, err := weightRampIncrementFromValue("[]", rampAnalysis)
+	assert.EqualError(t, err, "measurement value '[]' of metric 'headroom' is not a number")
+}
+
+func TestAnalysisGatedWeightRamp(t *testing.T) {
+	newRamp := func(f *fixture, measurementFinishedAt time.Time) *v1alpha1.Rollout {
+		at := analysisTemplate("bar")
+		steps := []v1alpha1.CanaryStep{{
+			SetWeightRamp: &v1alpha1.SetWeightRamp{
+				From:     10,
+				To:       50,
+				Interval: "1m",
+				Analysis: &v1alpha1.WeightRampAnalysis{MetricName: "headroom", MinIncrement: 5, MaxIncrement: 25},
+			},
+		}}
+		r1 := newCanaryRollout("foo", 10, nil, steps, ptr.To[int32](0), intstr.FromInt(1), intstr.FromInt(0))
+		r2 := bumpVersion(r1)
+		r2.Spec.Strategy.Canary.Analysis = &v1alpha1.RolloutAnalysisBackground{
+			RolloutAnalysis: v1alpha1.RolloutAnalysis{
+				Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+			},
+		}
+		ar := analysisRun(at, v1alpha1.RolloutTypeBackgroundRunLabel, r2)
+		finishedAt := metav1.NewTime(measurementFinishedAt)
+		ar.Status = v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:  "headroom",
+				Phase: v1alpha1.AnalysisPhaseRunning,
+				Measurements: []v1alpha1.Measurement{
+					{Phase: v1alpha1.AnalysisPhaseSuccessful, Value: "[0.5]", FinishedAt: &finishedAt},
+					{Phase: v1alpha1.AnalysisPhaseRunning},
+				},
+			}},
+		}
+
+		rs1 := newReplicaSetWithStatus(r1, 9, 9)
+		rs2 := newReplicaSetWithStatus(r2, 1, 1)
+		f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+		f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+		rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+		r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, false)
+		r2.Status.Canary.CurrentBackgroundAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+			Name:   ar.Name,
+			Status: v1alpha1.AnalysisPhaseRunning,
+		}
+		r2.Status.Canary.WeightRamp = &v1alpha1.WeightRampStatus{
+			StepIndex:         0,
+			Weight:            10,
+			LastIncrementTime: metav1.NewTime(timeutil.Now().Add(-2 * time.Minute)),
+		}
+
+		f.rolloutLister = append(f.rolloutLister, r2)
+		f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+		f.analysisRunLister = append(f.analysisRunLister, ar)
+		f.objects = append(f.objects, r2, at, ar)
+		return r2
+	}
+
+	t.Run("increment is chosen from the measurement", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		r2 := newRamp(f, timeutil.Now().Add(-30*time.Second))
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patched := f.getPatchedRolloutAsObject(patchIndex)
+		rampStatus := patched.Status.Canary.WeightRamp
+		assert.NotNil(t, rampStatus)
+		assert.Equal(t, int32(25), rampStatus.Weight)
+		assert.Len(t, rampStatus.Increments, 1)
+		assert.Equal(t, int32(15), rampStatus.Increments[0].Increment)
+		assert.Equal(t, int32(25), rampStatus.Increments[0].Weight)
+		assert.Equal(t, "[0.5]", rampStatus.Increments[0].MeasurementValue)
+	})
+
+	t.Run("waits for a measurement after the last increment", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		r2 := newRamp(f, timeutil.Now().Add(-5*time.Minute))
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		// the ramp status is kept as is, so the patch does not touch it
+		patch := f.getPatchedRollout(patchIndex)
+		assert.NotContains(t, patch, `"weightRamp"`)
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
 func (c *rolloutContext) rolloutCanary() error {
@@ -82,6 +83,8 @@ func (c *rolloutContext) rolloutCanary() error {
 		return err
 	}
 
+	c.reconcileClusters()
+
 	noScalingOccurred, err := c.reconcileCanaryReplicaSets()
 	if err != nil {
 		return err
@@ -305,7 +308,7 @@ func (c *rolloutContext) canProceedWithScaleDownAnnotation(oldRSs []*appsv1.Repl
 }
 
 func (c *rolloutContext) completedCurrentCanaryStep() bool {
-	if c.rollout.Spec.Paused {
+	if c.rollout.Spec.Paused || c.revisionHold != "" {
 		return false
 	}
 	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
@@ -315,8 +318,21 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 	switch {
 	case currentStep.Pause != nil:
 		return c.pauseContext.CompletedCanaryPauseStep(*currentStep.Pause)
+	case c.statefulSet != nil && (currentStep.SetCanaryScale != nil || currentStep.SetWeight != nil):
+		return c.completedStatefulSetStep()
 	case currentStep.SetCanaryScale != nil:
 		return replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs, c.newStatus.Canary.Weights)
+	case currentStep.SetWeightRamp != nil:
+		if replicasetutil.GetWeightRampWeight(c.rollout) != currentStep.SetWeightRamp.To {
+			return false
+		}
+		if !replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs, c.newStatus.Canary.Weights) {
+			return false
+		}
+		if c.newStatus.Canary.Weights != nil && c.newStatus.Canary.Weights.Verified != nil && !*c.newStatus.Canary.Weights.Verified {
+			return false
+		}
+		return true
 	case currentStep.SetWeight != nil:
 		if !replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs, c.newStatus.Canary.Weights) {
 			return false
@@ -326,6 +342,8 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 			return false
 		}
 		return true
+	case currentStep.SetClusterWeight != nil:
+		return c.completedClusterWeightStep(currentStep.SetClusterWeight)
 	case currentStep.Experiment != nil:
 		experiment := c.currentEx
 		return experiment != nil && experiment.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
@@ -351,6 +369,10 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 
 	newStatus.Canary.StablePingPong = c.rollout.Status.Canary.StablePingPong
 	newStatus.Canary.StepPluginStatuses = c.rollout.Status.Canary.StepPluginStatuses
+	newStatus.Canary.WeightRamp = c.rollout.Status.Canary.WeightRamp
+	if newStatus.Clusters == nil && len(c.rollout.Spec.Clusters) > 0 {
+		newStatus.Clusters = c.rollout.Status.Clusters
+	}
 	c.stepPluginContext.updateStatus(&newStatus)
 
 	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
@@ -365,8 +387,9 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 				// If we get here, we detected that we've moved back to the stable ReplicaSet
 				c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: "SkipSteps"}, "Rollback to stable ReplicaSets")
 				newStatus.CurrentStepIndex = &stepCount
-			} else if c.isRollbackWithinWindow() && replicasetutil.IsActive(c.newRS) {
-				// Else if we get here we detected that we are within the rollback window we can skip steps and move back to the active ReplicaSet
+			} else if c.isRollbackWithinWindow() && (replicasetutil.IsActive(c.newRS) || c.statefulSet != nil) {
+				// Else if we get here we detected that we are within the rollback window we can skip steps and move back to the active ReplicaSet.
+				// The revisions of a StatefulSet have no pods of their own to keep active, so any revision within the window qualifies.
 				c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: "SkipSteps"}, "Rollback to active ReplicaSets within RollbackWindow")
 				newStatus.CurrentStepIndex = &stepCount
 			}
@@ -401,8 +424,15 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 		return c.persistRolloutStatus(&newStatus)
 	}
 
+	if currentStep != nil && currentStep.SetWeightRamp != nil {
+		c.reconcileWeightRamp(&newStatus, currentStep.SetWeightRamp, *currentStepIndex)
+	}
+
 	if c.completedCurrentCanaryStep() {
 		stepStr := rolloututil.CanaryStepString(*currentStep)
+		if currentStep.SetWeightRamp != nil {
+			newStatus.Canary.WeightRamp = nil
+		}
 		*currentStepIndex++
 		newStatus.Canary.CurrentStepAnalysisRunStatus = nil
 
@@ -414,6 +444,70 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 	return c.persistRolloutStatus(&newStatus)
 }
 
+// reconcileWeightRamp advances the setWeightRamp step at the given index. The weight is raised by
+// the increment once the canary has reached the current weight and the interval since the last
+// increment has elapsed. The position of the ramp is recorded in the status, which is left in
+// place on an abort so that the ramp resumes from the same weight when the rollout is retried.
+func (c *rolloutContext) reconcileWeightRamp(newStatus *v1alpha1.RolloutStatus, ramp *v1alpha1.SetWeightRamp, stepIndex int32) {
+	now := timeutil.MetaNow()
+	if newStatus.Canary.WeightRamp == nil || newStatus.Canary.WeightRamp.StepIndex != stepIndex {
+		newStatus.Canary.WeightRamp = &v1alpha1.WeightRampStatus{
+			StepIndex:         stepIndex,
+			Weight:            ramp.From,
+			LastIncrementTime: now,
+		}
+		c.log.Infof("Starting weight ramp at %d", ramp.From)
+	}
+	rampStatus := newStatus.Canary.WeightRamp
+	if rampStatus.Weight >= ramp.To || c.haltProgress() != "" {
+		return
+	}
+	interval, err := ramp.Interval.Duration()
+	if err != nil {
+		c.log.Warnf("Invalid setWeightRamp interval '%s': %v", ramp.Interval, err)
+		return
+	}
+	if !replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs, newStatus.Canary.Weights) {
+		return
+	}
+	if newStatus.Canary.Weights != nil && newStatus.Canary.Weights.Verified != nil && !*newStatus.Canary.Weights.Verified {
+		return
+	}
+	nextIncrement := rampStatus.LastIncrementTime.Add(interval)
+	if now.Time.Before(nextIncrement) {
+		c.checkEnqueueRolloutDuringWait(rampStatus.LastIncrementTime, int32(interval.Seconds()))
+		return
+	}
+	increment := ramp.Increment
+	increments := rampStatus.Increments
+	if ramp.Analysis != nil {
+		measurement := c.weightRampMeasurement(ramp.Analysis, rampStatus.LastIncrementTime)
+		if measurement == nil {
+			c.log.Infof("Waiting for a measurement of metric '%s' to raise weight ramp", ramp.Analysis.MetricName)
+			return
+		}
+		increment, err = weightRampIncrementFromValue(measurement.Value, ramp.Analysis)
+		if err != nil {
+			c.log.Warnf("Using minimum increment for weight ramp: %v", err)
+			increment = ramp.Analysis.MinIncrement
+		}
+		increments = append(increments, v1alpha1.WeightRampIncrement{
+			Increment:        increment,
+			Weight:           min(rampStatus.Weight+increment, ramp.To),
+			MeasurementValue: measurement.Value,
+			Time:             now,
+		})
+	}
+	weight := min(rampStatus.Weight+increment, ramp.To)
+	c.log.Infof("Raising weight ramp from %d to %d", rampStatus.Weight, weight)
+	newStatus.Canary.WeightRamp = &v1alpha1.WeightRampStatus{
+		StepIndex:         stepIndex,
+		Weight:            weight,
+		LastIncrementTime: now,
+		Increments:        increments,
+	}
+}
+
 func (c *rolloutContext) reconcileCanaryReplicaSets() (bool, error) {
 	if haltReason := c.haltProgress(); haltReason != "" {
 		c.log.Infof("Skipping canary/stable ReplicaSet reconciliation: %s", haltReason)
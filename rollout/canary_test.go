@@ -2179,3 +2179,102 @@ func TestIsDynamicallyRollingBackToStable(t *testing.T) {
 		})
 	}
 }
+
+func newWeightRampRollout(f *fixture, canaryReplicas int, rampStatus *v1alpha1.WeightRampStatus) (*v1alpha1.Rollout, *appsv1.ReplicaSet) {
+	steps := []v1alpha1.CanaryStep{{
+		SetWeightRamp: &v1alpha1.SetWeightRamp{From: 10, To: 30, Increment: 10, Interval: "1m"},
+	}, {
+		Pause: &v1alpha1.RolloutPause{},
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 10-canaryReplicas, 10-canaryReplicas)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, canaryReplicas, canaryReplicas)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, int32(canaryReplicas), 10, false)
+	r2.Status.Canary.WeightRamp = rampStatus
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	return r2, rs2
+}
+
+func TestCanaryRolloutWeightRamp(t *testing.T) {
+	t.Run("starts the ramp at the initial weight", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		r2, _ := newWeightRampRollout(f, 1, nil)
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patched := f.getPatchedRolloutAsObject(patchIndex)
+		assert.NotNil(t, patched.Status.Canary.WeightRamp)
+		assert.Equal(t, int32(0), patched.Status.Canary.WeightRamp.StepIndex)
+		assert.Equal(t, int32(10), patched.Status.Canary.WeightRamp.Weight)
+		assert.Nil(t, patched.Status.CurrentStepIndex)
+	})
+
+	t.Run("raises the weight once the interval has elapsed", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		earlier := timeutil.MetaNow()
+		earlier.Time = earlier.Add(-2 * time.Minute)
+		r2, _ := newWeightRampRollout(f, 1, &v1alpha1.WeightRampStatus{StepIndex: 0, Weight: 10, LastIncrementTime: earlier})
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patched := f.getPatchedRolloutAsObject(patchIndex)
+		assert.NotNil(t, patched.Status.Canary.WeightRamp)
+		assert.Equal(t, int32(20), patched.Status.Canary.WeightRamp.Weight)
+		assert.True(t, patched.Status.Canary.WeightRamp.LastIncrementTime.After(earlier.Time))
+	})
+
+	t.Run("waits for the canary to reach the current weight", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		earlier := timeutil.MetaNow()
+		earlier.Time = earlier.Add(-2 * time.Minute)
+		r2, rs2 := newWeightRampRollout(f, 1, &v1alpha1.WeightRampStatus{StepIndex: 0, Weight: 20, LastIncrementTime: earlier})
+		f.expectUpdateReplicaSetAction(rs2)
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		// the ramp status is kept as is, so the patch does not touch it
+		patch := f.getPatchedRollout(patchIndex)
+		assert.NotContains(t, patch, `"weightRamp"`)
+	})
+
+	t.Run("completes the step at the final weight", func(t *testing.T) {
+		f := newFixture(t)
+		defer f.Close()
+		r2, _ := newWeightRampRollout(f, 3, &v1alpha1.WeightRampStatus{StepIndex: 0, Weight: 30, LastIncrementTime: timeutil.MetaNow()})
+
+		patchIndex := f.expectPatchRolloutAction(r2)
+		f.run(getKey(r2, t))
+
+		patch := f.getPatchedRollout(patchIndex)
+		assert.Contains(t, patch, `"weightRamp":null`)
+		assert.Contains(t, patch, `"currentStepIndex":1`)
+	})
+}
+
+func TestCanaryRolloutWeightRampNoProgressWhilePaused(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	earlier := timeutil.MetaNow()
+	earlier.Time = earlier.Add(-2 * time.Minute)
+	r2, _ := newWeightRampRollout(f, 1, &v1alpha1.WeightRampStatus{StepIndex: 0, Weight: 10, LastIncrementTime: earlier})
+	r2.Spec.Paused = true
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the ramp status is kept as is, so the patch does not touch it
+	patch := f.getPatchedRollout(patchIndex)
+	assert.NotContains(t, patch, `"weightRamp"`)
+}
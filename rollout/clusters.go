@@ -0,0 +1,233 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/multicluster"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// reconcileClusters mirrors the stable and canary ReplicaSets of the rollout into every remote cluster listed in
+// spec.clusters, and scales them according to the canary weight of the cluster. A cluster which cannot be reconciled
+// is reported in its status rather than failing the reconciliation, so that one unreachable cluster does not prevent
+// the others from progressing.
+func (c *rolloutContext) reconcileClusters() {
+	if len(c.rollout.Spec.Clusters) == 0 || c.newRS == nil {
+		return
+	}
+	c.clustersReady = map[string]bool{}
+	statuses := make([]v1alpha1.RolloutClusterStatus, 0, len(c.rollout.Spec.Clusters))
+	for _, rc := range c.rollout.Spec.Clusters {
+		status, ready, err := c.reconcileCluster(rc)
+		if err != nil {
+			c.log.Warnf("Failed to reconcile cluster %s: %v", rc.Name, err)
+			status.Message = err.Error()
+			if prev := c.previousClusterStatus(rc.Name); prev == nil || prev.Message != status.Message {
+				c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.ClusterReconcileErrorReason}, conditions.ClusterReconcileErrorMessage, rc.Name, err.Error())
+			}
+		}
+		c.clustersReady[rc.Name] = ready && err == nil
+		statuses = append(statuses, status)
+	}
+	c.newStatus.Clusters = statuses
+}
+
+// reconcileCluster reconciles the ReplicaSets of a remote cluster and returns its status, and whether all of its
+// desired pods are available
+func (c *rolloutContext) reconcileCluster(rc v1alpha1.RolloutCluster) (v1alpha1.RolloutClusterStatus, bool, error) {
+	weight := replicasetutil.GetClusterWeight(c.rollout, rc.Name)
+	status := v1alpha1.RolloutClusterStatus{
+		Name:     rc.Name,
+		Weight:   weight,
+		CanaryRS: c.newRS.Name,
+	}
+	if c.clusterProvider == nil {
+		return status, false, fmt.Errorf("multi-cluster rollouts are not enabled in the controller")
+	}
+	cluster, err := c.clusterProvider.Get(rc.Name)
+	if err != nil {
+		return status, false, err
+	}
+
+	namespace := rc.Namespace
+	if namespace == "" {
+		namespace = c.rollout.Namespace
+	}
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	if rc.Replicas != nil {
+		replicas = *rc.Replicas
+	}
+	desired := map[string]int32{}
+	if c.stableRS == nil || c.stableRS.Name == c.newRS.Name {
+		desired[c.newRS.Name] = replicas
+		status.StableRS = c.newRS.Name
+	} else {
+		canaryReplicas := int32(math.Ceil(float64(replicas) * float64(weight) / 100))
+		desired[c.newRS.Name] = canaryReplicas
+		desired[c.stableRS.Name] = replicas - canaryReplicas
+		status.StableRS = c.stableRS.Name
+	}
+
+	source := c.rollout.Namespace + "/" + c.rollout.Name
+	rsList, err := cluster.ReplicaSetLister.ReplicaSets(namespace).List(labels.Everything())
+	if err != nil {
+		return status, false, err
+	}
+	managed := map[string]*appsv1.ReplicaSet{}
+	for _, rs := range rsList {
+		if rs.Annotations[multicluster.SourceRolloutAnnotation] == source {
+			managed[rs.Name] = rs
+		}
+	}
+
+	ctx := context.TODO()
+	ready := true
+	for _, rs := range []*appsv1.ReplicaSet{c.stableRS, c.newRS} {
+		if rs == nil {
+			continue
+		}
+		if _, ok := managed[rs.Name]; ok {
+			continue
+		}
+		remoteRS := newRemoteReplicaSet(rs, namespace, source, desired[rs.Name])
+		c.log.Infof("Creating ReplicaSet %s in cluster %s with %d replicas", rs.Name, rc.Name, desired[rs.Name])
+		_, err := cluster.KubeClient.AppsV1().ReplicaSets(namespace).Create(ctx, remoteRS, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			err = c.labelRemoteReplicaSet(cluster, namespace, rs.Name, source)
+		}
+		if err != nil {
+			return status, false, err
+		}
+		if desired[rs.Name] > 0 {
+			ready = false
+		}
+	}
+
+	for name, rs := range managed {
+		desiredReplicas, ok := desired[name]
+		if !ok && defaults.GetReplicasOrDefault(rs.Spec.Replicas) == 0 && rs.Status.Replicas == 0 {
+			c.log.Infof("Deleting ReplicaSet %s in cluster %s", name, rc.Name)
+			err := cluster.KubeClient.AppsV1().ReplicaSets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return status, false, err
+			}
+			continue
+		}
+		if defaults.GetReplicasOrDefault(rs.Spec.Replicas) != desiredReplicas {
+			rsCopy := rs.DeepCopy()
+			rsCopy.Spec.Replicas = ptr.To(desiredReplicas)
+			c.log.Infof("Scaling ReplicaSet %s in cluster %s from %d to %d", name, rc.Name, defaults.GetReplicasOrDefault(rs.Spec.Replicas), desiredReplicas)
+			if _, err := cluster.KubeClient.AppsV1().ReplicaSets(namespace).Update(ctx, rsCopy, metav1.UpdateOptions{}); err != nil {
+				return status, false, err
+			}
+			ready = false
+		}
+		if (ok && rs.Status.AvailableReplicas < desiredReplicas) || (!ok && rs.Status.Replicas > 0) {
+			ready = false
+		}
+		status.Replicas += rs.Status.Replicas
+		status.AvailableReplicas += rs.Status.AvailableReplicas
+		if name == c.newRS.Name {
+			status.UpdatedReplicas = rs.Status.Replicas
+		}
+	}
+	return status, ready, nil
+}
+
+// labelRemoteReplicaSet adds the managed label to a ReplicaSet of the rollout which already exists in a remote cluster
+// without it, so that it is watched. Such ReplicaSets were created by previous versions of the controller.
+func (c *rolloutContext) labelRemoteReplicaSet(cluster *multicluster.Cluster, namespace, name, source string) error {
+	ctx := context.TODO()
+	rs, err := cluster.KubeClient.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if rs.Annotations[multicluster.SourceRolloutAnnotation] != source || rs.Labels[multicluster.ManagedReplicaSetLabel] == multicluster.ManagedReplicaSetLabelValue {
+		return nil
+	}
+	rsCopy := rs.DeepCopy()
+	if rsCopy.Labels == nil {
+		rsCopy.Labels = map[string]string{}
+	}
+	rsCopy.Labels[multicluster.ManagedReplicaSetLabel] = multicluster.ManagedReplicaSetLabelValue
+	c.log.Infof("Labeling ReplicaSet %s in cluster %s as managed", name, cluster.Name)
+	_, err = cluster.KubeClient.AppsV1().ReplicaSets(namespace).Update(ctx, rsCopy, metav1.UpdateOptions{})
+	return err
+}
+
+// newRemoteReplicaSet returns a copy of a ReplicaSet of the rollout to be created in a remote cluster. The copy has no
+// owner references since the rollout does not exist in the remote cluster, and is annotated with the rollout instead.
+// It is labeled as managed, so that it is watched.
+func newRemoteReplicaSet(rs *appsv1.ReplicaSet, namespace, source string, replicas int32) *appsv1.ReplicaSet {
+	rsLabels := map[string]string{}
+	for k, v := range rs.Labels {
+		rsLabels[k] = v
+	}
+	rsLabels[multicluster.ManagedReplicaSetLabel] = multicluster.ManagedReplicaSetLabelValue
+	annotations := map[string]string{}
+	for k, v := range rs.Annotations {
+		annotations[k] = v
+	}
+	annotations[multicluster.SourceRolloutAnnotation] = source
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        rs.Name,
+			Namespace:   namespace,
+			Labels:      rsLabels,
+			Annotations: annotations,
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas:        ptr.To(replicas),
+			MinReadySeconds: rs.Spec.MinReadySeconds,
+			Selector:        rs.Spec.Selector,
+			Template:        rs.Spec.Template,
+		},
+	}
+}
+
+func (c *rolloutContext) previousClusterStatus(name string) *v1alpha1.RolloutClusterStatus {
+	for i := range c.rollout.Status.Clusters {
+		if c.rollout.Status.Clusters[i].Name == name {
+			return &c.rollout.Status.Clusters[i]
+		}
+	}
+	return nil
+}
+
+// completedClusterWeightStep returns whether every cluster of a setClusterWeight step runs the weight of the step
+// with all of its desired pods available
+func (c *rolloutContext) completedClusterWeightStep(step *v1alpha1.SetClusterWeight) bool {
+	for _, name := range step.Clusters {
+		if !c.clustersReady[name] {
+			return false
+		}
+	}
+	return true
+}
+
+// enqueueSourceRollout enqueues the rollout which manages a ReplicaSet of a remote cluster
+func (c *Controller) enqueueSourceRollout(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	rs, ok := obj.(*appsv1.ReplicaSet)
+	if !ok {
+		return
+	}
+	if key, ok := rs.Annotations[multicluster.SourceRolloutAnnotation]; ok {
+		c.rolloutWorkqueue.AddRateLimited(key)
+	}
+}
@@ -0,0 +1,272 @@
+package rollout
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/multicluster"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+type fakeClusterProvider struct {
+	clusters map[string]*multicluster.Cluster
+}
+
+func (p *fakeClusterProvider) Get(name string) (*multicluster.Cluster, error) {
+	cluster, ok := p.clusters[name]
+	if !ok {
+		return nil, fmt.Errorf("cluster secret argo-rollouts/%s not found", name)
+	}
+	return cluster, nil
+}
+
+func (p *fakeClusterProvider) AddReplicaSetEventHandler(handler cache.ResourceEventHandler) {}
+
+func newFakeCluster(name string, rss ...*appsv1.ReplicaSet) *multicluster.Cluster {
+	objs := make([]runtime.Object, 0, len(rss))
+	for _, rs := range rss {
+		objs = append(objs, rs)
+	}
+	client := k8sfake.NewSimpleClientset(objs...)
+	informer := kubeinformers.NewSharedInformerFactory(client, 0).Apps().V1().ReplicaSets()
+	for _, rs := range rss {
+		informer.Informer().GetIndexer().Add(rs)
+	}
+	return &multicluster.Cluster{
+		Name:             name,
+		KubeClient:       client,
+		ReplicaSetLister: informer.Lister(),
+	}
+}
+
+// newRemoteCopy returns the copy of a ReplicaSet in a remote cluster with the given replicas and available replicas
+func newRemoteCopy(rs *appsv1.ReplicaSet, replicas, available int32) *appsv1.ReplicaSet {
+	remote := newRemoteReplicaSet(rs, metav1.NamespaceDefault, "default/foo", replicas)
+	remote.Status.Replicas = replicas
+	remote.Status.AvailableReplicas = available
+	return remote
+}
+
+// newMultiClusterRollout returns a rollout updating from rs1 to rs2 at the given step, with a staging cluster of 4
+// replicas and a prod cluster
+func newMultiClusterRollout(stepIndex int32) (*v1alpha1.Rollout, *appsv1.ReplicaSet, *appsv1.ReplicaSet) {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: ptr.To[int32](10)},
+		{SetClusterWeight: &v1alpha1.SetClusterWeight{Clusters: []string{"staging"}, Weight: 50}},
+		{SetClusterWeight: &v1alpha1.SetClusterWeight{Clusters: []string{"prod"}, Weight: 50}},
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, ptr.To(stepIndex), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Clusters = []v1alpha1.RolloutCluster{{Name: "staging", Replicas: ptr.To[int32](4)}, {Name: "prod"}}
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	r2.Status.StableRS = rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	return r2, rs1, rs2
+}
+
+func newClusterRolloutContext(r *v1alpha1.Rollout, stableRS, newRS *appsv1.ReplicaSet, provider multicluster.Provider) *rolloutContext {
+	return &rolloutContext{
+		rollout:  r,
+		log:      logutil.WithRollout(r),
+		stableRS: stableRS,
+		newRS:    newRS,
+		reconcilerBase: reconcilerBase{
+			clusterProvider: provider,
+			recorder:        record.NewFakeEventRecorder(),
+		},
+	}
+}
+
+func createdReplicaSets(t *testing.T, cluster *multicluster.Cluster) map[string]int32 {
+	t.Helper()
+	created := map[string]int32{}
+	for _, action := range cluster.KubeClient.(*k8sfake.Clientset).Actions() {
+		if createAction, ok := action.(k8stesting.CreateAction); ok {
+			rs := createAction.GetObject().(*appsv1.ReplicaSet)
+			assert.Equal(t, "default/foo", rs.Annotations[multicluster.SourceRolloutAnnotation])
+			assert.Equal(t, multicluster.ManagedReplicaSetLabelValue, rs.Labels[multicluster.ManagedReplicaSetLabel])
+			assert.Empty(t, rs.OwnerReferences)
+			created[rs.Name] = *rs.Spec.Replicas
+		}
+	}
+	return created
+}
+
+func TestReconcileClustersCreatesReplicaSets(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(1)
+	staging := newFakeCluster("staging")
+	prod := newFakeCluster("prod")
+	roCtx := newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging, "prod": prod}})
+
+	roCtx.reconcileClusters()
+
+	// staging runs half of its 4 replicas as canary, while prod is not yet listed in a step
+	assert.Equal(t, map[string]int32{rs1.Name: 2, rs2.Name: 2}, createdReplicaSets(t, staging))
+	assert.Equal(t, map[string]int32{rs1.Name: 10, rs2.Name: 0}, createdReplicaSets(t, prod))
+	require.Len(t, roCtx.newStatus.Clusters, 2)
+	assert.Equal(t, v1alpha1.RolloutClusterStatus{Name: "staging", Weight: 50, StableRS: rs1.Name, CanaryRS: rs2.Name}, roCtx.newStatus.Clusters[0])
+	assert.Equal(t, v1alpha1.RolloutClusterStatus{Name: "prod", Weight: 0, StableRS: rs1.Name, CanaryRS: rs2.Name}, roCtx.newStatus.Clusters[1])
+	assert.False(t, roCtx.completedClusterWeightStep(r.Spec.Strategy.Canary.Steps[1].SetClusterWeight))
+}
+
+func TestReconcileClustersCompletesStep(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(1)
+	staging := newFakeCluster("staging", newRemoteCopy(rs1, 2, 2), newRemoteCopy(rs2, 2, 2))
+	prod := newFakeCluster("prod", newRemoteCopy(rs1, 10, 10), newRemoteCopy(rs2, 0, 0))
+	roCtx := newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging, "prod": prod}})
+
+	roCtx.reconcileClusters()
+
+	assert.Empty(t, staging.KubeClient.(*k8sfake.Clientset).Actions())
+	assert.Empty(t, prod.KubeClient.(*k8sfake.Clientset).Actions())
+	assert.Equal(t, v1alpha1.RolloutClusterStatus{
+		Name:              "staging",
+		Weight:            50,
+		StableRS:          rs1.Name,
+		CanaryRS:          rs2.Name,
+		Replicas:          4,
+		UpdatedReplicas:   2,
+		AvailableReplicas: 4,
+	}, roCtx.newStatus.Clusters[0])
+	assert.True(t, roCtx.completedClusterWeightStep(r.Spec.Strategy.Canary.Steps[1].SetClusterWeight))
+}
+
+func TestReconcileClustersScalesReplicaSets(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(2)
+	rs0 := rs1.DeepCopy()
+	rs0.Name = "foo-old"
+	staging := newFakeCluster("staging", newRemoteCopy(rs1, 2, 2), newRemoteCopy(rs2, 2, 2), newRemoteCopy(rs0, 0, 0))
+	prod := newFakeCluster("prod", newRemoteCopy(rs1, 10, 10), newRemoteCopy(rs2, 0, 0))
+	roCtx := newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging, "prod": prod}})
+
+	roCtx.reconcileClusters()
+
+	// the old ReplicaSet without pods is deleted from staging
+	stagingActions := staging.KubeClient.(*k8sfake.Clientset).Actions()
+	require.Len(t, stagingActions, 1)
+	assert.Equal(t, "delete", stagingActions[0].GetVerb())
+	assert.Equal(t, "foo-old", stagingActions[0].(k8stesting.DeleteAction).GetName())
+
+	// prod is scaled to the weight of the current step
+	scaled := map[string]int32{}
+	for _, action := range prod.KubeClient.(*k8sfake.Clientset).Actions() {
+		rs := action.(k8stesting.UpdateAction).GetObject().(*appsv1.ReplicaSet)
+		scaled[rs.Name] = *rs.Spec.Replicas
+	}
+	assert.Equal(t, map[string]int32{rs1.Name: 5, rs2.Name: 5}, scaled)
+	assert.Equal(t, int32(50), roCtx.newStatus.Clusters[1].Weight)
+	assert.False(t, roCtx.completedClusterWeightStep(r.Spec.Strategy.Canary.Steps[2].SetClusterWeight))
+}
+
+func TestReconcileClustersLabelsExistingReplicaSets(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(1)
+	// copies created by a previous version of the controller are not labeled, so they are not in the cache
+	unlabeled := newRemoteCopy(rs1, 2, 2)
+	delete(unlabeled.Labels, multicluster.ManagedReplicaSetLabel)
+	other := newRemoteCopy(rs2, 2, 2)
+	delete(other.Labels, multicluster.ManagedReplicaSetLabel)
+	other.Annotations[multicluster.SourceRolloutAnnotation] = "default/bar"
+	staging := newFakeCluster("staging")
+	staging.KubeClient = k8sfake.NewSimpleClientset(unlabeled, other)
+	roCtx := newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging}})
+
+	roCtx.reconcileClusters()
+
+	labeled := map[string]bool{}
+	for _, action := range staging.KubeClient.(*k8sfake.Clientset).Actions() {
+		if updateAction, ok := action.(k8stesting.UpdateAction); ok && action.GetVerb() == "update" {
+			rs := updateAction.GetObject().(*appsv1.ReplicaSet)
+			labeled[rs.Name] = rs.Labels[multicluster.ManagedReplicaSetLabel] == multicluster.ManagedReplicaSetLabelValue
+		}
+	}
+	// the ReplicaSet of another rollout is left alone
+	assert.Equal(t, map[string]bool{rs1.Name: true}, labeled)
+}
+
+func TestReconcileClustersAborted(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(1)
+	r.Status.Abort = true
+	staging := newFakeCluster("staging", newRemoteCopy(rs1, 2, 2), newRemoteCopy(rs2, 2, 2))
+	roCtx := newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging}})
+
+	roCtx.reconcileClusters()
+
+	scaled := map[string]int32{}
+	for _, action := range staging.KubeClient.(*k8sfake.Clientset).Actions() {
+		rs := action.(k8stesting.UpdateAction).GetObject().(*appsv1.ReplicaSet)
+		scaled[rs.Name] = *rs.Spec.Replicas
+	}
+	assert.Equal(t, map[string]int32{rs1.Name: 4, rs2.Name: 0}, scaled)
+}
+
+func TestReconcileClustersUnreachable(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(1)
+	staging := newFakeCluster("staging")
+	roCtx := newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging}})
+
+	roCtx.reconcileClusters()
+
+	// staging is reconciled even though prod is unreachable
+	assert.Len(t, createdReplicaSets(t, staging), 2)
+	assert.Equal(t, "cluster secret argo-rollouts/prod not found", roCtx.newStatus.Clusters[1].Message)
+	assert.Equal(t, []string{conditions.ClusterReconcileErrorReason}, roCtx.recorder.(*record.FakeEventRecorder).Events())
+
+	// the event is not repeated while the error is unchanged
+	r.Status.Clusters = roCtx.newStatus.Clusters
+	roCtx = newClusterRolloutContext(r, rs1, rs2, &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging}})
+	roCtx.reconcileClusters()
+	assert.Empty(t, roCtx.recorder.(*record.FakeEventRecorder).Events())
+}
+
+func TestReconcileClustersNotEnabled(t *testing.T) {
+	r, rs1, rs2 := newMultiClusterRollout(1)
+	roCtx := newClusterRolloutContext(r, rs1, rs2, nil)
+
+	roCtx.reconcileClusters()
+
+	require.Len(t, roCtx.newStatus.Clusters, 2)
+	assert.Equal(t, "multi-cluster rollouts are not enabled in the controller", roCtx.newStatus.Clusters[0].Message)
+	assert.False(t, roCtx.completedClusterWeightStep(r.Spec.Strategy.Canary.Steps[1].SetClusterWeight))
+}
+
+func TestCanaryRolloutSetClusterWeightStep(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r2, rs1, rs2 := newMultiClusterRollout(1)
+	staging := newFakeCluster("staging", newRemoteCopy(rs1, 2, 2), newRemoteCopy(rs2, 2, 2))
+	prod := newFakeCluster("prod", newRemoteCopy(rs1, 10, 10), newRemoteCopy(rs2, 0, 0))
+	f.clusterProvider = &fakeClusterProvider{clusters: map[string]*multicluster.Cluster{"staging": staging, "prod": prod}}
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	r2 = updateCanaryRolloutStatus(r2, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 10, 1, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	require.NotNil(t, patched.Status.CurrentStepIndex)
+	assert.Equal(t, int32(2), *patched.Status.CurrentStepIndex)
+	require.Len(t, patched.Status.Clusters, 2)
+	assert.Equal(t, int32(50), patched.Status.Clusters[0].Weight)
+	assert.Equal(t, int32(4), patched.Status.Clusters[0].AvailableReplicas)
+}
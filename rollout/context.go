@@ -3,9 +3,11 @@ package rollout
 import (
 	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 )
 
 type rolloutContext struct {
@@ -55,10 +57,43 @@ type rolloutContext struct {
 	// since we do not want to continually verify weight in case it could incur rate-limiting or other expenses.
 	targetsVerified *bool
 
+	// trafficWeightsCondition is the TrafficWeightsMismatch condition computed while reconciling multiple
+	// traffic routers. nil indicates the condition should be left unchanged.
+	trafficWeightsCondition *v1alpha1.RolloutCondition
+	// removeTrafficWeightsCondition indicates the rollout no longer uses multiple traffic routers and the
+	// TrafficWeightsMismatch condition should be removed
+	removeTrafficWeightsCondition bool
+
 	// newRSWithinDelay indicates if the newRS has a valid (non-expired) scale-down-deadline
 	// annotation at the start of reconciliation (before it may be removed).
 	// Used to detect fast rollbacks where we skip pause/analysis steps.
 	newRSWithinDelay bool
+
+	// revisionHold is the reason the new revision is held, e.g. because it is outside of the deployment windows or
+	// its dependencies are not met. An empty string indicates the revision is not held.
+	revisionHold string
+	// dependenciesCondition is the WaitingForDependencies condition computed while reconciling the dependencies of
+	// the rollout. nil indicates the condition should be removed.
+	dependenciesCondition *v1alpha1.RolloutCondition
+	// clustersReady indicates for every remote cluster reconciled in this reconciliation whether its ReplicaSets run
+	// the canary weight of the cluster with all desired pods available
+	clustersReady map[string]bool
+
+	// statefulSet is the StatefulSet referenced by the rollout, if any. Its revisions are represented by newRS,
+	// stableRS and allRSs, which are not persisted.
+	statefulSet *appsv1.StatefulSet
+	// statefulSetPods are the pods of the StatefulSet
+	statefulSetPods []*corev1.Pod
+
+	// daemonSet is the DaemonSet owned by a rollout with the DaemonSet strategy. Its revisions are represented by
+	// newRS, stableRS and allRSs, which are not persisted.
+	daemonSet *appsv1.DaemonSet
+	// daemonSetPods are the pods of the DaemonSet
+	daemonSetPods []*corev1.Pod
+	// daemonSetTemplates are the templates of the revisions of the DaemonSet by their pod template hash
+	daemonSetTemplates map[string]*corev1.PodTemplateSpec
+	// daemonSetUpdatedNodes are the names of the nodes which should run the new revision of the DaemonSet
+	daemonSetUpdatedNodes map[string]bool
 }
 
 func (c *rolloutContext) reconcile() error {
@@ -71,6 +106,31 @@ func (c *rolloutContext) reconcile() error {
 		return nil
 	}
 
+	if rolloututil.IsStatefulSetWorkload(c.rollout) {
+		observed, err := c.syncStatefulSetRevisions()
+		if err != nil || !observed {
+			return err
+		}
+	}
+	if c.rollout.Spec.Strategy.DaemonSet != nil {
+		err := c.syncDaemonSetRevisions()
+		if err != nil {
+			return err
+		}
+	}
+
+	c.reconcileDeploymentWindows()
+	if err := c.reconcileDependencies(); err != nil {
+		return err
+	}
+
+	if c.statefulSet != nil {
+		return c.rolloutStatefulSet()
+	}
+	if c.daemonSet != nil {
+		return c.rolloutDaemonSet()
+	}
+
 	isScalingEvent, err := c.isScalingEvent()
 	if err != nil {
 		return err
@@ -124,6 +184,23 @@ func (c *rolloutContext) SetCurrentAnalysisRuns(currARs analysisutil.CurrentAnal
 				Message: currStepAr.Status.Message,
 			}
 		}
+	} else if c.rollout.Spec.Strategy.DaemonSet != nil {
+		currBackgroundAr := currARs.DaemonSetBackground
+		if currBackgroundAr != nil {
+			c.newStatus.DaemonSet.CurrentBackgroundAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+				Name:    currBackgroundAr.Name,
+				Status:  currBackgroundAr.Status.Phase,
+				Message: currBackgroundAr.Status.Message,
+			}
+		}
+		currStepAr := currARs.DaemonSetStep
+		if currStepAr != nil {
+			c.newStatus.DaemonSet.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+				Name:    currStepAr.Name,
+				Status:  currStepAr.Status.Phase,
+				Message: currStepAr.Status.Message,
+			}
+		}
 	} else if c.rollout.Spec.Strategy.BlueGreen != nil {
 		currPrePromoAr := currARs.BlueGreenPrePromotion
 		if currPrePromoAr != nil {
@@ -146,11 +223,15 @@ func (c *rolloutContext) SetCurrentAnalysisRuns(currARs analysisutil.CurrentAnal
 
 // haltProgress returns a reason on whether or not we should halt all progress with an update
 // to ReplicaSet counts (e.g. due to canary steps or blue-green promotion). This is either because
-// user explicitly paused the rollout by setting `spec.paused`, or the analysis was inconclusive
+// user explicitly paused the rollout by setting `spec.paused`, the analysis was inconclusive, or the
+// new revision is held by its deployment windows or dependencies
 func (c *rolloutContext) haltProgress() string {
 	if c.rollout.Spec.Paused {
 		return "user paused"
 	}
+	if c.revisionHold != "" {
+		return c.revisionHold
+	}
 	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil {
 		return "inconclusive analysis"
 	}
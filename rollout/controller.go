@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
+	kubeinformers "k8s.io/client-go/informers"
 	appsinformers "k8s.io/client-go/informers/apps/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -27,6 +28,7 @@ import (
 	v1 "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	kubectlutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/kubectl/pkg/util/slice"
 	"k8s.io/kubernetes/pkg/controller"
 	"k8s.io/utils/ptr"
@@ -51,6 +53,7 @@ import (
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/multicluster"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	resourceversionutil "github.com/argoproj/argo-rollouts/utils/resourceversion"
@@ -68,8 +71,6 @@ type TemplateRefResolver interface {
 type Controller struct {
 	reconcilerBase
 
-	// namespace which namespace(s) operates on
-	namespace string
 	// rsControl is used for adopting/releasing replica sets.
 	replicaSetControl controller.RSControlInterface
 
@@ -98,7 +99,11 @@ type ControllerConfig struct {
 	AnalysisRunInformer             informers.AnalysisRunInformer
 	AnalysisTemplateInformer        informers.AnalysisTemplateInformer
 	ClusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer
+	RolloutScheduleInformer         informers.RolloutScheduleInformer
 	ReplicaSetInformer              appsinformers.ReplicaSetInformer
+	WorkloadInformerFactory         kubeinformers.SharedInformerFactory
+	WorkloadPodInformerFactory      kubeinformers.SharedInformerFactory
+	NodeInformerFactory             kubeinformers.SharedInformerFactory
 	ServicesInformer                coreinformers.ServiceInformer
 	IngressWrapper                  IngressWrapper
 	RolloutsInformer                informers.RolloutInformer
@@ -113,11 +118,14 @@ type ControllerConfig struct {
 	Recorder                        record.EventRecorder
 	EphemeralMetadataThreads        int
 	EphemeralMetadataPodRetries     int
+	ClusterProvider                 multicluster.Provider
 }
 
 // reconcilerBase is a shared datastructure containing all clients and configuration necessary to
 // reconcile a rollout. This is shared between the controller and the rolloutContext
 type reconcilerBase struct {
+	// namespace which namespace(s) operates on
+	namespace string
 	// kubeclientset is a standard kubernetes clientset
 	kubeclientset kubernetes.Interface
 	// argoprojclientset is a clientset for our own API group
@@ -134,6 +142,9 @@ type reconcilerBase struct {
 	rolloutsInformer              cache.SharedIndexInformer
 	rolloutsLister                listers.RolloutLister
 	replicaSetInformer            cache.SharedIndexInformer
+	statefulSets                  *workloadCache
+	daemonSets                    *workloadCache
+	nodes                         *nodeCache
 	rolloutsSynced                cache.InformerSynced
 	rolloutsIndexer               cache.Indexer
 	servicesLister                v1.ServiceLister
@@ -142,6 +153,8 @@ type reconcilerBase struct {
 	analysisRunLister             listers.AnalysisRunLister
 	analysisTemplateLister        listers.AnalysisTemplateLister
 	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
+	rolloutScheduleLister         listers.RolloutScheduleLister
+	clusterProvider               multicluster.Provider
 	IstioController               *istio.IstioController
 
 	podRestarter RolloutPodRestarter
@@ -188,6 +201,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		},
 	}
 	base := reconcilerBase{
+		namespace:                     cfg.Namespace,
 		kubeclientset:                 cfg.KubeClientSet,
 		argoprojclientset:             cfg.ArgoProjClientset,
 		dynamicclientset:              cfg.DynamicClientSet,
@@ -196,6 +210,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		replicaSetSynced:              cfg.ReplicaSetInformer.Informer().HasSynced,
 		rolloutsInformer:              cfg.RolloutsInformer.Informer(),
 		replicaSetInformer:            cfg.ReplicaSetInformer.Informer(),
+		nodes:                         newNodeCache(cfg.NodeInformerFactory),
 		rolloutsIndexer:               cfg.RolloutsInformer.Informer().GetIndexer(),
 		rolloutsLister:                cfg.RolloutsInformer.Lister(),
 		rolloutsSynced:                cfg.RolloutsInformer.Informer().HasSynced,
@@ -205,6 +220,8 @@ func NewController(cfg ControllerConfig) *Controller {
 		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
 		analysisTemplateLister:        cfg.AnalysisTemplateInformer.Lister(),
 		clusterAnalysisTemplateLister: cfg.ClusterAnalysisTemplateInformer.Lister(),
+		rolloutScheduleLister:         cfg.RolloutScheduleInformer.Lister(),
+		clusterProvider:               cfg.ClusterProvider,
 		recorder:                      cfg.Recorder,
 		resyncPeriod:                  cfg.ResyncPeriod,
 		podRestarter:                  podRestarter,
@@ -216,7 +233,6 @@ func NewController(cfg ControllerConfig) *Controller {
 
 	controller := &Controller{
 		reconcilerBase:        base,
-		namespace:             cfg.Namespace,
 		replicaSetControl:     replicaSetControl,
 		rolloutWorkqueue:      cfg.RolloutWorkQueue,
 		serviceWorkqueue:      cfg.ServiceWorkQueue,
@@ -240,11 +256,66 @@ func NewController(cfg ControllerConfig) *Controller {
 	})
 	controller.newTrafficRoutingReconciler = controller.NewTrafficRoutingReconciler
 
+	// Add Rollout indexes against the Rollouts they depend on and the StatefulSets they reference
+	kubectlutil.CheckErr(cfg.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
+		dependsOnIndexName: func(obj any) ([]string, error) {
+			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
+				return dependencyKeys(ro), nil
+			}
+			return nil, nil
+		},
+		statefulSetIndexName: func(obj any) ([]string, error) {
+			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil && rolloututil.IsStatefulSetWorkload(ro) {
+				return []string{fmt.Sprintf("%s/%s", ro.Namespace, ro.Spec.WorkloadRef.Name)}, nil
+			}
+			return nil, nil
+		},
+	}))
+
 	log.Info("Setting up event handlers")
+	if cfg.ClusterProvider != nil {
+		// Set up an event handler for when ReplicaSets managed by a rollout change in a remote cluster
+		cfg.ClusterProvider.AddReplicaSetEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueSourceRollout,
+			UpdateFunc: func(old, new any) {
+				controller.enqueueSourceRollout(new)
+			},
+			DeleteFunc: controller.enqueueSourceRollout,
+		})
+	}
+	// Set up an event handler for when StatefulSets referenced by a rollout change, once they are cached
+	controller.statefulSets = newWorkloadCache("StatefulSet", cfg.WorkloadInformerFactory, cfg.WorkloadPodInformerFactory,
+		func(factory kubeinformers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Apps().V1().StatefulSets().Informer()
+		},
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueStatefulSetRollouts,
+			UpdateFunc: func(old, new any) {
+				controller.enqueueStatefulSetRollouts(new)
+			},
+			DeleteFunc: controller.enqueueStatefulSetRollouts,
+		})
+	// Set up an event handler for when DaemonSets owned by a rollout change, once they are cached
+	controller.daemonSets = newWorkloadCache("DaemonSet", cfg.WorkloadInformerFactory, cfg.WorkloadPodInformerFactory,
+		func(factory kubeinformers.SharedInformerFactory) cache.SharedIndexInformer {
+			return factory.Apps().V1().DaemonSets().Informer()
+		},
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
+			},
+			UpdateFunc: func(old, new any) {
+				controllerutil.EnqueueParentObject(new, register.RolloutKind, controller.enqueueRollout)
+			},
+			DeleteFunc: func(obj any) {
+				controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
+			},
+		})
 	// Set up an event handler for when rollout resources change
 	cfg.RolloutsInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
 			controller.enqueueRollout(obj)
+			controller.enqueueDependents(obj)
 			ro := unstructuredutil.ObjectToRollout(obj)
 			if ro != nil {
 				logCtx := logutil.WithRollout(ro)
@@ -278,8 +349,10 @@ func NewController(cfg ControllerConfig) *Controller {
 				logCtx.Info("rollout enqueue due to update event")
 			}
 			controller.enqueueRollout(new)
+			controller.enqueueDependents(new)
 		},
 		DeleteFunc: func(obj any) {
+			controller.enqueueDependents(obj)
 			if ro := unstructuredutil.ObjectToRollout(obj); ro != nil {
 				logCtx := logutil.WithRollout(ro)
 				logCtx.Info("rollout enqueue due to delete event")
@@ -363,6 +436,9 @@ func removedKeys(name string, old, new *v1alpha1.Rollout, keyFunc func(ro *v1alp
 // workers to finish processing their current work items.
 func (c *Controller) Run(ctx context.Context, threadiness int) error {
 	log.Info("Starting Rollout workers")
+	c.nodes.run(ctx.Done())
+	c.statefulSets.run(ctx.Done())
+	c.daemonSets.run(ctx.Done())
 	wg := sync.WaitGroup{}
 	for i := 0; i < threadiness; i++ {
 		wg.Add(1)
@@ -608,6 +684,15 @@ func (c *rolloutContext) getRolloutValidationErrors() error {
 		return rolloutValidationErrors[0]
 	}
 
+	if c.namespace != metav1.NamespaceAll {
+		// a namespaced controller only watches the Rollouts of its own namespace
+		rolloutValidationErrors = validation.ValidateDependsOnNamespace(c.rollout, c.namespace, field.NewPath("spec", "dependsOn"))
+		rolloutValidationErrors = append(rolloutValidationErrors, validation.ValidateScheduleRefsNamespace(c.rollout, c.namespace, field.NewPath("spec", "strategy", "deploymentWindows", "scheduleRefs"))...)
+		if len(rolloutValidationErrors) > 0 {
+			return rolloutValidationErrors[0]
+		}
+	}
+
 	refResources, err := c.getRolloutReferencedResources()
 	if err != nil {
 		return err
@@ -56,6 +56,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/hash"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	"github.com/argoproj/argo-rollouts/utils/multicluster"
 	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
@@ -119,6 +120,7 @@ type fixture struct {
 	experimentLister              []*v1alpha1.Experiment
 	analysisRunLister             []*v1alpha1.AnalysisRun
 	clusterAnalysisTemplateLister []*v1alpha1.ClusterAnalysisTemplate
+	rolloutScheduleLister         []*v1alpha1.RolloutSchedule
 	analysisTemplateLister        []*v1alpha1.AnalysisTemplate
 	replicaSetLister              []*appsv1.ReplicaSet
 	serviceLister                 []*corev1.Service
@@ -143,6 +145,8 @@ type fixture struct {
 	// events holds all the K8s Event Reasons emitted during the run
 	events             []string
 	fakeTrafficRouting *mocks.TrafficRoutingReconciler
+	// extraFakeTrafficRoutings are returned as additional traffic routers after fakeTrafficRouting
+	extraFakeTrafficRoutings []*mocks.TrafficRoutingReconciler
 	// reseedRolloutMutator, if set, is applied to the rollout when re-seeding between syncs (for multi-sync tests).
 	reseedRolloutMutator func(*v1alpha1.Rollout)
 	// allowErrorOnLastSync, if set, do not fail the test when the final sync returns an error (e.g. "delaying destination rule switch").
@@ -150,6 +154,8 @@ type fixture struct {
 	// workloadRefResolveFn, if set, is used by the fake workload ref resolver to repopulate the pod
 	// template (which remarshalRollout strips for TemplateResolvedFromRef rollouts), mimicking the real resolver.
 	workloadRefResolveFn func(*v1alpha1.Rollout) error
+	// clusterProvider, if set, provides the remote clusters of rollouts with spec.clusters
+	clusterProvider multicluster.Provider
 }
 
 func newFixture(t *testing.T) *fixture {
@@ -660,7 +666,11 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
 		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
 		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutScheduleInformer:         i.Argoproj().V1alpha1().RolloutSchedules(),
 		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		WorkloadInformerFactory:         kubeinformers.NewSharedInformerFactory(f.kubeclient, resync()),
+		WorkloadPodInformerFactory:      kubeinformers.NewSharedInformerFactory(f.kubeclient, resync()),
+		NodeInformerFactory:             kubeinformers.NewSharedInformerFactory(f.kubeclient, resync()),
 		ServicesInformer:                k8sI.Core().V1().Services(),
 		IngressWrapper:                  ingressWrapper,
 		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
@@ -676,6 +686,7 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		RefResolver:                     &FakeWorkloadRefResolver{resolveFn: f.workloadRefResolveFn},
 		EphemeralMetadataThreads:        DefaultEphemeralMetadataThreads,
 		EphemeralMetadataPodRetries:     DefaultEphemeralMetadataPodRetries,
+		ClusterProvider:                 f.clusterProvider,
 	})
 
 	c.enqueueRollout = func(obj any) {
@@ -705,6 +716,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 			}
 			var reconcilers = []trafficrouting.TrafficRoutingReconciler{}
 			reconcilers = append(reconcilers, f.fakeTrafficRouting)
+			for _, extra := range f.extraFakeTrafficRoutings {
+				reconcilers = append(reconcilers, extra)
+			}
 			return reconcilers, nil
 		}
 	}
@@ -736,6 +750,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	for _, cat := range f.clusterAnalysisTemplateLister {
 		i.Argoproj().V1alpha1().ClusterAnalysisTemplates().Informer().GetIndexer().Add(cat)
 	}
+	for _, schedule := range f.rolloutScheduleLister {
+		i.Argoproj().V1alpha1().RolloutSchedules().Informer().GetIndexer().Add(schedule)
+	}
 	for _, ar := range f.analysisRunLister {
 		i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer().Add(ar)
 	}
@@ -940,15 +957,24 @@ func filterInformerActions(actions []core.Action) []core.Action {
 			action.Matches("watch", "analysistemplates") ||
 			action.Matches("list", "clusteranalysistemplates") ||
 			action.Matches("watch", "clusteranalysistemplates") ||
+			action.Matches("list", "rolloutschedules") ||
+			action.Matches("watch", "rolloutschedules") ||
 			action.Matches("list", "rollouts") ||
 			action.Matches("watch", "rollouts") ||
 			action.Matches("list", "replicaSets") ||
 			action.Matches("watch", "replicaSets") ||
+			action.Matches("list", "statefulsets") ||
+			action.Matches("watch", "statefulsets") ||
+			action.Matches("list", "daemonsets") ||
+			action.Matches("watch", "daemonsets") ||
 			action.Matches("list", "services") ||
 			action.Matches("watch", "services") ||
 			action.Matches("list", "ingresses") ||
 			action.Matches("watch", "ingresses") ||
-			action.Matches("list", "pods") {
+			action.Matches("list", "controllerrevisions") ||
+			action.Matches("watch", "controllerrevisions") ||
+			action.Matches("list", "pods") ||
+			action.Matches("watch", "pods") {
 			continue
 		}
 		ret = append(ret, action)
@@ -2754,7 +2780,7 @@ func TestRolloutStrategyNotSet(t *testing.T) {
 	patchIndex := f.expectPatchRolloutAction(r)
 	f.runExpectError(getKey(r, t), true)
 	patchedRollout := f.getPatchedRollout(patchIndex)
-	assert.Contains(t, patchedRollout, `Rollout has missing field '.spec.strategy.canary or .spec.strategy.blueGreen'`)
+	assert.Contains(t, patchedRollout, `Rollout has missing field '.spec.strategy.canary, .spec.strategy.blueGreen or .spec.strategy.daemonSet'`)
 }
 
 func TestSyncHandlerStaleCacheGuard(t *testing.T) {
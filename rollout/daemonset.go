@@ -0,0 +1,496 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	labelsutil "k8s.io/kubernetes/pkg/util/labels"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// syncDaemonSetRevisions reads the DaemonSet owned by a rollout with the DaemonSet strategy, creating it if it does not
+// exist, and represents each of its revisions with a ReplicaSet which is never persisted, like the revisions of a
+// StatefulSet. The revisions are identified by the pod template hash of the rollout, which is added to the template of
+// the DaemonSet, and the status of each ReplicaSet counts the pods running its revision.
+func (c *rolloutContext) syncDaemonSetRevisions() error {
+	if err := c.daemonSets.start(); err != nil {
+		return err
+	}
+	ds, err := appslisters.NewDaemonSetLister(c.daemonSets.indexer).DaemonSets(c.rollout.Namespace).Get(c.rollout.Name)
+	if k8serrors.IsNotFound(err) {
+		ds, err = c.createDaemonSet()
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(ds, c.rollout) {
+		return fmt.Errorf("DaemonSet %s is not controlled by rollout %s", ds.Name, c.rollout.Name)
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	revisions, err := c.daemonSets.listRevisions(ds, selector)
+	if err != nil {
+		return err
+	}
+	pods, err := c.daemonSets.listPods(ds, selector)
+	if err != nil {
+		return err
+	}
+
+	// the DaemonSet controller records the template of every revision, labeled with the labels of the template
+	templates := map[string]*corev1.PodTemplateSpec{}
+	created := map[string]metav1.Time{}
+	for _, revision := range revisions {
+		podHash := revision.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+		if podHash == "" {
+			continue
+		}
+		var data struct {
+			Spec struct {
+				Template corev1.PodTemplateSpec `json:"template"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(revision.Data.Raw, &data); err != nil {
+			c.log.Warnf("Failed to decode ControllerRevision %s: %v", revision.Name, err)
+			continue
+		}
+		if previous, ok := created[podHash]; !ok || previous.Before(&revision.CreationTimestamp) {
+			templates[podHash] = &data.Spec.Template
+			created[podHash] = revision.CreationTimestamp
+		}
+	}
+
+	newHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	podHashes := []string{newHash}
+	if c.rollout.Status.StableRS != "" {
+		podHashes = append(podHashes, c.rollout.Status.StableRS)
+	}
+	for podHash := range templates {
+		podHashes = append(podHashes, podHash)
+	}
+	for _, pod := range pods {
+		if podHash := pod.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]; podHash != "" {
+			podHashes = append(podHashes, podHash)
+		}
+	}
+
+	var allRSs []*appsv1.ReplicaSet
+	var newRS *appsv1.ReplicaSet
+	seen := map[string]bool{}
+	for _, podHash := range podHashes {
+		if seen[podHash] {
+			continue
+		}
+		seen[podHash] = true
+		creationTimestamp, ok := created[podHash]
+		if !ok {
+			creationTimestamp = timeutil.MetaNow()
+		}
+		rs := newDaemonSetRevisionReplicaSet(c.rollout, ds, podHash, creationTimestamp, pods)
+		if podHash == newHash {
+			newRS = rs
+		}
+		allRSs = append(allRSs, rs)
+	}
+
+	var nodes []*corev1.Node
+	if replicasetutil.DaemonSetCohortsSelectByLabel(c.rollout) {
+		nodes, err = c.nodes.list()
+		if err != nil {
+			return err
+		}
+	}
+	nodes = daemonSetNodes(pods, nodes)
+	updatedNodes, err := replicasetutil.GetDaemonSetUpdatedNodes(c.rollout, nodes)
+	if err != nil {
+		return err
+	}
+
+	c.daemonSet = ds
+	c.daemonSetPods = pods
+	c.daemonSetTemplates = templates
+	c.daemonSetUpdatedNodes = updatedNodes
+	c.newRS = newRS
+	c.allRSs = allRSs
+	c.olderRSs = replicasetutil.FindOldReplicaSets(c.rollout, allRSs, newRS)
+	c.stableRS = replicasetutil.GetStableRS(c.rollout, newRS, c.olderRSs)
+	c.otherRSs = replicasetutil.GetOtherRSs(c.rollout, newRS, c.stableRS, allRSs)
+	return nil
+}
+
+// daemonSetNodes returns the nodes running a pod of the DaemonSet. The given nodes are used for their labels, and a
+// node which is not among them is represented by its name only.
+func daemonSetNodes(pods []*corev1.Pod, nodes []*corev1.Node) []*corev1.Node {
+	byName := map[string]*corev1.Node{}
+	for _, node := range nodes {
+		byName[node.Name] = node
+	}
+	var result []*corev1.Node
+	seen := map[string]bool{}
+	for _, pod := range pods {
+		nodeName := pod.Spec.NodeName
+		if nodeName == "" || seen[nodeName] {
+			continue
+		}
+		seen[nodeName] = true
+		node, ok := byName[nodeName]
+		if !ok {
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+// createDaemonSet creates the DaemonSet of the rollout with the template of the rollout. It uses the OnDelete update
+// strategy, so that the rollout decides which nodes run the new revision by deleting their pods.
+func (c *rolloutContext) createDaemonSet() (*appsv1.DaemonSet, error) {
+	ctx := context.TODO()
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            c.rollout.Name,
+			Namespace:       c.rollout.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(c.rollout, controllerKind)},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: c.rollout.Spec.Selector,
+			Template: *newDaemonSetTemplate(c.rollout),
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.OnDeleteDaemonSetStrategyType,
+			},
+			MinReadySeconds:      c.rollout.Spec.MinReadySeconds,
+			RevisionHistoryLimit: ptr.To(defaults.GetRevisionHistoryLimitOrDefault(c.rollout)),
+		},
+	}
+	created, err := c.kubeclientset.AppsV1().DaemonSets(ds.Namespace).Create(ctx, ds, metav1.CreateOptions{})
+	if err != nil {
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.FailedDaemonSetCreateReason}, conditions.FailedDaemonSetCreateMessage, ds.Name, err)
+		return nil, err
+	}
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.NewDaemonSetReason}, conditions.NewDaemonSetMessage, created.Name)
+	return created, nil
+}
+
+// newDaemonSetTemplate returns the template of the rollout labeled with its pod template hash
+func newDaemonSetTemplate(rollout *v1alpha1.Rollout) *corev1.PodTemplateSpec {
+	template := rollout.Spec.Template.DeepCopy()
+	podHash := hash.ComputePodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
+	template.Labels = labelsutil.CloneAndAddLabel(rollout.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+	return template
+}
+
+// newDaemonSetRevisionReplicaSet returns the ReplicaSet representing a revision of a DaemonSet, whose replicas are the
+// pods running the revision
+func newDaemonSetRevisionReplicaSet(rollout *v1alpha1.Rollout, ds *appsv1.DaemonSet, podHash string, created metav1.Time, pods []*corev1.Pod) *appsv1.ReplicaSet {
+	labels := labelsutil.CloneAndAddLabel(rollout.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+
+	var replicas, readyReplicas, availableReplicas int32
+	now := timeutil.MetaNow()
+	for _, pod := range pods {
+		if pod.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != podHash || pod.DeletionTimestamp != nil {
+			continue
+		}
+		replicas++
+		if podutil.IsPodReady(pod) {
+			readyReplicas++
+		}
+		if podutil.IsPodAvailable(pod, ds.Spec.MinReadySeconds, now) {
+			availableReplicas++
+		}
+	}
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fmt.Sprintf("%s-%s", ds.Name, podHash),
+			Namespace:         ds.Namespace,
+			UID:               types.UID(fmt.Sprintf("%s-%s", ds.UID, podHash)),
+			CreationTimestamp: created,
+			Labels:            labels,
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: ptr.To(replicas),
+			Selector: ds.Spec.Selector,
+		},
+		Status: appsv1.ReplicaSetStatus{
+			Replicas:             replicas,
+			FullyLabeledReplicas: replicas,
+			ReadyReplicas:        readyReplicas,
+			AvailableReplicas:    availableReplicas,
+		},
+	}
+}
+
+// rolloutDaemonSet progresses a rollout with the DaemonSet strategy through its steps. Each cohort step updates the
+// pods of the nodes of its cohort to the new revision by deleting them, so that the DaemonSet controller recreates
+// them from the template of the DaemonSet.
+func (c *rolloutContext) rolloutDaemonSet() error {
+	if replicasetutil.PodTemplateOrStepsChanged(c.rollout, c.newRS) {
+		return c.syncRolloutStatusDaemonSet()
+	}
+
+	err := c.reconcileAnalysisRuns()
+	if err != nil {
+		return err
+	}
+
+	err = c.reconcileDaemonSetTemplate()
+	if err != nil {
+		return err
+	}
+
+	err = c.reconcileDaemonSetPods()
+	if err != nil {
+		return err
+	}
+
+	c.reconcileDaemonSetPause()
+	return c.syncRolloutStatusDaemonSet()
+}
+
+// desiredDaemonSetTemplate returns the template of the DaemonSet. It is the template of the stable revision while the
+// rollout is aborted, so that the pods which are deleted on the updated nodes are recreated with the stable revision.
+func (c *rolloutContext) desiredDaemonSetTemplate() (*corev1.PodTemplateSpec, error) {
+	if c.pauseContext.IsAborted() && c.stableRS != nil && c.stableRS != c.newRS {
+		template, ok := c.daemonSetTemplates[c.rollout.Status.StableRS]
+		if !ok {
+			return nil, fmt.Errorf("revision %s of DaemonSet %s not found", c.rollout.Status.StableRS, c.daemonSet.Name)
+		}
+		return template, nil
+	}
+	return newDaemonSetTemplate(c.rollout), nil
+}
+
+// reconcileDaemonSetTemplate updates the template of the DaemonSet to the desired revision
+func (c *rolloutContext) reconcileDaemonSetTemplate() error {
+	ctx := context.TODO()
+	template, err := c.desiredDaemonSetTemplate()
+	if err != nil {
+		return err
+	}
+	ds := c.daemonSet
+	podHash := template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	revisionHistoryLimit := defaults.GetRevisionHistoryLimitOrDefault(c.rollout)
+	if ds.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] == podHash &&
+		ds.Spec.MinReadySeconds == c.rollout.Spec.MinReadySeconds &&
+		ptr.Deref(ds.Spec.RevisionHistoryLimit, 0) == revisionHistoryLimit {
+		return nil
+	}
+	dsCopy := ds.DeepCopy()
+	dsCopy.Spec.Template = *template
+	dsCopy.Spec.MinReadySeconds = c.rollout.Spec.MinReadySeconds
+	dsCopy.Spec.RevisionHistoryLimit = ptr.To(revisionHistoryLimit)
+	c.log.Infof("Updating DaemonSet %s to revision %s", ds.Name, podHash)
+	updated, err := c.kubeclientset.AppsV1().DaemonSets(ds.Namespace).Update(ctx, dsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	c.daemonSet = updated
+	return nil
+}
+
+// reconcileDaemonSetPods deletes the pods of the nodes which should run the revision of the template of the DaemonSet
+// but run another revision, in the order of their node names. Available pods are only deleted as long as no more than
+// maxUnavailable pods of the DaemonSet are unavailable. When the rollout is aborted, every node should run the stable
+// revision.
+func (c *rolloutContext) reconcileDaemonSetPods() error {
+	ctx := context.TODO()
+	ds := c.daemonSet
+	if ds.Status.ObservedGeneration < ds.Generation {
+		// a pod deleted before the DaemonSet controller observes the template would be recreated with the previous one
+		c.log.Infof("Waiting for DaemonSet %s to observe generation %d", ds.Name, ds.Generation)
+		return nil
+	}
+	aborted := c.pauseContext.IsAborted()
+	if reason := c.haltProgress(); reason != "" && !aborted {
+		c.log.Infof("Not updating the pods of DaemonSet %s: %s", ds.Name, reason)
+		return nil
+	}
+
+	podHash := ds.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	now := timeutil.MetaNow()
+	var available int32
+	var outdated []*corev1.Pod
+	for _, pod := range c.daemonSetPods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if podutil.IsPodAvailable(pod, ds.Spec.MinReadySeconds, now) {
+			available++
+		}
+		if pod.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != podHash && (aborted || c.daemonSetUpdatedNodes[pod.Spec.NodeName]) {
+			outdated = append(outdated, pod)
+		}
+	}
+	sort.Slice(outdated, func(i, j int) bool {
+		return outdated[i].Spec.NodeName < outdated[j].Spec.NodeName
+	})
+
+	unavailable := max(ds.Status.DesiredNumberScheduled-available, 0)
+	budget := replicasetutil.DaemonSetMaxUnavailable(c.rollout, ds.Status.DesiredNumberScheduled) - unavailable
+	for _, pod := range outdated {
+		// deleting an unavailable pod does not make another pod unavailable
+		if podutil.IsPodAvailable(pod, ds.Spec.MinReadySeconds, now) {
+			if budget <= 0 {
+				continue
+			}
+			budget--
+		}
+		c.log.Infof("Deleting pod %s on node %s to update it to revision %s", pod.Name, pod.Spec.NodeName, podHash)
+		err := c.kubeclientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// completedDaemonSetCohort returns whether every updated node runs an available pod of the new revision and no other pod
+func (c *rolloutContext) completedDaemonSetCohort() bool {
+	ds := c.daemonSet
+	if ds.Status.ObservedGeneration < ds.Generation {
+		return false
+	}
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	now := timeutil.MetaNow()
+	for _, pod := range c.daemonSetPods {
+		if !c.daemonSetUpdatedNodes[pod.Spec.NodeName] {
+			continue
+		}
+		if pod.DeletionTimestamp != nil || pod.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] != podHash {
+			return false
+		}
+		if !podutil.IsPodAvailable(pod, ds.Spec.MinReadySeconds, now) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *rolloutContext) completedCurrentDaemonSetStep() bool {
+	if c.rollout.Spec.Paused || c.revisionHold != "" {
+		return false
+	}
+	currentStep, _ := replicasetutil.GetCurrentDaemonSetStep(c.rollout)
+	if currentStep == nil {
+		return false
+	}
+	switch {
+	case currentStep.Pause != nil:
+		return c.pauseContext.CompletedDaemonSetPauseStep(*currentStep.Pause)
+	case currentStep.Cohort != nil:
+		return c.completedDaemonSetCohort()
+	case currentStep.Analysis != nil:
+		currentStepAr := c.currentArs.DaemonSetStep
+		analysisExistsAndCompleted := currentStepAr != nil && currentStepAr.Status.Phase.Completed()
+		return analysisExistsAndCompleted && currentStepAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
+	}
+	return false
+}
+
+func (c *rolloutContext) reconcileDaemonSetPause() bool {
+	if c.rollout.Spec.Paused {
+		return false
+	}
+	if c.rollout.Status.PromoteFull {
+		return false
+	}
+	totalSteps := len(c.rollout.Spec.Strategy.DaemonSet.Steps)
+	currentStep, currentStepIndex := replicasetutil.GetCurrentDaemonSetStep(c.rollout)
+	if currentStep == nil || currentStep.Pause == nil {
+		return false
+	}
+	c.log.Infof("Reconciling DaemonSet pause step (stepIndex: %d/%d)", *currentStepIndex, totalSteps)
+	cond := getPauseCondition(c.rollout, v1alpha1.PauseReasonDaemonSetPauseStep)
+	if cond == nil {
+		// the controller pauses the rollout unless it was unpaused by the user, see reconcileCanaryPause
+		if !c.rollout.Status.ControllerPause {
+			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonDaemonSetPauseStep)
+		}
+		return true
+	}
+	if currentStep.Pause.Duration == nil {
+		return true
+	}
+	c.checkEnqueueRolloutDuringWait(cond.StartTime, currentStep.Pause.DurationSeconds())
+	return true
+}
+
+func (c *rolloutContext) syncRolloutStatusDaemonSet() error {
+	newStatus := c.calculateBaseStatus()
+	newStatus.AvailableReplicas = replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs)
+	newStatus.Selector = metav1.FormatLabelSelector(c.rollout.Spec.Selector)
+	newStatus.DaemonSet.Nodes = c.daemonSet.Status.DesiredNumberScheduled
+	newStatus.DaemonSet.UpdatedNodes = int32(len(c.daemonSetUpdatedNodes))
+
+	currentStep, currentStepIndex := replicasetutil.GetCurrentDaemonSetStep(c.rollout)
+	newStatus.StableRS = c.rollout.Status.StableRS
+	newStatus.CurrentStepHash = conditions.ComputeStepHash(c.rollout)
+	stepCount := int32(len(c.rollout.Spec.Strategy.DaemonSet.Steps))
+
+	if replicasetutil.PodTemplateOrStepsChanged(c.rollout, c.newRS) {
+		c.resetRolloutStatus(&newStatus)
+		if c.newRS != nil && stepCount > 0 && c.rollout.Status.StableRS == replicasetutil.GetPodTemplateHash(c.newRS) {
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: "SkipSteps"}, "Rollback to stable revision")
+			newStatus.CurrentStepIndex = &stepCount
+		}
+		return c.persistRolloutStatus(&newStatus)
+	}
+
+	if c.rollout.Status.PromoteFull {
+		c.pauseContext.ClearPauseConditions()
+		c.pauseContext.RemoveAbort()
+		if stepCount > 0 {
+			currentStepIndex = &stepCount
+		}
+	}
+
+	if reason := c.shouldFullPromote(newStatus); reason != "" {
+		err := c.promoteStable(&newStatus, reason)
+		if err != nil {
+			return err
+		}
+		return c.persistRolloutStatus(&newStatus)
+	}
+
+	if c.pauseContext.IsAborted() {
+		if stepCount > int32(0) {
+			if newStatus.StableRS == newStatus.CurrentPodHash {
+				newStatus.CurrentStepIndex = &stepCount
+			} else {
+				newStatus.CurrentStepIndex = ptr.To[int32](0)
+			}
+		}
+		return c.persistRolloutStatus(&newStatus)
+	}
+
+	if c.completedCurrentDaemonSetStep() {
+		stepStr := rolloututil.DaemonSetStepString(*currentStep)
+		*currentStepIndex++
+		newStatus.DaemonSet.CurrentStepAnalysisRunStatus = nil
+
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepCompletedReason}, conditions.RolloutStepCompletedMessage, int(*currentStepIndex), stepCount, stepStr)
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonDaemonSetPauseStep)
+	}
+
+	newStatus.CurrentStepIndex = currentStepIndex
+	return c.persistRolloutStatus(&newStatus)
+}
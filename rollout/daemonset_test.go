@@ -0,0 +1,346 @@
+package rollout
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const daemonSetStableHash = "stable"
+
+// newDaemonSetRollout returns a rollout with the DaemonSet strategy at the given step, whose steps update half of the
+// nodes, pause and then update the nodes of zone b
+func newDaemonSetRollout(stepIndex int32) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "rollout-uid",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "agent", Image: "agent:v2"}}},
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{
+					Steps: []v1alpha1.DaemonSetStep{
+						{Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](50)}},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{Cohort: &v1alpha1.DaemonSetCohort{NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "b"}}}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:         daemonSetStableHash,
+			CurrentStepIndex: ptr.To(stepIndex),
+		},
+	}
+}
+
+func daemonSetNewHash(r *v1alpha1.Rollout) string {
+	return hash.ComputePodTemplateHash(&r.Spec.Template, r.Status.CollisionCount)
+}
+
+// newRolloutDaemonSet returns the DaemonSet of the rollout running on 4 nodes with the template of the given revision
+func newRolloutDaemonSet(r *v1alpha1.Rollout, podHash string) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            r.Name,
+			Namespace:       r.Namespace,
+			UID:             "ds-uid",
+			Generation:      1,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(r, controllerKind)},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: r.Spec.Selector,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}},
+			},
+			UpdateStrategy:       appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType},
+			RevisionHistoryLimit: ptr.To[int32](10),
+		},
+		Status: appsv1.DaemonSetStatus{
+			ObservedGeneration:     1,
+			DesiredNumberScheduled: 4,
+		},
+	}
+}
+
+func newDaemonSetRevision(t *testing.T, ds *appsv1.DaemonSet, podHash string) *appsv1.ControllerRevision {
+	t.Helper()
+	labels := map[string]string{"foo": "bar", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}
+	template := corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: labels}}
+	data, err := json.Marshal(map[string]any{"spec": map[string]any{"template": template}})
+	require.NoError(t, err)
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ds.Name + "-" + podHash,
+			Namespace:       ds.Namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ds, appsv1.SchemeGroupVersion.WithKind("DaemonSet"))},
+		},
+		Data: runtime.RawExtension{Raw: data},
+	}
+}
+
+// newDaemonSetPods returns an available pod of the DaemonSet on each of the nodes a-1, a-2, b-1 and b-2, running the
+// given revisions
+func newDaemonSetPods(ds *appsv1.DaemonSet, podHashes ...string) []*corev1.Pod {
+	var pods []*corev1.Pod
+	for i, node := range []string{"a-1", "a-2", "b-1", "b-2"}[:len(podHashes)] {
+		pods = append(pods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            ds.Name + "-" + node,
+				Namespace:       ds.Namespace,
+				Labels:          map[string]string{"foo": "bar", v1alpha1.DefaultRolloutUniqueLabelKey: podHashes[i]},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ds, appsv1.SchemeGroupVersion.WithKind("DaemonSet"))},
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: timeutil.MetaTime(timeutil.Now().Add(-time.Minute)),
+				}},
+			},
+		})
+	}
+	return pods
+}
+
+func newDaemonSetNodes() []runtime.Object {
+	var nodes []runtime.Object
+	for _, name := range []string{"a-1", "a-2", "b-1", "b-2"} {
+		nodes = append(nodes, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"zone": name[:1]}},
+		})
+	}
+	return nodes
+}
+
+func newDaemonSetRolloutContext(t *testing.T, r *v1alpha1.Rollout, ds *appsv1.DaemonSet, objs ...runtime.Object) (*rolloutContext, *k8sfake.Clientset) {
+	t.Helper()
+	if ds != nil {
+		objs = append(objs, ds)
+	}
+	client := k8sfake.NewSimpleClientset(objs...)
+	// the informers are served by their own clients, which are kept apart so that their actions are not recorded
+	var nodes, workloads []runtime.Object
+	for _, obj := range objs {
+		switch obj := obj.(type) {
+		case *appsv1.DaemonSet, *appsv1.ControllerRevision, *corev1.Pod:
+			workloads = append(workloads, obj)
+		case *corev1.Node:
+			nodes = append(nodes, obj)
+		}
+	}
+	nodeI := kubeinformers.NewSharedInformerFactory(k8sfake.NewSimpleClientset(nodes...), 0)
+	nodeCache := newNodeCache(nodeI)
+	nodeI.Start(nil)
+	nodeI.WaitForCacheSync(nil)
+	return &rolloutContext{
+		rollout:      r,
+		log:          logutil.WithRollout(r),
+		pauseContext: &pauseContext{rollout: r},
+		reconcilerBase: reconcilerBase{
+			kubeclientset: client,
+			daemonSets:    newSyncedWorkloadCache(t, "DaemonSet", daemonSetInformer, workloads...),
+			nodes:         nodeCache,
+			recorder:      record.NewFakeEventRecorder(),
+		},
+	}, client
+}
+
+// syncedDaemonSetRolloutContext returns the context of a rollout whose DaemonSet revisions are already synced
+func syncedDaemonSetRolloutContext(t *testing.T, r *v1alpha1.Rollout, ds *appsv1.DaemonSet, pods []*corev1.Pod) (*rolloutContext, *k8sfake.Clientset) {
+	t.Helper()
+	objs := newDaemonSetNodes()
+	objs = append(objs, newDaemonSetRevision(t, ds, daemonSetStableHash), newDaemonSetRevision(t, ds, daemonSetNewHash(r)))
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	roCtx, client := newDaemonSetRolloutContext(t, r, ds, objs...)
+	require.NoError(t, roCtx.syncDaemonSetRevisions())
+	client.ClearActions()
+	return roCtx, client
+}
+
+func TestSyncDaemonSetRevisions(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	newHash := daemonSetNewHash(r)
+	ds := newRolloutDaemonSet(r, newHash)
+	pods := newDaemonSetPods(ds, newHash, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash)
+	pods[0].Status.Conditions = nil
+	roCtx, _ := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	require.NotNil(t, roCtx.newRS)
+	assert.Equal(t, "foo-"+newHash, roCtx.newRS.Name)
+	assert.Equal(t, newHash, roCtx.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, int32(1), roCtx.newRS.Status.Replicas)
+	assert.Equal(t, int32(0), roCtx.newRS.Status.AvailableReplicas)
+
+	require.NotNil(t, roCtx.stableRS)
+	assert.Equal(t, "foo-stable", roCtx.stableRS.Name)
+	assert.Equal(t, int32(3), roCtx.stableRS.Status.AvailableReplicas)
+	assert.Len(t, roCtx.allRSs, 2)
+	assert.Contains(t, roCtx.daemonSetTemplates, daemonSetStableHash)
+	// the first cohort is half of the nodes by name
+	assert.Equal(t, map[string]bool{"a-1": true, "a-2": true}, roCtx.daemonSetUpdatedNodes)
+}
+
+func TestSyncDaemonSetRevisionsNodeSelector(t *testing.T) {
+	r := newDaemonSetRollout(2)
+	newHash := daemonSetNewHash(r)
+	ds := newRolloutDaemonSet(r, newHash)
+	pods := newDaemonSetPods(ds, newHash, newHash, daemonSetStableHash, daemonSetStableHash)
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	// the cohort of zone b adds the nodes b-1 and b-2, whose labels are read from the nodes
+	assert.Len(t, roCtx.daemonSetUpdatedNodes, 4)
+	// revisions, pods and nodes are read from the informers
+	require.NoError(t, roCtx.syncDaemonSetRevisions())
+	assert.Empty(t, client.Actions())
+}
+
+func TestSyncDaemonSetRevisionsCreatesDaemonSet(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	r.Status.StableRS = ""
+	roCtx, client := newDaemonSetRolloutContext(t, r, nil)
+
+	require.NoError(t, roCtx.syncDaemonSetRevisions())
+	require.NotNil(t, roCtx.daemonSet)
+	created, err := client.AppsV1().DaemonSets(r.Namespace).Get(t.Context(), r.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, appsv1.OnDeleteDaemonSetStrategyType, created.Spec.UpdateStrategy.Type)
+	assert.Equal(t, daemonSetNewHash(r), created.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.True(t, metav1.IsControlledBy(created, r))
+	require.NotNil(t, roCtx.newRS)
+	assert.Nil(t, roCtx.stableRS)
+}
+
+func TestSyncDaemonSetRevisionsNotControlled(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	ds := newRolloutDaemonSet(r, daemonSetStableHash)
+	ds.OwnerReferences = nil
+	roCtx, _ := newDaemonSetRolloutContext(t, r, ds)
+
+	assert.EqualError(t, roCtx.syncDaemonSetRevisions(), "DaemonSet foo is not controlled by rollout foo")
+}
+
+func TestReconcileDaemonSetTemplate(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	ds := newRolloutDaemonSet(r, daemonSetStableHash)
+	pods := newDaemonSetPods(ds, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash)
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	require.NoError(t, roCtx.reconcileDaemonSetTemplate())
+	require.Len(t, client.Actions(), 1)
+	assert.Equal(t, daemonSetNewHash(r), roCtx.daemonSet.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+
+	// no pod is deleted before the DaemonSet controller observes the template
+	roCtx.daemonSet.Generation = 2
+	client.ClearActions()
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	assert.Empty(t, deletedPods(client))
+}
+
+func TestReconcileDaemonSetPods(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	ds := newRolloutDaemonSet(r, daemonSetNewHash(r))
+	pods := newDaemonSetPods(ds, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash)
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	// a single pod of the cohort is deleted at a time by default
+	assert.Equal(t, []string{"foo-a-1"}, deletedPods(client))
+	assert.False(t, roCtx.completedCurrentDaemonSetStep())
+
+	r.Spec.Strategy.DaemonSet.MaxUnavailable = ptr.To(intstr.FromInt32(3))
+	client.ClearActions()
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	// only the nodes of the cohort are updated
+	assert.Equal(t, []string{"foo-a-1", "foo-a-2"}, deletedPods(client))
+}
+
+func TestReconcileDaemonSetPodsUnavailable(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	ds := newRolloutDaemonSet(r, daemonSetNewHash(r))
+	pods := newDaemonSetPods(ds, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash)
+	pods[3].Status.Conditions = nil
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	assert.Empty(t, deletedPods(client))
+
+	pods[1].Status.Conditions = nil
+	roCtx, client = syncedDaemonSetRolloutContext(t, r, ds, pods)
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	// an unavailable pod of the cohort can always be deleted
+	assert.Equal(t, []string{"foo-a-2"}, deletedPods(client))
+}
+
+func TestReconcileDaemonSetPodsPaused(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	r.Spec.Paused = true
+	ds := newRolloutDaemonSet(r, daemonSetNewHash(r))
+	pods := newDaemonSetPods(ds, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash, daemonSetStableHash)
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	assert.Empty(t, deletedPods(client))
+}
+
+func TestReconcileDaemonSetAbort(t *testing.T) {
+	r := newDaemonSetRollout(2)
+	r.Status.Abort = true
+	newHash := daemonSetNewHash(r)
+	ds := newRolloutDaemonSet(r, newHash)
+	pods := newDaemonSetPods(ds, newHash, newHash, daemonSetStableHash, daemonSetStableHash)
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	// the template of the stable revision is restored
+	require.NoError(t, roCtx.reconcileDaemonSetTemplate())
+	assert.Equal(t, daemonSetStableHash, roCtx.daemonSet.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+
+	roCtx.daemonSet.Status.ObservedGeneration = roCtx.daemonSet.Generation
+	client.ClearActions()
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	assert.Equal(t, []string{"foo-a-1"}, deletedPods(client))
+}
+
+func TestCompletedDaemonSetCohort(t *testing.T) {
+	r := newDaemonSetRollout(0)
+	newHash := daemonSetNewHash(r)
+	ds := newRolloutDaemonSet(r, newHash)
+	pods := newDaemonSetPods(ds, newHash, newHash, daemonSetStableHash, daemonSetStableHash)
+	roCtx, client := syncedDaemonSetRolloutContext(t, r, ds, pods)
+
+	require.NoError(t, roCtx.reconcileDaemonSetPods())
+	assert.Empty(t, client.Actions())
+	assert.True(t, roCtx.completedCurrentDaemonSetStep())
+
+	pods[1].Status.Conditions = nil
+	roCtx, _ = syncedDaemonSetRolloutContext(t, r, ds, pods)
+	assert.False(t, roCtx.completedCurrentDaemonSetStep())
+}
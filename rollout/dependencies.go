@@ -0,0 +1,131 @@
+package rollout
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const (
+	dependenciesHoldReason = "waiting for dependencies"
+	// dependsOnIndexName is the index by which Rollouts are cached against the Rollouts they depend on
+	dependsOnIndexName = "byDependsOn"
+)
+
+// reconcileDependencies holds a new revision before it starts until the Rollouts listed in spec.dependsOn reach their
+// required phase. An update which already started is not held back by its dependencies.
+func (c *rolloutContext) reconcileDependencies() error {
+	held := getPauseCondition(c.rollout, v1alpha1.PauseReasonWaitingForDependencies) != nil
+	if len(c.rollout.Spec.DependsOn) == 0 || !c.isHoldableUpdate() || !c.atRevisionStart() {
+		if held {
+			c.releaseDependenciesHold()
+		}
+		return nil
+	}
+
+	unmet, err := c.unmetDependencies()
+	if err != nil {
+		return err
+	}
+	if len(unmet) == 0 {
+		if held {
+			c.releaseDependenciesHold()
+		}
+		return nil
+	}
+	details := strings.Join(unmet, ", ")
+	if !held {
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.WaitingForDependenciesReason}, conditions.WaitingForDependenciesMessage, details)
+	}
+	c.holdRevision(dependenciesHoldReason)
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonWaitingForDependencies)
+	c.dependenciesCondition = conditions.NewRolloutCondition(v1alpha1.WaitingForDependencies, corev1.ConditionTrue, conditions.WaitingForDependenciesReason, details)
+	return nil
+}
+
+// releaseDependenciesHold removes the WaitingForDependencies pause condition. Like a deployment window hold, the
+// revision stays held for the rest of this reconciliation and the rollout is requeued to continue afterwards.
+func (c *rolloutContext) releaseDependenciesHold() {
+	c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonWaitingForDependencies)
+	if c.pauseContext.IsAborted() {
+		return
+	}
+	c.log.Info("Releasing dependencies hold")
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.DependenciesSatisfiedReason}, conditions.DependenciesSatisfiedMessage)
+	c.holdRevision(dependenciesHoldReason)
+	c.enqueueRollout(c.rollout)
+}
+
+// unmetDependencies returns a description of each dependency of the rollout which is not met. An error getting a
+// dependency other than it not existing is returned, so that the rollout is requeued.
+func (c *rolloutContext) unmetDependencies() ([]string, error) {
+	var unmet []string
+	for _, dep := range c.rollout.Spec.DependsOn {
+		namespace := dependencyNamespace(c.rollout, dep)
+		phase := dep.Phase
+		if phase == "" {
+			phase = v1alpha1.RolloutPhaseHealthy
+		}
+		ro, err := c.rolloutsLister.Rollouts(namespace).Get(dep.Name)
+		if err != nil {
+			if !k8serrors.IsNotFound(err) {
+				return nil, err
+			}
+			unmet = append(unmet, fmt.Sprintf("Rollout '%s/%s' not found", namespace, dep.Name))
+			continue
+		}
+		if currentPhase, _ := rolloututil.GetRolloutPhase(ro); currentPhase != phase {
+			unmet = append(unmet, fmt.Sprintf("Rollout '%s/%s' is %s, waiting for %s", namespace, dep.Name, currentPhase, phase))
+			continue
+		}
+		if dep.RevisionAnnotation != "" {
+			desired := c.rollout.Annotations[dep.RevisionAnnotation]
+			if current := ro.Annotations[dep.RevisionAnnotation]; current != desired {
+				unmet = append(unmet, fmt.Sprintf("Rollout '%s/%s' has %s '%s', waiting for '%s'", namespace, dep.Name, dep.RevisionAnnotation, current, desired))
+			}
+		}
+	}
+	return unmet, nil
+}
+
+func dependencyNamespace(ro *v1alpha1.Rollout, dep v1alpha1.RolloutDependency) string {
+	if dep.Namespace != "" {
+		return dep.Namespace
+	}
+	return ro.Namespace
+}
+
+// dependencyKeys returns the namespace/name keys of the Rollouts the given rollout depends on
+func dependencyKeys(ro *v1alpha1.Rollout) []string {
+	var keys []string
+	for _, dep := range ro.Spec.DependsOn {
+		keys = append(keys, fmt.Sprintf("%s/%s", dependencyNamespace(ro, dep), dep.Name))
+	}
+	return keys
+}
+
+// enqueueDependents enqueues the Rollouts which depend on the given rollout, so that they re-evaluate their
+// dependencies when it changes
+func (c *Controller) enqueueDependents(obj any) {
+	ro := unstructuredutil.ObjectToRollout(obj)
+	if ro == nil {
+		return
+	}
+	dependents, err := c.rolloutsIndexer.ByIndex(dependsOnIndexName, fmt.Sprintf("%s/%s", ro.Namespace, ro.Name))
+	if err != nil {
+		logutil.WithRollout(ro).Errorf("Failed to look up dependent rollouts: %v", err)
+		return
+	}
+	for _, dependent := range dependents {
+		c.enqueueRollout(dependent)
+	}
+}
@@ -0,0 +1,170 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newUpstreamRollout returns a Rollout in the given phase which other rollouts can depend on
+func newUpstreamRollout(f *fixture, name string, phase v1alpha1.RolloutPhase) *v1alpha1.Rollout {
+	upstream := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   metav1.NamespaceDefault,
+			Annotations: map[string]string{},
+		},
+		Status: v1alpha1.RolloutStatus{
+			Phase: phase,
+		},
+	}
+	f.rolloutLister = append(f.rolloutLister, upstream)
+	f.objects = append(f.objects, upstream)
+	return upstream
+}
+
+func TestDependsOnHoldsNewRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	newUpstreamRollout(f, "backend-api", v1alpha1.RolloutPhaseProgressing)
+	r2, _ := newDeploymentWindowRollout(f, nil)
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api"}}
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	require.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonWaitingForDependencies, patched.Status.PauseConditions[0].Reason)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.WaitingForDependencies)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, conditions.WaitingForDependenciesReason, cond.Reason)
+	assert.Equal(t, "Rollout 'default/backend-api' is Progressing, waiting for Healthy", cond.Message)
+	assert.Equal(t, "WaitingForDependencies: Rollout 'default/backend-api' is Progressing, waiting for Healthy", patched.Status.Message)
+}
+
+func TestDependsOnStartsNewRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	newUpstreamRollout(f, "backend-api", v1alpha1.RolloutPhaseHealthy)
+	r2, rs2 := newDeploymentWindowRollout(f, nil)
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api"}}
+
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Empty(t, patched.Status.PauseConditions)
+	assert.Nil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.WaitingForDependencies))
+}
+
+func TestDependsOnRevisionAnnotation(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	upstream := newUpstreamRollout(f, "backend-api", v1alpha1.RolloutPhaseHealthy)
+	upstream.Annotations["example.com/release"] = "2024.1"
+	r2, rs2 := newDeploymentWindowRollout(f, nil)
+	r2.Annotations["example.com/release"] = "2024.2"
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api", RevisionAnnotation: "example.com/release"}}
+
+	// the annotations of the rollout are copied to its new ReplicaSet, which is not scaled up
+	updateIndex := f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	assert.Equal(t, int32(0), *f.getUpdatedReplicaSet(updateIndex).Spec.Replicas)
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.WaitingForDependencies)
+	require.NotNil(t, cond)
+	assert.Equal(t, "Rollout 'default/backend-api' has example.com/release '2024.1', waiting for '2024.2'", cond.Message)
+}
+
+func TestDependsOnMissingRollout(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, _ := newDeploymentWindowRollout(f, nil)
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api", Namespace: "backend", Phase: v1alpha1.RolloutPhasePaused}}
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.WaitingForDependencies)
+	require.NotNil(t, cond)
+	assert.Equal(t, "Rollout 'backend/backend-api' not found", cond.Message)
+}
+
+// verify a namespaced controller rejects a dependency in another namespace, which it can not resolve
+func TestDependsOnOtherNamespaceWithNamespacedController(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, _ := newDeploymentWindowRollout(f, nil)
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api", Namespace: "backend"}}
+
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	c.namespace = metav1.NamespaceDefault
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runController(getKey(r2, t), true, true, c, i, k8sI)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.InvalidSpec)
+	require.NotNil(t, cond)
+	assert.Equal(t, "The Rollout \"foo\" is invalid: spec.dependsOn[0].namespace: Invalid value: \"backend\": Namespace must be the namespace of the rollout when the controller is namespaced (default)", cond.Message)
+}
+
+func TestDependsOnReleasesHeldRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	newUpstreamRollout(f, "backend-api", v1alpha1.RolloutPhaseHealthy)
+	r2, _ := newDeploymentWindowRollout(f, nil)
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api"}}
+	earlier := metav1.NewTime(timeutil.Now().Add(-time.Minute))
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonWaitingForDependencies,
+		StartTime: earlier,
+	}}
+	r2.Status.ControllerPause = true
+	cond := conditions.NewRolloutCondition(v1alpha1.WaitingForDependencies, corev1.ConditionTrue, conditions.WaitingForDependenciesReason, "Rollout 'default/backend-api' is Progressing, waiting for Healthy")
+	conditions.SetRolloutCondition(&r2.Status, *cond)
+
+	// the replica sets are left untouched until the next reconciliation
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Empty(t, patched.Status.PauseConditions)
+	assert.False(t, patched.Status.ControllerPause)
+	assert.Nil(t, conditions.GetRolloutCondition(patched.Status, v1alpha1.WaitingForDependencies))
+	f.enqueuedObjectsLock.Lock()
+	defer f.enqueuedObjectsLock.Unlock()
+	// the informer enqueues the rollout when it and the Rollout it depends on are added, and the rollout is enqueued
+	// again to release the hold
+	assert.Equal(t, 3, f.enqueuedObjects[getKey(r2, t)])
+}
+
+func TestEnqueueDependents(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	upstream := newUpstreamRollout(f, "backend-api", v1alpha1.RolloutPhaseHealthy)
+	r2, _ := newDeploymentWindowRollout(f, nil)
+	r2.Spec.DependsOn = []v1alpha1.RolloutDependency{{Name: "backend-api"}}
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	c.enqueueDependents(upstream)
+	c.enqueueDependents(r2)
+
+	f.enqueuedObjectsLock.Lock()
+	defer f.enqueuedObjectsLock.Unlock()
+	assert.Equal(t, 1, f.enqueuedObjects[getKey(r2, t)])
+	assert.Equal(t, 0, f.enqueuedObjects[getKey(upstream, t)])
+}
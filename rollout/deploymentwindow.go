@@ -0,0 +1,117 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/deploymentwindow"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const deploymentWindowHoldReason = "outside deployment window"
+
+// reconcileDeploymentWindows holds a new revision while it is outside of the deployment windows of the rollout. A
+// canary is held before it starts its steps and before it is promoted after completing them. A blue-green rollout is
+// held before its active service is switched. Rollbacks, aborts and full promotions are never held.
+func (c *rolloutContext) reconcileDeploymentWindows() {
+	held := getPauseCondition(c.rollout, v1alpha1.PauseReasonDeploymentWindow) != nil
+	if c.rollout.Spec.Strategy.DeploymentWindows == nil || !c.atDeploymentWindowGate() {
+		if held {
+			c.releaseDeploymentWindowHold()
+		}
+		return
+	}
+
+	windows, err := c.deploymentWindows()
+	if err != nil {
+		// fail closed so that a missing or invalid schedule never lets a revision through during a freeze
+		c.log.Warnf("Failed to evaluate deployment windows: %v", err)
+		if !held {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.DeploymentWindowErrorReason}, conditions.DeploymentWindowErrorMessage, err)
+		}
+		c.holdForDeploymentWindow(nil)
+		return
+	}
+
+	now := timeutil.Now()
+	next, found := windows.NextAllowed(now)
+	if found && !next.After(now) {
+		if held {
+			c.releaseDeploymentWindowHold()
+		}
+		return
+	}
+	if !found {
+		c.log.Warn("No deployment window opens within a year")
+		c.holdForDeploymentWindow(nil)
+		return
+	}
+	nextWindow := metav1.NewTime(next.UTC())
+	if !held {
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.DeploymentWindowClosedReason}, conditions.DeploymentWindowClosedMessage, nextWindow.Format(time.RFC3339))
+	}
+	c.holdForDeploymentWindow(&nextWindow)
+	remaining := next.Sub(now)
+	if remaining < c.resyncPeriod {
+		c.log.Infof("Enqueueing Rollout in %s for the next deployment window", remaining.String())
+		c.enqueueRolloutAfter(c.rollout, remaining)
+	}
+}
+
+// holdForDeploymentWindow pauses the rollout until the next deployment window. nextWindow is nil if it is unknown.
+func (c *rolloutContext) holdForDeploymentWindow(nextWindow *metav1.Time) {
+	c.holdRevision(deploymentWindowHoldReason)
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonDeploymentWindow)
+	c.newStatus.NextDeploymentWindow = nextWindow
+}
+
+// releaseDeploymentWindowHold removes the DeploymentWindow pause condition. The revision stays held for the rest of
+// this reconciliation, since the controller pause set by the deployment window would otherwise be mistaken for a
+// user resuming the next pause of the rollout. The rollout is requeued to continue immediately afterwards.
+func (c *rolloutContext) releaseDeploymentWindowHold() {
+	c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonDeploymentWindow)
+	if c.pauseContext.IsAborted() {
+		return
+	}
+	c.log.Info("Releasing deployment window hold")
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.DeploymentWindowOpenedReason}, conditions.DeploymentWindowOpenedMessage)
+	c.holdRevision(deploymentWindowHoldReason)
+	c.enqueueRollout(c.rollout)
+}
+
+// atDeploymentWindowGate returns whether the rollout is updating to a new revision and is at a point of the update at
+// which the deployment windows apply
+func (c *rolloutContext) atDeploymentWindowGate() bool {
+	if !c.isHoldableUpdate() {
+		return false
+	}
+	if c.atRevisionStart() {
+		return true
+	}
+	if c.rollout.Spec.Strategy.Canary != nil {
+		_, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+		return currentStepIndex != nil && *currentStepIndex >= int32(len(c.rollout.Spec.Strategy.Canary.Steps))
+	}
+	return false
+}
+
+// deploymentWindows returns the inline deployment windows of the rollout together with the windows of the
+// RolloutSchedules it references
+func (c *rolloutContext) deploymentWindows() (deploymentwindow.Windows, error) {
+	spec := c.rollout.Spec.Strategy.DeploymentWindows
+	windows := append([]v1alpha1.DeploymentWindow{}, spec.Windows...)
+	for _, ref := range spec.ScheduleRefs {
+		schedule, err := c.rolloutScheduleLister.Get(ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get RolloutSchedule '%s': %w", ref.Name, err)
+		}
+		windows = append(windows, schedule.Spec.Windows...)
+	}
+	return deploymentwindow.ParseAll(windows)
+}
@@ -0,0 +1,242 @@
+package rollout
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// dailyWindow returns an Allow window which opens daily at the given offset from now and stays open for an hour
+func dailyWindow(offset time.Duration) v1alpha1.DeploymentWindow {
+	start := timeutil.Now().Add(offset).UTC()
+	return v1alpha1.DeploymentWindow{
+		Kind:     v1alpha1.DeploymentWindowKindAllow,
+		RRule:    fmt.Sprintf("DTSTART:%s\nRRULE:FREQ=DAILY", start.Format("20060102T150405Z")),
+		Duration: "1h",
+	}
+}
+
+func newDeploymentWindowRollout(f *fixture, deploymentWindows *v1alpha1.DeploymentWindows) (*v1alpha1.Rollout, *appsv1.ReplicaSet) {
+	steps := []v1alpha1.CanaryStep{{
+		SetWeight: int32Ptr(10),
+	}, {
+		Pause: &v1alpha1.RolloutPause{},
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Strategy.DeploymentWindows = deploymentWindows
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	return r2, rs2
+}
+
+func TestDeploymentWindowHoldsNewRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, _ := newDeploymentWindowRollout(f, &v1alpha1.DeploymentWindows{
+		Windows: []v1alpha1.DeploymentWindow{dailyWindow(2 * time.Hour)},
+	})
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonDeploymentWindow, patched.Status.PauseConditions[0].Reason)
+	assert.True(t, patched.Status.ControllerPause)
+	nextWindow := timeutil.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+	assert.NotNil(t, patched.Status.NextDeploymentWindow)
+	assert.True(t, nextWindow.Equal(patched.Status.NextDeploymentWindow.Time))
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, patched.Status.Phase)
+	assert.Equal(t, fmt.Sprintf("DeploymentWindow: next window opens at %s", nextWindow.Format(time.RFC3339)), patched.Status.Message)
+}
+
+func TestDeploymentWindowAllowsNewRevisionInsideWindow(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, rs2 := newDeploymentWindowRollout(f, &v1alpha1.DeploymentWindows{
+		Windows: []v1alpha1.DeploymentWindow{dailyWindow(-30 * time.Minute)},
+	})
+
+	f.expectUpdateReplicaSetAction(rs2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Empty(t, patched.Status.PauseConditions)
+	assert.Nil(t, patched.Status.NextDeploymentWindow)
+}
+
+func TestDeploymentWindowReleasesHeldRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, _ := newDeploymentWindowRollout(f, &v1alpha1.DeploymentWindows{
+		Windows: []v1alpha1.DeploymentWindow{dailyWindow(-30 * time.Minute)},
+	})
+	earlier := metav1.NewTime(timeutil.Now().Add(-time.Hour))
+	r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason:    v1alpha1.PauseReasonDeploymentWindow,
+		StartTime: earlier,
+	}}
+	r2.Status.ControllerPause = true
+	r2.Status.NextDeploymentWindow = &earlier
+
+	// the replica sets are left untouched until the next reconciliation
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Empty(t, patched.Status.PauseConditions)
+	assert.False(t, patched.Status.ControllerPause)
+	assert.Nil(t, patched.Status.NextDeploymentWindow)
+	f.enqueuedObjectsLock.Lock()
+	defer f.enqueuedObjectsLock.Unlock()
+	// one enqueue comes from the informer and the other releases the hold
+	assert.Equal(t, 2, f.enqueuedObjects[getKey(r2, t)])
+}
+
+func TestDeploymentWindowScheduleRef(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	schedule := &v1alpha1.RolloutSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "business-hours"},
+		Spec: v1alpha1.RolloutScheduleSpec{
+			Windows: []v1alpha1.DeploymentWindow{dailyWindow(3 * time.Hour)},
+		},
+	}
+	f.rolloutScheduleLister = append(f.rolloutScheduleLister, schedule)
+	f.objects = append(f.objects, schedule)
+	r2, _ := newDeploymentWindowRollout(f, &v1alpha1.DeploymentWindows{
+		ScheduleRefs: []v1alpha1.RolloutScheduleRef{{Name: "business-hours"}},
+	})
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	require.NotNil(t, patched.Status.NextDeploymentWindow)
+	assert.True(t, timeutil.Now().Add(3*time.Hour).UTC().Truncate(time.Second).Equal(patched.Status.NextDeploymentWindow.Time))
+}
+
+func TestDeploymentWindowMissingScheduleHoldsRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, _ := newDeploymentWindowRollout(f, &v1alpha1.DeploymentWindows{
+		ScheduleRefs: []v1alpha1.RolloutScheduleRef{{Name: "missing"}},
+	})
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonDeploymentWindow, patched.Status.PauseConditions[0].Reason)
+	assert.Nil(t, patched.Status.NextDeploymentWindow)
+	assert.Equal(t, "DeploymentWindow", patched.Status.Message)
+}
+
+// verify a namespaced controller rejects references to RolloutSchedules, which are cluster-scoped and not watched by it
+func TestDeploymentWindowScheduleRefWithNamespacedController(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r2, _ := newDeploymentWindowRollout(f, &v1alpha1.DeploymentWindows{
+		ScheduleRefs: []v1alpha1.RolloutScheduleRef{{Name: "business-hours"}},
+	})
+
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	c.namespace = metav1.NamespaceDefault
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.runController(getKey(r2, t), true, true, c, i, k8sI)
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.InvalidSpec)
+	require.NotNil(t, cond)
+	assert.Equal(t, "The Rollout \"foo\" is invalid: spec.strategy.deploymentWindows.scheduleRefs: Forbidden: RolloutSchedules cannot be referenced when the controller is namespaced (default), use inline windows instead", cond.Message)
+}
+
+func TestDeploymentWindowHoldsPromotion(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{
+		Pause: &v1alpha1.RolloutPause{},
+	}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	r1.Spec.Strategy.DeploymentWindows = &v1alpha1.DeploymentWindows{
+		Windows: []v1alpha1.DeploymentWindow{dailyWindow(2 * time.Hour)},
+	}
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 0, 0)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 10, 10)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 10, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	// the stable ReplicaSet is left unchanged
+	assert.Empty(t, patched.Status.StableRS)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonDeploymentWindow, patched.Status.PauseConditions[0].Reason)
+}
+
+func TestDeploymentWindowHoldsBlueGreenPromotion(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	r1.Spec.Strategy.DeploymentWindows = &v1alpha1.DeploymentWindows{
+		Windows: []v1alpha1.DeploymentWindow{dailyWindow(2 * time.Hour)},
+	}
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	r2 = updateBlueGreenRolloutStatus(r2, rs2PodHash, rs1PodHash, rs1PodHash, 1, 1, 2, 1, false, true, false)
+	previewSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}
+	previewSvc := newService("preview", 80, previewSelector, r2)
+	activeSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}
+	activeSvc := newService("active", 80, activeSelector, r2)
+
+	f.objects = append(f.objects, r2)
+	f.kubeobjects = append(f.kubeobjects, previewSvc, activeSvc, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.serviceLister = append(f.serviceLister, activeSvc, previewSvc)
+
+	// the active service is not switched
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	// the stable ReplicaSet is left unchanged
+	assert.Empty(t, patched.Status.StableRS)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonDeploymentWindow, patched.Status.PauseConditions[0].Reason)
+}
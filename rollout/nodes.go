@@ -0,0 +1,48 @@
+package rollout
+
+import (
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// nodeCache lists nodes from an informer which is only started once a DaemonSet rollout selects its cohorts by node
+// labels, so that the controller neither watches nodes nor needs permission to do so otherwise.
+type nodeCache struct {
+	factory kubeinformers.SharedInformerFactory
+	lister  v1.NodeLister
+	synced  cache.InformerSynced
+	once    sync.Once
+	stopCh  <-chan struct{}
+}
+
+func newNodeCache(factory kubeinformers.SharedInformerFactory) *nodeCache {
+	informer := factory.Core().V1().Nodes()
+	return &nodeCache{
+		factory: factory,
+		lister:  informer.Lister(),
+		synced:  informer.Informer().HasSynced,
+	}
+}
+
+// run sets the channel which stops the informer once it is started
+func (n *nodeCache) run(stopCh <-chan struct{}) {
+	n.stopCh = stopCh
+}
+
+// list starts the node informer on first use and returns the cached nodes. An error is returned until the informer
+// has synced, so that the rollout is requeued rather than its cohorts computed from a partial list of nodes.
+func (n *nodeCache) list() ([]*corev1.Node, error) {
+	n.once.Do(func() {
+		n.factory.Start(n.stopCh)
+	})
+	if !n.synced() {
+		return nil, fmt.Errorf("waiting for the node cache to sync")
+	}
+	return n.lister.List(labels.Everything())
+}
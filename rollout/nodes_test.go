@@ -0,0 +1,32 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNodeCacheStartsOnFirstList(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "a-1"}})
+	nodes := newNodeCache(kubeinformers.NewSharedInformerFactory(client, 0))
+	nodes.run(t.Context().Done())
+
+	// nodes are not watched until they are listed
+	assert.Empty(t, client.Actions())
+
+	require.Eventually(t, func() bool {
+		list, err := nodes.list()
+		if err != nil {
+			assert.EqualError(t, err, "waiting for the node cache to sync")
+			return false
+		}
+		return len(list) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.True(t, client.Actions()[0].Matches("list", "nodes"))
+}
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/hash"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
@@ -134,6 +135,45 @@ func getPauseCondition(rollout *v1alpha1.Rollout, reason v1alpha1.PauseReason) *
 	return nil
 }
 
+// holdRevision holds the new revision for the given reason, which is then reported by haltProgress. The first reason
+// is kept if the revision is held for several reasons.
+func (c *rolloutContext) holdRevision(reason string) {
+	if c.revisionHold == "" {
+		c.revisionHold = reason
+	}
+}
+
+// isHoldableUpdate returns whether the rollout is updating to a new revision which may be held. Rollbacks, aborts and
+// full promotions are never held.
+func (c *rolloutContext) isHoldableUpdate() bool {
+	if c.stableRS == nil || c.rollout.Status.PromoteFull || c.pauseContext.IsAborted() {
+		return false
+	}
+	return c.newRevisionPodHash() != c.rollout.Status.StableRS && !c.isFastRollback()
+}
+
+// atRevisionStart returns whether the update to the new revision has not started yet, i.e. a canary or DaemonSet
+// rollout has not completed its first step or a blue-green rollout has not switched its active service
+func (c *rolloutContext) atRevisionStart() bool {
+	if c.rollout.Spec.Strategy.Canary != nil {
+		_, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+		return currentStepIndex == nil || *currentStepIndex == 0
+	}
+	if c.rollout.Spec.Strategy.DaemonSet != nil {
+		_, currentStepIndex := replicasetutil.GetCurrentDaemonSetStep(c.rollout)
+		return currentStepIndex == nil || *currentStepIndex == 0
+	}
+	return c.rollout.Status.BlueGreen.ActiveSelector != c.newRevisionPodHash()
+}
+
+// newRevisionPodHash returns the pod template hash of the revision the rollout is updating to
+func (c *rolloutContext) newRevisionPodHash() string {
+	if c.newRS != nil {
+		return replicasetutil.GetPodTemplateHash(c.newRS)
+	}
+	return hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+}
+
 // requiresManualAction returns true if the given pause reason requires manual intervention
 func requiresManualAction(reason v1alpha1.PauseReason, ro *v1alpha1.Rollout) bool {
 	switch reason {
@@ -147,6 +187,11 @@ func requiresManualAction(reason v1alpha1.PauseReason, ro *v1alpha1.Rollout) boo
 				return currentStep.Pause.Duration == nil // indefinite pause
 			}
 		}
+	case v1alpha1.PauseReasonDaemonSetPauseStep:
+		currentStep, _ := replicasetutil.GetCurrentDaemonSetStep(ro)
+		if currentStep != nil && currentStep.Pause != nil {
+			return currentStep.Pause.Duration == nil
+		}
 	case v1alpha1.PauseReasonBlueGreenPause:
 		bg := ro.Spec.Strategy.BlueGreen
 		if bg != nil {
@@ -193,8 +238,17 @@ func (pCtx *pauseContext) CompletedBlueGreenPause() bool {
 }
 
 func (pCtx *pauseContext) CompletedCanaryPauseStep(pause v1alpha1.RolloutPause) bool {
+	return pCtx.completedPauseStep(v1alpha1.PauseReasonCanaryPauseStep, pause)
+}
+
+// CompletedDaemonSetPauseStep returns true if the pause step of a DaemonSet rollout was resumed or its duration elapsed
+func (pCtx *pauseContext) CompletedDaemonSetPauseStep(pause v1alpha1.RolloutPause) bool {
+	return pCtx.completedPauseStep(v1alpha1.PauseReasonDaemonSetPauseStep, pause)
+}
+
+func (pCtx *pauseContext) completedPauseStep(reason v1alpha1.PauseReason, pause v1alpha1.RolloutPause) bool {
 	rollout := pCtx.rollout
-	pauseCondition := getPauseCondition(rollout, v1alpha1.PauseReasonCanaryPauseStep)
+	pauseCondition := getPauseCondition(rollout, reason)
 
 	if rollout.Status.ControllerPause && pauseCondition == nil {
 		pCtx.log.Info("Rollout has been unpaused")
@@ -0,0 +1,31 @@
+package rollout
+
+import (
+	"context"
+	"time"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+)
+
+// SimulationHooks replace the parts of the rollout controller which act outside of the clientsets, so that the
+// rollout simulator can run the controller against fake clientsets
+type SimulationHooks struct {
+	// EnqueueRolloutAfter is called instead of the workqueue when the controller requeues a rollout after a duration.
+	// Immediate requeues are dropped, since the simulator syncs the rollout until it settles.
+	EnqueueRolloutAfter func(obj any, duration time.Duration)
+	// NewTrafficRoutingReconcilers returns the traffic routing reconcilers of the rollout
+	NewTrafficRoutingReconcilers func(ro *v1alpha1.Rollout) ([]trafficrouting.TrafficRoutingReconciler, error)
+}
+
+// NewSimulatedController returns a rollout controller using the hooks of the simulator, as the function syncing the
+// rollout of the given key
+func NewSimulatedController(cfg ControllerConfig, hooks SimulationHooks) func(ctx context.Context, key string) error {
+	c := NewController(cfg)
+	c.enqueueRollout = func(obj any) {}
+	c.enqueueRolloutAfter = hooks.EnqueueRolloutAfter
+	c.newTrafficRoutingReconciler = func(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) {
+		return hooks.NewTrafficRoutingReconcilers(roCtx.rollout)
+	}
+	return c.syncHandler
+}
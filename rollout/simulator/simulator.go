@@ -0,0 +1,955 @@
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/rollout"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
+	"github.com/argoproj/argo-rollouts/utils/queue"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+const (
+	// RevisionAnnotation is added to the pod template of the revision the simulation starts from, so that
+	// the simulated rollout updates to the pod template of the manifest
+	RevisionAnnotation = "rollout.argoproj.io/simulated-revision"
+	// PrePromotionKey is the key of the scripted result of the pre-promotion analysis of a blue-green rollout
+	PrePromotionKey = "prePromotion"
+	// PostPromotionKey is the key of the scripted result of the post-promotion analysis of a blue-green rollout
+	PostPromotionKey = "postPromotion"
+	// DefaultTimeout is the virtual time after which a simulation stops
+	DefaultTimeout = 24 * time.Hour
+
+	simulatedTrafficRouter = "simulator"
+	simulatedRequeueSkew   = time.Millisecond
+	// simulatedResyncPeriod is the default resync period of the controller
+	simulatedResyncPeriod   = 15 * time.Minute
+	maxSimulationIterations = 10000
+)
+
+// Options configures a rollout simulation
+type Options struct {
+	// Objects are the AnalysisTemplates, ClusterAnalysisTemplates and Services referenced by the rollout
+	Objects []runtime.Object
+	// AnalysisResults are the scripted results of the analysis runs and experiments, keyed by the index of the canary
+	// step during which they run, or by PrePromotionKey and PostPromotionKey. Analysis runs and
+	// experiments without a scripted result are Successful once their metrics completed all their measurements.
+	AnalysisResults map[string]v1alpha1.AnalysisPhase
+	// Timeout is the virtual time after which the simulation stops. Defaults to DefaultTimeout.
+	Timeout time.Duration
+}
+
+// Event is an event of the timeline of a simulation
+type Event struct {
+	// Time is the virtual time since the rollout was updated
+	Time    time.Duration
+	Message string
+}
+
+// Simulation is the timeline and the outcome of a rollout simulation
+type Simulation struct {
+	Events []Event
+	// Phase and Message are the phase and message of the rollout when the simulation stopped
+	Phase   v1alpha1.RolloutPhase
+	Message string
+	// Duration is the virtual time the update of the rollout took
+	Duration time.Duration
+}
+
+// simulator runs the rollout controller against fake clientsets and acts as the ReplicaSet, AnalysisRun and
+// Experiment controllers, advancing a virtual clock to the next time the rollout controller asked to be requeued.
+type simulator struct {
+	opts      Options
+	key       string
+	namespace string
+	// sync syncs the rollout of a key with the rollout controller
+	sync       func(ctx context.Context, key string) error
+	client     *fake.Clientset
+	kubeclient *k8sfake.Clientset
+	informers  informers.SharedInformerFactory
+	kubeInf    kubeinformers.SharedInformerFactory
+
+	now   time.Time
+	start time.Time
+	// lastChange is the virtual time of the last change of the simulated objects
+	lastChange time.Time
+	// resyncStart is the virtual time the informers started resyncing
+	resyncStart time.Time
+	uid         int
+	wakeups     []time.Time
+	// analysisStarts are the virtual times the analysis runs and experiments started
+	analysisStarts map[string]time.Time
+
+	recording bool
+	events    []Event
+	lastError string
+	weight    *int32
+}
+
+// Simulate runs the update of a rollout to the pod template of the manifest, from a healthy revision with another pod
+// template, and returns the timeline of the ReplicaSet scales, traffic weights, steps, pauses and analysis.
+// Indefinite pauses are promoted, as a user would.
+//
+// Simulate is not safe for concurrent use, nor for use in a process running the controller: the virtual clock of the
+// simulation replaces the clock of the utils/time package until it returns.
+func Simulate(ctx context.Context, manifest *v1alpha1.Rollout, opts Options) (*Simulation, error) {
+	if err := validateSimulatedRollout(manifest); err != nil {
+		return nil, err
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+
+	now := timeutil.Now().Truncate(time.Second)
+	s := &simulator{
+		opts:           opts,
+		now:            now,
+		analysisStarts: map[string]time.Time{},
+	}
+	prevNowFn := timeutil.GetNowTimeFunc()
+	defer timeutil.SetNowTimeFunc(prevNowFn)
+	timeutil.SetNowTimeFunc(func() time.Time { return s.now })
+
+	desired := simulatedRollout(manifest)
+	s.namespace = desired.Namespace
+	s.key = desired.Namespace + "/" + desired.Name
+	if err := s.newController(desired); err != nil {
+		return nil, err
+	}
+
+	// deploy the previous revision, which is promoted without going through the steps
+	previous := desired.DeepCopy()
+	if previous.Spec.Template.Annotations == nil {
+		previous.Spec.Template.Annotations = map[string]string{}
+	}
+	previous.Spec.Template.Annotations[RevisionAnnotation] = "previous"
+	previous.Generation = 1
+	if _, err := s.client.ArgoprojV1alpha1().Rollouts(s.namespace).Create(ctx, previous, metav1.CreateOptions{}); err != nil {
+		return nil, err
+	}
+	if err := s.run(ctx, false); err != nil {
+		return nil, err
+	}
+	ro, err := s.client.ArgoprojV1alpha1().Rollouts(s.namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if phase, message := rolloututil.GetRolloutPhase(ro); phase != v1alpha1.RolloutPhaseHealthy {
+		return nil, fmt.Errorf("the previous revision of the rollout did not become Healthy: %s: %s", phase, message)
+	}
+
+	// update the rollout to the pod template of the manifest
+	s.now = s.now.Add(time.Minute)
+	s.start = s.now
+	s.lastChange = s.now
+	s.wakeups = nil
+	s.recording = true
+	ro.Spec = desired.Spec
+	ro.Generation++
+	if _, err := s.client.ArgoprojV1alpha1().Rollouts(s.namespace).Update(ctx, ro, metav1.UpdateOptions{}); err != nil {
+		return nil, err
+	}
+	s.record("Rollout updated to the pod template of the manifest")
+	if err := s.run(ctx, true); err != nil {
+		return nil, err
+	}
+
+	ro, err = s.client.ArgoprojV1alpha1().Rollouts(s.namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	phase, message := rolloututil.GetRolloutPhase(ro)
+	return &Simulation{
+		Events:   s.events,
+		Phase:    phase,
+		Message:  message,
+		Duration: s.lastChange.Sub(s.start).Truncate(time.Second),
+	}, nil
+}
+
+// validateSimulatedRollout returns an error when the rollout is invalid, or uses features the simulator does not support
+func validateSimulatedRollout(ro *v1alpha1.Rollout) error {
+	if ro.Name == "" {
+		return fmt.Errorf("rollout has no name")
+	}
+	switch {
+	case ro.Spec.WorkloadRef != nil:
+		return fmt.Errorf("rollout '%s' references a workload, which the simulator does not support", ro.Name)
+	case ro.Spec.Strategy.DaemonSet != nil:
+		return fmt.Errorf("rollout '%s' uses the daemonSet strategy, which the simulator does not support", ro.Name)
+	case len(ro.Spec.Clusters) > 0:
+		return fmt.Errorf("rollout '%s' deploys to remote clusters, which the simulator does not support", ro.Name)
+	}
+	if ro.Spec.Strategy.Canary != nil {
+		for i, step := range ro.Spec.Strategy.Canary.Steps {
+			if step.Plugin != nil {
+				return fmt.Errorf("step %d of rollout '%s' is a plugin step, which the simulator does not support", i, ro.Name)
+			}
+		}
+	}
+	if errs := validation.ValidateRollout(ro); len(errs) > 0 {
+		return fmt.Errorf("the Rollout \"%s\" is invalid: %s", ro.Name, errs[0].Error())
+	}
+	return nil
+}
+
+// simulatedRollout returns the rollout to simulate. Its traffic routers are replaced by the traffic router of the
+// simulator, which records the weights instead of configuring a mesh or an ingress.
+func simulatedRollout(manifest *v1alpha1.Rollout) *v1alpha1.Rollout {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        manifest.Name,
+			Namespace:   manifest.Namespace,
+			Labels:      manifest.Labels,
+			Annotations: manifest.Annotations,
+		},
+		Spec: *manifest.Spec.DeepCopy(),
+	}
+	if ro.Namespace == "" {
+		ro.Namespace = metav1.NamespaceDefault
+	}
+	if ro.Spec.Strategy.Canary != nil && ro.Spec.Strategy.Canary.TrafficRouting != nil {
+		trafficRouting := ro.Spec.Strategy.Canary.TrafficRouting
+		ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			ManagedRoutes:    trafficRouting.ManagedRoutes,
+			MaxTrafficWeight: trafficRouting.MaxTrafficWeight,
+			Plugins:          map[string]json.RawMessage{simulatedTrafficRouter: json.RawMessage("{}")},
+		}
+	}
+	return ro
+}
+
+// referencedServices returns the names of the services the rollout references
+func referencedServices(ro *v1alpha1.Rollout) []string {
+	var names []string
+	if canary := ro.Spec.Strategy.Canary; canary != nil {
+		names = append(names, canary.CanaryService, canary.StableService)
+		if canary.PingPong != nil {
+			names = append(names, canary.PingPong.PingService, canary.PingPong.PongService)
+		}
+	}
+	if blueGreen := ro.Spec.Strategy.BlueGreen; blueGreen != nil {
+		names = append(names, blueGreen.ActiveService, blueGreen.PreviewService)
+	}
+	var services []string
+	for _, name := range names {
+		if name != "" {
+			services = append(services, name)
+		}
+	}
+	return services
+}
+
+func (s *simulator) newController(ro *v1alpha1.Rollout) error {
+	var objects, kubeObjects []runtime.Object
+	services := map[string]bool{}
+	for _, obj := range s.opts.Objects {
+		obj = obj.DeepCopyObject()
+		if m, err := meta.Accessor(obj); err == nil && m.GetNamespace() == "" {
+			if _, ok := obj.(*v1alpha1.ClusterAnalysisTemplate); !ok {
+				m.SetNamespace(s.namespace)
+			}
+		}
+		switch o := obj.(type) {
+		case *corev1.Service:
+			services[o.Name] = true
+			kubeObjects = append(kubeObjects, o)
+		case *v1alpha1.AnalysisTemplate, *v1alpha1.ClusterAnalysisTemplate:
+			objects = append(objects, o)
+		}
+	}
+	for _, name := range referencedServices(ro) {
+		if services[name] {
+			continue
+		}
+		services[name] = true
+		kubeObjects = append(kubeObjects, &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: s.namespace},
+			Spec: corev1.ServiceSpec{
+				Selector: ro.Spec.Selector.MatchLabels,
+				Ports:    []corev1.ServicePort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP}},
+			},
+		})
+	}
+
+	s.client = fake.NewSimpleClientset(objects...)
+	s.kubeclient = k8sfake.NewSimpleClientset(kubeObjects...)
+	s.client.PrependReactor("create", "*", s.onCreate)
+	s.kubeclient.PrependReactor("create", "*", s.onCreate)
+	s.informers = informers.NewSharedInformerFactory(s.client, 0)
+	s.kubeInf = kubeinformers.NewSharedInformerFactory(s.kubeclient, 0)
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return err
+	}
+	vsvcGVR := istioutil.GetIstioVirtualServiceGVR()
+	destGVR := istioutil.GetIstioDestinationRuleGVR()
+	dynamicClient := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, map[schema.GroupVersionResource]string{
+		vsvcGVR: vsvcGVR.Resource + "List",
+		destGVR: destGVR.Resource + "List",
+	})
+	dynamicInformerFactory := dynamicinformer.NewDynamicSharedInformerFactory(dynamicClient, 0)
+	ingressWrapper, err := ingressutil.NewIngressWrapper(ingressutil.IngressModeNetworking, s.kubeclient, s.kubeInf)
+	if err != nil {
+		return err
+	}
+
+	s.sync = rollout.NewSimulatedController(rollout.ControllerConfig{
+		Namespace:                       s.namespace,
+		KubeClientSet:                   s.kubeclient,
+		ArgoProjClientset:               s.client,
+		DynamicClientSet:                dynamicClient,
+		ExperimentInformer:              s.informers.Argoproj().V1alpha1().Experiments(),
+		AnalysisRunInformer:             s.informers.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:        s.informers.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer: s.informers.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutScheduleInformer:         s.informers.Argoproj().V1alpha1().RolloutSchedules(),
+		ReplicaSetInformer:              s.kubeInf.Apps().V1().ReplicaSets(),
+		WorkloadInformerFactory:         s.kubeInf,
+		WorkloadPodInformerFactory:      s.kubeInf,
+		NodeInformerFactory:             s.kubeInf,
+		ServicesInformer:                s.kubeInf.Core().V1().Services(),
+		IngressWrapper:                  ingressWrapper,
+		RolloutsInformer:                s.informers.Argoproj().V1alpha1().Rollouts(),
+		IstioPrimaryDynamicClient:       dynamicClient,
+		IstioVirtualServiceInformer:     dynamicInformerFactory.ForResource(vsvcGVR).Informer(),
+		IstioDestinationRuleInformer:    dynamicInformerFactory.ForResource(destGVR).Informer(),
+		ResyncPeriod:                    simulatedResyncPeriod,
+		RolloutWorkQueue:                workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts"),
+		ServiceWorkQueue:                workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services"),
+		IngressWorkQueue:                workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses"),
+		MetricsServer:                   simulatedMetricsRecorder{},
+		Recorder:                        record.NewFakeEventRecorder(),
+		RefResolver:                     simulatedRefResolver{},
+		EphemeralMetadataThreads:        rollout.DefaultEphemeralMetadataThreads,
+		EphemeralMetadataPodRetries:     rollout.DefaultEphemeralMetadataPodRetries,
+	}, rollout.SimulationHooks{
+		// the simulator syncs the rollout until it settles, and then advances the virtual clock to the next requeue
+		EnqueueRolloutAfter: func(obj any, duration time.Duration) {
+			// like the workqueue, requeue the rollout slightly after the requested time
+			s.wakeups = append(s.wakeups, s.now.Add(duration+simulatedRequeueSkew))
+		},
+		NewTrafficRoutingReconcilers: func(ro *v1alpha1.Rollout) ([]trafficrouting.TrafficRoutingReconciler, error) {
+			if ro.Spec.Strategy.Canary == nil || ro.Spec.Strategy.Canary.TrafficRouting == nil {
+				return nil, nil
+			}
+			return []trafficrouting.TrafficRoutingReconciler{&simulatedTrafficRoutingReconciler{s: s, rollout: ro}}, nil
+		},
+	})
+	return nil
+}
+
+// onCreate sets the fields the API server sets on the objects created by the controllers
+func (s *simulator) onCreate(action k8stesting.Action) (bool, runtime.Object, error) {
+	createAction, ok := action.(k8stesting.CreateAction)
+	if !ok {
+		return false, nil, nil
+	}
+	if m, err := meta.Accessor(createAction.GetObject()); err == nil {
+		s.uid++
+		m.SetUID(types.UID(fmt.Sprintf("simulated-%d", s.uid)))
+		m.SetCreationTimestamp(metav1.NewTime(s.now))
+	}
+	return false, nil, nil
+}
+
+// run syncs the rollout until it settles, promotes indefinite pauses, and advances the virtual clock to the next
+// requeue of the rollout or completion of an analysis, until the rollout does not expect any further change
+func (s *simulator) run(ctx context.Context, recording bool) error {
+	deadline := s.now.Add(s.opts.Timeout)
+	s.resyncStart = s.now
+	// idleResync is whether the rollout did not change since the last resync
+	idleResync := false
+	for i := 0; i < maxSimulationIterations; i++ {
+		s.refreshInformers(ctx)
+		before, err := s.snapshot(ctx)
+		if err != nil {
+			return err
+		}
+		if err := s.sync(ctx, s.key); err != nil {
+			if err.Error() != s.lastError {
+				s.record(fmt.Sprintf("Reconciliation error: %v", err))
+			}
+			s.lastError = err.Error()
+		} else {
+			s.lastError = ""
+		}
+		if err := s.simulateReplicaSets(ctx); err != nil {
+			return err
+		}
+		if err := s.simulateAnalysis(ctx); err != nil {
+			return err
+		}
+		after, err := s.snapshot(ctx)
+		if err != nil {
+			return err
+		}
+		if recording {
+			s.recordChanges(before, after)
+		}
+		if !reflect.DeepEqual(before, after) {
+			s.lastChange = s.now
+			idleResync = false
+			continue
+		}
+
+		// the rollout settled until it is promoted, or until its next requeue or resync
+		promoted, err := s.promote(ctx)
+		if err != nil {
+			return err
+		}
+		if promoted {
+			continue
+		}
+		next := s.nextWakeup()
+		if resync := s.nextResync(); next.IsZero() || resync.Before(next) {
+			if next.IsZero() && idleResync {
+				return nil
+			}
+			next = resync
+			idleResync = true
+		}
+		if next.After(deadline) {
+			s.now = deadline
+			s.record(fmt.Sprintf("Simulation stopped after %s", s.opts.Timeout))
+			return nil
+		}
+		s.now = next
+	}
+	return fmt.Errorf("the simulation of rollout '%s' did not settle after %d reconciliations", s.key, maxSimulationIterations)
+}
+
+// nextWakeup returns the earliest requeue after the current virtual time, or zero if there is none
+func (s *simulator) nextWakeup() time.Time {
+	var next time.Time
+	var wakeups []time.Time
+	for _, wakeup := range s.wakeups {
+		if !wakeup.After(s.now) {
+			continue
+		}
+		wakeups = append(wakeups, wakeup)
+		if next.IsZero() || wakeup.Before(next) {
+			next = wakeup
+		}
+	}
+	s.wakeups = wakeups
+	return next
+}
+
+// nextResync returns the time of the next resync of the informers after the current virtual time
+func (s *simulator) nextResync() time.Time {
+	resyncs := s.now.Sub(s.resyncStart)/simulatedResyncPeriod + 1
+	return s.resyncStart.Add(resyncs * simulatedResyncPeriod)
+}
+
+// refreshInformers replaces the caches of the informers with the objects of the fake clientsets
+func (s *simulator) refreshInformers(ctx context.Context) {
+	replace := func(informer cache.SharedIndexInformer, list runtime.Object, err error) {
+		if err != nil {
+			return
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return
+		}
+		objs := make([]any, 0, len(items))
+		for _, item := range items {
+			objs = append(objs, item)
+		}
+		_ = informer.GetIndexer().Replace(objs, "")
+	}
+	argoproj := s.client.ArgoprojV1alpha1()
+	apps := s.kubeclient.AppsV1()
+	opts := metav1.ListOptions{}
+
+	list, err := argoproj.Rollouts(s.namespace).List(ctx, opts)
+	replace(s.informers.Argoproj().V1alpha1().Rollouts().Informer(), list, err)
+	arList, err := argoproj.AnalysisRuns(s.namespace).List(ctx, opts)
+	replace(s.informers.Argoproj().V1alpha1().AnalysisRuns().Informer(), arList, err)
+	atList, err := argoproj.AnalysisTemplates(s.namespace).List(ctx, opts)
+	replace(s.informers.Argoproj().V1alpha1().AnalysisTemplates().Informer(), atList, err)
+	catList, err := argoproj.ClusterAnalysisTemplates().List(ctx, opts)
+	replace(s.informers.Argoproj().V1alpha1().ClusterAnalysisTemplates().Informer(), catList, err)
+	exList, err := argoproj.Experiments(s.namespace).List(ctx, opts)
+	replace(s.informers.Argoproj().V1alpha1().Experiments().Informer(), exList, err)
+	rsList, err := apps.ReplicaSets(s.namespace).List(ctx, opts)
+	replace(s.kubeInf.Apps().V1().ReplicaSets().Informer(), rsList, err)
+	svcList, err := s.kubeclient.CoreV1().Services(s.namespace).List(ctx, opts)
+	replace(s.kubeInf.Core().V1().Services().Informer(), svcList, err)
+}
+
+// simulateReplicaSets makes the pods of the ReplicaSets available, as the ReplicaSet controller and the kubelets would
+func (s *simulator) simulateReplicaSets(ctx context.Context) error {
+	rsIf := s.kubeclient.AppsV1().ReplicaSets(s.namespace)
+	rsList, err := rsIf.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range rsList.Items {
+		rs := &rsList.Items[i]
+		replicas := int32(0)
+		if rs.Spec.Replicas != nil {
+			replicas = *rs.Spec.Replicas
+		}
+		status := appsv1.ReplicaSetStatus{
+			Replicas:             replicas,
+			FullyLabeledReplicas: replicas,
+			ReadyReplicas:        replicas,
+			AvailableReplicas:    replicas,
+			ObservedGeneration:   rs.Generation,
+		}
+		if reflect.DeepEqual(rs.Status, status) {
+			continue
+		}
+		rs.Status = status
+		if _, err := rsIf.UpdateStatus(ctx, rs, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// simulateAnalysis completes the analysis runs and experiments with their scripted result, once their metrics
+// completed all their measurements
+func (s *simulator) simulateAnalysis(ctx context.Context) error {
+	ro, err := s.client.ArgoprojV1alpha1().Rollouts(s.namespace).Get(ctx, s.rolloutName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	currentStepKey := ""
+	if ro.Status.CurrentStepIndex != nil {
+		currentStepKey = strconv.Itoa(int(*ro.Status.CurrentStepIndex))
+	}
+
+	arIf := s.client.ArgoprojV1alpha1().AnalysisRuns(s.namespace)
+	arList, err := arIf.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range arList.Items {
+		ar := &arList.Items[i]
+		if ar.Status.Phase.Completed() {
+			continue
+		}
+		key := currentStepKey
+		switch ar.Labels[v1alpha1.RolloutTypeLabel] {
+		case v1alpha1.RolloutTypeStepLabel:
+			key = ar.Labels[v1alpha1.RolloutCanaryStepIndexLabel]
+		case v1alpha1.RolloutTypePrePromotionLabel:
+			key = PrePromotionKey
+		case v1alpha1.RolloutTypePostPromotionLabel:
+			key = PostPromotionKey
+		}
+		result, scripted := s.opts.AnalysisResults[key]
+		duration, finite := simulatedAnalysisDuration(ar)
+		phase, message := s.simulatedPhase("analysis/"+ar.Name, result, scripted, duration, finite, ar.Spec.Terminate)
+		if phase == ar.Status.Phase {
+			continue
+		}
+		ar.Status.Phase = phase
+		ar.Status.Message = message
+		startedAt := metav1.NewTime(s.analysisStarts["analysis/"+ar.Name])
+		ar.Status.StartedAt = &startedAt
+		if _, err := arIf.Update(ctx, ar, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	exIf := s.client.ArgoprojV1alpha1().Experiments(s.namespace)
+	exList, err := exIf.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range exList.Items {
+		ex := &exList.Items[i]
+		if ex.Status.Phase.Completed() {
+			continue
+		}
+		result, scripted := s.opts.AnalysisResults[currentStepKey]
+		duration, _ := ex.Spec.Duration.Duration()
+		phase, message := s.simulatedPhase("experiment/"+ex.Name, result, scripted, duration, true, ex.Spec.Terminate)
+		if phase == ex.Status.Phase {
+			continue
+		}
+		ex.Status.Phase = phase
+		ex.Status.Message = message
+		if _, err := exIf.Update(ctx, ex, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// simulatedPhase returns the phase of an analysis run or experiment, which completes with its scripted result, or
+// Successful, once it ran for its duration. Indefinite analysis runs complete with their scripted result right away,
+// and are otherwise Successful once they are terminated.
+func (s *simulator) simulatedPhase(key string, result v1alpha1.AnalysisPhase, scripted bool, duration time.Duration, finite, terminated bool) (v1alpha1.AnalysisPhase, string) {
+	started, ok := s.analysisStarts[key]
+	if !ok {
+		started = s.now
+		s.analysisStarts[key] = started
+	}
+	if terminated {
+		return v1alpha1.AnalysisPhaseSuccessful, "Terminated"
+	}
+	if !finite {
+		if scripted {
+			return result, "Simulated result"
+		}
+		return v1alpha1.AnalysisPhaseRunning, ""
+	}
+	finishedAt := started.Add(duration)
+	if s.now.Before(finishedAt) {
+		s.wakeups = append(s.wakeups, finishedAt)
+		return v1alpha1.AnalysisPhaseRunning, ""
+	}
+	if scripted {
+		return result, "Simulated result"
+	}
+	return v1alpha1.AnalysisPhaseSuccessful, ""
+}
+
+// simulatedAnalysisDuration returns how long the metrics of the analysis run take to complete all their measurements,
+// or false when a metric measures indefinitely
+func simulatedAnalysisDuration(ar *v1alpha1.AnalysisRun) (time.Duration, bool) {
+	var longest time.Duration
+	for _, metric := range ar.Spec.Metrics {
+		initialDelay, _ := metric.InitialDelay.Duration()
+		interval, _ := metric.Interval.Duration()
+		count := 1
+		if metric.Count != nil {
+			count = metric.Count.IntValue()
+		} else if interval > 0 {
+			return 0, false
+		}
+		if count < 1 {
+			count = 1
+		}
+		if duration := initialDelay + time.Duration(count-1)*interval; duration > longest {
+			longest = duration
+		}
+	}
+	return longest, true
+}
+
+// promote resumes a rollout waiting on a pause without a duration, as `kubectl argo rollouts promote` would. Rollouts paused
+// because of an inconclusive analysis are left for the user to decide.
+func (s *simulator) promote(ctx context.Context) (bool, error) {
+	roIf := s.client.ArgoprojV1alpha1().Rollouts(s.namespace)
+	ro, err := roIf.Get(ctx, s.rolloutName(), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	if ro.Status.Abort || len(ro.Status.PauseConditions) == 0 {
+		return false, nil
+	}
+	for _, cond := range ro.Status.PauseConditions {
+		switch cond.Reason {
+		case v1alpha1.PauseReasonCanaryPauseStep:
+			step, _ := replicasetutil.GetCurrentCanaryStep(ro)
+			if step == nil || step.Pause == nil || step.Pause.Duration != nil {
+				return false, nil
+			}
+		case v1alpha1.PauseReasonBlueGreenPause:
+			if ro.Spec.Strategy.BlueGreen == nil || ro.Spec.Strategy.BlueGreen.AutoPromotionSeconds > 0 {
+				return false, nil
+			}
+		default:
+			return false, nil
+		}
+	}
+	ro.Status.PauseConditions = nil
+	if _, err := roIf.UpdateStatus(ctx, ro, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+	s.record("Promoted")
+	return true, nil
+}
+
+func (s *simulator) rolloutName() string {
+	return s.key[strings.Index(s.key, "/")+1:]
+}
+
+func (s *simulator) record(message string) {
+	if !s.recording {
+		return
+	}
+	s.events = append(s.events, Event{Time: s.now.Sub(s.start).Truncate(time.Second), Message: message})
+}
+
+// simulationState is the state of the simulated objects, whose changes make up the timeline
+type simulationState struct {
+	Rollout         v1alpha1.RolloutStatus
+	ReplicaSets     map[string]int32
+	Revisions       map[string]string
+	AnalysisRuns    map[string]v1alpha1.AnalysisPhase
+	Experiments     map[string]v1alpha1.AnalysisPhase
+	ReplicaSetState []appsv1.ReplicaSetStatus
+	Services        map[string]string
+}
+
+func (s *simulator) snapshot(ctx context.Context) (*simulationState, error) {
+	state := &simulationState{
+		ReplicaSets:  map[string]int32{},
+		Revisions:    map[string]string{},
+		AnalysisRuns: map[string]v1alpha1.AnalysisPhase{},
+		Experiments:  map[string]v1alpha1.AnalysisPhase{},
+		Services:     map[string]string{},
+	}
+	ro, err := s.client.ArgoprojV1alpha1().Rollouts(s.namespace).Get(ctx, s.rolloutName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	state.Rollout = ro.Status
+	rsList, err := s.kubeclient.AppsV1().ReplicaSets(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(rsList.Items, func(i, j int) bool { return rsList.Items[i].Name < rsList.Items[j].Name })
+	for _, rs := range rsList.Items {
+		replicas := int32(0)
+		if rs.Spec.Replicas != nil {
+			replicas = *rs.Spec.Replicas
+		}
+		state.ReplicaSets[rs.Name] = replicas
+		state.Revisions[rs.Name] = rs.Annotations[annotations.RevisionAnnotation]
+		state.ReplicaSetState = append(state.ReplicaSetState, rs.Status)
+	}
+	arList, err := s.client.ArgoprojV1alpha1().AnalysisRuns(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ar := range arList.Items {
+		state.AnalysisRuns[ar.Name] = ar.Status.Phase
+	}
+	exList, err := s.client.ArgoprojV1alpha1().Experiments(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ex := range exList.Items {
+		state.Experiments[ex.Name] = ex.Status.Phase
+	}
+	svcList, err := s.kubeclient.CoreV1().Services(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range svcList.Items {
+		state.Services[svc.Name] = labels.Set(svc.Spec.Selector).String()
+	}
+	return state, nil
+}
+
+// recordChanges records the changes between two states in the timeline
+func (s *simulator) recordChanges(before, after *simulationState) {
+	for _, name := range sortedKeys(after.ReplicaSets) {
+		replicas := after.ReplicaSets[name]
+		previous, existed := before.ReplicaSets[name]
+		switch {
+		case !existed:
+			s.record(fmt.Sprintf("ReplicaSet %s (revision %s) created with %d replicas", name, after.Revisions[name], replicas))
+		case previous != replicas:
+			s.record(fmt.Sprintf("ReplicaSet %s (revision %s) scaled from %d to %d", name, after.Revisions[name], previous, replicas))
+		}
+	}
+
+	// an abort resets the step index, but does not run the steps again
+	if !reflect.DeepEqual(before.Rollout.CurrentStepIndex, after.Rollout.CurrentStepIndex) && after.Rollout.CurrentStepIndex != nil && !after.Rollout.Abort {
+		s.recordStep(*after.Rollout.CurrentStepIndex)
+	}
+
+	beforePauses := pauseReasons(before.Rollout)
+	afterPauses := pauseReasons(after.Rollout)
+	if afterPauses != beforePauses {
+		if afterPauses != "" {
+			s.record(fmt.Sprintf("Paused: %s", afterPauses))
+		} else if !after.Rollout.Abort {
+			s.record("Resumed")
+		}
+	}
+
+	for _, name := range sortedKeys(after.Experiments) {
+		s.recordPhase("Experiment", name, before.Experiments, after.Experiments)
+	}
+	for _, name := range sortedKeys(after.AnalysisRuns) {
+		s.recordPhase("AnalysisRun", name, before.AnalysisRuns, after.AnalysisRuns)
+	}
+
+	for _, name := range sortedKeys(after.Services) {
+		if selector, ok := before.Services[name]; ok && selector != after.Services[name] {
+			s.record(fmt.Sprintf("Service %s selects %s", name, after.Services[name]))
+		}
+	}
+
+	beforePhase, afterPhase := before.Rollout.Phase, after.Rollout.Phase
+	if beforePhase != afterPhase && afterPhase != "" {
+		message := string(afterPhase)
+		if after.Rollout.Message != "" {
+			message = fmt.Sprintf("%s: %s", afterPhase, after.Rollout.Message)
+		}
+		s.record(fmt.Sprintf("Rollout %s", message))
+	}
+}
+
+func (s *simulator) recordStep(index int32) {
+	ro, err := s.informers.Argoproj().V1alpha1().Rollouts().Lister().Rollouts(s.namespace).Get(s.rolloutName())
+	if err != nil || ro.Spec.Strategy.Canary == nil {
+		return
+	}
+	steps := ro.Spec.Strategy.Canary.Steps
+	if int(index) >= len(steps) {
+		s.record("All steps completed")
+		return
+	}
+	step, err := json.Marshal(steps[index])
+	if err != nil {
+		return
+	}
+	s.record(fmt.Sprintf("Step %d: %s", index, step))
+}
+
+func (s *simulator) recordPhase(kind, name string, before, after map[string]v1alpha1.AnalysisPhase) {
+	previous, existed := before[name]
+	phase := after[name]
+	if !existed {
+		s.record(fmt.Sprintf("%s %s created", kind, name))
+	}
+	if previous != phase && phase.Completed() {
+		s.record(fmt.Sprintf("%s %s %s", kind, name, phase))
+	}
+}
+
+func (s *simulator) recordWeight(weight int32, maxWeight int32) {
+	if s.weight != nil && *s.weight == weight {
+		return
+	}
+	s.weight = &weight
+	s.record(fmt.Sprintf("Traffic weight: canary %d%%, stable %d%%", weight*100/maxWeight, (maxWeight-weight)*100/maxWeight))
+}
+
+func pauseReasons(status v1alpha1.RolloutStatus) string {
+	var reasons []string
+	for _, cond := range status.PauseConditions {
+		reasons = append(reasons, string(cond.Reason))
+	}
+	return strings.Join(reasons, ", ")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// simulatedTrafficRoutingReconciler records the traffic weights and routes in the timeline of the simulation
+type simulatedTrafficRoutingReconciler struct {
+	s       *simulator
+	rollout *v1alpha1.Rollout
+}
+
+func (r *simulatedTrafficRoutingReconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+func (r *simulatedTrafficRoutingReconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	r.s.recordWeight(desiredWeight, weightutil.MaxTrafficWeight(r.rollout))
+	return nil
+}
+
+func (r *simulatedTrafficRoutingReconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if headerRouting == nil {
+		return nil
+	}
+	if len(headerRouting.Match) == 0 {
+		r.s.record(fmt.Sprintf("Header route %s removed", headerRouting.Name))
+	} else {
+		r.s.record(fmt.Sprintf("Header route %s set", headerRouting.Name))
+	}
+	return nil
+}
+
+func (r *simulatedTrafficRoutingReconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	if setMirrorRoute == nil {
+		return nil
+	}
+	if len(setMirrorRoute.Match) == 0 {
+		r.s.record(fmt.Sprintf("Mirror route %s removed", setMirrorRoute.Name))
+	} else {
+		r.s.record(fmt.Sprintf("Mirror route %s set", setMirrorRoute.Name))
+	}
+	return nil
+}
+
+func (r *simulatedTrafficRoutingReconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	return nil, nil
+}
+
+func (r *simulatedTrafficRoutingReconciler) RemoveManagedRoutes() error {
+	return nil
+}
+
+func (r *simulatedTrafficRoutingReconciler) Type() string {
+	return "Simulator"
+}
+
+// simulatedRefResolver resolves nothing, since the simulator does not support workload references
+type simulatedRefResolver struct{}
+
+func (simulatedRefResolver) Resolve(r *v1alpha1.Rollout) error {
+	return nil
+}
+
+// simulatedMetricsRecorder discards the metrics of the simulated controller
+type simulatedMetricsRecorder struct{}
+
+func (simulatedMetricsRecorder) IncRolloutReconcile(rollout *v1alpha1.Rollout, duration time.Duration) {
+}
+
+func (simulatedMetricsRecorder) IncExperimentReconcile(ex *v1alpha1.Experiment, duration time.Duration) {
+}
+
+func (simulatedMetricsRecorder) IncAnalysisRunReconcile(ar *v1alpha1.AnalysisRun, duration time.Duration) {
+}
+
+func (simulatedMetricsRecorder) IncError(namespace, name string, kind string) {}
+
+func (simulatedMetricsRecorder) EmitRolloutDuration(ds *v1alpha1.RolloutDurationStatus) {}
+
+func (simulatedMetricsRecorder) Remove(namespace string, name string, kind string) {}
@@ -0,0 +1,244 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newSimulatedRollout(strategy v1alpha1.RolloutStrategy) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook"},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: ptr.To[int32](5),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "guestbook"}},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "guestbook", Image: "guestbook:v2"}},
+				},
+			},
+			Strategy: strategy,
+		},
+	}
+}
+
+func newSimulatedAnalysisTemplate(name string, count int32, interval v1alpha1.DurationString) *v1alpha1.AnalysisTemplate {
+	return &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:     "success-rate",
+				Count:    ptr.To(intstr.FromInt32(count)),
+				Interval: interval,
+				Provider: v1alpha1.MetricProvider{
+					Prometheus: &v1alpha1.PrometheusMetric{Address: "http://prometheus:9090", Query: "up"},
+				},
+			}},
+		},
+	}
+}
+
+func simulationMessages(simulation *Simulation) []string {
+	var messages []string
+	for _, event := range simulation.Events {
+		messages = append(messages, fmt.Sprintf("%s %s", event.Time, event.Message))
+	}
+	return messages
+}
+
+func assertTimeline(t *testing.T, simulation *Simulation, expected ...string) {
+	t.Helper()
+	timeline := strings.Join(simulationMessages(simulation), "\n")
+	last := -1
+	for _, message := range expected {
+		i := strings.Index(timeline, message)
+		if !assert.True(t, i > last, "timeline does not contain %q after the previous events:\n%s", message, timeline) {
+			return
+		}
+		last = i
+	}
+}
+
+func TestSimulateCanary(t *testing.T) {
+	ro := newSimulatedRollout(v1alpha1.RolloutStrategy{
+		Canary: &v1alpha1.CanaryStrategy{
+			Steps: []v1alpha1.CanaryStep{
+				{SetWeight: ptr.To[int32](20)},
+				{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(60)}},
+				{SetWeight: ptr.To[int32](60)},
+				{Pause: &v1alpha1.RolloutPause{}},
+			},
+		},
+	})
+	simulation, err := Simulate(context.Background(), ro, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutPhaseHealthy, simulation.Phase)
+	assert.Equal(t, time.Minute, simulation.Duration)
+	assertTimeline(t, simulation,
+		"0s Rollout updated to the pod template of the manifest",
+		"(revision 2) scaled from 0 to 1",
+		"0s Paused: CanaryPauseStep",
+		"1m0s Resumed",
+		"(revision 2) scaled from 1 to 3",
+		"1m0s Promoted",
+		"1m0s All steps completed",
+		"1m0s Rollout Healthy",
+	)
+}
+
+func newSimulatedAnalysisRollout() *v1alpha1.Rollout {
+	return newSimulatedRollout(v1alpha1.RolloutStrategy{
+		Canary: &v1alpha1.CanaryStrategy{
+			CanaryService: "guestbook-canary",
+			StableService: "guestbook-stable",
+			TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+				Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "guestbook"},
+			},
+			Analysis: &v1alpha1.RolloutAnalysisBackground{
+				RolloutAnalysis: v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "background"}}},
+				StartingStep:    ptr.To[int32](1),
+			},
+			Steps: []v1alpha1.CanaryStep{
+				{SetWeight: ptr.To[int32](20)},
+				{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke"}}}},
+				{SetWeight: ptr.To[int32](50)},
+				{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromString("5m")}},
+			},
+		},
+	})
+}
+
+func newSimulatedAnalysisTemplates() []runtime.Object {
+	background := newSimulatedAnalysisTemplate("background", 0, "1m")
+	background.Spec.Metrics[0].Count = nil
+	return []runtime.Object{newSimulatedAnalysisTemplate("smoke", 3, "1m"), background}
+}
+
+func TestSimulateCanaryAnalysis(t *testing.T) {
+	simulation, err := Simulate(context.Background(), newSimulatedAnalysisRollout(), Options{Objects: newSimulatedAnalysisTemplates()})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutPhaseHealthy, simulation.Phase)
+	assert.Equal(t, 7*time.Minute+30*time.Second, simulation.Duration)
+	assertTimeline(t, simulation,
+		"0s Traffic weight: canary 20%, stable 80%",
+		"0s AnalysisRun guestbook-8458755875-2-1 created",
+		"2m0s AnalysisRun guestbook-8458755875-2-1 Successful",
+		"(revision 2) scaled from 1 to 3",
+		"2m0s Traffic weight: canary 50%, stable 50%",
+		"7m0s Traffic weight: canary 100%, stable 0%",
+		"7m0s Rollout Healthy",
+		"7m0s AnalysisRun guestbook-8458755875-2 Successful",
+		"(revision 1) scaled from 5 to 0",
+	)
+}
+
+func TestSimulateCanaryAnalysisFailed(t *testing.T) {
+	simulation, err := Simulate(context.Background(), newSimulatedAnalysisRollout(), Options{
+		Objects:         newSimulatedAnalysisTemplates(),
+		AnalysisResults: map[string]v1alpha1.AnalysisPhase{"1": v1alpha1.AnalysisPhaseFailed},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutPhaseDegraded, simulation.Phase)
+	assert.Contains(t, simulation.Message, "RolloutAborted")
+	assertTimeline(t, simulation,
+		"(revision 2) scaled from 0 to 1",
+		"AnalysisRun guestbook-8458755875-2 Failed",
+		"0s Rollout Degraded: RolloutAborted",
+		"30s ReplicaSet",
+		"(revision 2) scaled from 1 to 0",
+	)
+}
+
+func TestSimulateCanaryAnalysisInconclusive(t *testing.T) {
+	simulation, err := Simulate(context.Background(), newSimulatedAnalysisRollout(), Options{
+		Objects:         newSimulatedAnalysisTemplates(),
+		AnalysisResults: map[string]v1alpha1.AnalysisPhase{"1": v1alpha1.AnalysisPhaseInconclusive},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, simulation.Phase)
+	assert.Equal(t, "InconclusiveAnalysisRun", simulation.Message)
+}
+
+func TestSimulateBlueGreen(t *testing.T) {
+	ro := newSimulatedRollout(v1alpha1.RolloutStrategy{
+		BlueGreen: &v1alpha1.BlueGreenStrategy{
+			ActiveService:        "guestbook-active",
+			PreviewService:       "guestbook-preview",
+			AutoPromotionEnabled: ptr.To(false),
+			PrePromotionAnalysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke"}}},
+		},
+	})
+	objs := newSimulatedAnalysisTemplates()
+
+	simulation, err := Simulate(context.Background(), ro, Options{Objects: objs})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutPhaseHealthy, simulation.Phase)
+	assertTimeline(t, simulation,
+		"Service guestbook-preview selects",
+		"(revision 2) scaled from 0 to 5",
+		"2m0s Promoted",
+		"Service guestbook-active selects",
+		"2m0s Rollout Healthy",
+		"2m30s ReplicaSet",
+	)
+
+	simulation, err = Simulate(context.Background(), ro, Options{
+		Objects:         objs,
+		AnalysisResults: map[string]v1alpha1.AnalysisPhase{PrePromotionKey: v1alpha1.AnalysisPhaseFailed},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutPhaseDegraded, simulation.Phase)
+	assert.Contains(t, simulation.Message, "pre-promotion analysis phase error/failed")
+	assertTimeline(t, simulation, "2m0s Rollout Degraded", "(revision 2) scaled from 5 to 0")
+}
+
+func TestSimulateUnsupported(t *testing.T) {
+	ro := newSimulatedRollout(v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}})
+	ro.Spec.Template = corev1.PodTemplateSpec{}
+	ro.Spec.WorkloadRef = &v1alpha1.ObjectRef{Kind: "Deployment", Name: "guestbook", APIVersion: "apps/v1"}
+	_, err := Simulate(context.Background(), ro, Options{})
+	assert.EqualError(t, err, "rollout 'guestbook' references a workload, which the simulator does not support")
+}
+
+func TestSimulatedAnalysisDuration(t *testing.T) {
+	template := newSimulatedAnalysisTemplate("smoke", 3, "1m")
+	template.Spec.Metrics[0].InitialDelay = "30s"
+	ar := &v1alpha1.AnalysisRun{Spec: v1alpha1.AnalysisRunSpec{Metrics: template.Spec.Metrics}}
+	duration, finite := simulatedAnalysisDuration(ar)
+	assert.True(t, finite)
+	assert.Equal(t, 2*time.Minute+30*time.Second, duration)
+
+	ar.Spec.Metrics[0].Count = nil
+	_, finite = simulatedAnalysisDuration(ar)
+	assert.False(t, finite)
+}
+
+func TestSimulateRestoresClock(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	prevNowFn := timeutil.GetNowTimeFunc()
+	defer timeutil.SetNowTimeFunc(prevNowFn)
+	timeutil.SetNowTimeFunc(func() time.Time { return now })
+
+	ro := newSimulatedRollout(v1alpha1.RolloutStrategy{
+		Canary: &v1alpha1.CanaryStrategy{
+			Steps: []v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(60)}}},
+		},
+	})
+	_, err := Simulate(context.Background(), ro, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, now, timeutil.Now())
+}
@@ -0,0 +1,270 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	"k8s.io/client-go/tools/cache"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const (
+	// statefulSetIndexName is the index by which Rollouts are cached against the StatefulSets they reference
+	statefulSetIndexName = "byStatefulSet"
+
+	statefulSetPartitionPatch = `{"spec":{"replicas":%d,"updateStrategy":{"rollingUpdate":{"partition":%d}}}}`
+)
+
+// syncStatefulSetRevisions reads the StatefulSet referenced by the rollout and represents each of its revisions with a
+// ReplicaSet which is never persisted, so that the steps, analysis and status of the canary strategy apply to the
+// revisions as they do to real ReplicaSets. The status of each ReplicaSet counts the pods running its revision. It
+// returns false if the StatefulSet controller has not observed the latest spec yet, since its revisions are not known.
+func (c *rolloutContext) syncStatefulSetRevisions() (bool, error) {
+	if err := c.statefulSets.start(); err != nil {
+		return false, err
+	}
+	sts, err := appslisters.NewStatefulSetLister(c.statefulSets.indexer).StatefulSets(c.rollout.Namespace).Get(c.rollout.Spec.WorkloadRef.Name)
+	if err != nil {
+		return false, err
+	}
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.UpdateRevision == "" {
+		c.log.Infof("Waiting for StatefulSet %s to observe generation %d", sts.Name, sts.Generation)
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	revisions, err := c.statefulSets.listRevisions(sts, selector)
+	if err != nil {
+		return false, err
+	}
+	pods, err := c.statefulSets.listPods(sts, selector)
+	if err != nil {
+		return false, err
+	}
+
+	var allRSs []*appsv1.ReplicaSet
+	var newRS *appsv1.ReplicaSet
+	for _, revision := range revisions {
+		rs := newStatefulSetRevisionReplicaSet(sts, revision.Name, revision.UID, revision.CreationTimestamp, pods)
+		if revision.Name == sts.Status.UpdateRevision {
+			newRS = rs
+		}
+		allRSs = append(allRSs, rs)
+	}
+	if newRS == nil {
+		// the update revision was created after the revisions were listed
+		newRS = newStatefulSetRevisionReplicaSet(sts, sts.Status.UpdateRevision, "", timeutil.MetaNow(), pods)
+		allRSs = append(allRSs, newRS)
+	}
+
+	c.statefulSet = sts
+	c.statefulSetPods = pods
+	c.newRS = newRS
+	c.allRSs = allRSs
+	c.olderRSs = replicasetutil.FindOldReplicaSets(c.rollout, allRSs, newRS)
+	c.stableRS = replicasetutil.GetStableRS(c.rollout, newRS, c.olderRSs)
+	c.otherRSs = replicasetutil.GetOtherRSs(c.rollout, newRS, c.stableRS, allRSs)
+	return true, nil
+}
+
+// newStatefulSetRevisionReplicaSet returns the ReplicaSet representing a revision of a StatefulSet. Its pod template
+// hash is the hash of the revision, and its replicas are the pods running the revision.
+func newStatefulSetRevisionReplicaSet(sts *appsv1.StatefulSet, revision string, uid types.UID, created metav1.Time, pods []*corev1.Pod) *appsv1.ReplicaSet {
+	labels := map[string]string{}
+	for k, v := range sts.Spec.Template.Labels {
+		labels[k] = v
+	}
+	labels[v1alpha1.DefaultRolloutUniqueLabelKey] = strings.TrimPrefix(revision, sts.Name+"-")
+
+	var replicas, readyReplicas, availableReplicas int32
+	now := timeutil.MetaNow()
+	for _, pod := range pods {
+		if pod.Labels[appsv1.ControllerRevisionHashLabelKey] != revision || pod.DeletionTimestamp != nil {
+			continue
+		}
+		replicas++
+		if podutil.IsPodReady(pod) {
+			readyReplicas++
+		}
+		if podutil.IsPodAvailable(pod, sts.Spec.MinReadySeconds, now) {
+			availableReplicas++
+		}
+	}
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              revision,
+			Namespace:         sts.Namespace,
+			UID:               uid,
+			CreationTimestamp: created,
+			Labels:            labels,
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: ptr.To(replicas),
+			Selector: sts.Spec.Selector,
+		},
+		Status: appsv1.ReplicaSetStatus{
+			Replicas:             replicas,
+			FullyLabeledReplicas: replicas,
+			ReadyReplicas:        readyReplicas,
+			AvailableReplicas:    availableReplicas,
+		},
+	}
+}
+
+// rolloutStatefulSet progresses a rollout which references a StatefulSet through its canary steps. Instead of scaling
+// ReplicaSets, the canary weight is applied by lowering the partition of the StatefulSet, so that the pods with the
+// highest ordinals are updated to the new revision first.
+func (c *rolloutContext) rolloutStatefulSet() error {
+	err := c.reconcileAnalysisRuns()
+	if err != nil {
+		return err
+	}
+
+	err = c.reconcileStatefulSetPartition()
+	if err != nil {
+		return err
+	}
+
+	c.reconcileCanaryPause()
+	return c.syncRolloutStatusCanary()
+}
+
+// statefulSetCanaryReplicas returns the number of pods of the StatefulSet which should run the new revision
+func (c *rolloutContext) statefulSetCanaryReplicas() int32 {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	if c.stableRS == nil || c.stableRS == c.newRS {
+		return replicas
+	}
+	if c.pauseContext.IsAborted() {
+		return 0
+	}
+	return replicasetutil.GetStatefulSetCanaryReplicas(c.rollout, replicas)
+}
+
+// desiredStatefulSetPartition returns the partition of the StatefulSet, below which pods run the current revision.
+// Once all pods run the new revision, the partition is raised to the number of replicas so that the next revision is
+// not rolled out by the StatefulSet controller before the rollout starts its steps.
+func (c *rolloutContext) desiredStatefulSetPartition() int32 {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	canaryReplicas := c.statefulSetCanaryReplicas()
+	if canaryReplicas == replicas && c.statefulSet.Status.CurrentRevision == c.statefulSet.Status.UpdateRevision {
+		return replicas
+	}
+	return replicas - canaryReplicas
+}
+
+// reconcileStatefulSetPartition sets the replicas and the partition of the StatefulSet. Pods below the partition which
+// do not run the current revision, e.g. canary pods after an abort, are not reverted by the StatefulSet controller, so
+// they are deleted one at a time to be recreated with the current revision.
+func (c *rolloutContext) reconcileStatefulSetPartition() error {
+	ctx := context.TODO()
+	sts := c.statefulSet
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	partition := c.desiredStatefulSetPartition()
+
+	if defaults.GetReplicasOrDefault(sts.Spec.Replicas) != replicas || statefulSetPartition(sts) != partition {
+		c.log.Infof("Patching StatefulSet %s to %d replicas with partition %d", sts.Name, replicas, partition)
+		patch := fmt.Sprintf(statefulSetPartitionPatch, replicas, partition)
+		updated, err := c.kubeclientset.AppsV1().StatefulSets(sts.Namespace).Patch(ctx, sts.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			return err
+		}
+		c.statefulSet = updated
+		return nil
+	}
+
+	var revert []*corev1.Pod
+	for _, pod := range c.statefulSetPods {
+		if pod.DeletionTimestamp != nil {
+			c.log.Infof("Waiting for pod %s to terminate", pod.Name)
+			return nil
+		}
+		ordinal, ok := statefulSetPodOrdinal(sts, pod)
+		if ok && ordinal < partition && pod.Labels[appsv1.ControllerRevisionHashLabelKey] != sts.Status.CurrentRevision {
+			revert = append(revert, pod)
+		}
+	}
+	if len(revert) == 0 {
+		return nil
+	}
+	sort.Slice(revert, func(i, j int) bool {
+		ordinalI, _ := statefulSetPodOrdinal(sts, revert[i])
+		ordinalJ, _ := statefulSetPodOrdinal(sts, revert[j])
+		return ordinalI > ordinalJ
+	})
+	pod := revert[0]
+	c.log.Infof("Deleting pod %s to revert it to revision %s", pod.Name, sts.Status.CurrentRevision)
+	err := c.kubeclientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// completedStatefulSetStep returns whether the StatefulSet runs the canary of the current step in all of its pods,
+// with all of them available
+func (c *rolloutContext) completedStatefulSetStep() bool {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	if statefulSetPartition(c.statefulSet) != c.desiredStatefulSetPartition() {
+		return false
+	}
+	if c.newRS.Status.AvailableReplicas != c.statefulSetCanaryReplicas() {
+		return false
+	}
+	return replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs) == replicas
+}
+
+func statefulSetPartition(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.UpdateStrategy.RollingUpdate == nil {
+		return 0
+	}
+	return ptr.Deref(sts.Spec.UpdateStrategy.RollingUpdate.Partition, 0)
+}
+
+func statefulSetPodOrdinal(sts *appsv1.StatefulSet, pod *corev1.Pod) (int32, bool) {
+	ordinal, err := strconv.ParseInt(strings.TrimPrefix(pod.Name, sts.Name+"-"), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return int32(ordinal), true
+}
+
+// enqueueStatefulSetRollouts enqueues the rollouts which reference a StatefulSet
+func (c *Controller) enqueueStatefulSetRollouts(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	sts, ok := obj.(*appsv1.StatefulSet)
+	if !ok {
+		return
+	}
+	rollouts, err := c.rolloutsIndexer.ByIndex(statefulSetIndexName, fmt.Sprintf("%s/%s", sts.Namespace, sts.Name))
+	if err != nil {
+		logutil.WithObject(sts).Errorf("Failed to look up rollouts of StatefulSet: %v", err)
+		return
+	}
+	for _, ro := range rollouts {
+		if rollout := unstructuredutil.ObjectToRollout(ro); rollout != nil {
+			c.enqueueRollout(rollout)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package rollout
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newStatefulSet returns a StatefulSet named foo with 5 replicas, the given partition and revisions
+func newStatefulSet(partition int32, currentRevision, updateRevision string) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "foo",
+			Namespace:  metav1.NamespaceDefault,
+			UID:        "sts-uid",
+			Generation: 1,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: ptr.To[int32](5),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+			},
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: ptr.To(partition)},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 1,
+			CurrentRevision:    currentRevision,
+			UpdateRevision:     updateRevision,
+		},
+	}
+}
+
+func newStatefulSetRevision(sts *appsv1.StatefulSet, revision string) *appsv1.ControllerRevision {
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            revision,
+			Namespace:       sts.Namespace,
+			UID:             types.UID("uid-" + revision),
+			Labels:          map[string]string{"foo": "bar"},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(sts, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))},
+		},
+	}
+}
+
+// newStatefulSetPods returns an available pod of the StatefulSet for each of the given revisions, by ordinal
+func newStatefulSetPods(sts *appsv1.StatefulSet, revisions ...string) []*corev1.Pod {
+	var pods []*corev1.Pod
+	for ordinal, revision := range revisions {
+		pods = append(pods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-%d", sts.Name, ordinal),
+				Namespace: sts.Namespace,
+				Labels: map[string]string{
+					"foo":                                 "bar",
+					appsv1.ControllerRevisionHashLabelKey: revision,
+				},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(sts, appsv1.SchemeGroupVersion.WithKind("StatefulSet"))},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: timeutil.MetaTime(timeutil.Now().Add(-time.Minute)),
+				}},
+			},
+		})
+	}
+	return pods
+}
+
+// newStatefulSetRollout returns a canary rollout of 5 replicas referencing the StatefulSet foo at the given step,
+// whose stable revision is foo-a
+func newStatefulSetRollout(stepIndex int32) *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: ptr.To[int32](40)},
+		{Pause: &v1alpha1.RolloutPause{}},
+		{SetCanaryScale: &v1alpha1.SetCanaryScale{Replicas: ptr.To[int32](4)}},
+	}
+	r := newCanaryRollout("foo", 5, nil, steps, ptr.To(stepIndex), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.Template = corev1.PodTemplateSpec{}
+	r.Spec.WorkloadRef = &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "foo"}
+	r.Status.StableRS = "a"
+	return r
+}
+
+func newStatefulSetRolloutContext(t *testing.T, r *v1alpha1.Rollout, sts *appsv1.StatefulSet, revisions []*appsv1.ControllerRevision, pods []*corev1.Pod) (*rolloutContext, *k8sfake.Clientset) {
+	t.Helper()
+	objs := []runtime.Object{sts}
+	for _, revision := range revisions {
+		objs = append(objs, revision)
+	}
+	for _, pod := range pods {
+		objs = append(objs, pod)
+	}
+	client := k8sfake.NewSimpleClientset(objs...)
+	return &rolloutContext{
+		rollout:      r,
+		log:          logutil.WithRollout(r),
+		pauseContext: &pauseContext{rollout: r},
+		reconcilerBase: reconcilerBase{
+			kubeclientset: client,
+			statefulSets:  newSyncedWorkloadCache(t, "StatefulSet", statefulSetInformer, objs...),
+			recorder:      record.NewFakeEventRecorder(),
+		},
+	}, client
+}
+
+// syncedStatefulSetRolloutContext returns the context of a rollout whose StatefulSet revisions foo-a and foo-b are
+// already synced
+func syncedStatefulSetRolloutContext(t *testing.T, r *v1alpha1.Rollout, sts *appsv1.StatefulSet, pods []*corev1.Pod) (*rolloutContext, *k8sfake.Clientset) {
+	t.Helper()
+	revisions := []*appsv1.ControllerRevision{newStatefulSetRevision(sts, "foo-a"), newStatefulSetRevision(sts, "foo-b")}
+	roCtx, client := newStatefulSetRolloutContext(t, r, sts, revisions, pods)
+	observed, err := roCtx.syncStatefulSetRevisions()
+	require.NoError(t, err)
+	require.True(t, observed)
+	client.ClearActions()
+	return roCtx, client
+}
+
+func patchedStatefulSet(t *testing.T, client *k8sfake.Clientset) string {
+	t.Helper()
+	for _, action := range client.Actions() {
+		if patchAction, ok := action.(k8stesting.PatchAction); ok && patchAction.GetResource().Resource == "statefulsets" {
+			return string(patchAction.GetPatch())
+		}
+	}
+	return ""
+}
+
+func deletedPods(client *k8sfake.Clientset) []string {
+	var names []string
+	for _, action := range client.Actions() {
+		if deleteAction, ok := action.(k8stesting.DeleteAction); ok && deleteAction.GetResource().Resource == "pods" {
+			names = append(names, deleteAction.GetName())
+		}
+	}
+	return names
+}
+
+func TestSyncStatefulSetRevisions(t *testing.T) {
+	sts := newStatefulSet(4, "foo-a", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a", "foo-a", "foo-b")
+	pods[4].Status.Conditions = nil
+	otherPod := newStatefulSetPods(sts, "foo-a")[0]
+	otherPod.Name = "other-0"
+	otherPod.OwnerReferences = nil
+	revisions := []*appsv1.ControllerRevision{newStatefulSetRevision(sts, "foo-a"), newStatefulSetRevision(sts, "foo-b")}
+	roCtx, client := newStatefulSetRolloutContext(t, newStatefulSetRollout(1), sts, revisions, append(pods, otherPod))
+	observed, err := roCtx.syncStatefulSetRevisions()
+	require.NoError(t, err)
+	require.True(t, observed)
+	// the revisions and pods are read from the informer caches
+	assert.Empty(t, client.Actions())
+
+	require.NotNil(t, roCtx.newRS)
+	assert.Equal(t, "foo-b", roCtx.newRS.Name)
+	assert.Equal(t, "b", roCtx.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, "bar", roCtx.newRS.Labels["foo"])
+	assert.Equal(t, int32(1), roCtx.newRS.Status.Replicas)
+	assert.Equal(t, int32(0), roCtx.newRS.Status.AvailableReplicas)
+
+	require.NotNil(t, roCtx.stableRS)
+	assert.Equal(t, "foo-a", roCtx.stableRS.Name)
+	assert.Equal(t, int32(4), roCtx.stableRS.Status.Replicas)
+	assert.Equal(t, int32(4), roCtx.stableRS.Status.AvailableReplicas)
+	assert.Len(t, roCtx.allRSs, 2)
+	assert.Len(t, roCtx.statefulSetPods, 5)
+}
+
+func TestSyncStatefulSetRevisionsNotObserved(t *testing.T) {
+	sts := newStatefulSet(5, "foo-a", "foo-a")
+	sts.Generation = 2
+	roCtx, _ := newStatefulSetRolloutContext(t, newStatefulSetRollout(0), sts, nil, nil)
+
+	observed, err := roCtx.syncStatefulSetRevisions()
+	require.NoError(t, err)
+	assert.False(t, observed)
+	assert.Nil(t, roCtx.statefulSet)
+}
+
+func TestSyncStatefulSetRevisionsMissingUpdateRevision(t *testing.T) {
+	sts := newStatefulSet(5, "foo-a", "foo-b")
+	roCtx, _ := newStatefulSetRolloutContext(t, newStatefulSetRollout(0), sts, []*appsv1.ControllerRevision{newStatefulSetRevision(sts, "foo-a")}, nil)
+
+	observed, err := roCtx.syncStatefulSetRevisions()
+	require.NoError(t, err)
+	assert.True(t, observed)
+	require.NotNil(t, roCtx.newRS)
+	assert.Equal(t, "foo-b", roCtx.newRS.Name)
+	assert.Len(t, roCtx.allRSs, 2)
+}
+
+func TestReconcileStatefulSetPartitionSetWeight(t *testing.T) {
+	sts := newStatefulSet(5, "foo-a", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a", "foo-a", "foo-a")
+	roCtx, client := syncedStatefulSetRolloutContext(t, newStatefulSetRollout(0), sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	// 40% of 5 replicas are updated by lowering the partition to 3
+	assert.Equal(t, `{"spec":{"replicas":5,"updateStrategy":{"rollingUpdate":{"partition":3}}}}`, patchedStatefulSet(t, client))
+	assert.Equal(t, int32(3), statefulSetPartition(roCtx.statefulSet))
+	assert.False(t, roCtx.completedStatefulSetStep())
+}
+
+func TestReconcileStatefulSetPartitionSetCanaryScale(t *testing.T) {
+	sts := newStatefulSet(3, "foo-a", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a", "foo-b", "foo-b")
+	roCtx, client := syncedStatefulSetRolloutContext(t, newStatefulSetRollout(2), sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	assert.Equal(t, `{"spec":{"replicas":5,"updateStrategy":{"rollingUpdate":{"partition":1}}}}`, patchedStatefulSet(t, client))
+}
+
+func TestReconcileStatefulSetPartitionReplicas(t *testing.T) {
+	sts := newStatefulSet(3, "foo-a", "foo-b")
+	sts.Spec.Replicas = ptr.To[int32](3)
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a")
+	roCtx, client := syncedStatefulSetRolloutContext(t, newStatefulSetRollout(0), sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	// the replicas of the rollout are applied to the StatefulSet
+	assert.Equal(t, `{"spec":{"replicas":5,"updateStrategy":{"rollingUpdate":{"partition":3}}}}`, patchedStatefulSet(t, client))
+}
+
+func TestCompletedStatefulSetStep(t *testing.T) {
+	sts := newStatefulSet(3, "foo-a", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a", "foo-b", "foo-b")
+	roCtx, client := syncedStatefulSetRolloutContext(t, newStatefulSetRollout(0), sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	assert.Empty(t, client.Actions())
+	assert.True(t, roCtx.completedStatefulSetStep())
+	assert.True(t, roCtx.completedCurrentCanaryStep())
+}
+
+func TestReconcileStatefulSetPartitionLocksAfterPromotion(t *testing.T) {
+	sts := newStatefulSet(0, "foo-b", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-b", "foo-b", "foo-b", "foo-b", "foo-b")
+	r := newStatefulSetRollout(3)
+	r.Status.StableRS = "b"
+	roCtx, client := syncedStatefulSetRolloutContext(t, r, sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	// the partition is raised so that the next revision waits for the steps of the rollout
+	assert.Equal(t, `{"spec":{"replicas":5,"updateStrategy":{"rollingUpdate":{"partition":5}}}}`, patchedStatefulSet(t, client))
+}
+
+func TestReconcileStatefulSetPartitionRevertsOnAbort(t *testing.T) {
+	sts := newStatefulSet(5, "foo-a", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a", "foo-b", "foo-b")
+	r := newStatefulSetRollout(0)
+	r.Status.Abort = true
+	roCtx, client := syncedStatefulSetRolloutContext(t, r, sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	assert.Empty(t, patchedStatefulSet(t, client))
+	// the canary pod with the highest ordinal is deleted first
+	assert.Equal(t, []string{"foo-4"}, deletedPods(client))
+}
+
+func TestReconcileStatefulSetPartitionWaitsForTerminatingPod(t *testing.T) {
+	sts := newStatefulSet(5, "foo-a", "foo-b")
+	pods := newStatefulSetPods(sts, "foo-a", "foo-a", "foo-a", "foo-b", "foo-b")
+	pods[4].DeletionTimestamp = ptr.To(timeutil.MetaNow())
+	r := newStatefulSetRollout(0)
+	r.Status.Abort = true
+	roCtx, client := syncedStatefulSetRolloutContext(t, r, sts, pods)
+
+	require.NoError(t, roCtx.reconcileStatefulSetPartition())
+	assert.Empty(t, deletedPods(client))
+}
+
+func TestEnqueueStatefulSetRollouts(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r := newStatefulSetRollout(0)
+	other := newStatefulSetRollout(0)
+	other.Name = "other"
+	other.Spec.WorkloadRef.Name = "other"
+	f.rolloutLister = append(f.rolloutLister, r, other)
+	f.objects = append(f.objects, r, other)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	c.enqueueStatefulSetRollouts(newStatefulSet(5, "foo-a", "foo-a"))
+
+	f.enqueuedObjectsLock.Lock()
+	defer f.enqueuedObjectsLock.Unlock()
+	assert.Equal(t, 1, f.enqueuedObjects[getKey(r, t)])
+	assert.Equal(t, 0, f.enqueuedObjects[getKey(other, t)])
+}
@@ -801,6 +801,9 @@ func isIndefiniteStep(r *v1alpha1.Rollout) bool {
 	if currentStep != nil && (currentStep.Experiment != nil || currentStep.Analysis != nil || currentStep.Pause != nil) {
 		return true
 	}
+	if daemonSetStep, _ := replicasetutil.GetCurrentDaemonSetStep(r); daemonSetStep != nil && (daemonSetStep.Analysis != nil || daemonSetStep.Pause != nil) {
+		return true
+	}
 	// also check the pause condition to cover blueGreen
 	pauseCond := conditions.GetRolloutCondition(r.Status, v1alpha1.RolloutPaused)
 	pausedCondTrue := pauseCond != nil && pauseCond.Status == corev1.ConditionTrue
@@ -962,6 +965,9 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus *v1alpha1.RolloutS
 	} else if c.rollout.Spec.Strategy.Canary != nil && replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs) >= defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas) {
 		availability := conditions.NewRolloutCondition(v1alpha1.RolloutAvailable, corev1.ConditionTrue, conditions.AvailableReason, conditions.AvailableMessage)
 		conditions.SetRolloutCondition(newStatus, *availability)
+	} else if c.rollout.Spec.Strategy.DaemonSet != nil && newStatus.AvailableReplicas >= newStatus.DaemonSet.Nodes {
+		availability := conditions.NewRolloutCondition(v1alpha1.RolloutAvailable, corev1.ConditionTrue, conditions.AvailableReason, conditions.AvailableMessage)
+		conditions.SetRolloutCondition(newStatus, *availability)
 	} else {
 		noAvailability := conditions.NewRolloutCondition(v1alpha1.RolloutAvailable, corev1.ConditionFalse, conditions.AvailableReason, conditions.NotAvailableMessage)
 		conditions.SetRolloutCondition(newStatus, *noAvailability)
@@ -976,6 +982,11 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus *v1alpha1.RolloutS
 		conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutReplicaFailure)
 	}
 
+	if c.trafficWeightsCondition != nil || c.removeTrafficWeightsCondition {
+		setReconciledCondition(newStatus, v1alpha1.TrafficWeightsMismatch, c.trafficWeightsCondition)
+	}
+	setReconciledCondition(newStatus, v1alpha1.WaitingForDependencies, c.dependenciesCondition)
+
 	if conditions.RolloutCompleted(newStatus) {
 		// The event gets triggered in function promoteStable
 		updateCompletedCond := conditions.NewRolloutCondition(v1alpha1.RolloutCompleted, corev1.ConditionTrue,
@@ -992,6 +1003,22 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus *v1alpha1.RolloutS
 	}
 }
 
+// setReconciledCondition sets a condition computed during the reconciliation, or removes it when the condition is nil.
+// Its message reports details which change while its status and reason stay the same, such as the weight of each
+// traffic router, so the message is updated even then, keeping the last transition time.
+func setReconciledCondition(newStatus *v1alpha1.RolloutStatus, condType v1alpha1.RolloutConditionType, cond *v1alpha1.RolloutCondition) {
+	if cond == nil {
+		conditions.RemoveRolloutCondition(newStatus, condType)
+		return
+	}
+	currentCond := conditions.GetRolloutCondition(*newStatus, condType)
+	if currentCond != nil && currentCond.Status == cond.Status && currentCond.Message != cond.Message {
+		cond.LastTransitionTime = currentCond.LastTransitionTime
+		conditions.RemoveRolloutCondition(newStatus, condType)
+	}
+	conditions.SetRolloutCondition(newStatus, *cond)
+}
+
 // persistRolloutStatus persists updates to rollout status. If no changes were made, it is a no-op
 func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus) error {
 	ctx := context.TODO()
@@ -1075,7 +1102,7 @@ func (c *rolloutContext) sendStateChangeEvents(prevStatus, newStatus *v1alpha1.R
 }
 
 // used for unit testing
-var nowFn = func() time.Time { return time.Now() }
+var nowFn = func() time.Time { return timeutil.Now() }
 
 // requeueStuckRollout checks whether the provided rollout needs to be synced for a progress
 // check. It returns the time after the rollout will be requeued for the progress check, 0 if it
@@ -1171,6 +1198,9 @@ func (c *rolloutContext) resetRolloutStatus(newStatus *v1alpha1.RolloutStatus) {
 	newStatus.Canary.CurrentStepAnalysisRunStatus = nil
 	newStatus.Canary.CurrentBackgroundAnalysisRunStatus = nil
 	newStatus.Canary.StepPluginStatuses = nil
+	newStatus.Canary.WeightRamp = nil
+	newStatus.DaemonSet.CurrentStepAnalysisRunStatus = nil
+	newStatus.DaemonSet.CurrentBackgroundAnalysisRunStatus = nil
 	newStatus.CurrentStepIndex = replicasetutil.ResetCurrentStepIndex(c.rollout)
 }
 
@@ -1182,18 +1212,7 @@ func (c *rolloutContext) resetRolloutStatus(newStatus *v1alpha1.RolloutStatus) {
 // the result while an update is in flight (e.g. a spec change was just
 // detected); on an idle rollout a true result is meaningless.
 func (c *rolloutContext) isRollback() bool {
-	if c.newRS == nil || c.stableRS == nil {
-		return false
-	}
-	newRSHash := replicasetutil.GetPodTemplateHash(c.newRS)
-
-	// rollbackToStable is also true when the rollout is already promoted
-	// since newRS is the stable RS. After it is completed, we cannot know if the rollout reached
-	// the stable state via a rollback or via a normal promotion.
-	rollbackToStable := c.rollout.Status.StableRS == newRSHash
-
-	rollbackToPreviousRevision := c.newRS.CreationTimestamp.Before(&c.stableRS.CreationTimestamp)
-	return rollbackToStable || rollbackToPreviousRevision
+	return replicasetutil.IsRollback(c.rollout, c.newRS, c.stableRS)
 }
 
 // isFastRollback returns true if we are fast-rolling back to a previous
@@ -1214,34 +1233,15 @@ func (c *rolloutContext) isFastRollback() bool {
 }
 
 func (c *rolloutContext) isRollbackWithinWindow() bool {
-	if c.newRS == nil || c.stableRS == nil {
-		return false
-	}
-	if !c.newRS.CreationTimestamp.Before(&c.stableRS.CreationTimestamp) {
-		return false
-	}
-	if c.rollout.Spec.RollbackWindow != nil {
-		if c.rollout.Spec.RollbackWindow.Revisions > 0 {
-			var windowSize int32
-			for _, rs := range c.allRSs {
-				if rs.Annotations != nil && rs.Annotations[v1alpha1.ExperimentNameAnnotationKey] != "" {
-					continue
-				}
-
-				// is newRS < rs < stableRS ? then it's part of the window
-				if rs.CreationTimestamp.Before(&c.stableRS.CreationTimestamp) &&
-					c.newRS.CreationTimestamp.Before(&rs.CreationTimestamp) {
-					windowSize = windowSize + 1
-				}
-			}
-			if windowSize < c.rollout.Spec.RollbackWindow.Revisions {
-				c.log.Infof("Rollback within the window: %d (%v)", windowSize, c.rollout.Spec.RollbackWindow.Revisions)
-				return true
-			}
+	withinWindow, windowSize := replicasetutil.IsRollbackWithinWindow(c.rollout, c.newRS, c.stableRS, c.allRSs)
+	if windowSize >= 0 {
+		if withinWindow {
+			c.log.Infof("Rollback within the window: %d (%v)", windowSize, c.rollout.Spec.RollbackWindow.Revisions)
+		} else {
 			c.log.Infof("Rollback outside the window: %d (%v)", windowSize, c.rollout.Spec.RollbackWindow.Revisions)
 		}
 	}
-	return false
+	return withinWindow
 }
 
 // shouldFullPromote returns a reason string explaining why a rollout should fully promote, marking
@@ -1275,6 +1275,9 @@ func (c *rolloutContext) shouldFullPromote(newStatus v1alpha1.RolloutStatus) str
 		stepCount := len(c.rollout.Spec.Strategy.Canary.Steps)
 		completedAllSteps := stepCount == 0 || (currentStepIndex != nil && *currentStepIndex == int32(stepCount))
 		if completedAllSteps {
+			if c.revisionHold != "" {
+				return ""
+			}
 			return fmt.Sprintf("Completed all %d canary steps", stepCount)
 		}
 	} else if c.rollout.Spec.Strategy.BlueGreen != nil {
@@ -1312,6 +1315,29 @@ func (c *rolloutContext) shouldFullPromote(newStatus v1alpha1.RolloutStatus) str
 			}
 		}
 		return "Completed blue-green update"
+	} else if c.rollout.Spec.Strategy.DaemonSet != nil {
+		if c.pauseContext.IsAborted() {
+			return ""
+		}
+		// every node needs to run an available pod of the new revision
+		if c.newRS == nil || c.newRS.Status.AvailableReplicas < c.daemonSet.Status.DesiredNumberScheduled {
+			return ""
+		}
+		if c.rollout.Status.PromoteFull {
+			return "Full promotion requested"
+		}
+		if c.isFastRollback() {
+			return "Fast rollback"
+		}
+		_, currentStepIndex := replicasetutil.GetCurrentDaemonSetStep(c.rollout)
+		stepCount := len(c.rollout.Spec.Strategy.DaemonSet.Steps)
+		completedAllSteps := stepCount == 0 || (currentStepIndex != nil && *currentStepIndex == int32(stepCount))
+		if completedAllSteps {
+			if c.revisionHold != "" {
+				return ""
+			}
+			return fmt.Sprintf("Completed all %d DaemonSet steps", stepCount)
+		}
 	}
 	return ""
 }
@@ -1331,6 +1357,14 @@ func (c *rolloutContext) promoteStable(newStatus *v1alpha1.RolloutStatus, reason
 			newStatus.CurrentStepIndex = nil
 		}
 	}
+	if c.rollout.Spec.Strategy.DaemonSet != nil {
+		stepCount := int32(len(c.rollout.Spec.Strategy.DaemonSet.Steps))
+		if stepCount > 0 {
+			newStatus.CurrentStepIndex = &stepCount
+		} else {
+			newStatus.CurrentStepIndex = nil
+		}
+	}
 	previousStableHash := newStatus.StableRS
 	revision, _ := replicasetutil.Revision(c.rollout)
 	if previousStableHash != newStatus.CurrentPodHash {
@@ -14,6 +14,7 @@ import (
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 
 	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -48,6 +49,9 @@ var (
 		{Group: "apps", Kind: "ReplicaSet"}: {
 			TemplatePath: []string{"spec", "template"}, SelectorPath: []string{"spec", "selector"},
 		},
+		{Group: "apps", Kind: "StatefulSet"}: {
+			TemplatePath: []string{"spec", "template"}, SelectorPath: []string{"spec", "selector"},
+		},
 	}
 )
 
@@ -155,6 +159,13 @@ func (r *informerBasedTemplateResolver) Resolve(rollout *v1alpha1.Rollout) error
 	if !ok {
 		return fmt.Errorf("informer for %v must have unstructured object but had %v", gvk, obj)
 	}
+	if gvk.Kind == "StatefulSet" {
+		// the pods of a StatefulSet are updated by lowering its partition, which requires the RollingUpdate strategy
+		strategy, _, _ := unstructured.NestedString(un.Object, "spec", "updateStrategy", "type")
+		if strategy == string(appsv1.OnDeleteStatefulSetStrategyType) {
+			return fmt.Errorf("StatefulSet with the %s update strategy is not supported", strategy)
+		}
+	}
 
 	if podTemplateSpecMap, ok, _ := unstructured.NestedMap(un.Object, info.TemplatePath...); ok {
 		var template corev1.PodTemplateSpec
@@ -48,6 +48,7 @@ func newFakeDiscoClient() *discofake.FakeDiscovery {
 					APIResources: []metav1.APIResource{
 						{Name: "deployments", Namespaced: true, Kind: "Deployment"},
 						{Name: "replicasets", Namespaced: true, Kind: "ReplicaSet"},
+						{Name: "statefulsets", Namespaced: true, Kind: "StatefulSet"},
 					},
 				},
 			},
@@ -274,6 +275,61 @@ func TestResolve_ReplicaSetRef(t *testing.T) {
 	assert.Equal(t, rs.Spec.Template, rollout.Spec.Template)
 }
 
+func TestResolve_StatefulSetRef(t *testing.T) {
+	newStatefulSetRollout := func() *v1alpha1.Rollout {
+		return &v1alpha1.Rollout{
+			ObjectMeta: v1.ObjectMeta{
+				Namespace: "default",
+			},
+			Spec: v1alpha1.RolloutSpec{
+				WorkloadRef: &v1alpha1.ObjectRef{
+					Name:       "my-sts",
+					Kind:       "StatefulSet",
+					APIVersion: "apps/v1",
+				},
+			},
+		}
+	}
+	newStatefulSet := func(name string, strategy appsv1.StatefulSetUpdateStrategyType) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "StatefulSet",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "db"}},
+				},
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{Type: strategy},
+			},
+		}
+	}
+	sts := newStatefulSet("my-sts", appsv1.RollingUpdateStatefulSetStrategyType)
+	onDeleteSts := newStatefulSet("on-delete", appsv1.OnDeleteStatefulSetStrategyType)
+
+	discoveryClient := newFakeDiscoClient()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, sts, onDeleteSts)
+
+	resolver, cancel := newResolver(dynamicClient, discoveryClient, fake.NewSimpleClientset())
+	defer cancel()
+
+	rollout := newStatefulSetRollout()
+	err := resolver.Resolve(rollout)
+	assert.NoError(t, err)
+	assert.Equal(t, sts.Spec.Template, rollout.Spec.Template)
+	assert.Equal(t, sts.Spec.Selector, rollout.Spec.Selector)
+
+	rollout = newStatefulSetRollout()
+	rollout.Spec.WorkloadRef.Name = "on-delete"
+	err = resolver.Resolve(rollout)
+	assert.EqualError(t, err, "StatefulSet with the OnDelete update strategy is not supported")
+}
+
 func TestResolveRefDeployment_PodTemplate(t *testing.T) {
 	rollout := v1alpha1.Rollout{
 		ObjectMeta: v1.ObjectMeta{
@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 
@@ -13,6 +14,8 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/plugin"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
@@ -20,6 +23,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	a6 "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/gatewayapi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
@@ -30,6 +34,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 )
 
@@ -114,6 +119,14 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		}))
 	}
 
+	if rollout.Spec.Strategy.Canary.TrafficRouting.GatewayAPI != nil {
+		trafficReconcilers = append(trafficReconcilers, gatewayapi.NewReconciler(gatewayapi.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   c.dynamicclientset,
+			Recorder: c.recorder,
+		}))
+	}
+
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Plugins != nil {
 		for pluginName := range rollout.Spec.Strategy.Canary.TrafficRouting.Plugins {
 			pluginReconciler, err := plugin.NewReconciler(&plugin.ReconcilerConfig{
@@ -165,6 +178,7 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 	if err != nil {
 		return err
 	}
+	c.removeTrafficWeightsCondition = len(reconcilers) < 2
 	// ensure that trafficReconcilers list is healthy
 	if len(reconcilers) == 0 {
 		c.log.Info("No TrafficRouting Reconcilers found")
@@ -173,15 +187,20 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 	}
 
 	c.log.Infof("Found %d TrafficRouting Reconcilers", len(reconcilers))
+	currentStep, index := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	var desiredWeight int32
+	var weightDestinations []v1alpha1.WeightDestination
+	var canaryHash, stableHash string
+	// updated holds the reconcilers whose weight has been set during this reconciliation, so that they can
+	// be rolled back to the previous weights if another reconciler fails
+	updated := make([]trafficrouting.TrafficRoutingReconciler, 0, len(reconcilers))
 	// iterate over the list of trafficReconcilers
 	for _, reconciler := range reconcilers {
 		c.log.Infof("Reconciling TrafficRouting with type '%s'", reconciler.Type())
 
-		currentStep, index := replicasetutil.GetCurrentCanaryStep(c.rollout)
-		desiredWeight := int32(0)
-		weightDestinations := make([]v1alpha1.WeightDestination, 0)
-
-		var canaryHash, stableHash string
+		desiredWeight = int32(0)
+		weightDestinations = make([]v1alpha1.WeightDestination, 0)
+		canaryHash, stableHash = "", ""
 		if c.stableRS != nil {
 			stableHash = c.stableRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 		}
@@ -251,8 +270,8 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 				// Use the previous weight since the new RS is not ready for a new weight
 				for i := *index - 1; i >= 0; i-- {
 					step := c.rollout.Spec.Strategy.Canary.Steps[i]
-					if step.SetWeight != nil {
-						desiredWeight = *step.SetWeight
+					if weight := replicasetutil.GetStepWeight(step); weight != nil {
+						desiredWeight = *weight
 						break
 					}
 				}
@@ -311,50 +330,172 @@ func (c *rolloutContext) reconcileTrafficRouting() error {
 		err = reconciler.SetWeight(desiredWeight, weightDestinations...)
 		if err != nil {
 			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: "TrafficRoutingError"}, err.Error())
+			c.rollbackTrafficWeights(reconcilers, updated, desiredWeight, conditions.TrafficWeightsRolledBackMessage, []string{fmt.Sprintf("%s: %s", reconciler.Type(), err)})
 			return err
 		}
+		updated = append(updated, reconciler)
+	}
 
-		if modified, newWeights := calculateWeightStatus(c.rollout, canaryHash, stableHash, desiredWeight, weightDestinations...); modified {
-			c.log.Infof("Previous weights: %v", c.rollout.Status.Canary.Weights)
-			c.log.Infof("New weights: %v", newWeights)
-			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.TrafficWeightUpdatedReason}, trafficWeightUpdatedMessage(c.rollout.Status.Canary.Weights, newWeights))
-			c.newStatus.Canary.Weights = newWeights
-		}
+	modified, newWeights := calculateWeightStatus(c.rollout, canaryHash, stableHash, desiredWeight, weightDestinations...)
 
-		weightVerified, err := reconciler.VerifyWeight(desiredWeight, weightDestinations...)
-		c.newStatus.Canary.Weights.Verified = weightVerified
+	// verify the weights of every reconciler before deciding whether the weights took effect, so that
+	// a router which is lagging behind is reported alongside the ones which already verified
+	var weightVerified *bool
+	var verifyErr error
+	routerWeights := make([]string, 0, len(reconcilers))
+	for _, reconciler := range reconcilers {
+		verified, err := reconciler.VerifyWeight(desiredWeight, weightDestinations...)
 		if err != nil {
 			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.WeightVerifyErrorReason}, conditions.WeightVerifyErrorMessage, err)
-			return nil // return nil instead of error since we want to continue with normal reconciliation
+			routerWeights = append(routerWeights, fmt.Sprintf("%s: weight %d verify failed: %v", reconciler.Type(), desiredWeight, err))
+			if verifyErr == nil {
+				verifyErr = err
+				weightVerified = verified
+			}
+			continue
 		}
+		routerWeights = append(routerWeights, routerWeightMessage(reconciler.Type(), desiredWeight, verified))
+		if verified != nil && (weightVerified == nil || *weightVerified) {
+			weightVerified = verified
+		}
+	}
 
-		var indexString string
-		if index != nil {
-			indexString = strconv.FormatInt(int64(*index), 10)
+	if len(reconcilers) > 1 && (verifyErr != nil || (weightVerified != nil && !*weightVerified)) {
+		// multiple traffic routers only commit the new weights to the status once all of them verified them, so that
+		// the status keeps reporting the weights the routers are known to serve. The routers are rolled back to those
+		// weights when one of them fails to verify the new weight or does not verify it within the progress deadline.
+		if verifyErr != nil || c.trafficWeightsVerifyTimedOut() {
+			c.rollbackTrafficWeights(reconcilers, reconcilers, desiredWeight, conditions.TrafficWeightsVerifyRolledBackMessage, routerWeights)
 		} else {
-			indexString = "n/a"
+			c.setTrafficWeightsCondition(reconcilers, weightVerified, routerWeights)
 		}
+		c.holdTrafficWeights(canaryHash, stableHash)
+		c.log.Infof("Desired weight %d not yet verified by all traffic routers", desiredWeight)
+		c.enqueueRolloutAfter(c.rollout, defaults.GetRolloutVerifyRetryInterval())
+		return nil
+	}
 
-		if weightVerified != nil {
-			if *weightVerified {
-				c.log.Infof("Desired weight (stepIdx: %s) %d verified", indexString, desiredWeight)
-			} else {
-				c.log.Infof("Desired weight (stepIdx: %s) %d not yet verified", indexString, desiredWeight)
-				logCtx := logutil.WithRollout(c.rollout)
-				logCtx.Info("rollout enqueue due to trafficrouting")
-				c.enqueueRolloutAfter(c.rollout, defaults.GetRolloutVerifyRetryInterval())
-				// At the end of the rollout we need to verify the weight is correct, and return an error if not because we don't want the rest of the
-				// reconcile process to continue. We don't need to do this if we are in the middle of the rollout because the rest of the reconcile
-				// process won't scale down the old replicasets yet due to being in the middle of some steps.
-				if desiredWeight == weightutil.MaxTrafficWeight(c.rollout) && len(c.rollout.Spec.Strategy.Canary.Steps) >= int(*c.rollout.Status.CurrentStepIndex) {
-					return fmt.Errorf("end of rollout, desired weight %d not yet verified", desiredWeight)
-				}
+	if modified {
+		c.log.Infof("Previous weights: %v", c.rollout.Status.Canary.Weights)
+		c.log.Infof("New weights: %v", newWeights)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.TrafficWeightUpdatedReason}, trafficWeightUpdatedMessage(c.rollout.Status.Canary.Weights, newWeights))
+		c.newStatus.Canary.Weights = newWeights
+	}
+	c.newStatus.Canary.Weights.Verified = weightVerified
+	if verifyErr != nil {
+		// a verify error of a single traffic router is not a reason to roll back the weight: the router may still
+		// be propagating it, which is verified again on the next reconciliation
+		return nil // return nil instead of error since we want to continue with normal reconciliation
+	}
+	c.setTrafficWeightsCondition(reconcilers, weightVerified, routerWeights)
+
+	var indexString string
+	if index != nil {
+		indexString = strconv.FormatInt(int64(*index), 10)
+	} else {
+		indexString = "n/a"
+	}
+
+	if weightVerified != nil {
+		if *weightVerified {
+			c.log.Infof("Desired weight (stepIdx: %s) %d verified", indexString, desiredWeight)
+		} else {
+			c.log.Infof("Desired weight (stepIdx: %s) %d not yet verified", indexString, desiredWeight)
+			logCtx := logutil.WithRollout(c.rollout)
+			logCtx.Info("rollout enqueue due to trafficrouting")
+			c.enqueueRolloutAfter(c.rollout, defaults.GetRolloutVerifyRetryInterval())
+			// At the end of the rollout we need to verify the weight is correct, and return an error if not because we don't want the rest of the
+			// reconcile process to continue. We don't need to do this if we are in the middle of the rollout because the rest of the reconcile
+			// process won't scale down the old replicasets yet due to being in the middle of some steps.
+			if desiredWeight == weightutil.MaxTrafficWeight(c.rollout) && len(c.rollout.Spec.Strategy.Canary.Steps) >= int(*c.rollout.Status.CurrentStepIndex) {
+				return fmt.Errorf("end of rollout, desired weight %d not yet verified", desiredWeight)
 			}
 		}
 	}
 	return nil
 }
 
+// rollbackTrafficWeights restores the previous weights on the reconcilers which were already updated, so that multiple traffic routers do
+// not stay at different weights when one of them fails to set or verify the desired weight. The weights of the status
+// are restored as well, so that they keep reporting the weight the routers serve. The message of the condition lists
+// the given results of the routers followed by the weight each of them was rolled back to. It is a no-op for a single
+// traffic router.
+func (c *rolloutContext) rollbackTrafficWeights(reconcilers, updated []trafficrouting.TrafficRoutingReconciler, desiredWeight int32, message string, routerWeights []string) {
+	if len(reconcilers) < 2 {
+		return
+	}
+	c.newStatus.Canary.Weights = c.rollout.Status.Canary.Weights
+	var previousWeight int32
+	var previousDestinations []v1alpha1.WeightDestination
+	if c.rollout.Status.Canary.Weights != nil {
+		previousWeight = c.rollout.Status.Canary.Weights.Canary.Weight
+		previousDestinations = c.rollout.Status.Canary.Weights.Additional
+	}
+	routerWeights = append([]string{}, routerWeights...)
+	if previousWeight != desiredWeight {
+		for _, reconciler := range updated {
+			if err := reconciler.SetWeight(previousWeight, previousDestinations...); err != nil {
+				c.log.Warnf("Failed to roll back weight of TrafficRouting with type '%s': %v", reconciler.Type(), err)
+				routerWeights = append(routerWeights, fmt.Sprintf("%s: weight %d, rollback failed: %v", reconciler.Type(), desiredWeight, err))
+				continue
+			}
+			c.log.Infof("Rolled back weight of TrafficRouting with type '%s' to %d", reconciler.Type(), previousWeight)
+			routerWeights = append(routerWeights, fmt.Sprintf("%s: rolled back to weight %d", reconciler.Type(), previousWeight))
+		}
+	}
+	msg := fmt.Sprintf(message, desiredWeight, strings.Join(routerWeights, ", "))
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.TrafficWeightsRolledBackReason}, msg)
+	c.trafficWeightsCondition = conditions.NewRolloutCondition(v1alpha1.TrafficWeightsMismatch, corev1.ConditionTrue, conditions.TrafficWeightsRolledBackReason, msg)
+}
+
+// holdTrafficWeights keeps the weights of the status at the weights the traffic routers were last known to serve,
+// marked as unverified so that the rollout does not progress past the current step
+func (c *rolloutContext) holdTrafficWeights(canaryHash, stableHash string) {
+	weights := c.rollout.Status.Canary.Weights.DeepCopy()
+	if weights == nil {
+		_, weights = calculateWeightStatus(c.rollout, canaryHash, stableHash, 0)
+	}
+	weights.Verified = ptr.To(false)
+	c.newStatus.Canary.Weights = weights
+}
+
+// trafficWeightsVerifyTimedOut returns whether the traffic routers have reported the same mismatch of the desired
+// weight for longer than the progress deadline of the rollout
+func (c *rolloutContext) trafficWeightsVerifyTimedOut() bool {
+	cond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.TrafficWeightsMismatch)
+	if cond == nil || cond.Status != corev1.ConditionTrue || cond.Reason != conditions.TrafficWeightsMismatchReason {
+		return false
+	}
+	deadline := time.Duration(defaults.GetProgressDeadlineSecondsOrDefault(c.rollout)) * time.Second
+	return timeutil.Now().Sub(cond.LastUpdateTime.Time) > deadline
+}
+
+// setTrafficWeightsCondition records whether the traffic routers of a rollout using multiple traffic routers agree
+// on the desired weight
+func (c *rolloutContext) setTrafficWeightsCondition(reconcilers []trafficrouting.TrafficRoutingReconciler, weightVerified *bool, routerWeights []string) {
+	if len(reconcilers) < 2 {
+		return
+	}
+	details := strings.Join(routerWeights, ", ")
+	if weightVerified != nil && !*weightVerified {
+		c.trafficWeightsCondition = conditions.NewRolloutCondition(v1alpha1.TrafficWeightsMismatch, corev1.ConditionTrue, conditions.TrafficWeightsMismatchReason, fmt.Sprintf(conditions.TrafficWeightsMismatchMessage, details))
+		return
+	}
+	c.trafficWeightsCondition = conditions.NewRolloutCondition(v1alpha1.TrafficWeightsMismatch, corev1.ConditionFalse, conditions.TrafficWeightsVerifiedReason, fmt.Sprintf(conditions.TrafficWeightsVerifiedMessage, details))
+}
+
+// routerWeightMessage describes the weight verification result of a single traffic router
+func routerWeightMessage(routerType string, weight int32, verified *bool) string {
+	switch {
+	case verified == nil:
+		return fmt.Sprintf("%s: weight %d", routerType, weight)
+	case *verified:
+		return fmt.Sprintf("%s: weight %d verified", routerType, weight)
+	default:
+		return fmt.Sprintf("%s: weight %d not verified", routerType, weight)
+	}
+}
+
 // calculateDesiredWeightOnAbortOrStableRollback returns the desired weight to use when we are either
 // aborting, or rolling back to stable RS.
 func (c *rolloutContext) calculateDesiredWeightOnAbortOrStableRollback() int32 {
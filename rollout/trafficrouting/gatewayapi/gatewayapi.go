@@ -0,0 +1,577 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "GatewayAPI"
+
+const (
+	// ManagedRoutesAnnotation records, in order, the names of the rules which the rollout has prepended to a route
+	ManagedRoutesAnnotation = "rollouts.argoproj.io/gatewayapi-managed-routes"
+
+	GatewayAPIRouteUpdateError = "GatewayAPIRouteUpdateError"
+
+	routeKindHTTP = "HTTPRoute"
+	routeKindGRPC = "GRPCRoute"
+	routeKindTCP  = "TCPRoute"
+)
+
+var (
+	// HTTPRouteGVR is the resource of the Gateway API HTTPRoute
+	HTTPRouteGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+	// GRPCRouteGVR is the resource of the Gateway API GRPCRoute
+	GRPCRouteGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "grpcroutes"}
+	// TCPRouteGVR is the resource of the Gateway API TCPRoute
+	TCPRouteGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1alpha2", Resource: "tcproutes"}
+)
+
+// ReconcilerConfig describes static configuration data for the Gateway API reconciler
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   dynamic.Interface
+	Recorder record.EventRecorder
+}
+
+// Reconciler holds required fields to reconcile Gateway API routes
+type Reconciler struct {
+	rollout  *v1alpha1.Rollout
+	client   dynamic.Interface
+	recorder record.EventRecorder
+	log      *logrus.Entry
+}
+
+type routeRef struct {
+	kind string
+	gvr  schema.GroupVersionResource
+	name string
+}
+
+// NewReconciler returns a trafficrouting reconciler which splits traffic by adjusting the backendRef weights of
+// Gateway API HTTPRoutes, GRPCRoutes and TCPRoutes. The routes are accessed through the dynamic client so that the
+// controller does not depend on the Gateway API CRDs being installed.
+func NewReconciler(cfg ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		rollout:  cfg.Rollout,
+		client:   cfg.Client,
+		recorder: cfg.Recorder,
+		log:      logutil.WithRollout(cfg.Rollout),
+	}
+}
+
+func (r *Reconciler) routes() []routeRef {
+	var refs []routeRef
+	gatewayAPI := r.rollout.Spec.Strategy.Canary.TrafficRouting.GatewayAPI
+	for _, route := range gatewayAPI.HTTPRoutes {
+		refs = append(refs, routeRef{kind: routeKindHTTP, gvr: HTTPRouteGVR, name: route.Name})
+	}
+	for _, route := range gatewayAPI.GRPCRoutes {
+		refs = append(refs, routeRef{kind: routeKindGRPC, gvr: GRPCRouteGVR, name: route.Name})
+	}
+	for _, route := range gatewayAPI.TCPRoutes {
+		refs = append(refs, routeRef{kind: routeKindTCP, gvr: TCPRouteGVR, name: route.Name})
+	}
+	return refs
+}
+
+func (r *Reconciler) getRoute(ctx context.Context, ref routeRef) (*unstructured.Unstructured, error) {
+	return r.client.Resource(ref.gvr).Namespace(r.rollout.Namespace).Get(ctx, ref.name, metav1.GetOptions{})
+}
+
+func (r *Reconciler) updateRoute(ctx context.Context, ref routeRef, route *unstructured.Unstructured) error {
+	_, err := r.client.Resource(ref.gvr).Namespace(r.rollout.Namespace).Update(ctx, route, metav1.UpdateOptions{})
+	if err != nil {
+		msg := fmt.Sprintf("Error updating %s %q: %s", ref.kind, ref.name, err)
+		r.recorder.Eventf(r.rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: GatewayAPIRouteUpdateError}, msg)
+	}
+	return err
+}
+
+// mutateRoute fetches the route, applies the mutation and only updates the route when the mutation changed it
+func (r *Reconciler) mutateRoute(ref routeRef, mutate func(route *unstructured.Unstructured) error) error {
+	ctx := context.TODO()
+	route, err := r.getRoute(ctx, ref)
+	if err != nil {
+		return err
+	}
+	original := route.DeepCopy()
+	if err := mutate(route); err != nil {
+		return err
+	}
+	if reflect.DeepEqual(original.Object, route.Object) {
+		r.log.Debugf("%s %s already up to date", ref.kind, ref.name)
+		return nil
+	}
+	return r.updateRoute(ctx, ref, route)
+}
+
+// UpdateHash is a no-op for the Gateway API since the canary and stable services select the pods
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// SetWeight sets the weights of the canary and stable backendRefs of every rule which references both services.
+// BackendRefs of additional destinations which already exist in such a rule get the weight of their destination.
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	weights := r.desiredWeights(desiredWeight, additionalDestinations...)
+	for _, ref := range r.routes() {
+		err := r.mutateRoute(ref, func(route *unstructured.Unstructured) error {
+			return setRouteWeights(route, weights, r.canaryService(), r.stableService())
+		})
+		if err != nil {
+			return err
+		}
+		r.log.Debugf("SetWeight: updated %s %s with desiredWeight (%d)", ref.kind, ref.name, desiredWeight)
+	}
+	return nil
+}
+
+// SetHeaderRoute prepends a rule sending requests with the matching headers to the canary service to every
+// HTTPRoute and GRPCRoute. The rule is removed again when the header route has no matches.
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	if headerRouting == nil {
+		return nil
+	}
+	for _, ref := range r.routes() {
+		if ref.kind == routeKindTCP {
+			continue
+		}
+		err := r.mutateRoute(ref, func(route *unstructured.Unstructured) error {
+			var rule map[string]any
+			if headerRouting.Match != nil {
+				weighted, err := r.weightedRule(route)
+				if err != nil {
+					return err
+				}
+				rule, err = headerRule(headerRouting, weighted, r.canaryService())
+				if err != nil {
+					return err
+				}
+			}
+			return r.setManagedRule(route, headerRouting.Name, rule)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetMirrorRoute prepends a rule to every HTTPRoute which sends the matching requests to the stable service and
+// mirrors them to the canary service. The rule is removed again when the mirror route has no matches.
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	if setMirrorRoute == nil {
+		return nil
+	}
+	for _, ref := range r.routes() {
+		if ref.kind != routeKindHTTP {
+			continue
+		}
+		err := r.mutateRoute(ref, func(route *unstructured.Unstructured) error {
+			var rule map[string]any
+			if setMirrorRoute.Match != nil {
+				weighted, err := r.weightedRule(route)
+				if err != nil {
+					return err
+				}
+				rule, err = mirrorRule(setMirrorRoute, weighted, r.canaryService(), r.stableService())
+				if err != nil {
+					return err
+				}
+			}
+			return r.setManagedRule(route, setMirrorRoute.Name, rule)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyWeight returns true when the backendRef weights of every route match the desired weights and every parent
+// Gateway has accepted the latest generation of the route
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	weights := r.desiredWeights(desiredWeight, additionalDestinations...)
+	for _, ref := range r.routes() {
+		route, err := r.getRoute(ctx, ref)
+		if err != nil {
+			return ptr.To(false), err
+		}
+		expected := route.DeepCopy()
+		if err := setRouteWeights(expected, weights, r.canaryService(), r.stableService()); err != nil {
+			return ptr.To(false), err
+		}
+		if !reflect.DeepEqual(expected.Object, route.Object) {
+			r.log.Infof("VerifyWeight: %s %s does not have the desired weights", ref.kind, ref.name)
+			return ptr.To(false), nil
+		}
+		if !isAccepted(route) {
+			r.log.Infof("VerifyWeight: %s %s has not been accepted by all of its parents", ref.kind, ref.name)
+			return ptr.To(false), nil
+		}
+	}
+	return ptr.To(true), nil
+}
+
+// RemoveManagedRoutes removes every rule which was added to the routes by SetHeaderRoute or SetMirrorRoute
+func (r *Reconciler) RemoveManagedRoutes() error {
+	for _, ref := range r.routes() {
+		if ref.kind == routeKindTCP {
+			continue
+		}
+		err := r.mutateRoute(ref, func(route *unstructured.Unstructured) error {
+			managed := managedRuleNames(route)
+			rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+			if err != nil {
+				return err
+			}
+			if len(managed) > len(rules) {
+				return fmt.Errorf("%s %s has fewer rules than managed routes", ref.kind, ref.name)
+			}
+			if len(managed) == 0 {
+				return nil
+			}
+			if err := unstructured.SetNestedSlice(route.Object, rules[len(managed):], "spec", "rules"); err != nil {
+				return err
+			}
+			setManagedRuleNames(route, nil)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Type returns the type of the traffic routing reconciler
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+func (r *Reconciler) canaryService() string {
+	return r.rollout.Spec.Strategy.Canary.CanaryService
+}
+
+func (r *Reconciler) stableService() string {
+	return r.rollout.Spec.Strategy.Canary.StableService
+}
+
+// desiredWeights maps the name of each service to the weight its backendRef should have
+func (r *Reconciler) desiredWeights(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) map[string]int64 {
+	weights := map[string]int64{
+		r.canaryService(): int64(desiredWeight),
+	}
+	stableWeight := weightutil.MaxTrafficWeight(r.rollout) - desiredWeight
+	for _, dest := range additionalDestinations {
+		weights[dest.ServiceName] = int64(dest.Weight)
+		stableWeight -= dest.Weight
+	}
+	weights[r.stableService()] = int64(stableWeight)
+	return weights
+}
+
+// weightedRule returns a copy of the first unmanaged rule which references both the canary and stable services
+func (r *Reconciler) weightedRule(route *unstructured.Unstructured) (map[string]any, error) {
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	if err != nil {
+		return nil, err
+	}
+	managed := managedRuleNames(route)
+	for i, rule := range rules {
+		if i < len(managed) {
+			continue
+		}
+		typedRule, ok := rule.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s %s has a malformed rule", route.GetKind(), route.GetName())
+		}
+		if hasBackendRefs(typedRule, r.canaryService(), r.stableService()) {
+			return typedRule, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %s has no rule referencing both the canary service %q and the stable service %q", route.GetKind(), route.GetName(), r.canaryService(), r.stableService())
+}
+
+// setManagedRule adds, replaces or, when rule is nil, removes the managed rule with the given name. Managed rules
+// are kept at the start of the rules in the order of spec.strategy.canary.trafficRouting.managedRoutes.
+func (r *Reconciler) setManagedRule(route *unstructured.Unstructured, name string, rule map[string]any) error {
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	if err != nil {
+		return err
+	}
+	managed := managedRuleNames(route)
+	if len(managed) > len(rules) {
+		return fmt.Errorf("%s %s has fewer rules than managed routes", route.GetKind(), route.GetName())
+	}
+	managedRules := map[string]any{}
+	for i, managedName := range managed {
+		managedRules[managedName] = rules[i]
+	}
+	if rule == nil {
+		delete(managedRules, name)
+	} else {
+		managedRules[name] = rule
+	}
+
+	var names []string
+	var newRules []any
+	for _, managedRoute := range r.rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes {
+		if managedRule, ok := managedRules[managedRoute.Name]; ok {
+			names = append(names, managedRoute.Name)
+			newRules = append(newRules, managedRule)
+		}
+	}
+	newRules = append(newRules, rules[len(managed):]...)
+	if err := unstructured.SetNestedSlice(route.Object, newRules, "spec", "rules"); err != nil {
+		return err
+	}
+	setManagedRuleNames(route, names)
+	return nil
+}
+
+func managedRuleNames(route *unstructured.Unstructured) []string {
+	value := route.GetAnnotations()[ManagedRoutesAnnotation]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+func setManagedRuleNames(route *unstructured.Unstructured, names []string) {
+	annotations := route.GetAnnotations()
+	if len(names) == 0 {
+		delete(annotations, ManagedRoutesAnnotation)
+	} else {
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[ManagedRoutesAnnotation] = strings.Join(names, ",")
+	}
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	route.SetAnnotations(annotations)
+}
+
+func setRouteWeights(route *unstructured.Unstructured, weights map[string]int64, canaryService, stableService string) error {
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	if err != nil {
+		return err
+	}
+	managed := managedRuleNames(route)
+	found := false
+	for i, rule := range rules {
+		if i < len(managed) {
+			continue
+		}
+		typedRule, ok := rule.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s %s has a malformed rule", route.GetKind(), route.GetName())
+		}
+		if !hasBackendRefs(typedRule, canaryService, stableService) {
+			continue
+		}
+		found = true
+		backendRefs, _, _ := unstructured.NestedSlice(typedRule, "backendRefs")
+		for _, backendRef := range backendRefs {
+			typedBackendRef, ok := backendRef.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(typedBackendRef, "name")
+			if weight, ok := weights[name]; ok {
+				typedBackendRef["weight"] = weight
+			}
+		}
+		typedRule["backendRefs"] = backendRefs
+	}
+	if !found {
+		return fmt.Errorf("%s %s has no rule referencing both the canary service %q and the stable service %q", route.GetKind(), route.GetName(), canaryService, stableService)
+	}
+	return unstructured.SetNestedSlice(route.Object, rules, "spec", "rules")
+}
+
+func hasBackendRefs(rule map[string]any, services ...string) bool {
+	backendRefs, _, _ := unstructured.NestedSlice(rule, "backendRefs")
+	for _, service := range services {
+		if findBackendRef(backendRefs, service) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+func findBackendRef(backendRefs []any, service string) map[string]any {
+	for _, backendRef := range backendRefs {
+		typedBackendRef, ok := backendRef.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(typedBackendRef, "name"); name == service {
+			return typedBackendRef
+		}
+	}
+	return nil
+}
+
+// serviceBackendRef returns a backendRef to the service, copying the port of the existing backendRef for it
+func serviceBackendRef(weightedRule map[string]any, service string) map[string]any {
+	backendRefs, _, _ := unstructured.NestedSlice(weightedRule, "backendRefs")
+	backendRef := runtime.DeepCopyJSONValue(findBackendRef(backendRefs, service)).(map[string]any)
+	delete(backendRef, "weight")
+	return backendRef
+}
+
+func headerRule(headerRouting *v1alpha1.SetHeaderRoute, weightedRule map[string]any, canaryService string) (map[string]any, error) {
+	var headers []any
+	for _, match := range headerRouting.Match {
+		header, err := stringMatch(match.HeaderValue, "Exact", "RegularExpression", "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid header match for %q: %w", match.HeaderName, err)
+		}
+		header["name"] = match.HeaderName
+		headers = append(headers, header)
+	}
+	rule := map[string]any{
+		"backendRefs": []any{serviceBackendRef(weightedRule, canaryService)},
+	}
+	if matches, _, _ := unstructured.NestedSlice(weightedRule, "matches"); len(matches) > 0 {
+		for _, match := range matches {
+			typedMatch, ok := match.(map[string]any)
+			if !ok {
+				continue
+			}
+			existing, _, _ := unstructured.NestedSlice(typedMatch, "headers")
+			typedMatch["headers"] = append(existing, headers...)
+		}
+		rule["matches"] = matches
+	} else {
+		rule["matches"] = []any{map[string]any{"headers": headers}}
+	}
+	return rule, nil
+}
+
+func mirrorRule(setMirrorRoute *v1alpha1.SetMirrorRoute, weightedRule map[string]any, canaryService, stableService string) (map[string]any, error) {
+	var matches []any
+	for _, routeMatch := range setMirrorRoute.Match {
+		match := map[string]any{}
+		if routeMatch.Method != nil {
+			if routeMatch.Method.Exact == "" {
+				return nil, fmt.Errorf("only exact method matches are supported")
+			}
+			match["method"] = routeMatch.Method.Exact
+		}
+		if routeMatch.Path != nil {
+			path, err := stringMatch(routeMatch.Path, "Exact", "RegularExpression", "PathPrefix")
+			if err != nil {
+				return nil, fmt.Errorf("invalid path match: %w", err)
+			}
+			match["path"] = path
+		}
+		var headers []any
+		for name, value := range routeMatch.Headers {
+			header, err := stringMatch(&value, "Exact", "RegularExpression", "")
+			if err != nil {
+				return nil, fmt.Errorf("invalid header match for %q: %w", name, err)
+			}
+			header["name"] = name
+			headers = append(headers, header)
+		}
+		if len(headers) > 0 {
+			// Keep the generated rule stable across reconciliations
+			sort.Slice(headers, func(i, j int) bool {
+				return headers[i].(map[string]any)["name"].(string) < headers[j].(map[string]any)["name"].(string)
+			})
+			match["headers"] = headers
+		}
+		matches = append(matches, match)
+	}
+	requestMirror := map[string]any{
+		"backendRef": serviceBackendRef(weightedRule, canaryService),
+	}
+	if setMirrorRoute.Percentage != nil {
+		requestMirror["percent"] = int64(*setMirrorRoute.Percentage)
+	}
+	return map[string]any{
+		"matches":     matches,
+		"backendRefs": []any{serviceBackendRef(weightedRule, stableService)},
+		"filters": []any{map[string]any{
+			"type":          "RequestMirror",
+			"requestMirror": requestMirror,
+		}},
+	}, nil
+}
+
+// stringMatch converts a StringMatch to a Gateway API match. A prefix match is converted to a regular expression
+// when the match has no native prefix type.
+func stringMatch(match *v1alpha1.StringMatch, exactType, regexType, prefixType string) (map[string]any, error) {
+	switch {
+	case match == nil:
+		return nil, fmt.Errorf("match value is required")
+	case match.Exact != "":
+		return map[string]any{"type": exactType, "value": match.Exact}, nil
+	case match.Regex != "":
+		return map[string]any{"type": regexType, "value": match.Regex}, nil
+	case match.Prefix != "" && prefixType != "":
+		return map[string]any{"type": prefixType, "value": match.Prefix}, nil
+	case match.Prefix != "":
+		return map[string]any{"type": regexType, "value": "^" + regexp.QuoteMeta(match.Prefix) + ".*"}, nil
+	}
+	return nil, fmt.Errorf("one of exact, prefix or regex is required")
+}
+
+// isAccepted returns true when the route has at least one parent and every parent has accepted the current
+// generation of the route
+func isAccepted(route *unstructured.Unstructured) bool {
+	parents, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+	if len(parents) == 0 {
+		return false
+	}
+	for _, parent := range parents {
+		typedParent, ok := parent.(map[string]any)
+		if !ok {
+			return false
+		}
+		conditions, _, _ := unstructured.NestedSlice(typedParent, "conditions")
+		accepted := false
+		for _, condition := range conditions {
+			typedCondition, ok := condition.(map[string]any)
+			if !ok {
+				continue
+			}
+			conditionType, _, _ := unstructured.NestedString(typedCondition, "type")
+			if conditionType != "Accepted" {
+				continue
+			}
+			status, _, _ := unstructured.NestedString(typedCondition, "status")
+			observedGeneration, _, _ := unstructured.NestedInt64(typedCondition, "observedGeneration")
+			accepted = status == string(metav1.ConditionTrue) && observedGeneration >= route.GetGeneration()
+		}
+		if !accepted {
+			return false
+		}
+	}
+	return true
+}
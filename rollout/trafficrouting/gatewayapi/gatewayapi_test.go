@@ -0,0 +1,399 @@
+package gatewayapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const httpRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: http-route
+  namespace: default
+  generation: 2
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: stable
+      port: 80
+      weight: 100
+    - name: canary
+      port: 80
+      weight: 0
+  - backendRefs:
+    - name: other
+      port: 80
+status:
+  parents:
+  - parentRef:
+      name: gateway
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 2
+`
+
+const grpcRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: GRPCRoute
+metadata:
+  name: grpc-route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable
+      port: 9090
+    - name: canary
+      port: 9090
+`
+
+const tcpRoute = `
+apiVersion: gateway.networking.k8s.io/v1alpha2
+kind: TCPRoute
+metadata:
+  name: tcp-route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable
+      port: 5432
+      weight: 100
+    - name: canary
+      port: 5432
+      weight: 0
+`
+
+func fakeRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: "default",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable",
+					CanaryService: "canary",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						GatewayAPI: &v1alpha1.GatewayAPITrafficRouting{
+							HTTPRoutes: []v1alpha1.GatewayAPIRoute{{Name: "http-route"}},
+						},
+						ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}, {Name: "mirror"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReconciler(ro *v1alpha1.Rollout, routes ...string) (*Reconciler, *unstructuredClient) {
+	client := testutil.NewFakeDynamicClient(unstructuredObjects(routes...)...)
+	r := NewReconciler(ReconcilerConfig{
+		Rollout:  ro,
+		Client:   client,
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, &unstructuredClient{r: r}
+}
+
+type unstructuredClient struct {
+	r *Reconciler
+}
+
+func (c *unstructuredClient) get(t *testing.T, ref routeRef) *unstructured.Unstructured {
+	t.Helper()
+	route, err := c.r.getRoute(context.TODO(), ref)
+	require.NoError(t, err)
+	return route
+}
+
+// toUnstructured decodes the route the way the dynamic client does, so that numbers are int64
+func toUnstructured(route string) *unstructured.Unstructured {
+	data, err := yaml.YAMLToJSON([]byte(route))
+	if err != nil {
+		panic(err)
+	}
+	obj, _, err := unstructured.UnstructuredJSONScheme.Decode(data, nil, nil)
+	if err != nil {
+		panic(err)
+	}
+	return obj.(*unstructured.Unstructured)
+}
+
+func unstructuredObjects(routes ...string) []runtime.Object {
+	var objs []runtime.Object
+	for _, route := range routes {
+		objs = append(objs, toUnstructured(route))
+	}
+	return objs
+}
+
+func httpRouteRef() routeRef {
+	return routeRef{kind: routeKindHTTP, gvr: HTTPRouteGVR, name: "http-route"}
+}
+
+func backendWeights(t *testing.T, route *unstructured.Unstructured, ruleIndex int) map[string]int64 {
+	t.Helper()
+	rules, _, err := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.NoError(t, err)
+	backendRefs, _, err := unstructured.NestedSlice(rules[ruleIndex].(map[string]any), "backendRefs")
+	require.NoError(t, err)
+	weights := map[string]int64{}
+	for _, backendRef := range backendRefs {
+		typed := backendRef.(map[string]any)
+		weight, _, _ := unstructured.NestedInt64(typed, "weight")
+		weights[typed["name"].(string)] = weight
+	}
+	return weights
+}
+
+func TestType(t *testing.T) {
+	r, _ := newReconciler(fakeRollout())
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary-hash", "stable-hash"))
+}
+
+func TestSetWeight(t *testing.T) {
+	ro := fakeRollout()
+	ro.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.GRPCRoutes = []v1alpha1.GatewayAPIRoute{{Name: "grpc-route"}}
+	ro.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.TCPRoutes = []v1alpha1.GatewayAPIRoute{{Name: "tcp-route"}}
+	r, c := newReconciler(ro, httpRoute, grpcRoute, tcpRoute)
+
+	require.NoError(t, r.SetWeight(30))
+
+	assert.Equal(t, map[string]int64{"stable": 70, "canary": 30}, backendWeights(t, c.get(t, httpRouteRef()), 0))
+	assert.Equal(t, map[string]int64{"other": 0}, backendWeights(t, c.get(t, httpRouteRef()), 1))
+	assert.Equal(t, map[string]int64{"stable": 70, "canary": 30}, backendWeights(t, c.get(t, routeRef{gvr: GRPCRouteGVR, name: "grpc-route"}), 0))
+	assert.Equal(t, map[string]int64{"stable": 70, "canary": 30}, backendWeights(t, c.get(t, routeRef{gvr: TCPRouteGVR, name: "tcp-route"}), 0))
+}
+
+func TestSetWeightNoUpdateWhenUnchanged(t *testing.T) {
+	ro := fakeRollout()
+	client := testutil.NewFakeDynamicClient(unstructuredObjects(httpRoute)...)
+	r := NewReconciler(ReconcilerConfig{Rollout: ro, Client: client, Recorder: record.NewFakeEventRecorder()})
+
+	require.NoError(t, r.SetWeight(0))
+	actions := client.Actions()
+	assert.Len(t, actions, 1)
+	assert.True(t, actions[0].Matches("get", "httproutes"))
+}
+
+func TestSetWeightWithAdditionalDestinations(t *testing.T) {
+	route := toUnstructured(httpRoute)
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	rule := rules[0].(map[string]any)
+	rule["backendRefs"] = append(rule["backendRefs"].([]any), map[string]any{"name": "experiment", "port": int64(80), "weight": int64(0)})
+	require.NoError(t, unstructured.SetNestedSlice(route.Object, rules, "spec", "rules"))
+
+	ro := fakeRollout()
+	client := testutil.NewFakeDynamicClient(route)
+	r := NewReconciler(ReconcilerConfig{Rollout: ro, Client: client, Recorder: record.NewFakeEventRecorder()})
+	c := &unstructuredClient{r: r}
+
+	require.NoError(t, r.SetWeight(10, v1alpha1.WeightDestination{ServiceName: "experiment", Weight: 20}))
+	assert.Equal(t, map[string]int64{"stable": 70, "canary": 10, "experiment": 20}, backendWeights(t, c.get(t, httpRouteRef()), 0))
+}
+
+func TestSetWeightErrors(t *testing.T) {
+	t.Run("route not found", func(t *testing.T) {
+		r, _ := newReconciler(fakeRollout())
+		assert.Error(t, r.SetWeight(10))
+	})
+	t.Run("no rule with canary and stable", func(t *testing.T) {
+		ro := fakeRollout()
+		ro.Spec.Strategy.Canary.CanaryService = "missing"
+		r, _ := newReconciler(ro, httpRoute)
+		err := r.SetWeight(10)
+		assert.EqualError(t, err, `HTTPRoute http-route has no rule referencing both the canary service "missing" and the stable service "stable"`)
+	})
+}
+
+func TestVerifyWeight(t *testing.T) {
+	t.Run("weights match after SetWeight", func(t *testing.T) {
+		r, _ := newReconciler(fakeRollout(), httpRoute)
+		require.NoError(t, r.SetWeight(30))
+		verified, err := r.VerifyWeight(30)
+		require.NoError(t, err)
+		assert.True(t, *verified)
+	})
+	t.Run("weights do not match", func(t *testing.T) {
+		r, _ := newReconciler(fakeRollout(), httpRoute)
+		verified, err := r.VerifyWeight(30)
+		require.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("weights match", func(t *testing.T) {
+		r, _ := newReconciler(fakeRollout(), httpRoute)
+		verified, err := r.VerifyWeight(0)
+		require.NoError(t, err)
+		assert.True(t, *verified)
+	})
+	t.Run("route not accepted for current generation", func(t *testing.T) {
+		route := toUnstructured(httpRoute)
+		route.SetGeneration(3)
+		client := testutil.NewFakeDynamicClient(route)
+		r := NewReconciler(ReconcilerConfig{Rollout: fakeRollout(), Client: client, Recorder: record.NewFakeEventRecorder()})
+		verified, err := r.VerifyWeight(0)
+		require.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("route without parents", func(t *testing.T) {
+		ro := fakeRollout()
+		ro.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.HTTPRoutes = nil
+		ro.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.GRPCRoutes = []v1alpha1.GatewayAPIRoute{{Name: "grpc-route"}}
+		r, _ := newReconciler(ro, grpcRoute)
+		verified, err := r.VerifyWeight(0)
+		require.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("route not found", func(t *testing.T) {
+		r, _ := newReconciler(fakeRollout())
+		verified, err := r.VerifyWeight(0)
+		assert.Error(t, err)
+		assert.False(t, *verified)
+	})
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	r, c := newReconciler(fakeRollout(), httpRoute)
+
+	err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name: "header",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{HeaderName: "x-exact", HeaderValue: &v1alpha1.StringMatch{Exact: "yes"}},
+			{HeaderName: "x-prefix", HeaderValue: &v1alpha1.StringMatch{Prefix: "a.b"}},
+		},
+	})
+	require.NoError(t, err)
+
+	route := c.get(t, httpRouteRef())
+	assert.Equal(t, "header", route.GetAnnotations()[ManagedRoutesAnnotation])
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.Len(t, rules, 3)
+	assert.Equal(t, map[string]any{
+		"matches": []any{map[string]any{
+			"path": map[string]any{"type": "PathPrefix", "value": "/"},
+			"headers": []any{
+				map[string]any{"name": "x-exact", "type": "Exact", "value": "yes"},
+				map[string]any{"name": "x-prefix", "type": "RegularExpression", "value": `^a\.b.*`},
+			},
+		}},
+		"backendRefs": []any{map[string]any{"name": "canary", "port": int64(80)}},
+	}, rules[0])
+	assert.Equal(t, map[string]int64{"stable": 100, "canary": 0}, backendWeights(t, route, 1))
+
+	// weights are only set on the unmanaged rules
+	require.NoError(t, r.SetWeight(20))
+	route = c.get(t, httpRouteRef())
+	assert.Equal(t, map[string]int64{"canary": 0}, backendWeights(t, route, 0))
+	assert.Equal(t, map[string]int64{"stable": 80, "canary": 20}, backendWeights(t, route, 1))
+
+	require.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header"}))
+	route = c.get(t, httpRouteRef())
+	rules, _, _ = unstructured.NestedSlice(route.Object, "spec", "rules")
+	assert.Len(t, rules, 2)
+	assert.Empty(t, route.GetAnnotations())
+}
+
+func TestSetHeaderRouteInvalidMatch(t *testing.T) {
+	r, _ := newReconciler(fakeRollout(), httpRoute)
+	err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "x-empty", HeaderValue: &v1alpha1.StringMatch{}}},
+	})
+	assert.EqualError(t, err, `invalid header match for "x-empty": one of exact, prefix or regex is required`)
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	r, c := newReconciler(fakeRollout(), httpRoute)
+
+	require.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "x-canary", HeaderValue: &v1alpha1.StringMatch{Exact: "true"}}},
+	}))
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name: "mirror",
+		Match: []v1alpha1.RouteMatch{{
+			Method:  &v1alpha1.StringMatch{Exact: "GET"},
+			Path:    &v1alpha1.StringMatch{Prefix: "/api"},
+			Headers: map[string]v1alpha1.StringMatch{"b": {Regex: "b.*"}, "a": {Exact: "a"}},
+		}},
+		Percentage: ptr.To[int32](50),
+	})
+	require.NoError(t, err)
+
+	route := c.get(t, httpRouteRef())
+	assert.Equal(t, "header,mirror", route.GetAnnotations()[ManagedRoutesAnnotation])
+	rules, _, _ := unstructured.NestedSlice(route.Object, "spec", "rules")
+	require.Len(t, rules, 4)
+	assert.Equal(t, map[string]any{
+		"matches": []any{map[string]any{
+			"method": "GET",
+			"path":   map[string]any{"type": "PathPrefix", "value": "/api"},
+			"headers": []any{
+				map[string]any{"name": "a", "type": "Exact", "value": "a"},
+				map[string]any{"name": "b", "type": "RegularExpression", "value": "b.*"},
+			},
+		}},
+		"backendRefs": []any{map[string]any{"name": "stable", "port": int64(80)}},
+		"filters": []any{map[string]any{
+			"type": "RequestMirror",
+			"requestMirror": map[string]any{
+				"backendRef": map[string]any{"name": "canary", "port": int64(80)},
+				"percent":    int64(50),
+			},
+		}},
+	}, rules[1])
+
+	require.NoError(t, r.RemoveManagedRoutes())
+	route = c.get(t, httpRouteRef())
+	rules, _, _ = unstructured.NestedSlice(route.Object, "spec", "rules")
+	assert.Len(t, rules, 2)
+	assert.Empty(t, route.GetAnnotations())
+}
+
+func TestSetMirrorRouteInvalidMethod(t *testing.T) {
+	r, _ := newReconciler(fakeRollout(), httpRoute)
+	err := r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name:  "mirror",
+		Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Prefix: "G"}}},
+	})
+	assert.EqualError(t, err, "only exact method matches are supported")
+}
+
+func TestManagedRoutesSkipTCPRoutes(t *testing.T) {
+	ro := fakeRollout()
+	ro.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.HTTPRoutes = nil
+	ro.Spec.Strategy.Canary.TrafficRouting.GatewayAPI.TCPRoutes = []v1alpha1.GatewayAPIRoute{{Name: "tcp-route"}}
+	client := testutil.NewFakeDynamicClient(unstructuredObjects(tcpRoute)...)
+	r := NewReconciler(ReconcilerConfig{Rollout: ro, Client: client, Recorder: record.NewFakeEventRecorder()})
+
+	require.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "x-canary", HeaderValue: &v1alpha1.StringMatch{Exact: "true"}}},
+	}))
+	require.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror", Match: []v1alpha1.RouteMatch{{}}}))
+	require.NoError(t, r.RemoveManagedRoutes())
+	assert.Empty(t, client.Actions())
+}
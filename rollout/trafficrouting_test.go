@@ -10,6 +10,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -37,6 +38,7 @@ import (
 	traefikMocks "github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik/mocks"
 	testutil "github.com/argoproj/argo-rollouts/test/util"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
@@ -141,6 +143,160 @@ func TestReconcileTrafficRoutingVerifyWeightFalse(t *testing.T) {
 	assert.True(t, enqueued)
 }
 
+// newFakeTypedTrafficRoutingReconciler returns a fake TrafficRoutingReconciler of the given type with mocked
+// UpdateHash and SetHeaderRoute
+func newFakeTypedTrafficRoutingReconciler(routerType string) *mocks.TrafficRoutingReconciler {
+	trafficRoutingReconciler := mocks.TrafficRoutingReconciler{}
+	trafficRoutingReconciler.On("Type").Return(routerType)
+	trafficRoutingReconciler.On("UpdateHash", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	trafficRoutingReconciler.On("SetHeaderRoute", mock.Anything, mock.Anything).Return(nil)
+	return &trafficRoutingReconciler
+}
+
+// verify the routers which already took the new weight are rolled back when another router fails to set it
+func TestReconcileTrafficRoutingMultipleRoutersSetWeightErr(t *testing.T) {
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	ro.Status.Canary.Weights = &v1alpha1.TrafficWeights{
+		Canary: v1alpha1.WeightDestination{Weight: 5},
+		Stable: v1alpha1.WeightDestination{Weight: 95},
+	}
+	istio := newFakeTypedTrafficRoutingReconciler("Istio")
+	istio.On("SetWeight", int32(10)).Return(nil)
+	istio.On("SetWeight", int32(5)).Return(nil)
+	nginx := newFakeTypedTrafficRoutingReconciler("Nginx")
+	nginx.On("SetWeight", int32(10)).Return(errors.New("ingress update failed"))
+	f.fakeTrafficRouting = istio
+	f.extraFakeTrafficRoutings = []*mocks.TrafficRoutingReconciler{nginx}
+
+	f.runExpectError(getKey(ro, t), true)
+	istio.AssertCalled(t, "SetWeight", int32(10))
+	istio.AssertCalled(t, "SetWeight", int32(5))
+	nginx.AssertNotCalled(t, "SetWeight", int32(5))
+	assert.Contains(t, f.events, conditions.TrafficWeightsRolledBackReason)
+}
+
+// verify the routers are rolled back and the rollout is held when one of them fails to verify the new weight
+func TestReconcileTrafficRoutingMultipleRoutersVerifyWeightErr(t *testing.T) {
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	ro.Status.Canary.Weights = &v1alpha1.TrafficWeights{
+		Canary: v1alpha1.WeightDestination{Weight: 5},
+		Stable: v1alpha1.WeightDestination{Weight: 95},
+	}
+	istio := newFakeTypedTrafficRoutingReconciler("Istio")
+	istio.On("SetWeight", mock.Anything).Return(nil)
+	istio.On("VerifyWeight", mock.Anything).Return(ptr.To[bool](true), nil)
+	nginx := newFakeTypedTrafficRoutingReconciler("Nginx")
+	nginx.On("SetWeight", mock.Anything).Return(nil)
+	nginx.On("VerifyWeight", mock.Anything).Return(nil, errors.New("verify failed"))
+	f.fakeTrafficRouting = istio
+	f.extraFakeTrafficRoutings = []*mocks.TrafficRoutingReconciler{nginx}
+
+	index := f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	istio.AssertCalled(t, "SetWeight", int32(5))
+	nginx.AssertCalled(t, "SetWeight", int32(5))
+	assert.Contains(t, f.events, conditions.TrafficWeightsRolledBackReason)
+	// the weights of the status are kept at the previous weight, so the patch only marks them as unverified
+	assert.NotContains(t, f.getPatchedRollout(index), `"weight":10`)
+	patched := f.getPatchedRolloutAsObject(index)
+	assert.False(t, *patched.Status.Canary.Weights.Verified)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.TrafficWeightsMismatch)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, conditions.TrafficWeightsRolledBackReason, cond.Reason)
+	assert.Equal(t, "Traffic weights rolled back after failing to verify weight 10: Istio: weight 10 verified, Nginx: weight 10 verify failed: verify failed, Istio: rolled back to weight 5, Nginx: rolled back to weight 5", cond.Message)
+}
+
+// verify the routers are rolled back once they have not verified the new weight within the progress deadline
+func TestReconcileTrafficRoutingMultipleRoutersVerifyWeightTimeout(t *testing.T) {
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	ro.Status.Canary.Weights = &v1alpha1.TrafficWeights{
+		Canary: v1alpha1.WeightDestination{Weight: 5},
+		Stable: v1alpha1.WeightDestination{Weight: 95},
+	}
+	mismatch := conditions.NewRolloutCondition(v1alpha1.TrafficWeightsMismatch, corev1.ConditionTrue, conditions.TrafficWeightsMismatchReason, "mismatch")
+	mismatch.LastUpdateTime = metav1.NewTime(timeutil.Now().Add(-time.Duration(defaults.GetProgressDeadlineSecondsOrDefault(ro)+1) * time.Second))
+	conditions.SetRolloutCondition(&ro.Status, *mismatch)
+	istio := newFakeTypedTrafficRoutingReconciler("Istio")
+	istio.On("SetWeight", mock.Anything).Return(nil)
+	istio.On("VerifyWeight", mock.Anything).Return(ptr.To[bool](true), nil)
+	alb := newFakeTypedTrafficRoutingReconciler("ALB")
+	alb.On("SetWeight", mock.Anything).Return(nil)
+	alb.On("VerifyWeight", mock.Anything).Return(ptr.To[bool](false), nil)
+	f.fakeTrafficRouting = istio
+	f.extraFakeTrafficRoutings = []*mocks.TrafficRoutingReconciler{alb}
+
+	index := f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	istio.AssertCalled(t, "SetWeight", int32(5))
+	alb.AssertCalled(t, "SetWeight", int32(5))
+	patched := f.getPatchedRolloutAsObject(index)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.TrafficWeightsMismatch)
+	assert.NotNil(t, cond)
+	assert.Equal(t, conditions.TrafficWeightsRolledBackReason, cond.Reason)
+	assert.Equal(t, "Traffic weights rolled back after failing to verify weight 10: Istio: weight 10 verified, ALB: weight 10 not verified, Istio: rolled back to weight 5, ALB: rolled back to weight 5", cond.Message)
+}
+
+// verify the weight of each router is reported when the routers disagree
+func TestReconcileTrafficRoutingMultipleRoutersWeightsMismatch(t *testing.T) {
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	istio := newFakeTypedTrafficRoutingReconciler("Istio")
+	istio.On("SetWeight", mock.Anything).Return(nil)
+	istio.On("VerifyWeight", mock.Anything).Return(ptr.To[bool](true), nil)
+	alb := newFakeTypedTrafficRoutingReconciler("ALB")
+	alb.On("SetWeight", mock.Anything).Return(nil)
+	alb.On("VerifyWeight", mock.Anything).Return(ptr.To[bool](false), nil)
+	f.fakeTrafficRouting = istio
+	f.extraFakeTrafficRoutings = []*mocks.TrafficRoutingReconciler{alb}
+
+	index := f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	istio.AssertNotCalled(t, "SetWeight", int32(0))
+	alb.AssertNotCalled(t, "SetWeight", int32(0))
+	patched := f.getPatchedRolloutAsObject(index)
+	// the status keeps reporting the previous weight until every router verified the new one
+	assert.Equal(t, int32(0), patched.Status.Canary.Weights.Canary.Weight)
+	assert.False(t, *patched.Status.Canary.Weights.Verified)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.TrafficWeightsMismatch)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, "Traffic routers have not all verified the desired weight: Istio: weight 10 verified, ALB: weight 10 not verified", cond.Message)
+}
+
+// verify the condition is cleared once the routers agree
+func TestReconcileTrafficRoutingMultipleRoutersWeightsVerified(t *testing.T) {
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	mismatch := conditions.NewRolloutCondition(v1alpha1.TrafficWeightsMismatch, corev1.ConditionTrue, conditions.TrafficWeightsMismatchReason, "mismatch")
+	conditions.SetRolloutCondition(&ro.Status, *mismatch)
+	istio := newFakeTypedTrafficRoutingReconciler("Istio")
+	istio.On("SetWeight", mock.Anything).Return(nil)
+	istio.On("VerifyWeight", mock.Anything).Return(ptr.To[bool](true), nil)
+	smi := newFakeTypedTrafficRoutingReconciler("SMI")
+	smi.On("SetWeight", mock.Anything).Return(nil)
+	smi.On("VerifyWeight", mock.Anything).Return(nil, nil)
+	f.fakeTrafficRouting = istio
+	f.extraFakeTrafficRoutings = []*mocks.TrafficRoutingReconciler{smi}
+
+	index := f.expectPatchRolloutAction(ro)
+	f.run(getKey(ro, t))
+
+	patched := f.getPatchedRolloutAsObject(index)
+	assert.True(t, *patched.Status.Canary.Weights.Verified)
+	cond := conditions.GetRolloutCondition(patched.Status, v1alpha1.TrafficWeightsMismatch)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, conditions.TrafficWeightsVerifiedReason, cond.Reason)
+	assert.Equal(t, "Traffic routers are at the desired weight: Istio: weight 10 verified, SMI: weight 10", cond.Message)
+}
+
 func TestReconcileTrafficRoutingVerifyWeightEndOfRollout(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
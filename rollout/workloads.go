@@ -0,0 +1,106 @@
+package rollout
+
+import (
+	"fmt"
+	"sync"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// workloadCache caches the workloads of one kind which rollouts reference or own, along with their ControllerRevisions
+// and pods. Its informers are only started once a rollout of that kind is reconciled, so that the controller neither
+// caches these resources nor needs permission to watch them otherwise.
+type workloadCache struct {
+	kind       string
+	factory    kubeinformers.SharedInformerFactory
+	podFactory kubeinformers.SharedInformerFactory
+	// informer returns the informer of the workloads from the factory
+	informer func(factory kubeinformers.SharedInformerFactory) cache.SharedIndexInformer
+	// handler is notified when a cached workload changes
+	handler cache.ResourceEventHandler
+	once    sync.Once
+	stopCh  <-chan struct{}
+
+	indexer        cache.Indexer
+	revisionLister appslisters.ControllerRevisionLister
+	podLister      v1.PodLister
+	synced         []cache.InformerSynced
+}
+
+func newWorkloadCache(kind string, factory, podFactory kubeinformers.SharedInformerFactory, informer func(kubeinformers.SharedInformerFactory) cache.SharedIndexInformer, handler cache.ResourceEventHandler) *workloadCache {
+	return &workloadCache{
+		kind:       kind,
+		factory:    factory,
+		podFactory: podFactory,
+		informer:   informer,
+		handler:    handler,
+	}
+}
+
+// run sets the channel which stops the informers once they are started
+func (w *workloadCache) run(stopCh <-chan struct{}) {
+	w.stopCh = stopCh
+}
+
+// start starts the informers on first use. The informers are only requested from the factories here, since a factory
+// starts every informer requested from it. An error is returned until the informers have synced, so that the rollout
+// is requeued rather than reconciled against a partial cache.
+func (w *workloadCache) start() error {
+	w.once.Do(func() {
+		informer := w.informer(w.factory)
+		if w.handler != nil {
+			informer.AddEventHandler(w.handler)
+		}
+		revisions := w.factory.Apps().V1().ControllerRevisions()
+		pods := w.podFactory.Core().V1().Pods()
+		w.indexer = informer.GetIndexer()
+		w.revisionLister = revisions.Lister()
+		w.podLister = pods.Lister()
+		w.synced = []cache.InformerSynced{informer.HasSynced, revisions.Informer().HasSynced, pods.Informer().HasSynced}
+		w.factory.Start(w.stopCh)
+		w.podFactory.Start(w.stopCh)
+	})
+	for _, synced := range w.synced {
+		if !synced() {
+			return fmt.Errorf("waiting for the %s cache to sync", w.kind)
+		}
+	}
+	return nil
+}
+
+// listRevisions returns the ControllerRevisions of the workload matching the selector
+func (w *workloadCache) listRevisions(owner metav1.Object, selector labels.Selector) ([]*appsv1.ControllerRevision, error) {
+	revisionList, err := w.revisionLister.ControllerRevisions(owner.GetNamespace()).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var revisions []*appsv1.ControllerRevision
+	for _, revision := range revisionList {
+		if metav1.IsControlledBy(revision, owner) {
+			revisions = append(revisions, revision)
+		}
+	}
+	return revisions, nil
+}
+
+// listPods returns the pods of the workload matching the selector
+func (w *workloadCache) listPods(owner metav1.Object, selector labels.Selector) ([]*corev1.Pod, error) {
+	podList, err := w.podLister.Pods(owner.GetNamespace()).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var pods []*corev1.Pod
+	for _, pod := range podList {
+		if metav1.IsControlledBy(pod, owner) {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
@@ -0,0 +1,63 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func statefulSetInformer(factory kubeinformers.SharedInformerFactory) cache.SharedIndexInformer {
+	return factory.Apps().V1().StatefulSets().Informer()
+}
+
+func daemonSetInformer(factory kubeinformers.SharedInformerFactory) cache.SharedIndexInformer {
+	return factory.Apps().V1().DaemonSets().Informer()
+}
+
+// newSyncedWorkloadCache returns a started workload cache of the objects. Its informers are served by their own client,
+// so that their actions are not recorded by the client of the rollout context.
+func newSyncedWorkloadCache(t *testing.T, kind string, informer func(kubeinformers.SharedInformerFactory) cache.SharedIndexInformer, objs ...runtime.Object) *workloadCache {
+	t.Helper()
+	factory := kubeinformers.NewSharedInformerFactory(k8sfake.NewSimpleClientset(objs...), 0)
+	workloads := newWorkloadCache(kind, factory, factory, informer, nil)
+	workloads.run(t.Context().Done())
+	require.Eventually(t, func() bool {
+		return workloads.start() == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return workloads
+}
+
+func TestWorkloadCacheStartsOnFirstUse(t *testing.T) {
+	sts := newStatefulSet(1, "foo-a", "foo-a")
+	client := k8sfake.NewSimpleClientset(sts, newStatefulSetRevision(sts, "foo-a"))
+	factory := kubeinformers.NewSharedInformerFactory(client, 0)
+	workloads := newWorkloadCache("StatefulSet", factory, factory, statefulSetInformer, nil)
+	workloads.run(t.Context().Done())
+
+	// nothing is watched until a rollout of the kind is reconciled
+	factory.Start(t.Context().Done())
+	assert.Empty(t, client.Actions())
+
+	require.Eventually(t, func() bool {
+		err := workloads.start()
+		if err != nil {
+			assert.EqualError(t, err, "waiting for the StatefulSet cache to sync")
+		}
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	obj, exists, err := workloads.indexer.GetByKey("default/foo")
+	require.NoError(t, err)
+	require.True(t, exists)
+	assert.Equal(t, sts.Name, obj.(*appsv1.StatefulSet).Name)
+	revisions, err := workloads.listRevisions(sts, labels.Everything())
+	require.NoError(t, err)
+	assert.Len(t, revisions, 1)
+}
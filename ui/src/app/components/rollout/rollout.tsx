@@ -359,6 +359,15 @@ const Step = (props: {step: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1
         content = `Set Weight: ${props.step.setWeight}`;
         unit = '%';
     }
+    if (props.step.setWeightRamp) {
+        icon = 'fa-weight';
+        content = `Set Weight Ramp: ${props.step.setWeightRamp.from}-${props.step.setWeightRamp.to} by ${props.step.setWeightRamp.increment} every ${props.step.setWeightRamp.interval}`;
+        unit = '%';
+    }
+    if (props.step.setClusterWeight) {
+        icon = 'fa-weight';
+        content = `Set Cluster Weight: ${props.step.setClusterWeight.weight}% in ${(props.step.setClusterWeight.clusters || []).join(', ')}`;
+    }
     if (props.step.pause) {
         icon = 'fa-pause-circle';
         if (props.step.pause.duration) {
@@ -250,6 +250,18 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRun
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRunSpec
      */
     ttlStrategy?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1TTLStrategy;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CompositeConditions}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRunSpec
+     */
+    compositeConditions?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CompositeConditions;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRunSpec
+     */
+    successWeightThreshold?: number;
 }
 /**
  * 
@@ -299,6 +311,12 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRun
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRunStatus
      */
     completedAt?: K8sIoApimachineryPkgApisMetaV1Time;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RunSummary}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRunStatus
+     */
+    advisorySummary?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RunSummary;
 }
 /**
  * 
@@ -742,6 +760,12 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStatu
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStatus
      */
     stepPluginStatuses?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1StepPluginStatus>;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStatus
+     */
+    weightRamp?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus;
 }
 /**
  * CanaryStep defines a step of a canary deployment.
@@ -797,6 +821,18 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStep
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStep
      */
     plugin?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1PluginStep;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStep
+     */
+    setWeightRamp?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetClusterWeight}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStep
+     */
+    setClusterWeight?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetClusterWeight;
 }
 /**
  * 
@@ -1044,6 +1080,125 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CloudWatchM
      */
     value?: string;
 }
+/**
+ * CompositeConditions are expressions over the results of the metrics of an analysis run which assess the run once all its metrics completed. The results of the metrics are available by metric name (e.g. errorRate.phase, latency.value), or through the metrics map (e.g. metrics[\"error-rate\"].phase).
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CompositeConditions
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CompositeConditions {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CompositeConditions
+     */
+    successCondition?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CompositeConditions
+     */
+    failureCondition?: string;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetCohort
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetCohort {
+    /**
+     * 
+     * @type {K8sIoApimachineryPkgApisMetaV1LabelSelector}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetCohort
+     */
+    nodeSelector?: K8sIoApimachineryPkgApisMetaV1LabelSelector;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetCohort
+     */
+    percentage?: number;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus {
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus
+     */
+    currentStepAnalysisRunStatus?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus
+     */
+    currentBackgroundAnalysisRunStatus?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus
+     */
+    nodes?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus
+     */
+    updatedNodes?: number;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep {
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetCohort}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep
+     */
+    cohort?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetCohort;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutPause}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep
+     */
+    pause?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutPause;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysis}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep
+     */
+    analysis?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysis;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy {
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy
+     */
+    steps?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStep>;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy
+     */
+    analysis?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground;
+    /**
+     * 
+     * @type {K8sIoApimachineryPkgUtilIntstrIntOrString}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy
+     */
+    maxUnavailable?: K8sIoApimachineryPkgUtilIntstrIntOrString;
+}
 /**
  * 
  * @export
@@ -1099,6 +1254,62 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DatadogMetr
      */
     requestTimeout?: string;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow
+     */
+    kind?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow
+     */
+    schedule?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow
+     */
+    rrule?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow
+     */
+    duration?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow
+     */
+    timeZone?: string;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindows
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindows {
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindows
+     */
+    windows?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindow>;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutScheduleRef>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindows
+     */
+    scheduleRefs?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutScheduleRef>;
+}
 /**
  * DryRun defines the settings for running the analysis in Dry-Run mode.
  * @export
@@ -1112,6 +1323,61 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DryRun {
      */
     metricName?: string;
 }
+/**
+ * ElasticsearchMetric defines the Elasticsearch or OpenSearch search to perform canary analysis. The search covers the documents of the interval of the metric before the measurement.
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    address?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    index?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    query?: string;
+    /**
+     * Aggregation is the aggregation DSL, as JSON, whose value is the result. When empty, the result is the number of matching documents.
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    aggregation?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    timestampField?: string;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetricHeader>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    headers?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetricHeader>;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    timeoutSeconds?: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric
+     */
+    insecure?: boolean;
+}
 /**
  * 
  * @export
@@ -1125,6 +1391,44 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1FieldRef {
      */
     fieldPath?: string;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute
+     */
+    name?: string;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting {
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting
+     */
+    httpRoutes?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute>;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting
+     */
+    grpcRoutes?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute>;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting
+     */
+    tcpRoutes?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPIRoute>;
+}
 /**
  * 
  * @export
@@ -1288,6 +1592,123 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JobMetric {
      */
     spec?: K8sIoApiBatchV1JobSpec;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison
+     */
+    name?: string;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison
+     */
+    canary?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison
+     */
+    baseline?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison
+     */
+    direction?: string;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric {
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric
+     */
+    comparisons?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeComparison>;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KayentaThreshold}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric
+     */
+    threshold?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KayentaThreshold;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric
+     */
+    confidenceLevel?: number;
+}
+/**
+ * JudgeQuery is a query of one of the providers returning the samples of a metric. The provider must return a number or an array of numbers.
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery {
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1PrometheusMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    prometheus?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1PrometheusMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    web?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DatadogMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    datadog?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DatadogMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WavefrontMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    wavefront?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WavefrontMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1NewRelicMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    newRelic?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1NewRelicMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CloudWatchMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    cloudWatch?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CloudWatchMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GraphiteMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    graphite?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GraphiteMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1InfluxdbMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    influxdb?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1InfluxdbMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SkyWalkingMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeQuery
+     */
+    skywalking?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SkyWalkingMetric;
+}
 /**
  * 
  * @export
@@ -1393,6 +1814,74 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KayentaThre
      */
     marginal?: string;
 }
+/**
+ * KubernetesMetric defines the pods whose health signals to measure, without an external monitoring system. The result is a map of the restarts, OOM kills, CrashLoopBackOffs, readiness flaps and Warning events of the pods.
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KubernetesMetric
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KubernetesMetric {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KubernetesMetric
+     */
+    podTemplateHash?: string;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    address?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    query?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    tenantID?: string;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1Authentication}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    authentication?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1Authentication;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    timeout?: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    insecure?: boolean;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetricHeader>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    headers?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetricHeader>;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1PrometheusRangeQueryArgs}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric
+     */
+    rangeQuery?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1PrometheusRangeQueryArgs;
+}
 /**
  * 
  * @export
@@ -1546,6 +2035,18 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1Metric {
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1Metric
      */
     consecutiveSuccessLimit?: K8sIoApimachineryPkgUtilIntstrIntOrString;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1Metric
+     */
+    advisory?: boolean;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1Metric
+     */
+    weight?: number;
 }
 /**
  * 
@@ -1619,6 +2120,42 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvi
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
      */
     skywalking?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SkyWalkingMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
+     */
+    judge?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1JudgeMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
+     */
+    otlp?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
+     */
+    elasticsearch?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1ElasticsearchMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
+     */
+    loki?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1LokiMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
+     */
+    sql?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KubernetesMetric}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricProvider
+     */
+    kubernetes?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1KubernetesMetric;
     /**
      * 
      * @type {{ [key: string]: string; }}
@@ -1710,6 +2247,12 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricResul
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricResult
      */
     consecutiveSuccess?: number;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1MetricResult
+     */
+    advisory?: boolean;
 }
 /**
  * 
@@ -1804,6 +2347,49 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OAuth2Confi
      */
     scopes?: Array<string>;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric {
+    /**
+     * Address is the URL of the Prometheus remote-read endpoint. When empty, the data points pushed to the OTLP receiver of the controller are queried.
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+     */
+    address?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+     */
+    metricName?: string;
+    /**
+     * 
+     * @type {{ [key: string]: string; }}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+     */
+    labels?: { [key: string]: string; };
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+     */
+    lookback?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+     */
+    timeoutSeconds?: string;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetricHeader>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1OTLPMetric
+     */
+    headers?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WebMetricHeader>;
+}
 /**
  * 
  * @export
@@ -2102,44 +2688,124 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnal
 /**
  * 
  * @export
- * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground {
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysis}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground
+     */
+    rolloutAnalysis?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysis;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground
+     */
+    startingStep?: number;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+     */
+    status?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+     */
+    message?: string;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster
  */
-export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground {
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster {
     /**
      * 
-     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysis}
-     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster
      */
-    rolloutAnalysis?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysis;
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster
+     */
+    namespace?: string;
     /**
      * 
      * @type {number}
-     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisBackground
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster
      */
-    startingStep?: number;
+    replicas?: number;
 }
 /**
  * 
  * @export
- * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
  */
-export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus {
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus {
     /**
      * 
      * @type {string}
-     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
      */
     name?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
+     */
+    weight?: number;
     /**
      * 
      * @type {string}
-     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
      */
-    status?: string;
+    stableRS?: string;
     /**
      * 
      * @type {string}
-     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutAnalysisRunStatus
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
+     */
+    canaryRS?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
+     */
+    replicas?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
+     */
+    updatedReplicas?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
+     */
+    availableReplicas?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus
      */
     message?: string;
 }
@@ -2186,6 +2852,37 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCond
      */
     message?: string;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency
+     */
+    namespace?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency
+     */
+    phase?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency
+     */
+    revisionAnnotation?: string;
+}
 /**
  * 
  * @export
@@ -2365,6 +3062,19 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutPaus
      */
     duration?: K8sIoApimachineryPkgUtilIntstrIntOrString;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutScheduleRef
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutScheduleRef {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutScheduleRef
+     */
+    name?: string;
+}
 /**
  * 
  * @export
@@ -2449,6 +3159,18 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutSpec
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutSpec
      */
     analysis?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1AnalysisRunStrategy;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutSpec
+     */
+    dependsOn?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDependency>;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutSpec
+     */
+    clusters?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutCluster>;
 }
 /**
  * 
@@ -2552,6 +3274,12 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStat
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStatus
      */
     blueGreen?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1BlueGreenStatus;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStatus
+     */
+    HPAReplicas?: number;
     /**
      * 
      * @type {number}
@@ -2618,6 +3346,24 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStat
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStatus
      */
     duration?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutDurationStatus;
+    /**
+     * 
+     * @type {K8sIoApimachineryPkgApisMetaV1Time}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStatus
+     */
+    nextDeploymentWindow?: K8sIoApimachineryPkgApisMetaV1Time;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStatus
+     */
+    clusters?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutClusterStatus>;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStatus
+     */
+    daemonSet?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStatus;
 }
 /**
  * 
@@ -2637,6 +3383,18 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStra
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStrategy
      */
     canary?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1CanaryStrategy;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindows}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStrategy
+     */
+    deploymentWindows?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DeploymentWindows;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutStrategy
+     */
+    daemonSet?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1DaemonSetStrategy;
 }
 /**
  * 
@@ -2710,6 +3468,12 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutTraf
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutTrafficRouting
      */
     maxTrafficWeight?: number;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RolloutTrafficRouting
+     */
+    gatewayAPI?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1GatewayAPITrafficRouting;
 }
 /**
  * 
@@ -2772,6 +3536,18 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RunSummary
      * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RunSummary
      */
     error?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RunSummary
+     */
+    weight?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1RunSummary
+     */
+    successfulWeight?: number;
 }
 /**
  * 
@@ -2792,6 +3568,43 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SMITrafficR
      */
     trafficSplitName?: string;
 }
+/**
+ * SQLMetric defines the SQL query to perform canary analysis. With a single column, the result is the array of the values of the column. With several columns, the result is the map of the columns of the first row.
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric {
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric
+     */
+    driver?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric
+     */
+    dsn?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric
+     */
+    query?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric
+     */
+    parameters?: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SQLMetric
+     */
+    timeoutSeconds?: string;
+}
 /**
  * 
  * @export
@@ -2892,6 +3705,25 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetCanarySc
      */
     matchTrafficWeight?: boolean;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetClusterWeight
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetClusterWeight {
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetClusterWeight
+     */
+    clusters?: Array<string>;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetClusterWeight
+     */
+    weight?: number;
+}
 /**
  * 
  * @export
@@ -2936,6 +3768,43 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetMirrorRo
      */
     percentage?: number;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp {
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp
+     */
+    from?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp
+     */
+    to?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp
+     */
+    increment?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp
+     */
+    interval?: string;
+    /**
+     * 
+     * @type {GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1SetWeightRamp
+     */
+    analysis?: GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis;
+}
 /**
  * 
  * @export
@@ -3366,6 +4235,93 @@ export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightDesti
      */
     podTemplateHash?: string;
 }
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis {
+    /**
+     * MetricName is the name of the background analysis metric whose latest successful measurement decides the increment. The measurement value is expected to be between 0 and 1, where 0 results in MinIncrement and 1 in MaxIncrement.
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis
+     */
+    metricName?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis
+     */
+    minIncrement?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampAnalysis
+     */
+    maxIncrement?: number;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement {
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement
+     */
+    increment?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement
+     */
+    weight?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement
+     */
+    measurementValue?: string;
+    /**
+     * 
+     * @type {K8sIoApimachineryPkgApisMetaV1Time}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement
+     */
+    time?: K8sIoApimachineryPkgApisMetaV1Time;
+}
+/**
+ * 
+ * @export
+ * @interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus
+ */
+export interface GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus {
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus
+     */
+    stepIndex?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus
+     */
+    weight?: number;
+    /**
+     * 
+     * @type {K8sIoApimachineryPkgApisMetaV1Time}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus
+     */
+    lastIncrementTime?: K8sIoApimachineryPkgApisMetaV1Time;
+    /**
+     * 
+     * @type {Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement>}
+     * @memberof GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampStatus
+     */
+    increments?: Array<GithubComArgoprojArgoRolloutsPkgApisRolloutsV1alpha1WeightRampIncrement>;
+}
 /**
  * 
  * @export
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	templateutil "github.com/argoproj/argo-rollouts/utils/template"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -145,6 +146,45 @@ func ValidateMetrics(metrics []v1alpha1.Metric) error {
 	return nil
 }
 
+// ValidateCompositeConditions validates the composite conditions of an analysis spec against its metrics
+func ValidateCompositeConditions(conditions *v1alpha1.CompositeConditions, metrics []v1alpha1.Metric) error {
+	if conditions == nil {
+		return nil
+	}
+	if conditions.SuccessCondition == "" && conditions.FailureCondition == "" {
+		return fmt.Errorf("compositeConditions: successCondition or failureCondition must be specified")
+	}
+	metricNames := make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		metricNames = append(metricNames, metric.Name)
+	}
+	if conditions.SuccessCondition != "" {
+		if err := evaluate.CompileCompositeCondition(metricNames, conditions.SuccessCondition); err != nil {
+			return fmt.Errorf("compositeConditions.successCondition: %v", err)
+		}
+	}
+	if conditions.FailureCondition != "" {
+		if err := evaluate.CompileCompositeCondition(metricNames, conditions.FailureCondition); err != nil {
+			return fmt.Errorf("compositeConditions.failureCondition: %v", err)
+		}
+	}
+	return nil
+}
+
+// ValidateSuccessWeightThreshold validates the success weight threshold of an analysis spec
+func ValidateSuccessWeightThreshold(threshold *int32, conditions *v1alpha1.CompositeConditions) error {
+	if threshold == nil {
+		return nil
+	}
+	if *threshold < 0 || *threshold > 100 {
+		return fmt.Errorf("successWeightThreshold must be between 0 and 100")
+	}
+	if conditions != nil {
+		return fmt.Errorf("successWeightThreshold and compositeConditions are mutually exclusive")
+	}
+	return nil
+}
+
 // ValidateMetric validates a single metric spec
 func ValidateMetric(metric v1alpha1.Metric) error {
 	count := 0
@@ -187,6 +227,9 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	if metric.ConsecutiveErrorLimit != nil && metric.ConsecutiveErrorLimit.IntValue() < 0 {
 		return fmt.Errorf("consecutiveErrorLimit must be >= 0")
 	}
+	if metric.Weight != nil && *metric.Weight < 0 {
+		return fmt.Errorf("weight must be >= 0")
+	}
 
 	if count > 0 {
 		if consecutiveSuccessLimit >= 0 && failureLimit >= 0 {
@@ -217,49 +260,125 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 		}
 	}
 
+	numProviders := countProviders(metric.Provider)
+	if numProviders == 0 {
+		return fmt.Errorf("no provider specified")
+	}
+	if numProviders > 1 {
+		return fmt.Errorf("multiple providers specified")
+	}
+	if metric.Provider.Judge != nil {
+		return validateJudge(metric.Provider.Judge)
+	}
+	if metric.Provider.OTLP != nil && metric.Provider.OTLP.MetricName == "" {
+		return fmt.Errorf("otlp metricName must be specified")
+	}
+	if metric.Provider.Elasticsearch != nil && metric.Provider.Elasticsearch.Index == "" {
+		return fmt.Errorf("elasticsearch index must be specified")
+	}
+	if metric.Provider.Kubernetes != nil && metric.Provider.Kubernetes.PodTemplateHash == "" {
+		return fmt.Errorf("kubernetes podTemplateHash must be specified")
+	}
+	if metric.Provider.SQL != nil {
+		if metric.Provider.SQL.DSN == "" {
+			return fmt.Errorf("sql dsn must be specified")
+		}
+		if metric.Provider.SQL.Query == "" {
+			return fmt.Errorf("sql query must be specified")
+		}
+	}
+	return nil
+}
+
+// countProviders returns the number of providers specified in a metric provider
+func countProviders(provider v1alpha1.MetricProvider) int {
 	numProviders := 0
-	if metric.Provider.Prometheus != nil {
+	if provider.Prometheus != nil {
 		numProviders++
 	}
-	if metric.Provider.Job != nil {
+	if provider.Job != nil {
 		numProviders++
 	}
-	if metric.Provider.Web != nil {
+	if provider.Web != nil {
 		numProviders++
 	}
-	if metric.Provider.Wavefront != nil {
+	if provider.Wavefront != nil {
 		numProviders++
 	}
-	if metric.Provider.Kayenta != nil {
+	if provider.Kayenta != nil {
 		numProviders++
 	}
-	if metric.Provider.Datadog != nil {
+	if provider.Datadog != nil {
 		numProviders++
 	}
-	if metric.Provider.NewRelic != nil {
+	if provider.NewRelic != nil {
 		numProviders++
 	}
-	if metric.Provider.CloudWatch != nil {
+	if provider.CloudWatch != nil {
 		numProviders++
 	}
-	if metric.Provider.Graphite != nil {
+	if provider.Graphite != nil {
 		numProviders++
 	}
-	if metric.Provider.Influxdb != nil {
+	if provider.Influxdb != nil {
 		numProviders++
 	}
-	if metric.Provider.SkyWalking != nil {
+	if provider.SkyWalking != nil {
 		numProviders++
 	}
-	if metric.Provider.Plugin != nil && len(metric.Provider.Plugin) > 0 {
-		// We allow exactly one plugin to be specified per analysis run template
-		numProviders = numProviders + len(metric.Provider.Plugin)
+	if provider.Judge != nil {
+		numProviders++
 	}
-	if numProviders == 0 {
-		return fmt.Errorf("no provider specified")
+	if provider.OTLP != nil {
+		numProviders++
 	}
-	if numProviders > 1 {
-		return fmt.Errorf("multiple providers specified")
+	if provider.Elasticsearch != nil {
+		numProviders++
+	}
+	if provider.Loki != nil {
+		numProviders++
+	}
+	if provider.SQL != nil {
+		numProviders++
+	}
+	if provider.Kubernetes != nil {
+		numProviders++
+	}
+	if provider.Plugin != nil && len(provider.Plugin) > 0 {
+		// We allow exactly one plugin to be specified per analysis run template
+		numProviders = numProviders + len(provider.Plugin)
+	}
+	return numProviders
+}
+
+// validateJudge validates the comparisons of a judge metric
+func validateJudge(judge *v1alpha1.JudgeMetric) error {
+	if len(judge.Comparisons) == 0 {
+		return fmt.Errorf("judge must have at least one comparison")
+	}
+	if judge.ConfidenceLevel != nil && (*judge.ConfidenceLevel <= 0 || *judge.ConfidenceLevel >= 100) {
+		return fmt.Errorf("judge confidenceLevel must be between 1 and 99")
+	}
+	names := map[string]bool{}
+	for _, comparison := range judge.Comparisons {
+		if comparison.Name == "" {
+			return fmt.Errorf("judge comparisons must have a name")
+		}
+		if names[comparison.Name] {
+			return fmt.Errorf("duplicate judge comparison name: '%s'", comparison.Name)
+		}
+		names[comparison.Name] = true
+		switch comparison.Direction {
+		case "", v1alpha1.JudgeDirectionIncrease, v1alpha1.JudgeDirectionDecrease, v1alpha1.JudgeDirectionEither:
+		default:
+			return fmt.Errorf("judge comparison '%s' has invalid direction '%s'", comparison.Name, comparison.Direction)
+		}
+		if countProviders(comparison.Canary.MetricProvider()) != 1 {
+			return fmt.Errorf("judge comparison '%s' canary must specify exactly one provider", comparison.Name)
+		}
+		if countProviders(comparison.Baseline.MetricProvider()) != 1 {
+			return fmt.Errorf("judge comparison '%s' baseline must specify exactly one provider", comparison.Name)
+		}
 	}
 	return nil
 }
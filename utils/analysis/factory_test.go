@@ -408,6 +408,94 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[0]: multiple providers specified")
 	})
+	t.Run("Ensure judge comparisons are valid", func(t *testing.T) {
+		comparison := v1alpha1.JudgeComparison{
+			Name:     "latency",
+			Canary:   v1alpha1.JudgeQuery{Prometheus: &v1alpha1.PrometheusMetric{}},
+			Baseline: v1alpha1.JudgeQuery{Prometheus: &v1alpha1.PrometheusMetric{}},
+		}
+		metric := v1alpha1.Metric{
+			Name: "judge",
+			Provider: v1alpha1.MetricProvider{
+				Judge: &v1alpha1.JudgeMetric{Comparisons: []v1alpha1.JudgeComparison{comparison}},
+			},
+		}
+		assert.NoError(t, ValidateMetric(metric))
+
+		metric.Provider.Judge.ConfidenceLevel = ptr.To[int32](100)
+		assert.EqualError(t, ValidateMetric(metric), "judge confidenceLevel must be between 1 and 99")
+		metric.Provider.Judge.ConfidenceLevel = nil
+
+		metric.Provider.Judge.Comparisons = []v1alpha1.JudgeComparison{comparison, comparison}
+		assert.EqualError(t, ValidateMetric(metric), "duplicate judge comparison name: 'latency'")
+
+		comparison.Direction = "Up"
+		metric.Provider.Judge.Comparisons = []v1alpha1.JudgeComparison{comparison}
+		assert.EqualError(t, ValidateMetric(metric), "judge comparison 'latency' has invalid direction 'Up'")
+
+		comparison.Direction = v1alpha1.JudgeDirectionIncrease
+		comparison.Baseline.Datadog = &v1alpha1.DatadogMetric{}
+		metric.Provider.Judge.Comparisons = []v1alpha1.JudgeComparison{comparison}
+		assert.EqualError(t, ValidateMetric(metric), "judge comparison 'latency' baseline must specify exactly one provider")
+
+		metric.Provider.Judge.Comparisons = nil
+		assert.EqualError(t, ValidateMetric(metric), "judge must have at least one comparison")
+
+		metric.Provider.Prometheus = &v1alpha1.PrometheusMetric{}
+		assert.EqualError(t, ValidateMetric(metric), "multiple providers specified")
+	})
+	t.Run("Ensure otlp metric name is set", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name: "otlp",
+			Provider: v1alpha1.MetricProvider{
+				OTLP: &v1alpha1.OTLPMetric{},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "otlp metricName must be specified")
+		metric.Provider.OTLP.MetricName = "errors"
+		assert.NoError(t, ValidateMetric(metric))
+	})
+	t.Run("Ensure elasticsearch index is set", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name: "elasticsearch",
+			Provider: v1alpha1.MetricProvider{
+				Elasticsearch: &v1alpha1.ElasticsearchMetric{},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "elasticsearch index must be specified")
+		metric.Provider.Elasticsearch.Index = "logs-*"
+		assert.NoError(t, ValidateMetric(metric))
+		metric.Provider.Loki = &v1alpha1.LokiMetric{}
+		assert.EqualError(t, ValidateMetric(metric), "multiple providers specified")
+	})
+	t.Run("Ensure sql dsn and query are set", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name: "sql",
+			Provider: v1alpha1.MetricProvider{
+				SQL: &v1alpha1.SQLMetric{},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "sql dsn must be specified")
+		metric.Provider.SQL.DSN = "{{args.dsn}}"
+		assert.EqualError(t, ValidateMetric(metric), "sql query must be specified")
+		metric.Provider.SQL.Query = "SELECT 1"
+		assert.NoError(t, ValidateMetric(metric))
+		metric.Provider.Prometheus = &v1alpha1.PrometheusMetric{}
+		assert.EqualError(t, ValidateMetric(metric), "multiple providers specified")
+	})
+	t.Run("Ensure kubernetes podTemplateHash is set", func(t *testing.T) {
+		metric := v1alpha1.Metric{
+			Name: "kubernetes",
+			Provider: v1alpha1.MetricProvider{
+				Kubernetes: &v1alpha1.KubernetesMetric{},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "kubernetes podTemplateHash must be specified")
+		metric.Provider.Kubernetes.PodTemplateHash = "{{args.canary-hash}}"
+		assert.NoError(t, ValidateMetric(metric))
+		metric.Provider.SQL = &v1alpha1.SQLMetric{}
+		assert.EqualError(t, ValidateMetric(metric), "multiple providers specified")
+	})
 	t.Run("Ensure consecutiveSuccessLimit >= 0", func(t *testing.T) {
 		consecutiveSuccessLimit := intstr.FromInt(-1)
 		spec := v1alpha1.AnalysisTemplateSpec{
@@ -445,6 +533,37 @@ func TestValidateMetrics(t *testing.T) {
 }
 
 // TestResolveMetricArgs verifies that metric arguments are resolved
+func TestValidateCompositeConditions(t *testing.T) {
+	metrics := []v1alpha1.Metric{{Name: "error-rate"}, {Name: "latency"}}
+	assert.NoError(t, ValidateCompositeConditions(nil, metrics))
+	assert.NoError(t, ValidateCompositeConditions(&v1alpha1.CompositeConditions{
+		SuccessCondition: `metrics["error-rate"].phase == Successful || latency.value < 300`,
+		FailureCondition: "latency.value > 1000",
+	}, metrics))
+	assert.EqualError(t, ValidateCompositeConditions(&v1alpha1.CompositeConditions{}, metrics), "compositeConditions: successCondition or failureCondition must be specified")
+	assert.ErrorContains(t, ValidateCompositeConditions(&v1alpha1.CompositeConditions{FailureCondition: "traffic.value < 10"}, metrics), "compositeConditions.failureCondition: unknown name traffic")
+}
+
+func TestValidateSuccessWeightThreshold(t *testing.T) {
+	assert.NoError(t, ValidateSuccessWeightThreshold(nil, &v1alpha1.CompositeConditions{SuccessCondition: "true"}))
+	assert.NoError(t, ValidateSuccessWeightThreshold(ptr.To[int32](0), nil))
+	assert.NoError(t, ValidateSuccessWeightThreshold(ptr.To[int32](100), nil))
+	assert.EqualError(t, ValidateSuccessWeightThreshold(ptr.To[int32](101), nil), "successWeightThreshold must be between 0 and 100")
+	assert.EqualError(t, ValidateSuccessWeightThreshold(ptr.To[int32](-1), nil), "successWeightThreshold must be between 0 and 100")
+	assert.EqualError(t, ValidateSuccessWeightThreshold(ptr.To[int32](50), &v1alpha1.CompositeConditions{SuccessCondition: "true"}), "successWeightThreshold and compositeConditions are mutually exclusive")
+}
+
+func TestValidateMetricWeight(t *testing.T) {
+	metric := v1alpha1.Metric{
+		Name:     "success-rate",
+		Weight:   ptr.To[int32](0),
+		Provider: v1alpha1.MetricProvider{Prometheus: &v1alpha1.PrometheusMetric{}},
+	}
+	assert.NoError(t, ValidateMetric(metric))
+	metric.Weight = ptr.To[int32](-1)
+	assert.EqualError(t, ValidateMetric(metric), "weight must be >= 0")
+}
+
 func TestResolveMetricArgs(t *testing.T) {
 	arg1, arg2 := "success-rate", "success-rate2"
 	args := []v1alpha1.Argument{
@@ -41,6 +41,10 @@ func FilterCurrentRolloutAnalysisRuns(analysisRuns []*v1alpha1.AnalysisRun, r *v
 				currArs.BlueGreenPrePromotion = ar
 			case getArName(r.Status.BlueGreen.PostPromotionAnalysisRunStatus):
 				currArs.BlueGreenPostPromotion = ar
+			case getArName(r.Status.DaemonSet.CurrentStepAnalysisRunStatus):
+				currArs.DaemonSetStep = ar
+			case getArName(r.Status.DaemonSet.CurrentBackgroundAnalysisRunStatus):
+				currArs.DaemonSetBackground = ar
 			default:
 				otherArs = append(otherArs, ar)
 			}
@@ -98,6 +98,26 @@ func TestFilterCurrentRolloutAnalysisRuns(t *testing.T) {
 		assert.Nil(t, currentArs.CanaryBackground)
 		assert.Nil(t, currentArs.CanaryStep)
 	})
+	t.Run("DaemonSet", func(t *testing.T) {
+		r := &v1alpha1.Rollout{
+			Status: v1alpha1.RolloutStatus{
+				DaemonSet: v1alpha1.DaemonSetStatus{
+					CurrentStepAnalysisRunStatus: &v1alpha1.RolloutAnalysisRunStatus{
+						Name: "foo",
+					},
+					CurrentBackgroundAnalysisRunStatus: &v1alpha1.RolloutAnalysisRunStatus{
+						Name: "bar",
+					},
+				},
+			},
+		}
+		currentArs, nonCurrentArs := FilterCurrentRolloutAnalysisRuns(ars, r)
+		assert.Len(t, nonCurrentArs, 1)
+		assert.Equal(t, currentArs.DaemonSetStep, ars[0])
+		assert.Equal(t, currentArs.DaemonSetBackground, ars[1])
+		assert.Nil(t, currentArs.CanaryStep)
+		assert.Len(t, currentArs.ToArray(), 2)
+	})
 }
 
 func TestFilterAnalysisRunsByName(t *testing.T) {
@@ -25,6 +25,8 @@ type CurrentAnalysisRuns struct {
 	BlueGreenPostPromotion *v1alpha1.AnalysisRun
 	CanaryStep             *v1alpha1.AnalysisRun
 	CanaryBackground       *v1alpha1.AnalysisRun
+	DaemonSetStep          *v1alpha1.AnalysisRun
+	DaemonSetBackground    *v1alpha1.AnalysisRun
 }
 
 func (c CurrentAnalysisRuns) ToArray() []*v1alpha1.AnalysisRun {
@@ -41,6 +43,12 @@ func (c CurrentAnalysisRuns) ToArray() []*v1alpha1.AnalysisRun {
 	if c.CanaryBackground != nil {
 		currentAnalysisRuns = append(currentAnalysisRuns, c.CanaryBackground)
 	}
+	if c.DaemonSetStep != nil {
+		currentAnalysisRuns = append(currentAnalysisRuns, c.DaemonSetStep)
+	}
+	if c.DaemonSetBackground != nil {
+		currentAnalysisRuns = append(currentAnalysisRuns, c.DaemonSetBackground)
+	}
 	return currentAnalysisRuns
 }
 
@@ -85,10 +93,15 @@ func IsTerminating(run *v1alpha1.AnalysisRun) bool {
 	if run.Spec.Terminate {
 		return true
 	}
+	// With composite conditions or a success weight threshold, the run is assessed from the results of all its
+	// metrics, so the failure of one of its metrics does not terminate the run
+	if run.Spec.CompositeConditions != nil || run.Spec.SuccessWeightThreshold != nil {
+		return false
+	}
 	for _, res := range run.Status.MetricResults {
-		// If this metric is running in the dryRun mode then we don't care about the failures and hence the terminal
-		// decision shouldn't be affected.
-		if res.DryRun {
+		// If this metric is running in the dryRun mode, or is advisory, then we don't care about the failures and
+		// hence the terminal decision shouldn't be affected.
+		if res.DryRun || res.Advisory {
 			continue
 		}
 
@@ -202,11 +215,11 @@ func TerminateRun(analysisRunIf argoprojclient.AnalysisRunInterface, name string
 // IsSemanticallyEqual checks to see if two analysis runs are semantically equal
 func IsSemanticallyEqual(left, right v1alpha1.AnalysisRunSpec) bool {
 	// NOTE: only consider metrics & args when comparing for semantic equality
-	leftBytes, err := json.Marshal(v1alpha1.AnalysisRunSpec{Metrics: left.Metrics, DryRun: left.DryRun, MeasurementRetention: left.MeasurementRetention, Args: left.Args})
+	leftBytes, err := json.Marshal(v1alpha1.AnalysisRunSpec{Metrics: left.Metrics, DryRun: left.DryRun, MeasurementRetention: left.MeasurementRetention, Args: left.Args, CompositeConditions: left.CompositeConditions, SuccessWeightThreshold: left.SuccessWeightThreshold})
 	if err != nil {
 		panic(err)
 	}
-	rightBytes, err := json.Marshal(v1alpha1.AnalysisRunSpec{Metrics: right.Metrics, DryRun: right.DryRun, MeasurementRetention: right.MeasurementRetention, Args: right.Args})
+	rightBytes, err := json.Marshal(v1alpha1.AnalysisRunSpec{Metrics: right.Metrics, DryRun: right.DryRun, MeasurementRetention: right.MeasurementRetention, Args: right.Args, CompositeConditions: right.CompositeConditions, SuccessWeightThreshold: right.SuccessWeightThreshold})
 	if err != nil {
 		panic(err)
 	}
@@ -309,6 +322,12 @@ func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, cluster
 	if err != nil {
 		return nil, err
 	}
+	if err := ValidateCompositeConditions(template.Spec.CompositeConditions, template.Spec.Metrics); err != nil {
+		return nil, err
+	}
+	if err := ValidateSuccessWeightThreshold(template.Spec.SuccessWeightThreshold, template.Spec.CompositeConditions); err != nil {
+		return nil, err
+	}
 	ar := v1alpha1.AnalysisRun{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:         name,
@@ -318,10 +337,12 @@ func NewAnalysisRunFromTemplates(templates []*v1alpha1.AnalysisTemplate, cluster
 			Annotations:  annotations,
 		},
 		Spec: v1alpha1.AnalysisRunSpec{
-			Metrics:              template.Spec.Metrics,
-			DryRun:               dryRun,
-			MeasurementRetention: measurementRetention,
-			Args:                 newArgs,
+			Metrics:                template.Spec.Metrics,
+			DryRun:                 dryRun,
+			MeasurementRetention:   measurementRetention,
+			Args:                   newArgs,
+			CompositeConditions:    template.Spec.CompositeConditions,
+			SuccessWeightThreshold: template.Spec.SuccessWeightThreshold,
 		},
 	}
 	return &ar, nil
@@ -344,16 +365,82 @@ func FlattenTemplates(templates []*v1alpha1.AnalysisTemplate, clusterTemplates [
 	if err != nil {
 		return nil, err
 	}
+	compositeConditions, err := flattenCompositeConditions(templates, clusterTemplates)
+	if err != nil {
+		return nil, err
+	}
+	successWeightThreshold, err := flattenSuccessWeightThreshold(templates, clusterTemplates)
+	if err != nil {
+		return nil, err
+	}
 	return &v1alpha1.AnalysisTemplate{
 		Spec: v1alpha1.AnalysisTemplateSpec{
-			Metrics:              metrics,
-			DryRun:               dryRunMetrics,
-			MeasurementRetention: measurementRetentionMetrics,
-			Args:                 args,
+			Metrics:                metrics,
+			DryRun:                 dryRunMetrics,
+			MeasurementRetention:   measurementRetentionMetrics,
+			Args:                   args,
+			CompositeConditions:    compositeConditions,
+			SuccessWeightThreshold: successWeightThreshold,
 		},
 	}, nil
 }
 
+// flattenSuccessWeightThreshold returns the success weight threshold of the templates, which must be the same for
+// all the templates specifying one
+func flattenSuccessWeightThreshold(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) (*int32, error) {
+	var threshold *int32
+	set := func(value *int32) error {
+		if value == nil {
+			return nil
+		}
+		if threshold != nil && *threshold != *value {
+			return fmt.Errorf("successWeightThreshold specified multiple times with different values: %d, %d", *threshold, *value)
+		}
+		v := *value
+		threshold = &v
+		return nil
+	}
+	for _, template := range templates {
+		if err := set(template.Spec.SuccessWeightThreshold); err != nil {
+			return nil, err
+		}
+	}
+	for _, template := range clusterTemplates {
+		if err := set(template.Spec.SuccessWeightThreshold); err != nil {
+			return nil, err
+		}
+	}
+	return threshold, nil
+}
+
+// flattenCompositeConditions returns the composite conditions of the templates, which at most one of the templates
+// may define since they assess the whole run
+func flattenCompositeConditions(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) (*v1alpha1.CompositeConditions, error) {
+	var compositeConditions *v1alpha1.CompositeConditions
+	var templateName string
+	set := func(name string, conditions *v1alpha1.CompositeConditions) error {
+		if conditions == nil {
+			return nil
+		}
+		if compositeConditions != nil {
+			return fmt.Errorf("compositeConditions specified in both '%s' and '%s'", templateName, name)
+		}
+		compositeConditions, templateName = conditions.DeepCopy(), name
+		return nil
+	}
+	for _, template := range templates {
+		if err := set(template.Name, template.Spec.CompositeConditions); err != nil {
+			return nil, err
+		}
+	}
+	for _, template := range clusterTemplates {
+		if err := set(template.Name, template.Spec.CompositeConditions); err != nil {
+			return nil, err
+		}
+	}
+	return compositeConditions, nil
+}
+
 func flattenArgs(templates []*v1alpha1.AnalysisTemplate, clusterTemplates []*v1alpha1.ClusterAnalysisTemplate) ([]v1alpha1.Argument, error) {
 	var combinedArgs []v1alpha1.Argument
 	appendOrUpdate := func(newArg v1alpha1.Argument) error {
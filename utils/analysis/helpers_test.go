@@ -237,6 +237,20 @@ func TestIsTerminating(t *testing.T) {
 	successRate.Phase = v1alpha1.AnalysisPhaseError
 	run.Status.MetricResults[1] = successRate
 	assert.True(t, IsTerminating(run))
+	// a failed metric does not terminate a run assessed by composite conditions
+	run.Spec.CompositeConditions = &v1alpha1.CompositeConditions{SuccessCondition: "true"}
+	assert.False(t, IsTerminating(run))
+	run.Spec.Terminate = true
+	assert.True(t, IsTerminating(run))
+	run.Spec.Terminate = false
+	run.Spec.CompositeConditions = nil
+	// nor does it terminate a run with a success weight threshold
+	run.Spec.SuccessWeightThreshold = ptr.To[int32](50)
+	assert.False(t, IsTerminating(run))
+	run.Spec.SuccessWeightThreshold = nil
+	// nor does an advisory metric
+	run.Status.MetricResults[1].Advisory = true
+	assert.False(t, IsTerminating(run))
 }
 
 func TestTerminateRun(t *testing.T) {
@@ -606,6 +620,75 @@ func TestFlattenTemplates(t *testing.T) {
 	})
 }
 
+func TestFlattenTemplatesCompositeConditions(t *testing.T) {
+	conditions := &v1alpha1.CompositeConditions{SuccessCondition: "foo.phase == Successful || bar.value < 10"}
+	foo := &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics:             []v1alpha1.Metric{{Name: "foo"}},
+			CompositeConditions: conditions,
+		},
+	}
+	bar := &v1alpha1.ClusterAnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{Name: "bar"}},
+		},
+	}
+
+	template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar})
+	assert.NoError(t, err)
+	assert.Equal(t, conditions, template.Spec.CompositeConditions)
+
+	run, err := NewAnalysisRunFromTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar}, nil, nil, nil, nil, nil, "run", "", metav1.NamespaceDefault)
+	assert.NoError(t, err)
+	assert.Equal(t, conditions, run.Spec.CompositeConditions)
+
+	// the composite conditions may only reference the metrics of the run
+	_, err = NewAnalysisRunFromTemplates([]*v1alpha1.AnalysisTemplate{foo}, nil, nil, nil, nil, nil, nil, "run", "", metav1.NamespaceDefault)
+	assert.ErrorContains(t, err, "compositeConditions.successCondition: unknown name bar")
+
+	bar.Spec.CompositeConditions = &v1alpha1.CompositeConditions{FailureCondition: "bar.phase == Failed"}
+	_, err = FlattenTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar})
+	assert.EqualError(t, err, "compositeConditions specified in both 'foo' and 'bar'")
+}
+
+func TestFlattenTemplatesSuccessWeightThreshold(t *testing.T) {
+	foo := &v1alpha1.AnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics:                []v1alpha1.Metric{{Name: "foo", Weight: ptr.To[int32](3)}},
+			SuccessWeightThreshold: ptr.To[int32](75),
+		},
+	}
+	bar := &v1alpha1.ClusterAnalysisTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{Name: "bar", Advisory: true}},
+		},
+	}
+
+	run, err := NewAnalysisRunFromTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar}, nil, nil, nil, nil, nil, "run", "", metav1.NamespaceDefault)
+	assert.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](75), run.Spec.SuccessWeightThreshold)
+	assert.True(t, run.Spec.Metrics[1].Advisory)
+
+	// the templates may specify the same threshold
+	bar.Spec.SuccessWeightThreshold = ptr.To[int32](75)
+	template, err := FlattenTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar})
+	assert.NoError(t, err)
+	assert.Equal(t, ptr.To[int32](75), template.Spec.SuccessWeightThreshold)
+
+	bar.Spec.SuccessWeightThreshold = ptr.To[int32](50)
+	_, err = FlattenTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar})
+	assert.EqualError(t, err, "successWeightThreshold specified multiple times with different values: 75, 50")
+
+	bar.Spec.SuccessWeightThreshold = nil
+	bar.Spec.CompositeConditions = &v1alpha1.CompositeConditions{FailureCondition: "bar.phase == Failed"}
+	_, err = NewAnalysisRunFromTemplates([]*v1alpha1.AnalysisTemplate{foo}, []*v1alpha1.ClusterAnalysisTemplate{bar}, nil, nil, nil, nil, nil, "run", "", metav1.NamespaceDefault)
+	assert.EqualError(t, err, "successWeightThreshold and compositeConditions are mutually exclusive")
+}
+
 func TestNewAnalysisRunFromTemplates(t *testing.T) {
 	templates := []*v1alpha1.AnalysisTemplate{{
 		ObjectMeta: metav1.ObjectMeta{
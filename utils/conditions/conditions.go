@@ -54,6 +54,15 @@ const (
 	// NewReplicaSetDetailedMessage is a more detailed format message
 	NewReplicaSetDetailedMessage = "Created ReplicaSet %s (revision %d)"
 
+	// NewDaemonSetReason is added in a rollout when it creates its DaemonSet.
+	NewDaemonSetReason = "NewDaemonSetCreated"
+	// NewDaemonSetMessage is added in a rollout when it creates its DaemonSet.
+	NewDaemonSetMessage = "Created DaemonSet %q"
+	// FailedDaemonSetCreateReason is added in a rollout when it cannot create its DaemonSet.
+	FailedDaemonSetCreateReason = "DaemonSetCreateError"
+	// FailedDaemonSetCreateMessage is added in a rollout when it cannot create its DaemonSet.
+	FailedDaemonSetCreateMessage = "Failed to create DaemonSet %q: %v"
+
 	// FoundNewRSReason is added in a rollout when it adopts an existing replica set.
 	FoundNewRSReason = "FoundNewReplicaSet"
 	// FoundNewRSMessage is added in a rollout when it adopts an existing replica set.
@@ -182,9 +191,42 @@ const (
 	// WeightVerifyErrorReason is emitted when there is an error verifying the set weight
 	WeightVerifyErrorReason  = "WeightVerifyError"
 	WeightVerifyErrorMessage = "Failed to verify weight: %s"
+	// TrafficWeightsMismatchReason is added in a rollout when its traffic routers have not all verified the desired weight
+	TrafficWeightsMismatchReason  = "TrafficWeightsMismatch"
+	TrafficWeightsMismatchMessage = "Traffic routers have not all verified the desired weight: %s"
+	// TrafficWeightsVerifiedReason is added in a rollout when its traffic routers agree on the desired weight
+	TrafficWeightsVerifiedReason  = "TrafficWeightsVerified"
+	TrafficWeightsVerifiedMessage = "Traffic routers are at the desired weight: %s"
+	// TrafficWeightsRolledBackReason is added in a rollout when the weights of its traffic routers were rolled back
+	// because one of the traffic routers failed to set or verify the desired weight
+	TrafficWeightsRolledBackReason  = "TrafficWeightsRolledBack"
+	TrafficWeightsRolledBackMessage = "Traffic weights rolled back after failing to set weight %d: %s"
+	// TrafficWeightsVerifyRolledBackMessage is the message of the TrafficWeightsRolledBack reason when the traffic
+	// routers failed to verify the desired weight, or did not verify it within the progress deadline
+	TrafficWeightsVerifyRolledBackMessage = "Traffic weights rolled back after failing to verify weight %d: %s"
 	// LoadBalancerNotFoundReason is emitted when load balancer can not be found
 	LoadBalancerNotFoundReason  = "LoadBalancerNotFound"
 	LoadBalancerNotFoundMessage = "Failed to find load balancer: %s"
+	// DeploymentWindowClosedReason is emitted when a new revision is held until the next deployment window
+	DeploymentWindowClosedReason  = "DeploymentWindowClosed"
+	DeploymentWindowClosedMessage = "Holding revision until the next deployment window opens at %s"
+	// DeploymentWindowOpenedReason is emitted when a held revision is allowed to progress again
+	DeploymentWindowOpenedReason  = "DeploymentWindowOpened"
+	DeploymentWindowOpenedMessage = "Deployment window opened"
+	// DeploymentWindowErrorReason is emitted when the deployment windows of a rollout cannot be evaluated
+	DeploymentWindowErrorReason  = "DeploymentWindowError"
+	DeploymentWindowErrorMessage = "Failed to evaluate deployment windows, holding revision: %s"
+
+	// WaitingForDependenciesReason is added in a rollout when a new revision is held until its dependencies are met
+	WaitingForDependenciesReason  = "WaitingForDependencies"
+	WaitingForDependenciesMessage = "Waiting for dependencies: %s"
+	// DependenciesSatisfiedReason is emitted when a held revision is allowed to progress after its dependencies are met
+	DependenciesSatisfiedReason  = "DependenciesSatisfied"
+	DependenciesSatisfiedMessage = "Dependencies satisfied"
+
+	// ClusterReconcileErrorReason is emitted when the ReplicaSets of a remote cluster cannot be reconciled
+	ClusterReconcileErrorReason  = "ClusterReconcileError"
+	ClusterReconcileErrorMessage = "Failed to reconcile cluster %s: %s"
 
 	RolloutAddedToInformerReason = "RolloutAddedToInformer"
 )
@@ -260,7 +302,7 @@ func RolloutProgressing(rollout *v1alpha1.Rollout, newStatus *v1alpha1.RolloutSt
 		strategySpecificProgress = activeSelectorChange || previewSelectorChange
 	}
 
-	if rollout.Spec.Strategy.Canary != nil {
+	if rollout.Spec.Strategy.Canary != nil || rollout.Spec.Strategy.DaemonSet != nil {
 		stableRSChange := newStatus.StableRS != oldStatus.StableRS
 		incrementStepIndex := false
 		if newStatus.CurrentStepIndex != nil && oldStatus.CurrentStepIndex != nil {
@@ -305,6 +347,17 @@ func RolloutHealthy(rollout *v1alpha1.Rollout, newStatus *v1alpha1.RolloutStatus
 		scaleDownOldReplicas := newStatus.Replicas == replicas
 		completedStrategy = executedAllSteps && currentRSIsStable && scaleDownOldReplicas
 	}
+	if rollout.Spec.Strategy.DaemonSet != nil {
+		// a DaemonSet runs one pod on each of its nodes
+		replicas = newStatus.DaemonSet.Nodes
+		stepCount := len(rollout.Spec.Strategy.DaemonSet.Steps)
+		executedAllSteps := true
+		if stepCount > 0 && newStatus.CurrentStepIndex != nil {
+			executedAllSteps = int32(stepCount) == *newStatus.CurrentStepIndex
+		}
+		currentRSIsStable := newStatus.StableRS != "" && newStatus.StableRS == newStatus.CurrentPodHash
+		completedStrategy = executedAllSteps && currentRSIsStable && newStatus.Replicas == replicas
+	}
 
 	return newStatus.UpdatedReplicas == replicas &&
 		newStatus.AvailableReplicas == replicas &&
@@ -320,11 +373,19 @@ func RolloutCompleted(newStatus *v1alpha1.RolloutStatus) bool {
 // ComputeStepHash returns a hash value calculated from the Rollout's steps. The hash will
 // be safe encoded to avoid bad words.
 func ComputeStepHash(rollout *v1alpha1.Rollout) string {
-	if rollout.Spec.Strategy.BlueGreen != nil || rollout.Spec.Strategy.Canary == nil {
+	var steps any
+	switch {
+	case rollout.Spec.Strategy.BlueGreen != nil:
+		return ""
+	case rollout.Spec.Strategy.Canary != nil:
+		steps = rollout.Spec.Strategy.Canary.Steps
+	case rollout.Spec.Strategy.DaemonSet != nil:
+		steps = rollout.Spec.Strategy.DaemonSet.Steps
+	default:
 		return ""
 	}
 	rolloutStepHasher := fnv.New32a()
-	stepsBytes, err := json.Marshal(rollout.Spec.Strategy.Canary.Steps)
+	stepsBytes, err := json.Marshal(steps)
 	if err != nil {
 		panic(err)
 	}
@@ -403,11 +403,41 @@ func TestRolloutHealthy(t *testing.T) {
 		return r
 	}
 
+	daemonSetRollout := func(nodes, current, updated, available int32, stepIndex int32) *v1alpha1.Rollout {
+		r := rollout(1, current, updated, available, true)
+		r.Spec.Replicas = nil
+		r.Spec.Strategy = v1alpha1.RolloutStrategy{
+			DaemonSet: &v1alpha1.DaemonSetStrategy{
+				Steps: []v1alpha1.DaemonSetStep{{Pause: &v1alpha1.RolloutPause{}}},
+			},
+		}
+		r.Status.StableRS = r.Status.CurrentPodHash
+		r.Status.CurrentStepIndex = &stepIndex
+		r.Status.DaemonSet.Nodes = nodes
+		r.Status.ObservedGeneration = strconv.Itoa(int(r.Generation))
+		return r
+	}
+
 	tests := []struct {
 		name     string
 		r        *v1alpha1.Rollout
 		expected bool
 	}{
+		{
+			name:     "DaemonSet complete",
+			r:        daemonSetRollout(3, 3, 3, 3, 1),
+			expected: true,
+		},
+		{
+			name:     "DaemonSet not complete: steps left",
+			r:        daemonSetRollout(3, 3, 3, 3, 0),
+			expected: false,
+		},
+		{
+			name:     "DaemonSet not complete: nodes running old pods",
+			r:        daemonSetRollout(3, 3, 2, 3, 1),
+			expected: false,
+		},
 		{
 			name: "BlueGreen complete",
 			// update hash to status.CurrentPodHash after k8s library update
@@ -632,6 +662,22 @@ func TestComputeStepHash(t *testing.T) {
 	roBlueGreenHash := ComputeStepHash(roBlueGreen)
 	assert.Equal(t, "", roBlueGreenHash)
 
+	roDaemonSet := ro.DeepCopy()
+	roDaemonSet.Spec.Strategy.Canary = nil
+	roDaemonSet.Spec.Strategy.DaemonSet = &v1alpha1.DaemonSetStrategy{
+		Steps: []v1alpha1.DaemonSetStep{
+			{
+				Pause: &v1alpha1.RolloutPause{},
+			},
+		},
+	}
+	roDaemonSetHash := ComputeStepHash(roDaemonSet)
+	assert.NotEqual(t, "", roDaemonSetHash)
+	roDaemonSet.Spec.Strategy.DaemonSet.Steps = append(roDaemonSet.Spec.Strategy.DaemonSet.Steps, v1alpha1.DaemonSetStep{
+		Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](50)},
+	})
+	assert.NotEqual(t, roDaemonSetHash, ComputeStepHash(roDaemonSet))
+
 	assert.NotEqual(t, baseline, roWithDiffStepsHash)
 	assert.Equal(t, baseline, roWithSameStepsHash)
 	assert.NotEqual(t, baseline, roNoStepsHash)
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"slices"
 	"sync"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -20,9 +21,26 @@ import (
 
 // Config is the in memory representation of the configmap with some additional fields/functions for ease of use.
 type Config struct {
-	configMap *v1.ConfigMap
-	plugins   []types.PluginItem
-	lock      *sync.RWMutex
+	configMap                *v1.ConfigMap
+	plugins                  []types.PluginItem
+	metricProviderRateLimits []MetricProviderRateLimit
+	lock                     *sync.RWMutex
+}
+
+// MetricProviderRateLimit limits the rate of the queries of the analysis controller to a metric provider, and caps
+// the backoff applied after the metric provider throttled a query
+type MetricProviderRateLimit struct {
+	// Provider is the type of the metric provider (e.g. Datadog)
+	Provider string `json:"provider"`
+	// Address limits the queries to the metric provider at this address only. When empty, the queries to all the
+	// addresses of the metric provider share the limit.
+	Address string `json:"address,omitempty"`
+	// QPS is the number of queries allowed per second. Zero disables the rate limit.
+	QPS float64 `json:"qps,omitempty"`
+	// Burst is the number of queries allowed at once. Defaults to 1.
+	Burst int `json:"burst,omitempty"`
+	// MaxBackoff caps the backoff applied after the metric provider throttled a query
+	MaxBackoff string `json:"maxBackoff,omitempty"`
 }
 
 var configMemoryCache *Config
@@ -70,11 +88,17 @@ func InitializeConfig(k8sClientset kubernetes.Interface, configMapName string) (
 		stepPlugins[i].Type = types.PluginTypeStep
 	}
 
+	var metricProviderRateLimits []MetricProviderRateLimit
+	if err = yaml.Unmarshal([]byte(configMapCluster.Data["metricProviderRateLimits"]), &metricProviderRateLimits); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metric provider rate limits while initializing: %w", err)
+	}
+
 	mutex.Lock()
 	configMemoryCache = &Config{
-		configMap: configMapCluster,
-		plugins:   slices.Concat(trafficRouterPlugins, metricProviderPlugins, stepPlugins),
-		lock:      &sync.RWMutex{},
+		configMap:                configMapCluster,
+		plugins:                  slices.Concat(trafficRouterPlugins, metricProviderPlugins, stepPlugins),
+		metricProviderRateLimits: metricProviderRateLimits,
+		lock:                     &sync.RWMutex{},
 	}
 	mutex.Unlock()
 
@@ -122,6 +146,14 @@ func (c *Config) GetPlugin(name string, pluginType types.PluginType) *types.Plug
 	return nil
 }
 
+// GetMetricProviderRateLimits returns the rate limits of the metric providers
+func (c *Config) GetMetricProviderRateLimits() []MetricProviderRateLimit {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	// Return a copy of the slice
+	return append([]MetricProviderRateLimit{}, c.metricProviderRateLimits...)
+}
+
 func (c *Config) ValidateConfig() error {
 	for _, pluginItem := range c.GetAllPlugins() {
 		matches := re.FindAllStringSubmatch(pluginItem.Name, -1)
@@ -129,6 +161,19 @@ func (c *Config) ValidateConfig() error {
 			return fmt.Errorf("plugin repository (%s) must be in the format of <namespace>/<name>", pluginItem.Name)
 		}
 	}
+	for _, limit := range c.GetMetricProviderRateLimits() {
+		if limit.Provider == "" {
+			return fmt.Errorf("metric provider rate limit must specify a provider")
+		}
+		if limit.QPS < 0 || limit.Burst < 0 {
+			return fmt.Errorf("metric provider rate limit of %s must not have a negative qps or burst", limit.Provider)
+		}
+		if limit.MaxBackoff != "" {
+			if _, err := time.ParseDuration(limit.MaxBackoff); err != nil {
+				return fmt.Errorf("metric provider rate limit of %s has an invalid maxBackoff: %w", limit.Provider, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -24,6 +24,8 @@ const (
 	DefaultMaxSurge = "25"
 	// DefaultMaxUnavailable default number for the max number of unavailable pods during a rollout
 	DefaultMaxUnavailable = "25"
+	// DefaultDaemonSetMaxUnavailable default number of pods which may be unavailable while a DaemonSet rollout updates their nodes
+	DefaultDaemonSetMaxUnavailable = int32(1)
 	// DefaultProgressDeadlineSeconds default number of seconds for the rollout to be making progress
 	DefaultProgressDeadlineSeconds = int32(600)
 	// DefaultScaleDownDelaySeconds default seconds before scaling down old replicaset after switching services
@@ -80,6 +82,7 @@ var (
 	appmeshCRDVersion            = DefaultAppMeshCRDVersion
 	defaultMetricCleanupDelay    = DefaultMetricCleanupDelay
 	defaultDescribeTagsLimit     = DefaultDescribeTagsLimit
+	analysisMeasurementCacheTTL  time.Duration
 )
 
 const (
@@ -154,6 +157,13 @@ func GetMaxUnavailableOrDefault(rollout *v1alpha1.Rollout) *intstr.IntOrString {
 	if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.MaxUnavailable != nil {
 		return rollout.Spec.Strategy.Canary.MaxUnavailable
 	}
+	if rollout.Spec.Strategy.DaemonSet != nil {
+		if rollout.Spec.Strategy.DaemonSet.MaxUnavailable != nil {
+			return rollout.Spec.Strategy.DaemonSet.MaxUnavailable
+		}
+		defaultValue := intstr.FromInt32(DefaultDaemonSetMaxUnavailable)
+		return &defaultValue
+	}
 	defaultValue := intstr.FromString(DefaultMaxUnavailable)
 	return &defaultValue
 }
@@ -378,3 +388,14 @@ func GetDescribeTagsLimit() int {
 func SetDescribeTagsLimit(limit int) {
 	defaultDescribeTagsLimit = limit
 }
+
+// GetAnalysisMeasurementCacheTTL returns the TTL of the measurements shared by the AnalysisRuns, which is 0 when the
+// measurement cache is disabled
+func GetAnalysisMeasurementCacheTTL() time.Duration {
+	return analysisMeasurementCacheTTL
+}
+
+// SetAnalysisMeasurementCacheTTL sets the TTL of the measurements shared by the AnalysisRuns
+func SetAnalysisMeasurementCacheTTL(ttl time.Duration) {
+	analysisMeasurementCacheTTL = ttl
+}
@@ -119,6 +119,26 @@ func TestGetMaxUnavailableOrDefault(t *testing.T) {
 	}
 	assert.Equal(t, maxUnavailable, *GetMaxUnavailableOrDefault(rolloutBlueGreenNonDefaultValue))
 
+	rolloutDaemonSetNonDefaultValue := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+		},
+	}
+	assert.Equal(t, maxUnavailable, *GetMaxUnavailableOrDefault(rolloutDaemonSetNonDefaultValue))
+
+	rolloutDaemonSetDefaultValue := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{},
+			},
+		},
+	}
+	assert.Equal(t, intstr.FromInt32(DefaultDaemonSetMaxUnavailable), *GetMaxUnavailableOrDefault(rolloutDaemonSetDefaultValue))
+
 	rolloutDefaultValue := &v1alpha1.Rollout{}
 	assert.Equal(t, intstr.FromString(DefaultMaxUnavailable), *GetMaxUnavailableOrDefault(rolloutDefaultValue))
 }
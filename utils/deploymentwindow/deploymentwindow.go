@@ -0,0 +1,180 @@
+package deploymentwindow
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	// embed the time zone database so that windows can be evaluated in images without zoneinfo
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+	"github.com/teambition/rrule-go"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// maxOccurrences bounds the number of consecutive occurrences which are merged into a single open period
+	maxOccurrences = 10000
+	// maxBoundaries bounds the number of window boundaries which are inspected when searching for the next allowed time
+	maxBoundaries = 10000
+	// searchHorizon is how far into the future the next allowed time is searched
+	searchHorizon = 366 * 24 * time.Hour
+)
+
+// Window is a parsed deployment window
+type Window struct {
+	kind     v1alpha1.DeploymentWindowKind
+	duration time.Duration
+	// next returns the first time after t at which the window opens, or the zero time if it never opens again
+	next func(t time.Time) time.Time
+}
+
+// Windows is a list of parsed deployment windows
+type Windows []*Window
+
+// Parse parses a deployment window
+func Parse(w v1alpha1.DeploymentWindow) (*Window, error) {
+	if w.Kind != v1alpha1.DeploymentWindowKindAllow && w.Kind != v1alpha1.DeploymentWindowKindBlock {
+		return nil, fmt.Errorf("kind must be either %s or %s", v1alpha1.DeploymentWindowKindAllow, v1alpha1.DeploymentWindowKindBlock)
+	}
+	if (w.Schedule == "") == (w.RRule == "") {
+		return nil, fmt.Errorf("exactly one of schedule or rrule must be specified")
+	}
+	duration, err := w.Duration.Duration()
+	if err != nil {
+		return nil, fmt.Errorf("invalid duration '%s': %w", w.Duration, err)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("duration must be greater than zero")
+	}
+	loc := time.UTC
+	if w.TimeZone != "" {
+		loc, err = time.LoadLocation(w.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %w", w.TimeZone, err)
+		}
+	}
+
+	window := &Window{
+		kind:     w.Kind,
+		duration: duration,
+	}
+	if w.Schedule != "" {
+		schedule, err := cron.ParseStandard(w.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule '%s': %w", w.Schedule, err)
+		}
+		window.next = func(t time.Time) time.Time {
+			return schedule.Next(t.In(loc))
+		}
+		return window, nil
+	}
+
+	lines := strings.Split(strings.TrimSpace(w.RRule), "\n")
+	hasStart := false
+	for i := range lines {
+		lines[i] = strings.TrimSpace(lines[i])
+		if strings.HasPrefix(lines[i], "DTSTART") {
+			hasStart = true
+		}
+	}
+	if !hasStart {
+		return nil, fmt.Errorf("rrule must specify DTSTART")
+	}
+	set, err := rrule.StrSliceToRRuleSetInLoc(lines, loc)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rrule '%s': %w", w.RRule, err)
+	}
+	window.next = func(t time.Time) time.Time {
+		return set.After(t, false)
+	}
+	return window, nil
+}
+
+// ParseAll parses a list of deployment windows
+func ParseAll(windows []v1alpha1.DeploymentWindow) (Windows, error) {
+	parsed := make(Windows, 0, len(windows))
+	for i, w := range windows {
+		window, err := Parse(w)
+		if err != nil {
+			return nil, fmt.Errorf("deployment window %d: %w", i, err)
+		}
+		parsed = append(parsed, window)
+	}
+	return parsed, nil
+}
+
+// openUntil returns the time at which the window closes if it is open at t. Occurrences which overlap or touch each
+// other are treated as a single open period.
+func (w *Window) openUntil(t time.Time) (time.Time, bool) {
+	start := w.next(t.Add(-w.duration))
+	if start.IsZero() || start.After(t) {
+		return time.Time{}, false
+	}
+	end := start.Add(w.duration)
+	for i := 0; i < maxOccurrences; i++ {
+		start = w.next(start)
+		if start.IsZero() || start.After(end) {
+			break
+		}
+		if candidate := start.Add(w.duration); candidate.After(end) {
+			end = candidate
+		}
+	}
+	return end, true
+}
+
+// Allowed returns whether new revisions are allowed to progress at t. This is the case when no block window is open
+// and, if there are any allow windows, at least one of them is open.
+func (ws Windows) Allowed(t time.Time) bool {
+	hasAllow := false
+	allowOpen := false
+	for _, w := range ws {
+		_, open := w.openUntil(t)
+		switch w.kind {
+		case v1alpha1.DeploymentWindowKindBlock:
+			if open {
+				return false
+			}
+		case v1alpha1.DeploymentWindowKindAllow:
+			hasAllow = true
+			allowOpen = allowOpen || open
+		}
+	}
+	return !hasAllow || allowOpen
+}
+
+// NextAllowed returns the first time at or after t at which new revisions are allowed to progress. It returns false
+// if no such time is found within a year.
+func (ws Windows) NextAllowed(t time.Time) (time.Time, bool) {
+	horizon := t.Add(searchHorizon)
+	for i := 0; i < maxBoundaries && !t.After(horizon); i++ {
+		if ws.Allowed(t) {
+			return t, true
+		}
+		// the result can only change at the end of an open block window or at the start of an allow window
+		var boundary time.Time
+		for _, w := range ws {
+			var candidate time.Time
+			switch w.kind {
+			case v1alpha1.DeploymentWindowKindBlock:
+				end, open := w.openUntil(t)
+				if !open {
+					continue
+				}
+				candidate = end
+			case v1alpha1.DeploymentWindowKindAllow:
+				candidate = w.next(t)
+			}
+			if !candidate.IsZero() && (boundary.IsZero() || candidate.Before(boundary)) {
+				boundary = candidate
+			}
+		}
+		if boundary.IsZero() {
+			return time.Time{}, false
+		}
+		t = boundary
+	}
+	return time.Time{}, false
+}
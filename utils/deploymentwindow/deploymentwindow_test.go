@@ -0,0 +1,199 @@
+package deploymentwindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func mustParse(t *testing.T, windows ...v1alpha1.DeploymentWindow) Windows {
+	t.Helper()
+	parsed, err := ParseAll(windows)
+	require.NoError(t, err)
+	return parsed
+}
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// businessHours allows deployments from 09:00 to 17:00 on weekdays
+var businessHours = v1alpha1.DeploymentWindow{
+	Kind:     v1alpha1.DeploymentWindowKindAllow,
+	Schedule: "0 9 * * 1-5",
+	Duration: "8h",
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		window v1alpha1.DeploymentWindow
+		err    string
+	}{
+		{
+			name:   "cron",
+			window: businessHours,
+		},
+		{
+			name: "rrule",
+			window: v1alpha1.DeploymentWindow{
+				Kind:     v1alpha1.DeploymentWindowKindBlock,
+				RRule:    "DTSTART:20241220T000000Z\nRRULE:FREQ=YEARLY",
+				Duration: "336h",
+			},
+		},
+		{
+			name:   "invalid kind",
+			window: v1alpha1.DeploymentWindow{Kind: "Maybe", Schedule: "0 9 * * *", Duration: "1h"},
+			err:    "kind must be either Allow or Block",
+		},
+		{
+			name:   "no schedule",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, Duration: "1h"},
+			err:    "exactly one of schedule or rrule must be specified",
+		},
+		{
+			name:   "schedule and rrule",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, Schedule: "0 9 * * *", RRule: "RRULE:FREQ=DAILY", Duration: "1h"},
+			err:    "exactly one of schedule or rrule must be specified",
+		},
+		{
+			name:   "invalid duration",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, Schedule: "0 9 * * *", Duration: "forever"},
+			err:    "invalid duration 'forever'",
+		},
+		{
+			name:   "zero duration",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, Schedule: "0 9 * * *", Duration: "0s"},
+			err:    "duration must be greater than zero",
+		},
+		{
+			name:   "invalid time zone",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, Schedule: "0 9 * * *", Duration: "1h", TimeZone: "Mars/Olympus_Mons"},
+			err:    "invalid time zone 'Mars/Olympus_Mons'",
+		},
+		{
+			name:   "invalid schedule",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, Schedule: "every day", Duration: "1h"},
+			err:    "invalid schedule 'every day'",
+		},
+		{
+			name:   "rrule without DTSTART",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, RRule: "RRULE:FREQ=DAILY", Duration: "1h"},
+			err:    "rrule must specify DTSTART",
+		},
+		{
+			name:   "invalid rrule",
+			window: v1alpha1.DeploymentWindow{Kind: v1alpha1.DeploymentWindowKindAllow, RRule: "DTSTART:20240101T000000Z\nRRULE:FREQ=SOMETIMES", Duration: "1h"},
+			err:    "invalid rrule",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.window)
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	windows := mustParse(t, businessHours)
+	// 2024-01-01 is a Monday
+	assert.False(t, windows.Allowed(date("2024-01-01T08:59:59Z")))
+	assert.True(t, windows.Allowed(date("2024-01-01T09:00:00Z")))
+	assert.True(t, windows.Allowed(date("2024-01-01T16:59:59Z")))
+	assert.False(t, windows.Allowed(date("2024-01-01T17:00:00Z")))
+	assert.False(t, windows.Allowed(date("2024-01-06T12:00:00Z")))
+
+	assert.True(t, Windows{}.Allowed(date("2024-01-06T12:00:00Z")))
+}
+
+func TestAllowedBlockTakesPrecedence(t *testing.T) {
+	freeze := v1alpha1.DeploymentWindow{
+		Kind:     v1alpha1.DeploymentWindowKindBlock,
+		RRule:    "DTSTART:20241220T000000Z\nRRULE:FREQ=YEARLY",
+		Duration: "336h",
+	}
+	windows := mustParse(t, businessHours, freeze)
+	assert.True(t, windows.Allowed(date("2024-12-19T12:00:00Z")))
+	assert.False(t, windows.Allowed(date("2024-12-20T12:00:00Z")))
+	assert.False(t, windows.Allowed(date("2025-01-02T12:00:00Z")))
+	assert.True(t, windows.Allowed(date("2025-01-03T12:00:00Z")))
+
+	// only block windows allow everything outside of them
+	windows = mustParse(t, freeze)
+	assert.True(t, windows.Allowed(date("2024-12-01T03:00:00Z")))
+	assert.False(t, windows.Allowed(date("2024-12-25T03:00:00Z")))
+}
+
+func TestAllowedTimeZone(t *testing.T) {
+	window := businessHours
+	window.TimeZone = "America/New_York"
+	windows := mustParse(t, window)
+	assert.False(t, windows.Allowed(date("2024-01-01T09:00:00Z")))
+	assert.True(t, windows.Allowed(date("2024-01-01T14:00:00Z")))
+	assert.True(t, windows.Allowed(date("2024-01-01T21:59:59Z")))
+	assert.False(t, windows.Allowed(date("2024-01-01T22:00:00Z")))
+}
+
+func TestAllowedMergesOverlappingOccurrences(t *testing.T) {
+	windows := mustParse(t, v1alpha1.DeploymentWindow{
+		Kind:     v1alpha1.DeploymentWindowKindBlock,
+		Schedule: "0 * * * *",
+		Duration: "90m",
+	})
+	end, open := windows[0].openUntil(date("2024-01-01T10:30:00Z"))
+	assert.True(t, open)
+	assert.True(t, end.After(date("2024-01-02T10:30:00Z")))
+}
+
+func TestNextAllowed(t *testing.T) {
+	windows := mustParse(t, businessHours)
+
+	next, ok := windows.NextAllowed(date("2024-01-01T12:00:00Z"))
+	assert.True(t, ok)
+	assert.Equal(t, date("2024-01-01T12:00:00Z"), next)
+
+	next, ok = windows.NextAllowed(date("2024-01-01T18:00:00Z"))
+	assert.True(t, ok)
+	assert.True(t, date("2024-01-02T09:00:00Z").Equal(next))
+
+	// Saturday waits until Monday
+	next, ok = windows.NextAllowed(date("2024-01-06T12:00:00Z"))
+	assert.True(t, ok)
+	assert.True(t, date("2024-01-08T09:00:00Z").Equal(next))
+}
+
+func TestNextAllowedAfterBlock(t *testing.T) {
+	windows := mustParse(t, businessHours, v1alpha1.DeploymentWindow{
+		Kind:     v1alpha1.DeploymentWindowKindBlock,
+		RRule:    "DTSTART:20241220T000000Z\nRRULE:FREQ=YEARLY",
+		Duration: "336h",
+	})
+	// the freeze ends on Friday 2025-01-03 at midnight, which is outside of business hours
+	next, ok := windows.NextAllowed(date("2024-12-23T10:00:00Z"))
+	assert.True(t, ok)
+	assert.True(t, date("2025-01-03T09:00:00Z").Equal(next))
+}
+
+func TestNextAllowedNever(t *testing.T) {
+	windows := mustParse(t, v1alpha1.DeploymentWindow{
+		Kind:     v1alpha1.DeploymentWindowKindAllow,
+		RRule:    "DTSTART:20200101T000000Z\nRRULE:FREQ=DAILY;COUNT=1",
+		Duration: "1h",
+	})
+	_, ok := windows.NextAllowed(date("2024-01-01T00:00:00Z"))
+	assert.False(t, ok)
+}
@@ -33,28 +33,54 @@ func EvaluateResult(result any, metric v1alpha1.Metric, logCtx logrus.Entry) (v1
 		}
 	}
 
+	return assessConditions(metric.SuccessCondition, metric.FailureCondition, successCondition, failCondition), nil
+}
+
+// EvaluateCompositeResult evaluates the composite conditions of an analysis run over the results of its metrics
+func EvaluateCompositeResult(results map[string]any, conditions v1alpha1.CompositeConditions) (v1alpha1.AnalysisPhase, error) {
+	successCondition := false
+	failCondition := false
+	var err error
+
+	if conditions.SuccessCondition != "" {
+		successCondition, err = EvalCompositeCondition(results, conditions.SuccessCondition)
+		if err != nil {
+			return v1alpha1.AnalysisPhaseError, fmt.Errorf("could not evaluate compositeConditions.successCondition \"%s\": %w", conditions.SuccessCondition, err)
+		}
+	}
+	if conditions.FailureCondition != "" {
+		failCondition, err = EvalCompositeCondition(results, conditions.FailureCondition)
+		if err != nil {
+			return v1alpha1.AnalysisPhaseError, fmt.Errorf("could not evaluate compositeConditions.failureCondition \"%s\": %w", conditions.FailureCondition, err)
+		}
+	}
+	return assessConditions(conditions.SuccessCondition, conditions.FailureCondition, successCondition, failCondition), nil
+}
+
+// assessConditions returns the phase of the outcome of a success and a failure condition, either of which may be unset
+func assessConditions(successExpression, failureExpression string, successCondition, failCondition bool) v1alpha1.AnalysisPhase {
 	switch {
-	case metric.SuccessCondition == "" && metric.FailureCondition == "":
+	case successExpression == "" && failureExpression == "":
 		//Always return success unless there is an error
-		return v1alpha1.AnalysisPhaseSuccessful, nil
-	case metric.SuccessCondition != "" && metric.FailureCondition == "":
+		return v1alpha1.AnalysisPhaseSuccessful
+	case successExpression != "" && failureExpression == "":
 		// Without a failure condition, a measurement is considered a failure if the measurement's success condition is not true
 		failCondition = !successCondition
-	case metric.SuccessCondition == "" && metric.FailureCondition != "":
+	case successExpression == "" && failureExpression != "":
 		// Without a success condition, a measurement is considered a successful if the measurement's failure condition is not true
 		successCondition = !failCondition
 	}
 
 	if failCondition {
-		return v1alpha1.AnalysisPhaseFailed, nil
+		return v1alpha1.AnalysisPhaseFailed
 	}
 
 	if !failCondition && !successCondition {
-		return v1alpha1.AnalysisPhaseInconclusive, nil
+		return v1alpha1.AnalysisPhaseInconclusive
 	}
 
 	// If we reach this code path, failCondition is false and successCondition is true
-	return v1alpha1.AnalysisPhaseSuccessful, nil
+	return v1alpha1.AnalysisPhaseSuccessful
 }
 
 // formatEvalError wraps an expression evaluation error with context about the condition,
@@ -151,6 +177,71 @@ func EvalCondition(resultValue any, condition string) (bool, error) {
 	}
 }
 
+// EvalCompositeCondition evaluates a composite condition with the results of the metrics, keyed by metric name, as
+// an input
+func EvalCompositeCondition(results map[string]any, condition string) (bool, error) {
+	env := compositeEnv(results)
+	program, err := expr.Compile(condition, expr.Env(env))
+	if err != nil {
+		return false, unwrapFileError(err)
+	}
+
+	output, err := expr.Run(program, env)
+	if err != nil {
+		return false, unwrapFileError(err)
+	}
+
+	switch val := output.(type) {
+	case bool:
+		return val, nil
+	default:
+		return false, fmt.Errorf("expected bool, but got %T", val)
+	}
+}
+
+// CompileCompositeCondition verifies that a composite condition only references the given metrics
+func CompileCompositeCondition(metricNames []string, condition string) error {
+	results := make(map[string]any, len(metricNames))
+	for _, name := range metricNames {
+		results[name] = map[string]any{}
+	}
+	_, err := expr.Compile(condition, expr.Env(compositeEnv(results)))
+	return unwrapFileError(err)
+}
+
+// compositeEnv returns the environment of the composite conditions. The results of the metrics are available by
+// metric name, unless the name collides with a builtin, and through the metrics map.
+func compositeEnv(results map[string]any) map[string]any {
+	env := map[string]any{}
+	for name, result := range results {
+		env[name] = result
+	}
+	builtins := map[string]any{
+		"metrics":      results,
+		"asInt":        asInt,
+		"asFloat":      asFloat,
+		"isNaN":        math.IsNaN,
+		"isInf":        isInf,
+		"Pending":      string(v1alpha1.AnalysisPhasePending),
+		"Running":      string(v1alpha1.AnalysisPhaseRunning),
+		"Successful":   string(v1alpha1.AnalysisPhaseSuccessful),
+		"Failed":       string(v1alpha1.AnalysisPhaseFailed),
+		"Inconclusive": string(v1alpha1.AnalysisPhaseInconclusive),
+		"Error":        string(v1alpha1.AnalysisPhaseError),
+	}
+	for name, builtin := range builtins {
+		env[name] = builtin
+	}
+	return env
+}
+
+func unwrapFileError(err error) error {
+	if fileErr, ok := err.(*file.Error); ok {
+		return errors.New(fileErr.Message)
+	}
+	return err
+}
+
 func isInf(f float64) bool {
 	return math.IsInf(f, 0)
 }
@@ -404,3 +404,50 @@ func TestIsNilOrEmpty(t *testing.T) {
 	assert.False(t, isNilOrEmpty(42))
 	assert.False(t, isNilOrEmpty("hello"))
 }
+
+func newCompositeResults() map[string]any {
+	return map[string]any{
+		"errorRate":  map[string]any{"phase": "Failed", "value": 0.2, "count": 5},
+		"latency":    map[string]any{"phase": "Successful", "value": 250.0, "count": 5},
+		"traffic":    map[string]any{"phase": "Successful", "value": 8.0, "count": 5},
+		"error-rate": map[string]any{"phase": "Successful", "value": 0.01, "count": 5},
+	}
+}
+
+func TestEvaluateCompositeResult(t *testing.T) {
+	results := newCompositeResults()
+	tests := []struct {
+		conditions v1alpha1.CompositeConditions
+		phase      v1alpha1.AnalysisPhase
+	}{
+		{v1alpha1.CompositeConditions{SuccessCondition: "errorRate.phase == Successful || (latency.value < 300 && traffic.value < 10)"}, v1alpha1.AnalysisPhaseSuccessful},
+		{v1alpha1.CompositeConditions{SuccessCondition: "errorRate.phase == Successful && latency.value < 300"}, v1alpha1.AnalysisPhaseFailed},
+		{v1alpha1.CompositeConditions{FailureCondition: "latency.value >= 300"}, v1alpha1.AnalysisPhaseSuccessful},
+		{v1alpha1.CompositeConditions{SuccessCondition: "latency.value < 200", FailureCondition: "latency.value >= 300"}, v1alpha1.AnalysisPhaseInconclusive},
+		{v1alpha1.CompositeConditions{SuccessCondition: `metrics["error-rate"].phase == Successful`}, v1alpha1.AnalysisPhaseSuccessful},
+		// weighted score of the metrics
+		{v1alpha1.CompositeConditions{SuccessCondition: "(errorRate.phase == Successful ? 0.5 : 0) + (latency.phase == Successful ? 0.3 : 0) + (traffic.phase == Successful ? 0.2 : 0) >= 0.5"}, v1alpha1.AnalysisPhaseSuccessful},
+	}
+	for _, test := range tests {
+		phase, err := EvaluateCompositeResult(results, test.conditions)
+		assert.NoError(t, err)
+		assert.Equal(t, test.phase, phase, test.conditions)
+	}
+}
+
+func TestEvaluateCompositeResultError(t *testing.T) {
+	phase, err := EvaluateCompositeResult(newCompositeResults(), v1alpha1.CompositeConditions{SuccessCondition: "latency.value"})
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, phase)
+	assert.EqualError(t, err, `could not evaluate compositeConditions.successCondition "latency.value": expected bool, but got float64`)
+
+	phase, err = EvaluateCompositeResult(newCompositeResults(), v1alpha1.CompositeConditions{FailureCondition: "unknown.phase == Failed"})
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, phase)
+	assert.ErrorContains(t, err, `could not evaluate compositeConditions.failureCondition "unknown.phase == Failed": unknown name unknown`)
+}
+
+func TestCompileCompositeCondition(t *testing.T) {
+	assert.NoError(t, CompileCompositeCondition([]string{"errorRate", "latency"}, "errorRate.phase == Successful && latency.value < 300"))
+	assert.NoError(t, CompileCompositeCondition([]string{"error-rate"}, `metrics["error-rate"].phase == Successful`))
+	assert.ErrorContains(t, CompileCompositeCondition([]string{"errorRate"}, "latency.value < 300"), "unknown name latency")
+	assert.Error(t, CompileCompositeCondition([]string{"errorRate"}, "errorRate.phase =="))
+}
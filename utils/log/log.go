@@ -20,6 +20,8 @@ const (
 	RolloutKey = "rollout"
 	// ExperimentKey defines the key for the experiment field
 	ExperimentKey = "experiment"
+	// ReleaseTrainKey defines the key for the releasetrain field
+	ReleaseTrainKey = "releasetrain"
 	// AnalysisRunKey defines the key for the analysisrun field
 	AnalysisRunKey = "analysisrun"
 	// ServiceKey defines the key for the service field
@@ -60,6 +62,8 @@ func WithObject(obj runtime.Object) *log.Entry {
 			kind = "clusteranalysistemplate"
 		case *v1alpha1.Experiment:
 			kind = "experiment"
+		case *v1alpha1.ReleaseTrain:
+			kind = "releasetrain"
 		}
 	}
 	objectMeta, err := meta.Accessor(obj)
@@ -86,6 +90,8 @@ func KindNamespaceName(logCtx *log.Entry) (string, string, string) {
 		kind = "Experiment"
 	} else if nameIf, ok = logCtx.Data["clusteranalysistemplate"]; ok {
 		kind = "ClusterAnalysisTemplate"
+	} else if nameIf, ok = logCtx.Data["releasetrain"]; ok {
+		kind = "ReleaseTrain"
 	}
 	name, _ := nameIf.(string)
 	namespace, _ := logCtx.Data["namespace"].(string)
@@ -102,6 +108,11 @@ func WithExperiment(experiment *v1alpha1.Experiment) *log.Entry {
 	return log.WithField(ExperimentKey, experiment.Name).WithField(NamespaceKey, experiment.Namespace)
 }
 
+// WithReleaseTrain returns a logging context for ReleaseTrains
+func WithReleaseTrain(train *v1alpha1.ReleaseTrain) *log.Entry {
+	return log.WithField(ReleaseTrainKey, train.Name).WithField(NamespaceKey, train.Namespace)
+}
+
 // WithAnalysisRun returns a logging context for AnalysisRun
 func WithAnalysisRun(ar *v1alpha1.AnalysisRun) *log.Entry {
 	return log.WithField(AnalysisRunKey, ar.Name).WithField(NamespaceKey, ar.Namespace)
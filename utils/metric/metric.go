@@ -1,12 +1,101 @@
 package metric
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
-// MarkMeasurementError sets an error message on a measurement along with finish time
+// ThrottledMetadataKey is the metadata key marking a measurement which was rescheduled because its metric provider
+// was throttled
+const ThrottledMetadataKey = "throttled"
+
+// ThrottledError is the error of a query which was rejected because the metric provider is rate limited
+type ThrottledError struct {
+	// RetryAfter is the delay requested by the metric provider, if any
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *ThrottledError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return e.Err
+}
+
+// IsThrottledResponse returns whether the response of a metric provider reports that the query was rate limited
+func IsThrottledResponse(response *http.Response) bool {
+	return response.StatusCode == http.StatusTooManyRequests
+}
+
+// NewThrottledError returns the error of a rate limited response, honoring its Retry-After header
+func NewThrottledError(response *http.Response, err error) error {
+	return &ThrottledError{
+		RetryAfter: ParseRetryAfter(response.Header.Get("Retry-After"), timeutil.Now()),
+		Err:        err,
+	}
+}
+
+// throttledRoundTripper turns the rate limited responses into a ThrottledError, for the metric providers whose
+// clients do not return the response of a failed query
+type throttledRoundTripper struct {
+	roundTripper http.RoundTripper
+}
+
+// NewThrottledRoundTripper returns a round tripper which fails the rate limited responses of the round tripper with a
+// ThrottledError, or of the default transport when it is nil
+func NewThrottledRoundTripper(roundTripper http.RoundTripper) http.RoundTripper {
+	if roundTripper == nil {
+		roundTripper = http.DefaultTransport
+	}
+	return throttledRoundTripper{roundTripper: roundTripper}
+}
+
+func (rt throttledRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	response, err := rt.roundTripper.RoundTrip(req)
+	if err != nil || !IsThrottledResponse(response) {
+		return response, err
+	}
+	defer response.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	return nil, NewThrottledError(response, fmt.Errorf("received rate limited response code: %v %s", response.StatusCode, string(body)))
+}
+
+// ParseRetryAfter returns the delay of a Retry-After header, which is either a number of seconds or an HTTP date.
+// It returns zero when the header is missing or invalid.
+func ParseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// MarkMeasurementError sets an error message on a measurement along with finish time. Errors caused by the metric
+// provider being throttled do not error the measurement, which is rescheduled instead.
 func MarkMeasurementError(m v1alpha1.Measurement, err error) v1alpha1.Measurement {
+	var throttledErr *ThrottledError
+	if errors.As(err, &throttledErr) {
+		return MarkMeasurementThrottled(m, throttledErr.RetryAfter, err.Error())
+	}
 	m.Phase = v1alpha1.AnalysisPhaseError
 	m.Message = err.Error()
 	if m.FinishedAt == nil {
@@ -15,3 +104,26 @@ func MarkMeasurementError(m v1alpha1.Measurement, err error) v1alpha1.Measuremen
 	}
 	return m
 }
+
+// MarkMeasurementThrottled reschedules a measurement whose metric provider is throttled. The measurement stays in
+// flight until its ResumeAt, when it is taken again, and is not counted as an error.
+func MarkMeasurementThrottled(m v1alpha1.Measurement, retryAfter time.Duration, message string) v1alpha1.Measurement {
+	m.Phase = v1alpha1.AnalysisPhaseRunning
+	m.Message = fmt.Sprintf("Metric provider throttled: %s", message)
+	m.FinishedAt = nil
+	m.ResumeAt = nil
+	if retryAfter > 0 {
+		resumeAt := metav1.NewTime(timeutil.Now().Add(retryAfter))
+		m.ResumeAt = &resumeAt
+	}
+	if m.Metadata == nil {
+		m.Metadata = map[string]string{}
+	}
+	m.Metadata[ThrottledMetadataKey] = "true"
+	return m
+}
+
+// IsMeasurementThrottled returns whether a measurement was rescheduled because its metric provider was throttled
+func IsMeasurementThrottled(m v1alpha1.Measurement) bool {
+	return m.Metadata[ThrottledMetadataKey] == "true"
+}
@@ -2,7 +2,11 @@ package metric
 
 import (
 	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,3 +24,62 @@ func TestMarkMeasurementError(t *testing.T) {
 	assert.Equal(t, err.Error(), m.Message)
 	assert.NotNil(t, m.FinishedAt)
 }
+
+func TestMarkMeasurementErrorThrottled(t *testing.T) {
+	now := metav1.Now()
+	m := v1alpha1.Measurement{
+		StartedAt: &now,
+	}
+	err := fmt.Errorf("query failed: %w", &ThrottledError{RetryAfter: time.Minute, Err: errors.New("received rate limited response code: 429")})
+	m = MarkMeasurementError(m, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, m.Phase)
+	assert.Equal(t, "Metric provider throttled: query failed: received rate limited response code: 429", m.Message)
+	assert.Nil(t, m.FinishedAt)
+	assert.NotNil(t, m.ResumeAt)
+	assert.True(t, IsMeasurementThrottled(m))
+}
+
+func TestMarkMeasurementThrottledWithoutRetryAfter(t *testing.T) {
+	m := MarkMeasurementThrottled(v1alpha1.Measurement{}, 0, "rate limited")
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, m.Phase)
+	assert.Nil(t, m.ResumeAt)
+	assert.True(t, IsMeasurementThrottled(m))
+	assert.False(t, IsMeasurementThrottled(v1alpha1.Measurement{}))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, 120*time.Second, ParseRetryAfter("120", now))
+	assert.Equal(t, 30*time.Second, ParseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), ParseRetryAfter(now.Add(-30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), ParseRetryAfter("-1", now))
+	assert.Equal(t, time.Duration(0), ParseRetryAfter("soon", now))
+	assert.Equal(t, time.Duration(0), ParseRetryAfter("", now))
+}
+
+func TestThrottledRoundTripper(t *testing.T) {
+	throttled := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if throttled {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte("slow down"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: NewThrottledRoundTripper(nil)}
+
+	_, err := client.Get(server.URL)
+	var throttledErr *ThrottledError
+	assert.ErrorAs(t, err, &throttledErr)
+	assert.Equal(t, 30*time.Second, throttledErr.RetryAfter)
+	assert.Contains(t, err.Error(), "received rate limited response code: 429 slow down")
+
+	throttled = false
+	response, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response.Body.Close()
+}
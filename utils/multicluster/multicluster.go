@@ -0,0 +1,285 @@
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ClusterSecretTypeLabel is the label identifying secrets which hold the kubeconfig of a remote cluster
+	ClusterSecretTypeLabel = "rollout.argoproj.io/secret-type"
+	// ClusterSecretType is the value of ClusterSecretTypeLabel for remote cluster secrets
+	ClusterSecretType = "cluster"
+	// KubeconfigKey is the key of the secret data which holds the kubeconfig of the remote cluster
+	KubeconfigKey = "kubeconfig"
+	// SourceRolloutAnnotation is set on ReplicaSets managed in a remote cluster to the namespace/name of the Rollout
+	// which manages them
+	SourceRolloutAnnotation = "rollout.argoproj.io/source-rollout"
+	// ManagedReplicaSetLabel is set on ReplicaSets managed in a remote cluster, so that only those are watched
+	ManagedReplicaSetLabel = "rollout.argoproj.io/managed-replicaset"
+	// ManagedReplicaSetLabelValue is the value of ManagedReplicaSetLabel
+	ManagedReplicaSetLabelValue = "true"
+
+	// cacheSyncTimeout bounds the time spent waiting for the informer of a newly connected cluster to sync
+	cacheSyncTimeout = 30 * time.Second
+	// minConnectBackoff and maxConnectBackoff bound the time before a failed connection to a cluster is retried
+	minConnectBackoff = 5 * time.Second
+	maxConnectBackoff = 5 * time.Minute
+)
+
+// Cluster is a connection to a remote cluster
+type Cluster struct {
+	// Name is the name of the secret holding the kubeconfig of the cluster
+	Name string
+	// KubeClient is a clientset for the cluster
+	KubeClient kubernetes.Interface
+	// ReplicaSetLister lists the ReplicaSets of the cluster from an informer cache
+	ReplicaSetLister appslisters.ReplicaSetLister
+}
+
+// Provider returns connections to remote clusters
+type Provider interface {
+	// Get returns the connection to the remote cluster with the given name
+	Get(name string) (*Cluster, error)
+	// AddReplicaSetEventHandler adds an event handler to the ReplicaSet informers of all remote clusters
+	AddReplicaSetEventHandler(handler cache.ResourceEventHandler)
+}
+
+type connection struct {
+	Cluster
+	informer        cache.SharedIndexInformer
+	resourceVersion string
+	cancel          context.CancelFunc
+}
+
+// failedConnection is a failed attempt to connect to a cluster, which is not retried before retryAt unless the
+// secret of the cluster changes
+type failedConnection struct {
+	err             error
+	resourceVersion string
+	backoff         time.Duration
+	retryAt         time.Time
+}
+
+// SecretProvider connects to the remote clusters described by the labeled kubeconfig secrets of a namespace. The
+// secrets are only watched once a cluster is first requested, and the connection to a cluster and its informer are
+// created on first use and recreated when the secret changes.
+type SecretProvider struct {
+	namespace      string
+	secretInformer cache.SharedIndexInformer
+	secretLister   corelisters.SecretLister
+	resyncPeriod   time.Duration
+	// newClient creates the clientset of a cluster. Overridden in unit tests
+	newClient func(config *rest.Config) (kubernetes.Interface, error)
+
+	secretInformerOnce sync.Once
+	// connecting ensures a single connection attempt per cluster, made without holding the lock so that a slow or
+	// unreachable cluster does not block the callers getting the other clusters
+	connecting singleflight.Group
+
+	lock        sync.Mutex
+	ctx         context.Context
+	connections map[string]*connection
+	failures    map[string]*failedConnection
+	handlers    []cache.ResourceEventHandler
+}
+
+// NewSecretProvider returns a provider of the remote clusters whose kubeconfig secrets are in the given namespace
+func NewSecretProvider(kubeclientset kubernetes.Interface, namespace string, resyncPeriod time.Duration) *SecretProvider {
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeclientset, resyncPeriod,
+		kubeinformers.WithNamespace(namespace),
+		kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = fmt.Sprintf("%s=%s", ClusterSecretTypeLabel, ClusterSecretType)
+		}))
+	secretInformer := factory.Core().V1().Secrets()
+	return &SecretProvider{
+		namespace:      namespace,
+		secretInformer: secretInformer.Informer(),
+		secretLister:   secretInformer.Lister(),
+		resyncPeriod:   resyncPeriod,
+		newClient: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+		connections: map[string]*connection{},
+		failures:    map[string]*failedConnection{},
+	}
+}
+
+// Start sets the context of the provider. The cluster secrets are watched from the first call to Get, so that
+// controllers without multi-cluster rollouts do not watch them. Remote cluster informers are stopped when the context
+// is done.
+func (p *SecretProvider) Start(ctx context.Context) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.ctx = ctx
+}
+
+// AddReplicaSetEventHandler adds an event handler to the ReplicaSet informers of all remote clusters
+func (p *SecretProvider) AddReplicaSetEventHandler(handler cache.ResourceEventHandler) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.handlers = append(p.handlers, handler)
+	for _, conn := range p.connections {
+		conn.addReplicaSetEventHandler(handler)
+	}
+}
+
+// Get returns the connection to the remote cluster with the given name. A failed connection is retried with an
+// exponential backoff, or as soon as the secret of the cluster changes.
+func (p *SecretProvider) Get(name string) (*Cluster, error) {
+	p.lock.Lock()
+	ctx := p.ctx
+	p.lock.Unlock()
+	if ctx == nil {
+		return nil, fmt.Errorf("cluster provider is not started")
+	}
+	if err := p.waitForSecrets(ctx); err != nil {
+		return nil, err
+	}
+	secret, err := p.secretLister.Secrets(p.namespace).Get(name)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("cluster secret %s/%s not found", p.namespace, name)
+		}
+		return nil, err
+	}
+
+	p.lock.Lock()
+	if conn, ok := p.connections[name]; ok && conn.resourceVersion == secret.ResourceVersion {
+		p.lock.Unlock()
+		return &conn.Cluster, nil
+	}
+	if failure, ok := p.failures[name]; ok && failure.resourceVersion == secret.ResourceVersion && timeutil.Now().Before(failure.retryAt) {
+		p.lock.Unlock()
+		return nil, failure.err
+	}
+	p.lock.Unlock()
+
+	cluster, err, _ := p.connecting.Do(name+"/"+secret.ResourceVersion, func() (any, error) {
+		return p.connect(ctx, secret)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cluster.(*Cluster), nil
+}
+
+// waitForSecrets starts watching the cluster secrets on first use and waits for them to be cached
+func (p *SecretProvider) waitForSecrets(ctx context.Context) error {
+	p.secretInformerOnce.Do(func() {
+		go p.secretInformer.Run(ctx.Done())
+	})
+	if !p.secretInformer.HasSynced() && !cache.WaitForCacheSync(ctx.Done(), p.secretInformer.HasSynced) {
+		return fmt.Errorf("failed to wait for cluster secrets to sync")
+	}
+	return nil
+}
+
+// connect connects to the cluster of the secret and records the connection, or the failure to connect
+func (p *SecretProvider) connect(ctx context.Context, secret *corev1.Secret) (*Cluster, error) {
+	name := secret.Name
+	p.lock.Lock()
+	if conn, ok := p.connections[name]; ok {
+		if conn.resourceVersion == secret.ResourceVersion {
+			p.lock.Unlock()
+			return &conn.Cluster, nil
+		}
+		log.Infof("Cluster secret %s/%s changed, reconnecting", p.namespace, name)
+		conn.cancel()
+		delete(p.connections, name)
+	}
+	handlers := append([]cache.ResourceEventHandler(nil), p.handlers...)
+	p.lock.Unlock()
+
+	conn, err := p.newConnection(ctx, secret, handlers)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if err != nil {
+		failure := &failedConnection{err: err, resourceVersion: secret.ResourceVersion, backoff: minConnectBackoff}
+		if previous, ok := p.failures[name]; ok && previous.resourceVersion == secret.ResourceVersion {
+			failure.backoff = min(2*previous.backoff, maxConnectBackoff)
+		}
+		failure.retryAt = timeutil.Now().Add(failure.backoff)
+		p.failures[name] = failure
+		log.Warnf("Failed to connect to cluster %s, retrying in %s: %v", name, failure.backoff, err)
+		return nil, err
+	}
+	delete(p.failures, name)
+	// handlers added while connecting
+	for _, handler := range p.handlers[len(handlers):] {
+		conn.addReplicaSetEventHandler(handler)
+	}
+	log.Infof("Connected to cluster %s", name)
+	p.connections[name] = conn
+	return &conn.Cluster, nil
+}
+
+// newConnection creates a connection to the cluster of the secret and waits for its informer to sync
+func (p *SecretProvider) newConnection(ctx context.Context, secret *corev1.Secret, handlers []cache.ResourceEventHandler) (*connection, error) {
+	kubeconfig, ok := secret.Data[KubeconfigKey]
+	if !ok {
+		return nil, fmt.Errorf("cluster secret %s/%s has no %s key", p.namespace, secret.Name, KubeconfigKey)
+	}
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kubeconfig in cluster secret %s/%s: %w", p.namespace, secret.Name, err)
+	}
+	client, err := p.newClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	// only the ReplicaSets managed by rollouts are watched, rather than every ReplicaSet of the cluster
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(client, p.resyncPeriod, kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.LabelSelector = labels.Set{ManagedReplicaSetLabel: ManagedReplicaSetLabelValue}.AsSelector().String()
+	}))
+	replicaSetInformer := factory.Apps().V1().ReplicaSets()
+	conn := &connection{
+		Cluster: Cluster{
+			Name:             secret.Name,
+			KubeClient:       client,
+			ReplicaSetLister: replicaSetInformer.Lister(),
+		},
+		informer:        replicaSetInformer.Informer(),
+		resourceVersion: secret.ResourceVersion,
+		cancel:          cancel,
+	}
+	for _, handler := range handlers {
+		conn.addReplicaSetEventHandler(handler)
+	}
+	factory.Start(ctx.Done())
+
+	syncCtx, syncCancel := context.WithTimeout(ctx, cacheSyncTimeout)
+	defer syncCancel()
+	if !cache.WaitForCacheSync(syncCtx.Done(), conn.informer.HasSynced) {
+		cancel()
+		return nil, fmt.Errorf("timed out waiting for the ReplicaSets of cluster %s to sync", secret.Name)
+	}
+	return conn, nil
+}
+
+func (c *connection) addReplicaSetEventHandler(handler cache.ResourceEventHandler) {
+	if _, err := c.informer.AddEventHandler(handler); err != nil {
+		log.Warnf("Failed to add ReplicaSet event handler to cluster %s: %v", c.Name, err)
+	}
+}
@@ -0,0 +1,220 @@
+package multicluster
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: staging
+  cluster:
+    server: https://staging.example.com
+contexts:
+- name: staging
+  context:
+    cluster: staging
+    user: staging
+current-context: staging
+users:
+- name: staging
+  user:
+    token: secret-token
+`
+
+func newClusterSecret(name, resourceVersion string, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "argo-rollouts",
+			ResourceVersion: resourceVersion,
+			Labels:          map[string]string{ClusterSecretTypeLabel: ClusterSecretType},
+		},
+		Data: data,
+	}
+}
+
+func newProvider(t *testing.T, secrets ...*corev1.Secret) (*SecretProvider, *[]*rest.Config) {
+	kubeclient := k8sfake.NewSimpleClientset()
+	for _, secret := range secrets {
+		_, err := kubeclient.CoreV1().Secrets(secret.Namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	provider := NewSecretProvider(kubeclient, "argo-rollouts", 0)
+	var configs []*rest.Config
+	provider.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+		configs = append(configs, config)
+		return k8sfake.NewSimpleClientset(&appsv1.ReplicaSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "guestbook-abc123", Namespace: "default", Labels: map[string]string{ManagedReplicaSetLabel: ManagedReplicaSetLabelValue}},
+		}, &appsv1.ReplicaSet{
+			// not managed by a rollout, so not watched
+			ObjectMeta: metav1.ObjectMeta{Name: "other-def456", Namespace: "default"},
+		}), nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	provider.Start(ctx)
+	return provider, &configs
+}
+
+func TestGetNotStarted(t *testing.T) {
+	provider := NewSecretProvider(k8sfake.NewSimpleClientset(), "argo-rollouts", 0)
+	_, err := provider.Get("staging")
+	assert.EqualError(t, err, "cluster provider is not started")
+}
+
+func TestGetCluster(t *testing.T) {
+	provider, configs := newProvider(t, newClusterSecret("staging", "1", map[string][]byte{KubeconfigKey: []byte(testKubeconfig)}))
+
+	cluster, err := provider.Get("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cluster.Name)
+	require.Len(t, *configs, 1)
+	assert.Equal(t, "https://staging.example.com", (*configs)[0].Host)
+	assert.Equal(t, "secret-token", (*configs)[0].BearerToken)
+
+	rss, err := cluster.ReplicaSetLister.ReplicaSets("default").List(labels.Everything())
+	require.NoError(t, err)
+	assert.Len(t, rss, 1)
+
+	// the connection is reused while the secret is unchanged
+	again, err := provider.Get("staging")
+	require.NoError(t, err)
+	assert.Same(t, cluster, again)
+	assert.Len(t, *configs, 1)
+}
+
+func TestGetClusterReconnectsOnSecretChange(t *testing.T) {
+	secret := newClusterSecret("staging", "1", map[string][]byte{KubeconfigKey: []byte(testKubeconfig)})
+	provider, configs := newProvider(t, secret)
+
+	cluster, err := provider.Get("staging")
+	require.NoError(t, err)
+
+	updated := secret.DeepCopy()
+	updated.ResourceVersion = "2"
+	require.NoError(t, provider.secretInformer.GetIndexer().Update(updated))
+
+	reconnected, err := provider.Get("staging")
+	require.NoError(t, err)
+	assert.NotSame(t, cluster, reconnected)
+	assert.Len(t, *configs, 2)
+}
+
+func TestGetClusterErrors(t *testing.T) {
+	provider, _ := newProvider(t,
+		newClusterSecret("no-kubeconfig", "1", map[string][]byte{}),
+		newClusterSecret("invalid-kubeconfig", "1", map[string][]byte{KubeconfigKey: []byte("{")}),
+	)
+
+	_, err := provider.Get("prod")
+	assert.EqualError(t, err, "cluster secret argo-rollouts/prod not found")
+
+	_, err = provider.Get("no-kubeconfig")
+	assert.EqualError(t, err, "cluster secret argo-rollouts/no-kubeconfig has no kubeconfig key")
+
+	_, err = provider.Get("invalid-kubeconfig")
+	assert.ErrorContains(t, err, "invalid kubeconfig in cluster secret argo-rollouts/invalid-kubeconfig")
+}
+
+func TestAddReplicaSetEventHandler(t *testing.T) {
+	provider, _ := newProvider(t, newClusterSecret("staging", "1", map[string][]byte{KubeconfigKey: []byte(testKubeconfig)}))
+
+	added := make(chan string, 10)
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			added <- obj.(*appsv1.ReplicaSet).Name
+		},
+	}
+	provider.AddReplicaSetEventHandler(handler)
+
+	_, err := provider.Get("staging")
+	require.NoError(t, err)
+
+	select {
+	case name := <-added:
+		assert.Equal(t, "guestbook-abc123", name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("ReplicaSet add event was not handled")
+	}
+}
+
+func TestSecretsWatchedOnFirstGet(t *testing.T) {
+	provider, _ := newProvider(t, newClusterSecret("staging", "1", map[string][]byte{KubeconfigKey: []byte(testKubeconfig)}))
+	assert.False(t, provider.secretInformer.HasSynced())
+
+	_, err := provider.Get("staging")
+	require.NoError(t, err)
+	assert.True(t, provider.secretInformer.HasSynced())
+}
+
+func TestGetClusterBacksOffAfterFailedConnection(t *testing.T) {
+	now := time.Now()
+	timeutil.SetNowTimeFunc(func() time.Time { return now })
+	defer timeutil.SetNowTimeFunc(time.Now)
+	provider, _ := newProvider(t, newClusterSecret("staging", "1", map[string][]byte{KubeconfigKey: []byte(testKubeconfig)}))
+	attempts := 0
+	provider.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+		attempts++
+		return nil, errors.New("connection refused")
+	}
+
+	_, err := provider.Get("staging")
+	assert.EqualError(t, err, "connection refused")
+	_, err = provider.Get("staging")
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 1, attempts)
+
+	now = now.Add(minConnectBackoff)
+	_, err = provider.Get("staging")
+	assert.EqualError(t, err, "connection refused")
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 2*minConnectBackoff, provider.failures["staging"].backoff)
+}
+
+func TestGetClusterDoesNotWaitForOtherClusters(t *testing.T) {
+	slowKubeconfig := strings.ReplaceAll(testKubeconfig, "staging.example.com", "slow.example.com")
+	provider, _ := newProvider(t,
+		newClusterSecret("staging", "1", map[string][]byte{KubeconfigKey: []byte(testKubeconfig)}),
+		newClusterSecret("slow", "1", map[string][]byte{KubeconfigKey: []byte(slowKubeconfig)}),
+	)
+	connecting := make(chan struct{})
+	release := make(chan struct{})
+	provider.newClient = func(config *rest.Config) (kubernetes.Interface, error) {
+		if config.Host == "https://slow.example.com" {
+			close(connecting)
+			<-release
+		}
+		return k8sfake.NewSimpleClientset(), nil
+	}
+
+	slowErr := make(chan error)
+	go func() {
+		_, err := provider.Get("slow")
+		slowErr <- err
+	}()
+	<-connecting
+	cluster, err := provider.Get("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "staging", cluster.Name)
+
+	close(release)
+	assert.NoError(t, <-slowErr)
+}
@@ -3,6 +3,7 @@ package replicaset
 import (
 	"encoding/json"
 	"math"
+	"slices"
 
 	"github.com/argoproj/argo-rollouts/utils/annotations"
 
@@ -541,10 +542,10 @@ func GetDesiredCanaryWeight(rollout *v1alpha1.Rollout, newRS, stableRS *appsv1.R
 		// find next step to scale down NewRS to
 		for i := len(rollout.Spec.Strategy.Canary.Steps) - 1; i >= 0; i-- {
 			step := rollout.Spec.Strategy.Canary.Steps[i]
-			if step.SetWeight != nil {
-				stepReplicas := trafficWeightToReplicas(rolloutSpecReplica, *step.SetWeight, maxWeight)
+			if weight := GetStepWeight(step); weight != nil {
+				stepReplicas := trafficWeightToReplicas(rolloutSpecReplica, *weight, maxWeight)
 				if stepReplicas < canaryReplicas {
-					return *step.SetWeight
+					return *weight
 				}
 			}
 		}
@@ -568,10 +569,96 @@ func GetCurrentSetWeight(rollout *v1alpha1.Rollout) int32 {
 		return weightutil.MaxTrafficWeight(rollout)
 	}
 
+	if currentStep.SetWeightRamp != nil {
+		return GetWeightRampWeight(rollout)
+	}
 	for i := *currentStepIndex; i >= 0; i-- {
 		step := rollout.Spec.Strategy.Canary.Steps[i]
+		if weight := GetStepWeight(step); weight != nil {
+			return *weight
+		}
+	}
+	return 0
+}
+
+// GetStepWeight returns the weight a step leaves the canary at once it has completed, or nil if
+// the step does not change the weight. A setWeightRamp step leaves the canary at its final weight.
+func GetStepWeight(step v1alpha1.CanaryStep) *int32 {
+	if step.SetWeight != nil {
+		return step.SetWeight
+	}
+	if step.SetWeightRamp != nil {
+		return &step.SetWeightRamp.To
+	}
+	return nil
+}
+
+// GetWeightRampWeight returns the weight of the setWeightRamp step the rollout is currently at.
+// The ramp position is recorded in the status so that the ramp resumes where it left off after
+// an abort or a controller restart. Without a recorded position, the ramp starts at its initial weight.
+func GetWeightRampWeight(rollout *v1alpha1.Rollout) int32 {
+	currentStep, currentStepIndex := GetCurrentCanaryStep(rollout)
+	if currentStep == nil || currentStep.SetWeightRamp == nil {
+		return 0
+	}
+	ramp := currentStep.SetWeightRamp
+	status := rollout.Status.Canary.WeightRamp
+	if status == nil || status.StepIndex != *currentStepIndex {
+		return ramp.From
+	}
+	return min(max(status.Weight, ramp.From), ramp.To)
+}
+
+// GetClusterWeight returns the canary weight of the remote cluster with the given name, which is the weight of the
+// last setClusterWeight step up to the current step which lists the cluster, or 0 if there is none. A rollout which
+// is fully promoted or has completed its steps runs the canary at full weight in every cluster, and an aborted rollout
+// runs no canary in any cluster.
+func GetClusterWeight(rollout *v1alpha1.Rollout, cluster string) int32 {
+	if rollout.Status.StableRS == "" || rollout.Status.CurrentPodHash == rollout.Status.StableRS {
+		return 100
+	}
+	if rollout.Status.Abort {
+		return 0
+	}
+	currentStep, currentStepIndex := GetCurrentCanaryStep(rollout)
+	if rollout.Status.PromoteFull || currentStep == nil {
+		return 100
+	}
+	for i := *currentStepIndex; i >= 0; i-- {
+		step := rollout.Spec.Strategy.Canary.Steps[i]
+		if step.SetClusterWeight != nil && slices.Contains(step.SetClusterWeight.Clusters, cluster) {
+			return step.SetClusterWeight.Weight
+		}
+	}
+	return 0
+}
+
+// GetStatefulSetCanaryReplicas returns the number of pods of a StatefulSet which run the canary out of the given
+// replicas. It is set by the last setWeight or setCanaryScale step up to the current step, the weights being rounded
+// up. A setCanaryScale step which matches the traffic weight defers to the setWeight steps before it. A rollout which is fully
+// promoted or has completed its steps runs the canary in all pods.
+func GetStatefulSetCanaryReplicas(rollout *v1alpha1.Rollout, replicas int32) int32 {
+	currentStep, currentStepIndex := GetCurrentCanaryStep(rollout)
+	if rollout.Status.PromoteFull || currentStep == nil {
+		return replicas
+	}
+	weightToReplicas := func(weight int32) int32 {
+		return min(int32(math.Ceil(float64(replicas)*float64(weight)/100)), replicas)
+	}
+	matchWeight := false
+	for i := *currentStepIndex; i >= 0; i-- {
+		step := rollout.Spec.Strategy.Canary.Steps[i]
+		if scale := step.SetCanaryScale; scale != nil && !matchWeight {
+			if scale.MatchTrafficWeight {
+				matchWeight = true
+			} else if scale.Replicas != nil {
+				return min(*scale.Replicas, replicas)
+			} else if scale.Weight != nil {
+				return weightToReplicas(*scale.Weight)
+			}
+		}
 		if step.SetWeight != nil {
-			return *step.SetWeight
+			return weightToReplicas(*step.SetWeight)
 		}
 	}
 	return 0
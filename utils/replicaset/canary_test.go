@@ -1179,6 +1179,99 @@ func TestGetCurrentSetWeight(t *testing.T) {
 
 }
 
+func TestGetCurrentSetWeightWithWeightRamp(t *testing.T) {
+	rollout := newRollout(10, 10, intstr.FromInt(0), intstr.FromInt(1), "", "", nil, nil)
+	rollout.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{
+		{SetWeight: ptr.To[int32](5)},
+		{SetWeightRamp: &v1alpha1.SetWeightRamp{From: 10, To: 50, Increment: 5, Interval: "2m"}},
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	assert.Equal(t, int32(10), GetCurrentSetWeight(rollout))
+
+	// the recorded position of another step is ignored
+	rollout.Status.Canary.WeightRamp = &v1alpha1.WeightRampStatus{StepIndex: 3, Weight: 30}
+	assert.Equal(t, int32(10), GetCurrentSetWeight(rollout))
+
+	rollout.Status.Canary.WeightRamp = &v1alpha1.WeightRampStatus{StepIndex: 1, Weight: 30}
+	assert.Equal(t, int32(30), GetCurrentSetWeight(rollout))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](2)
+	assert.Equal(t, int32(50), GetCurrentSetWeight(rollout))
+
+	rollout.Status.Abort = true
+	assert.Equal(t, int32(0), GetCurrentSetWeight(rollout))
+}
+
+func TestGetClusterWeight(t *testing.T) {
+	rollout := newRollout(10, 10, intstr.FromInt(0), intstr.FromInt(1), "current", "stable", nil, nil)
+	rollout.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{
+		{SetWeight: ptr.To[int32](5)},
+		{SetClusterWeight: &v1alpha1.SetClusterWeight{Clusters: []string{"staging"}, Weight: 50}},
+		{SetClusterWeight: &v1alpha1.SetClusterWeight{Clusters: []string{"staging", "prod"}, Weight: 20}},
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](0)
+	assert.Equal(t, int32(0), GetClusterWeight(rollout, "staging"))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	assert.Equal(t, int32(50), GetClusterWeight(rollout, "staging"))
+	assert.Equal(t, int32(0), GetClusterWeight(rollout, "prod"))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](3)
+	assert.Equal(t, int32(20), GetClusterWeight(rollout, "staging"))
+	assert.Equal(t, int32(20), GetClusterWeight(rollout, "prod"))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](4)
+	assert.Equal(t, int32(100), GetClusterWeight(rollout, "prod"))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	rollout.Status.PromoteFull = true
+	assert.Equal(t, int32(100), GetClusterWeight(rollout, "prod"))
+
+	rollout.Status.Abort = true
+	assert.Equal(t, int32(0), GetClusterWeight(rollout, "staging"))
+
+	rollout.Status.StableRS = "current"
+	assert.Equal(t, int32(100), GetClusterWeight(rollout, "staging"))
+}
+
+func TestGetStatefulSetCanaryReplicas(t *testing.T) {
+	rollout := newRollout(10, 10, intstr.FromInt(0), intstr.FromInt(1), "current", "stable", nil, nil)
+	rollout.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{
+		{Pause: &v1alpha1.RolloutPause{}},
+		{SetWeight: ptr.To[int32](25)},
+		{SetCanaryScale: &v1alpha1.SetCanaryScale{Replicas: ptr.To[int32](1)}},
+		{SetCanaryScale: &v1alpha1.SetCanaryScale{MatchTrafficWeight: true}},
+		{SetCanaryScale: &v1alpha1.SetCanaryScale{Weight: ptr.To[int32](50)}},
+		{Pause: &v1alpha1.RolloutPause{}},
+	}
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](0)
+	assert.Equal(t, int32(0), GetStatefulSetCanaryReplicas(rollout, 5))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	assert.Equal(t, int32(2), GetStatefulSetCanaryReplicas(rollout, 5))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](2)
+	assert.Equal(t, int32(1), GetStatefulSetCanaryReplicas(rollout, 5))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](3)
+	assert.Equal(t, int32(2), GetStatefulSetCanaryReplicas(rollout, 5))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](5)
+	assert.Equal(t, int32(3), GetStatefulSetCanaryReplicas(rollout, 5))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](6)
+	assert.Equal(t, int32(5), GetStatefulSetCanaryReplicas(rollout, 5))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	rollout.Status.PromoteFull = true
+	assert.Equal(t, int32(5), GetStatefulSetCanaryReplicas(rollout, 5))
+}
+
 func TestAtDesiredReplicaCountsForCanary(t *testing.T) {
 
 	t.Run("we are at desired replica counts and availability", func(t *testing.T) {
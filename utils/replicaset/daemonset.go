@@ -0,0 +1,114 @@
+package replicaset
+
+import (
+	"math"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+// GetCurrentDaemonSetStep returns the current step of a DaemonSet rollout. If there are no steps or the rollout
+// has already executed the last step, the func returns nil
+func GetCurrentDaemonSetStep(rollout *v1alpha1.Rollout) (*v1alpha1.DaemonSetStep, *int32) {
+	if rollout.Spec.Strategy.DaemonSet == nil || len(rollout.Spec.Strategy.DaemonSet.Steps) == 0 {
+		return nil, nil
+	}
+	currentStepIndex := int32(0)
+	if rollout.Status.CurrentStepIndex != nil {
+		currentStepIndex = *rollout.Status.CurrentStepIndex
+	}
+	if len(rollout.Spec.Strategy.DaemonSet.Steps) <= int(currentStepIndex) {
+		return nil, &currentStepIndex
+	}
+	return &rollout.Spec.Strategy.DaemonSet.Steps[currentStepIndex], &currentStepIndex
+}
+
+// DaemonSetBeforeStartingStep checks if a DaemonSet rollout is before the starting step of its background analysis
+func DaemonSetBeforeStartingStep(rollout *v1alpha1.Rollout) bool {
+	if rollout.Spec.Strategy.DaemonSet == nil || rollout.Spec.Strategy.DaemonSet.Analysis == nil || rollout.Spec.Strategy.DaemonSet.Analysis.StartingStep == nil {
+		return false
+	}
+	_, currStep := GetCurrentDaemonSetStep(rollout)
+	if currStep == nil {
+		return false
+	}
+	return *currStep < *rollout.Spec.Strategy.DaemonSet.Analysis.StartingStep
+}
+
+// DaemonSetMaxUnavailable returns the maximum number of pods of a DaemonSet rollout which may be unavailable out of
+// the pods of the given number of nodes. A percentage is rounded up, and at least one pod may always be unavailable
+// so that the update can progress.
+func DaemonSetMaxUnavailable(rollout *v1alpha1.Rollout, nodes int32) int32 {
+	// Error caught by validation
+	unavailable, _ := intstrutil.GetScaledValueFromIntOrPercent(defaults.GetMaxUnavailableOrDefault(rollout), int(nodes), true)
+	return max(int32(unavailable), 1)
+}
+
+// GetDaemonSetUpdatedNodes returns the names of the nodes which run the new revision of a DaemonSet rollout, out of
+// the given nodes running the DaemonSet. They are the nodes of the cohorts of the steps up to the current step. All
+// nodes are updated once the steps are completed or the rollout is fully promoted, and none once it is aborted.
+func GetDaemonSetUpdatedNodes(rollout *v1alpha1.Rollout, nodes []*corev1.Node) (map[string]bool, error) {
+	updated := map[string]bool{}
+	if rollout.Status.Abort {
+		return updated, nil
+	}
+	currentStep, currentStepIndex := GetCurrentDaemonSetStep(rollout)
+	if rollout.Status.PromoteFull || currentStep == nil {
+		for _, node := range nodes {
+			updated[node.Name] = true
+		}
+		return updated, nil
+	}
+
+	sorted := make([]*corev1.Node, len(nodes))
+	copy(sorted, nodes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	for i := int32(0); i <= *currentStepIndex; i++ {
+		cohort := rollout.Spec.Strategy.DaemonSet.Steps[i].Cohort
+		if cohort == nil {
+			continue
+		}
+		if cohort.Percentage != nil {
+			count := int(math.Ceil(float64(len(sorted)) * float64(*cohort.Percentage) / 100))
+			for _, node := range sorted[:min(count, len(sorted))] {
+				updated[node.Name] = true
+			}
+		}
+		if cohort.NodeSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(cohort.NodeSelector)
+			if err != nil {
+				return nil, err
+			}
+			for _, node := range sorted {
+				if selector.Matches(labels.Set(node.Labels)) {
+					updated[node.Name] = true
+				}
+			}
+		}
+	}
+	return updated, nil
+}
+
+// DaemonSetCohortsSelectByLabel returns whether a cohort of the steps up to the current step selects its nodes by
+// their labels, in which case the labels of the nodes are needed to find the updated nodes
+func DaemonSetCohortsSelectByLabel(rollout *v1alpha1.Rollout) bool {
+	currentStep, currentStepIndex := GetCurrentDaemonSetStep(rollout)
+	if currentStep == nil {
+		return false
+	}
+	for i := int32(0); i <= *currentStepIndex; i++ {
+		cohort := rollout.Spec.Strategy.DaemonSet.Steps[i].Cohort
+		if cohort != nil && cohort.NodeSelector != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,114 @@
+package replicaset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newDaemonSetRollout(steps ...v1alpha1.DaemonSetStep) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{
+					Steps: steps,
+				},
+			},
+		},
+	}
+}
+
+func newNodes(names ...string) []*corev1.Node {
+	var nodes []*corev1.Node
+	for _, name := range names {
+		nodes = append(nodes, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"zone": name[:1]},
+			},
+		})
+	}
+	return nodes
+}
+
+func TestGetCurrentDaemonSetStep(t *testing.T) {
+	rollout := newDaemonSetRollout()
+	step, index := GetCurrentDaemonSetStep(rollout)
+	assert.Nil(t, step)
+	assert.Nil(t, index)
+
+	rollout = newDaemonSetRollout(v1alpha1.DaemonSetStep{Pause: &v1alpha1.RolloutPause{}})
+	step, index = GetCurrentDaemonSetStep(rollout)
+	assert.NotNil(t, step.Pause)
+	assert.Equal(t, int32(0), *index)
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	step, index = GetCurrentDaemonSetStep(rollout)
+	assert.Nil(t, step)
+	assert.Equal(t, int32(1), *index)
+}
+
+func TestDaemonSetBeforeStartingStep(t *testing.T) {
+	rollout := newDaemonSetRollout(v1alpha1.DaemonSetStep{Pause: &v1alpha1.RolloutPause{}}, v1alpha1.DaemonSetStep{Pause: &v1alpha1.RolloutPause{}})
+	assert.False(t, DaemonSetBeforeStartingStep(rollout))
+
+	rollout.Spec.Strategy.DaemonSet.Analysis = &v1alpha1.RolloutAnalysisBackground{StartingStep: ptr.To[int32](1)}
+	assert.True(t, DaemonSetBeforeStartingStep(rollout))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](1)
+	assert.False(t, DaemonSetBeforeStartingStep(rollout))
+}
+
+func TestDaemonSetMaxUnavailable(t *testing.T) {
+	rollout := newDaemonSetRollout()
+	assert.Equal(t, int32(1), DaemonSetMaxUnavailable(rollout, 10))
+
+	rollout.Spec.Strategy.DaemonSet.MaxUnavailable = ptr.To(intstr.FromString("25%"))
+	assert.Equal(t, int32(3), DaemonSetMaxUnavailable(rollout, 10))
+
+	rollout.Spec.Strategy.DaemonSet.MaxUnavailable = ptr.To(intstr.FromInt32(0))
+	assert.Equal(t, int32(1), DaemonSetMaxUnavailable(rollout, 10))
+}
+
+func TestGetDaemonSetUpdatedNodes(t *testing.T) {
+	rollout := newDaemonSetRollout(
+		v1alpha1.DaemonSetStep{Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](25)}},
+		v1alpha1.DaemonSetStep{Pause: &v1alpha1.RolloutPause{}},
+		v1alpha1.DaemonSetStep{Cohort: &v1alpha1.DaemonSetCohort{NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "b"}}}},
+		v1alpha1.DaemonSetStep{Pause: &v1alpha1.RolloutPause{}},
+	)
+	nodes := newNodes("b-2", "a-2", "b-1", "a-1", "c-1")
+
+	updated, err := GetDaemonSetUpdatedNodes(rollout, nodes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a-1": true, "a-2": true}, updated)
+	assert.False(t, DaemonSetCohortsSelectByLabel(rollout))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](3)
+	updated, err = GetDaemonSetUpdatedNodes(rollout, nodes)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"a-1": true, "a-2": true, "b-1": true, "b-2": true}, updated)
+	assert.True(t, DaemonSetCohortsSelectByLabel(rollout))
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](4)
+	updated, err = GetDaemonSetUpdatedNodes(rollout, nodes)
+	assert.NoError(t, err)
+	assert.Len(t, updated, 5)
+
+	rollout.Status.CurrentStepIndex = ptr.To[int32](0)
+	rollout.Status.PromoteFull = true
+	updated, err = GetDaemonSetUpdatedNodes(rollout, nodes)
+	assert.NoError(t, err)
+	assert.Len(t, updated, 5)
+
+	rollout.Status.Abort = true
+	updated, err = GetDaemonSetUpdatedNodes(rollout, nodes)
+	assert.NoError(t, err)
+	assert.Empty(t, updated)
+}
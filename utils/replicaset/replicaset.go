@@ -508,6 +508,9 @@ func ResetCurrentStepIndex(rollout *v1alpha1.Rollout) *int32 {
 	if rollout.Spec.Strategy.Canary != nil && len(rollout.Spec.Strategy.Canary.Steps) > 0 {
 		return ptr.To[int32](0)
 	}
+	if rollout.Spec.Strategy.DaemonSet != nil && len(rollout.Spec.Strategy.DaemonSet.Steps) > 0 {
+		return ptr.To[int32](0)
+	}
 	return nil
 }
 
@@ -554,6 +557,60 @@ func GetPodTemplateHash(rs *appsv1.ReplicaSet) string {
 	return rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 }
 
+// IsRollback reports whether the new ReplicaSet of the rollout is consistent with a rollback: it is either the
+// stable ReplicaSet or a ReplicaSet older than stable. This is a state check, not an event check: it is also true
+// for a rollout which was promoted, since the new ReplicaSet is then the stable ReplicaSet.
+func IsRollback(rollout *v1alpha1.Rollout, newRS, stableRS *appsv1.ReplicaSet) bool {
+	if newRS == nil || stableRS == nil {
+		return false
+	}
+	// rollbackToStable is also true when the rollout is already promoted
+	// since newRS is the stable RS. After it is completed, we cannot know if the rollout reached
+	// the stable state via a rollback or via a normal promotion.
+	rollbackToStable := rollout.Status.StableRS == GetPodTemplateHash(newRS)
+	return rollbackToStable || IsRollbackToPreviousRevision(newRS, stableRS)
+}
+
+// IsRollbackToPreviousRevision returns whether the new ReplicaSet is older than the stable ReplicaSet
+func IsRollbackToPreviousRevision(newRS, stableRS *appsv1.ReplicaSet) bool {
+	if newRS == nil || stableRS == nil {
+		return false
+	}
+	return newRS.CreationTimestamp.Before(&stableRS.CreationTimestamp)
+}
+
+// RollbackWindowSize returns the number of revisions between the new ReplicaSet of a rollback and the stable
+// ReplicaSet. The ReplicaSets of experiments are not revisions of the rollout.
+func RollbackWindowSize(newRS, stableRS *appsv1.ReplicaSet, allRSs []*appsv1.ReplicaSet) int32 {
+	var windowSize int32
+	for _, rs := range allRSs {
+		if rs.Annotations != nil && rs.Annotations[v1alpha1.ExperimentNameAnnotationKey] != "" {
+			continue
+		}
+
+		// is newRS < rs < stableRS ? then it's part of the window
+		if rs.CreationTimestamp.Before(&stableRS.CreationTimestamp) &&
+			newRS.CreationTimestamp.Before(&rs.CreationTimestamp) {
+			windowSize = windowSize + 1
+		}
+	}
+	return windowSize
+}
+
+// IsRollbackWithinWindow returns whether the new ReplicaSet is a rollback to one of the previous revisions of the
+// rollback window of the rollout, along with the size of the window the rollback spans. The size is -1 when the new
+// ReplicaSet is not a rollback to a previous revision or the rollout has no rollback window.
+func IsRollbackWithinWindow(rollout *v1alpha1.Rollout, newRS, stableRS *appsv1.ReplicaSet, allRSs []*appsv1.ReplicaSet) (bool, int32) {
+	if !IsRollbackToPreviousRevision(newRS, stableRS) {
+		return false, -1
+	}
+	if rollout.Spec.RollbackWindow == nil || rollout.Spec.RollbackWindow.Revisions <= 0 {
+		return false, -1
+	}
+	windowSize := RollbackWindowSize(newRS, stableRS, allRSs)
+	return windowSize < rollout.Spec.RollbackWindow.Revisions, windowSize
+}
+
 func GetReplicaSetRevision(ro *v1alpha1.Rollout, rs *appsv1.ReplicaSet) int {
 	logCtx := logutil.WithRollout(ro).WithField("ReplicaSet", rs.Name)
 	revisionStr, ok := rs.Annotations[annotations.RevisionAnnotation]
@@ -788,6 +788,57 @@ func TestReplicaSetsByRevisionNumber(t *testing.T) {
 	})
 }
 
+func TestIsRollback(t *testing.T) {
+	now := metav1.Now()
+	ro := &v1alpha1.Rollout{Status: v1alpha1.RolloutStatus{StableRS: "stable"}}
+	older := rs(1, metav1.NewTime(now.Add(-2*time.Hour)))
+	older.Labels = map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "older"}
+	stable := rs(1, metav1.NewTime(now.Add(-time.Hour)))
+	stable.Labels = map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "stable"}
+	newer := rs(1, now)
+	newer.Labels = map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: "newer"}
+
+	assert.True(t, IsRollback(ro, stable, stable))
+	assert.True(t, IsRollback(ro, older, stable))
+	assert.False(t, IsRollback(ro, newer, stable))
+	assert.False(t, IsRollback(ro, nil, stable))
+	assert.False(t, IsRollback(ro, older, nil))
+	assert.True(t, IsRollbackToPreviousRevision(older, stable))
+	assert.False(t, IsRollbackToPreviousRevision(stable, stable))
+}
+
+func TestIsRollbackWithinWindow(t *testing.T) {
+	now := metav1.Now()
+	ro := &v1alpha1.Rollout{}
+	rs1 := rs(1, metav1.NewTime(now.Add(-4*time.Hour)))
+	rs2 := rs(1, metav1.NewTime(now.Add(-3*time.Hour)))
+	experimentRS := rs(1, metav1.NewTime(now.Add(-2*time.Hour)))
+	experimentRS.Annotations = map[string]string{v1alpha1.ExperimentNameAnnotationKey: "experiment"}
+	stable := rs(1, metav1.NewTime(now.Add(-time.Hour)))
+	allRSs := []*appsv1.ReplicaSet{rs1, rs2, experimentRS, stable}
+
+	assert.Equal(t, int32(1), RollbackWindowSize(rs1, stable, allRSs))
+	assert.Equal(t, int32(0), RollbackWindowSize(rs2, stable, allRSs))
+	withinWindow, windowSize := IsRollbackWithinWindow(ro, rs2, stable, allRSs)
+	assert.False(t, withinWindow)
+	assert.Equal(t, int32(-1), windowSize)
+
+	ro.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+	withinWindow, windowSize = IsRollbackWithinWindow(ro, rs2, stable, allRSs)
+	assert.True(t, withinWindow)
+	assert.Equal(t, int32(0), windowSize)
+	withinWindow, windowSize = IsRollbackWithinWindow(ro, rs1, stable, allRSs)
+	assert.False(t, withinWindow)
+	assert.Equal(t, int32(1), windowSize)
+	withinWindow, windowSize = IsRollbackWithinWindow(ro, stable, stable, allRSs)
+	assert.False(t, withinWindow)
+	assert.Equal(t, int32(-1), windowSize)
+
+	ro.Spec.RollbackWindow.Revisions = 2
+	withinWindow, _ = IsRollbackWithinWindow(ro, rs1, stable, allRSs)
+	assert.True(t, withinWindow)
+}
+
 func TestGetReplicaSetRevision(t *testing.T) {
 	ro := &v1alpha1.Rollout{
 		ObjectMeta: metav1.ObjectMeta{
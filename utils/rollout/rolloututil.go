@@ -3,8 +3,12 @@ package rollout
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 
@@ -22,6 +26,16 @@ func IsFullyPromoted(ro *v1alpha1.Rollout) bool {
 	return ro.Status.StableRS == ro.Status.CurrentPodHash
 }
 
+// IsStatefulSetWorkload returns whether the rollout references a StatefulSet, which it updates by driving the
+// partition of the StatefulSet instead of managing ReplicaSets
+func IsStatefulSetWorkload(ro *v1alpha1.Rollout) bool {
+	ref := ro.Spec.WorkloadRef
+	if ref == nil || ref.Kind != "StatefulSet" {
+		return false
+	}
+	return schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind).Group == appsv1.GroupName
+}
+
 // IsDynamicallyRollingBackToStable returns true when the rollout was in a canary update with
 // dynamic stable scaling but was interrupted and is now rolling back to the stable RS.
 // It also returns the previous desired (canary) pod template hash when true.
@@ -148,12 +162,25 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 		return v1alpha1.RolloutPhasePaused, "manually paused"
 	}
 	for _, pauseCond := range ro.Status.PauseConditions {
+		if pauseCond.Reason == v1alpha1.PauseReasonDeploymentWindow && ro.Status.NextDeploymentWindow != nil {
+			return v1alpha1.RolloutPhasePaused, fmt.Sprintf("%s: next window opens at %s", pauseCond.Reason, ro.Status.NextDeploymentWindow.UTC().Format(time.RFC3339))
+		}
+		if pauseCond.Reason == v1alpha1.PauseReasonWaitingForDependencies {
+			if cond := conditions.GetRolloutCondition(ro.Status, v1alpha1.WaitingForDependencies); cond != nil {
+				return v1alpha1.RolloutPhasePaused, fmt.Sprintf("%s: %s", pauseCond.Reason, cond.Message)
+			}
+		}
 		return v1alpha1.RolloutPhasePaused, string(pauseCond.Reason)
 	}
 	if ro.Spec.RestartAt != nil && (ro.Status.RestartedAt == nil || !ro.Spec.RestartAt.Time.Equal(ro.Status.RestartedAt.Time)) {
 		return v1alpha1.RolloutPhaseProgressing, "rollout is restarting"
 	}
-	if ro.Status.UpdatedReplicas < defaults.GetReplicasOrDefault(ro.Spec.Replicas) {
+	replicas := defaults.GetReplicasOrDefault(ro.Spec.Replicas)
+	if ro.Spec.Strategy.DaemonSet != nil {
+		// a DaemonSet runs one pod on each of its nodes
+		replicas = ro.Status.DaemonSet.Nodes
+	}
+	if ro.Status.UpdatedReplicas < replicas {
 		return v1alpha1.RolloutPhaseProgressing, "more replicas need to be updated"
 	}
 	if ro.Status.AvailableReplicas < ro.Status.UpdatedReplicas {
@@ -185,6 +212,13 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 		if ro.Status.StableRS == "" || !IsFullyPromoted(&ro) {
 			return v1alpha1.RolloutPhaseProgressing, "waiting for all steps to complete"
 		}
+	} else if ro.Spec.Strategy.DaemonSet != nil {
+		if ro.Status.Replicas > ro.Status.UpdatedReplicas {
+			return v1alpha1.RolloutPhaseProgressing, "old pods are pending replacement"
+		}
+		if ro.Status.StableRS == "" || !IsFullyPromoted(&ro) {
+			return v1alpha1.RolloutPhaseProgressing, "waiting for all steps to complete"
+		}
 	}
 	return v1alpha1.RolloutPhaseHealthy, ""
 }
@@ -204,6 +238,15 @@ func CanaryStepString(c v1alpha1.CanaryStep) string {
 	if c.SetWeight != nil {
 		return fmt.Sprintf("setWeight: %d", *c.SetWeight)
 	}
+	if c.SetWeightRamp != nil && c.SetWeightRamp.Analysis != nil {
+		return fmt.Sprintf("setWeightRamp: %d-%d by %d-%d every %s", c.SetWeightRamp.From, c.SetWeightRamp.To, c.SetWeightRamp.Analysis.MinIncrement, c.SetWeightRamp.Analysis.MaxIncrement, c.SetWeightRamp.Interval)
+	}
+	if c.SetWeightRamp != nil {
+		return fmt.Sprintf("setWeightRamp: %d-%d by %d every %s", c.SetWeightRamp.From, c.SetWeightRamp.To, c.SetWeightRamp.Increment, c.SetWeightRamp.Interval)
+	}
+	if c.SetClusterWeight != nil {
+		return fmt.Sprintf("setClusterWeight: %d in %s", c.SetClusterWeight.Weight, strings.Join(c.SetClusterWeight.Clusters, ","))
+	}
 	if c.Pause != nil {
 		str := "pause"
 		if c.Pause.Duration != nil {
@@ -238,6 +281,23 @@ func CanaryStepString(c v1alpha1.CanaryStep) string {
 	return "invalid"
 }
 
+// DaemonSetStepString returns a string representation of a DaemonSet step
+func DaemonSetStepString(s v1alpha1.DaemonSetStep) string {
+	if s.Cohort != nil && s.Cohort.Percentage != nil {
+		return fmt.Sprintf("cohort: %d%%", *s.Cohort.Percentage)
+	}
+	if s.Cohort != nil && s.Cohort.NodeSelector != nil {
+		return fmt.Sprintf("cohort: %s", metav1.FormatLabelSelector(s.Cohort.NodeSelector))
+	}
+	if s.Pause != nil {
+		return CanaryStepString(v1alpha1.CanaryStep{Pause: s.Pause})
+	}
+	if s.Analysis != nil {
+		return "analysis"
+	}
+	return "invalid"
+}
+
 // ShouldVerifyWeight We use this to test if we should verify weights because weight verification could involve
 // API calls to the cloud provider which could incur rate limiting
 func ShouldVerifyWeight(ro *v1alpha1.Rollout, desiredWeight int32) bool {
@@ -245,7 +305,7 @@ func ShouldVerifyWeight(ro *v1alpha1.Rollout, desiredWeight int32) bool {
 	// If we are in the middle of an update at a setWeight step, also perform weight verification.
 	// Note that we don't do this every reconciliation because weight verification typically involves
 	// API calls to the cloud provider which could incur rate limitingq
-	shouldVerifyWeight := (ro.Status.StableRS != "" && !IsFullyPromoted(ro) && currentStep != nil && currentStep.SetWeight != nil) ||
+	shouldVerifyWeight := (ro.Status.StableRS != "" && !IsFullyPromoted(ro) && currentStep != nil && (currentStep.SetWeight != nil || currentStep.SetWeightRamp != nil)) ||
 		(ro.Status.StableRS != "" && !IsFullyPromoted(ro) && currentStep == nil && desiredWeight == weightutil.MaxTrafficWeight(ro)) // We are at end of rollout
 
 	return shouldVerifyWeight
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/tj/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
@@ -93,6 +94,16 @@ func TestIsFullyPromoted(t *testing.T) {
 	}
 }
 
+func TestIsStatefulSetWorkload(t *testing.T) {
+	newRollout := func(ref *v1alpha1.ObjectRef) *v1alpha1.Rollout {
+		return &v1alpha1.Rollout{Spec: v1alpha1.RolloutSpec{WorkloadRef: ref}}
+	}
+	assert.False(t, IsStatefulSetWorkload(newRollout(nil)))
+	assert.False(t, IsStatefulSetWorkload(newRollout(&v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "guestbook"})))
+	assert.False(t, IsStatefulSetWorkload(newRollout(&v1alpha1.ObjectRef{APIVersion: "example.com/v1", Kind: "StatefulSet", Name: "guestbook"})))
+	assert.True(t, IsStatefulSetWorkload(newRollout(&v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "guestbook"})))
+}
+
 func TestRolloutStatusDegraded(t *testing.T) {
 	ro := newCanaryRollout()
 	ro.Status.Conditions = append(ro.Status.Conditions, v1alpha1.RolloutCondition{
@@ -358,6 +369,18 @@ func TestCanaryStepString(t *testing.T) {
 			step:           v1alpha1.CanaryStep{SetWeight: ptr.To[int32](20)},
 			expectedString: "setWeight: 20",
 		},
+		{
+			step:           v1alpha1.CanaryStep{SetWeightRamp: &v1alpha1.SetWeightRamp{From: 10, To: 50, Increment: 5, Interval: "2m"}},
+			expectedString: "setWeightRamp: 10-50 by 5 every 2m",
+		},
+		{
+			step:           v1alpha1.CanaryStep{SetWeightRamp: &v1alpha1.SetWeightRamp{From: 10, To: 50, Interval: "2m", Analysis: &v1alpha1.WeightRampAnalysis{MetricName: "headroom", MinIncrement: 2, MaxIncrement: 10}}},
+			expectedString: "setWeightRamp: 10-50 by 2-10 every 2m",
+		},
+		{
+			step:           v1alpha1.CanaryStep{SetClusterWeight: &v1alpha1.SetClusterWeight{Clusters: []string{"prod-eu", "prod-us"}, Weight: 25}},
+			expectedString: "setClusterWeight: 25 in prod-eu,prod-us",
+		},
 		{
 			step:           v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}},
 			expectedString: "pause",
@@ -428,6 +451,103 @@ func TestIsUnpausing(t *testing.T) {
 	assert.Equal(t, "waiting for rollout to unpause", message)
 }
 
+func TestCalculateRolloutPhaseDeploymentWindow(t *testing.T) {
+	ro := newCanaryRollout()
+	ro.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason: v1alpha1.PauseReasonDeploymentWindow,
+	}}
+	status, message := CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, status)
+	assert.Equal(t, "DeploymentWindow", message)
+
+	nextWindow := metav1.NewTime(time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC))
+	ro.Status.NextDeploymentWindow = &nextWindow
+	status, message = CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, status)
+	assert.Equal(t, "DeploymentWindow: next window opens at 2024-01-02T09:00:00Z", message)
+}
+
+func TestCalculateRolloutPhaseWaitingForDependencies(t *testing.T) {
+	ro := newCanaryRollout()
+	ro.Status.PauseConditions = []v1alpha1.PauseCondition{{
+		Reason: v1alpha1.PauseReasonWaitingForDependencies,
+	}}
+	status, message := CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, status)
+	assert.Equal(t, "WaitingForDependencies", message)
+
+	ro.Status.Conditions = []v1alpha1.RolloutCondition{{
+		Type:    v1alpha1.WaitingForDependencies,
+		Status:  corev1.ConditionTrue,
+		Message: "Rollout 'default/backend-api' is Progressing, waiting for Healthy",
+	}}
+	status, message = CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, status)
+	assert.Equal(t, "WaitingForDependencies: Rollout 'default/backend-api' is Progressing, waiting for Healthy", message)
+}
+
+func TestCalculateRolloutPhaseDaemonSet(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				DaemonSet: &v1alpha1.DaemonSetStrategy{},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentPodHash:    "new",
+			StableRS:          "stable",
+			Replicas:          3,
+			UpdatedReplicas:   1,
+			AvailableReplicas: 3,
+			DaemonSet:         v1alpha1.DaemonSetStatus{Nodes: 3},
+		},
+	}
+	status, message := CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+	assert.Equal(t, "more replicas need to be updated", message)
+
+	ro.Status.UpdatedReplicas = 3
+	status, message = CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+	assert.Equal(t, "waiting for all steps to complete", message)
+
+	ro.Status.StableRS = "new"
+	status, _ = CalculateRolloutPhase(ro.Spec, ro.Status)
+	assert.Equal(t, v1alpha1.RolloutPhaseHealthy, status)
+}
+
+func TestDaemonSetStepString(t *testing.T) {
+	tenS := intstr.FromString("10s")
+	tests := []struct {
+		step           v1alpha1.DaemonSetStep
+		expectedString string
+	}{
+		{
+			step:           v1alpha1.DaemonSetStep{Cohort: &v1alpha1.DaemonSetCohort{Percentage: ptr.To[int32](20)}},
+			expectedString: "cohort: 20%",
+		},
+		{
+			step:           v1alpha1.DaemonSetStep{Cohort: &v1alpha1.DaemonSetCohort{NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"zone": "a"}}}},
+			expectedString: "cohort: zone=a",
+		},
+		{
+			step:           v1alpha1.DaemonSetStep{Pause: &v1alpha1.RolloutPause{Duration: &tenS}},
+			expectedString: "pause: 10s",
+		},
+		{
+			step:           v1alpha1.DaemonSetStep{Analysis: &v1alpha1.RolloutAnalysis{}},
+			expectedString: "analysis",
+		},
+		{
+			step:           v1alpha1.DaemonSetStep{},
+			expectedString: "invalid",
+		},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expectedString, DaemonSetStepString(test.step))
+	}
+}
+
 func TestShouldVerifyWeight(t *testing.T) {
 	ro := newCanaryRollout()
 	ro.Status.StableRS = "34feab23f"
@@ -32,6 +32,14 @@ func SetNowTimeFunc(f func() time.Time) {
 
 }
 
+// GetNowTimeFunc returns the function used to return the current time, so that it can be restored once replaced
+func GetNowTimeFunc() func() time.Time {
+	nowLock.RLock()
+	defer nowLock.RUnlock()
+
+	return timeNowFunc
+}
+
 // MetaNow is a wrapper around metav1.Now() and used to override behavior in tests.
 var MetaNow = func() metav1.Time {
 	return metav1.Time{Time: Now()}
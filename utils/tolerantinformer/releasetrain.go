@@ -0,0 +1,61 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantReleaseTrainInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.ReleaseTrainInformer {
+	delegate := factory.ForResource(v1alpha1.ReleaseTrainGVR)
+	newFn := func() *v1alpha1.ReleaseTrain { return &v1alpha1.ReleaseTrain{} }
+	transform := makeTransform(newFn)
+	installTransform(delegate.Informer(), transform, "ReleaseTrain")
+	return &tolerantReleaseTrainInformer{delegate: delegate, transform: transform, newFn: newFn}
+}
+
+type tolerantReleaseTrainInformer struct {
+	delegate  informers.GenericInformer
+	transform cache.TransformFunc
+	newFn     func() *v1alpha1.ReleaseTrain
+}
+
+func (i *tolerantReleaseTrainInformer) Informer() cache.SharedIndexInformer {
+	return &transformingInformer{SharedIndexInformer: i.delegate.Informer(), transform: i.transform}
+}
+
+func (i *tolerantReleaseTrainInformer) Lister() rolloutlisters.ReleaseTrainLister {
+	return &tolerantReleaseTrainLister{indexer: i.delegate.Informer().GetIndexer(), newFn: i.newFn}
+}
+
+type tolerantReleaseTrainLister struct {
+	indexer cache.Indexer
+	newFn   func() *v1alpha1.ReleaseTrain
+}
+
+func (t *tolerantReleaseTrainLister) List(selector labels.Selector) ([]*v1alpha1.ReleaseTrain, error) {
+	return listTyped(t.indexer, "", selector, t.newFn)
+}
+
+func (t *tolerantReleaseTrainLister) ReleaseTrains(namespace string) rolloutlisters.ReleaseTrainNamespaceLister {
+	return &tolerantReleaseTrainNamespaceLister{indexer: t.indexer, namespace: namespace, newFn: t.newFn}
+}
+
+type tolerantReleaseTrainNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+	newFn     func() *v1alpha1.ReleaseTrain
+}
+
+func (t *tolerantReleaseTrainNamespaceLister) Get(name string) (*v1alpha1.ReleaseTrain, error) {
+	return getTyped(t.indexer, v1alpha1.Resource("releasetrain"), t.namespace, name, t.newFn)
+}
+
+func (t *tolerantReleaseTrainNamespaceLister) List(selector labels.Selector) ([]*v1alpha1.ReleaseTrain, error) {
+	return listTyped(t.indexer, t.namespace, selector, t.newFn)
+}
@@ -0,0 +1,47 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantRolloutScheduleInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.RolloutScheduleInformer {
+	delegate := factory.ForResource(v1alpha1.RolloutScheduleGVR)
+	newFn := func() *v1alpha1.RolloutSchedule { return &v1alpha1.RolloutSchedule{} }
+	transform := makeTransform(newFn)
+	installTransform(delegate.Informer(), transform, "RolloutSchedule")
+	return &tolerantRolloutScheduleInformer{delegate: delegate, transform: transform, newFn: newFn}
+}
+
+type tolerantRolloutScheduleInformer struct {
+	delegate  informers.GenericInformer
+	transform cache.TransformFunc
+	newFn     func() *v1alpha1.RolloutSchedule
+}
+
+func (i *tolerantRolloutScheduleInformer) Informer() cache.SharedIndexInformer {
+	return &transformingInformer{SharedIndexInformer: i.delegate.Informer(), transform: i.transform}
+}
+
+func (i *tolerantRolloutScheduleInformer) Lister() rolloutlisters.RolloutScheduleLister {
+	return &tolerantRolloutScheduleLister{indexer: i.delegate.Informer().GetIndexer(), newFn: i.newFn}
+}
+
+type tolerantRolloutScheduleLister struct {
+	indexer cache.Indexer
+	newFn   func() *v1alpha1.RolloutSchedule
+}
+
+func (t *tolerantRolloutScheduleLister) List(selector labels.Selector) ([]*v1alpha1.RolloutSchedule, error) {
+	return listTyped(t.indexer, "", selector, t.newFn)
+}
+
+func (t *tolerantRolloutScheduleLister) Get(name string) (*v1alpha1.RolloutSchedule, error) {
+	return getTyped(t.indexer, v1alpha1.Resource("rolloutschedule"), "", name, t.newFn)
+}
@@ -85,6 +85,24 @@ func ObjectToExperiment(obj any) *v1alpha1.Experiment {
 	return ex
 }
 
+func ObjectToReleaseTrain(obj any) *v1alpha1.ReleaseTrain {
+	un, ok := obj.(*unstructured.Unstructured)
+	if ok {
+		var rt v1alpha1.ReleaseTrain
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(un.Object, &rt)
+		if err != nil {
+			log.Warnf("Failed to convert ReleaseTrain from Unstructured object: %v", err)
+			return nil
+		}
+		return &rt
+	}
+	rt, ok := obj.(*v1alpha1.ReleaseTrain)
+	if !ok {
+		log.Warn("Object is neither a release train or unstructured")
+	}
+	return rt
+}
+
 var diffSeparator = regexp.MustCompile(`\n---`)
 
 // SplitYAML splits a YAML file into unstructured objects. Returns list of all unstructured objects